@@ -0,0 +1,11 @@
+package actionerror
+
+// CanaryUnhealthyError is returned when a canary deployment is canceled
+// because the canary instances did not stay healthy.
+type CanaryUnhealthyError struct {
+	Reason string
+}
+
+func (e CanaryUnhealthyError) Error() string {
+	return "Canary deployment canceled: " + e.Reason
+}
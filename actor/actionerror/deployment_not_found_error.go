@@ -9,3 +9,16 @@ type ActiveDeploymentNotFoundError struct {
 func (e ActiveDeploymentNotFoundError) Error() string {
 	return "No active deployment found for app."
 }
+
+// DeploymentNotFoundError is returned when a deployment of an app cannot be
+// found. GUID is empty when the app has no deployments at all.
+type DeploymentNotFoundError struct {
+	GUID string
+}
+
+func (e DeploymentNotFoundError) Error() string {
+	if e.GUID == "" {
+		return "No deployments found for app."
+	}
+	return "Deployment '" + e.GUID + "' not found for app."
+}
@@ -0,0 +1,19 @@
+package actionerror
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// ServiceInstanceOperationFailedError is returned when the last operation of
+// a service instance has failed.
+type ServiceInstanceOperationFailedError struct {
+	Name        string
+	Operation   resources.LastOperationType
+	Description string
+}
+
+func (e ServiceInstanceOperationFailedError) Error() string {
+	return fmt.Sprintf("The %s operation of service instance '%s' failed: %s", e.Operation, e.Name, e.Description)
+}
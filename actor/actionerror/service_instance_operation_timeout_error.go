@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// ServiceInstanceOperationTimeoutError is returned when the polling timeout
+// is reached waiting for the last operation of a service instance to finish.
+type ServiceInstanceOperationTimeoutError struct {
+	Name string
+}
+
+func (e ServiceInstanceOperationTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for service instance '%s' to finish its last operation", e.Name)
+}
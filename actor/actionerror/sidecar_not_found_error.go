@@ -0,0 +1,12 @@
+package actionerror
+
+import "fmt"
+
+// SidecarNotFoundError is returned when a requested sidecar is not found.
+type SidecarNotFoundError struct {
+	Name string
+}
+
+func (e SidecarNotFoundError) Error() string {
+	return fmt.Sprintf("Sidecar '%s' not found.", e.Name)
+}
@@ -2,6 +2,8 @@
 // controller
 package sharedaction
 
+import "code.cloudfoundry.org/clock"
+
 type AuthActor interface {
 	IsLoggedIn() bool
 }
@@ -10,6 +12,13 @@ type AuthActor interface {
 type Actor struct {
 	Config Config
 	AuthActor
+
+	// PushCache, when set, saves GatherDirectoryResources from hashing files
+	// that have not changed since the last push.
+	PushCache *PushCache
+
+	// Clock is used to wait between checks for changes to files.
+	Clock clock.Clock
 }
 
 // NewActor returns an Actor with default settings
@@ -22,5 +31,6 @@ func NewActor(config Config) *Actor {
 	return &Actor{
 		AuthActor: authActor,
 		Config:    config,
+		Clock:     clock.NewClock(),
 	}
 }
@@ -0,0 +1,82 @@
+package sharedaction
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	logcache "code.cloudfoundry.org/go-log-cache/v2"
+	"code.cloudfoundry.org/go-log-cache/v2/rpc/logcache_v1"
+)
+
+const (
+	httpTimerName       = "http"
+	httpStatusCodeTag   = "status_code"
+	httpProcessIDTag    = "process_id"
+	httpTimerPageLength = 1000
+)
+
+// HTTPRequestStats summarizes the HTTP requests routed to an app.
+type HTTPRequestStats struct {
+	Requests     int
+	ServerErrors int
+}
+
+// ErrorRate returns the percentage of requests that failed with a 5xx status
+// code, or 0 when no requests were made.
+func (stats HTTPRequestStats) ErrorRate() float64 {
+	if stats.Requests == 0 {
+		return 0
+	}
+	return float64(stats.ServerErrors) * 100 / float64(stats.Requests)
+}
+
+// GetHTTPRequestStatsSince counts the HTTP requests routed to the app since
+// the given time, and how many of them failed with a 5xx status code, using
+// the http timer envelopes stored in Log Cache. When processGUIDs are given,
+// only the requests routed to instances of those processes are counted, going
+// by the process_id tag of the envelopes.
+func GetHTTPRequestStatsSince(appGUID string, processGUIDs []string, client LogCacheClient, since time.Time) (HTTPRequestStats, error) {
+	processes := map[string]bool{}
+	for _, processGUID := range processGUIDs {
+		processes[processGUID] = true
+	}
+
+	var stats HTTPRequestStats
+	start := since
+
+	for {
+		envelopes, err := client.Read(
+			context.Background(),
+			appGUID,
+			start,
+			logcache.WithEnvelopeTypes(logcache_v1.EnvelopeType_TIMER),
+			logcache.WithLimit(httpTimerPageLength),
+		)
+		if err != nil {
+			return HTTPRequestStats{}, fmt.Errorf("Failed to retrieve HTTP metrics from Log Cache: %s", err)
+		}
+
+		for _, envelope := range envelopes {
+			timer := envelope.GetTimer()
+			if timer == nil || timer.GetName() != httpTimerName {
+				continue
+			}
+			if len(processes) > 0 && !processes[envelope.GetTags()[httpProcessIDTag]] {
+				continue
+			}
+
+			stats.Requests++
+			statusCode, err := strconv.Atoi(envelope.GetTags()[httpStatusCodeTag])
+			if err == nil && statusCode >= 500 && statusCode < 600 {
+				stats.ServerErrors++
+			}
+		}
+
+		if len(envelopes) < httpTimerPageLength {
+			return stats, nil
+		}
+		start = time.Unix(0, envelopes[len(envelopes)-1].GetTimestamp()+1)
+	}
+}
@@ -0,0 +1,141 @@
+package sharedaction_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HTTP metrics actions", func() {
+	var fakeLogCacheClient *sharedactionfakes.FakeLogCacheClient
+
+	httpTimer := func(timestamp int64, statusCode string) *loggregator_v2.Envelope {
+		return &loggregator_v2.Envelope{
+			Timestamp: timestamp,
+			SourceId:  "some-app-guid",
+			Tags:      map[string]string{"status_code": statusCode},
+			Message: &loggregator_v2.Envelope_Timer{
+				Timer: &loggregator_v2.Timer{Name: "http"},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+	})
+
+	Describe("HTTPRequestStats", func() {
+		Describe("ErrorRate", func() {
+			It("returns the percentage of server errors", func() {
+				Expect(sharedaction.HTTPRequestStats{Requests: 8, ServerErrors: 2}.ErrorRate()).To(Equal(25.0))
+			})
+
+			It("returns 0 when there were no requests", func() {
+				Expect(sharedaction.HTTPRequestStats{}.ErrorRate()).To(Equal(0.0))
+			})
+		})
+	})
+
+	Describe("GetHTTPRequestStatsSince", func() {
+		var (
+			since        time.Time
+			processGUIDs []string
+			stats        sharedaction.HTTPRequestStats
+			err          error
+		)
+
+		BeforeEach(func() {
+			since = time.Unix(0, 100)
+			processGUIDs = nil
+		})
+
+		JustBeforeEach(func() {
+			stats, err = sharedaction.GetHTTPRequestStatsSince("some-app-guid", processGUIDs, fakeLogCacheClient, since)
+		})
+
+		When("log cache returns http timers", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+					httpTimer(101, "200"),
+					httpTimer(102, "503"),
+					httpTimer(103, "404"),
+					httpTimer(104, "500"),
+					{
+						Timestamp: 105,
+						Message: &loggregator_v2.Envelope_Timer{
+							Timer: &loggregator_v2.Timer{Name: "some-other-timer"},
+						},
+					},
+				}, nil)
+			})
+
+			It("counts the requests and server errors", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stats).To(Equal(sharedaction.HTTPRequestStats{Requests: 4, ServerErrors: 2}))
+
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(1))
+				_, sourceID, start, _ := fakeLogCacheClient.ReadArgsForCall(0)
+				Expect(sourceID).To(Equal("some-app-guid"))
+				Expect(start).To(Equal(since))
+			})
+		})
+
+		When("process GUIDs are given", func() {
+			BeforeEach(func() {
+				processGUIDs = []string{"canary-process-guid"}
+
+				fromProcess := func(envelope *loggregator_v2.Envelope, processGUID string) *loggregator_v2.Envelope {
+					envelope.Tags["process_id"] = processGUID
+					return envelope
+				}
+				fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+					fromProcess(httpTimer(101, "200"), "canary-process-guid"),
+					fromProcess(httpTimer(102, "503"), "canary-process-guid"),
+					fromProcess(httpTimer(103, "500"), "old-process-guid"),
+					fromProcess(httpTimer(104, "502"), "old-process-guid"),
+					httpTimer(105, "500"),
+				}, nil)
+			})
+
+			It("only counts the requests routed to instances of those processes", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stats).To(Equal(sharedaction.HTTPRequestStats{Requests: 2, ServerErrors: 1}))
+			})
+		})
+
+		When("there are more timers than fit in a single page", func() {
+			BeforeEach(func() {
+				var page []*loggregator_v2.Envelope
+				for i := 0; i < 1000; i++ {
+					page = append(page, httpTimer(int64(101+i), "200"))
+				}
+				fakeLogCacheClient.ReadReturnsOnCall(0, page, nil)
+				fakeLogCacheClient.ReadReturnsOnCall(1, []*loggregator_v2.Envelope{httpTimer(2000, "502")}, nil)
+			})
+
+			It("reads the next page starting after the last envelope", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stats).To(Equal(sharedaction.HTTPRequestStats{Requests: 1001, ServerErrors: 1}))
+
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(2))
+				_, _, start, _ := fakeLogCacheClient.ReadArgsForCall(1)
+				Expect(start).To(Equal(time.Unix(0, 1101)))
+			})
+		})
+
+		When("log cache returns an error", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns(nil, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("Failed to retrieve HTTP metrics from Log Cache: some-error"))
+			})
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"time"
 
@@ -66,6 +67,46 @@ func NewLogMessage(message string, messageType string, timestamp time.Time, sour
 
 type LogMessages []*LogMessage
 
+// LogFilter restricts the log messages that are displayed. The zero value
+// matches every message.
+type LogFilter struct {
+	// SourceTypes matches the leading segment of a message's source type, so
+	// APP matches APP/PROC/WEB and APP/TASK/migrate alike.
+	SourceTypes []string
+	// Instance matches the message's source instance exactly when set.
+	Instance string
+	// Pattern matches against the message body when set.
+	Pattern *regexp.Regexp
+}
+
+// Matches returns true if the log message passes every criteria set on the
+// filter.
+func (filter LogFilter) Matches(log LogMessage) bool {
+	if len(filter.SourceTypes) > 0 {
+		sourceType := strings.SplitN(log.sourceType, "/", 2)[0]
+		found := false
+		for _, filterType := range filter.SourceTypes {
+			if strings.EqualFold(sourceType, filterType) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if filter.Instance != "" && filter.Instance != log.sourceInstance {
+		return false
+	}
+
+	if filter.Pattern != nil && !filter.Pattern.MatchString(log.message) {
+		return false
+	}
+
+	return true
+}
+
 func (lm LogMessages) Len() int { return len(lm) }
 
 func (lm LogMessages) Less(i, j int) bool {
@@ -122,6 +163,13 @@ func (b *cliRetryBackoff) Reset() {
 }
 
 func GetStreamingLogs(appGUID string, client LogCacheClient) (<-chan LogMessage, <-chan error, context.CancelFunc) {
+	return GetStreamingLogsSince(appGUID, client, time.Time{})
+}
+
+// GetStreamingLogsSince tails the logs of the given app starting at the
+// provided time. When since is zero, tailing starts just before the most
+// recent envelope in Log Cache.
+func GetStreamingLogsSince(appGUID string, client LogCacheClient, since time.Time) (<-chan LogMessage, <-chan error, context.CancelFunc) {
 
 	logrus.Info("Start Tailing Logs")
 
@@ -132,15 +180,18 @@ func GetStreamingLogs(appGUID string, client LogCacheClient) (<-chan LogMessage,
 		defer close(outgoingLogStream)
 		defer close(outgoingErrStream)
 
-		ts := latestEnvelopeTimestamp(client, outgoingErrStream, ctx, appGUID)
+		walkStartTime := since
+		if walkStartTime.IsZero() {
+			ts := latestEnvelopeTimestamp(client, outgoingErrStream, ctx, appGUID)
 
-		// if the context was cancelled we may not have seen an envelope
-		if ts.IsZero() {
-			return
-		}
+			// if the context was cancelled we may not have seen an envelope
+			if ts.IsZero() {
+				return
+			}
 
-		const offset = 1 * time.Second
-		walkStartTime := ts.Add(-offset)
+			const offset = 1 * time.Second
+			walkStartTime = ts.Add(-offset)
+		}
 
 		logcache.Walk(
 			ctx,
@@ -209,6 +260,12 @@ func latestEnvelopeTimestamp(client LogCacheClient, errs chan error, ctx context
 }
 
 func GetRecentLogs(appGUID string, client LogCacheClient) ([]LogMessage, error) {
+	return GetRecentLogsSince(appGUID, client, time.Time{})
+}
+
+// GetRecentLogsSince returns up to RecentLogsLines of the most recent logs of
+// the given app that were emitted at or after since.
+func GetRecentLogsSince(appGUID string, client LogCacheClient, since time.Time) ([]LogMessage, error) {
 	logLineRequestCount := RecentLogsLines
 	var envelopes []*loggregator_v2.Envelope
 	var err error
@@ -217,7 +274,7 @@ func GetRecentLogs(appGUID string, client LogCacheClient) ([]LogMessage, error)
 		envelopes, err = client.Read(
 			context.Background(),
 			appGUID,
-			time.Time{},
+			since,
 			logcache.WithEnvelopeTypes(logcache_v1.EnvelopeType_LOG),
 			logcache.WithLimit(logLineRequestCount),
 			logcache.WithDescending(),
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"regexp"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -55,6 +56,116 @@ var _ = Describe("Logging Actions", func() {
 		})
 	})
 
+	Describe("LogFilter", func() {
+		var (
+			filter  sharedaction.LogFilter
+			message sharedaction.LogMessage
+		)
+
+		BeforeEach(func() {
+			filter = sharedaction.LogFilter{}
+			message = *sharedaction.NewLogMessage(
+				"GET /health 200",
+				"OUT",
+				time.Unix(0, 0),
+				"APP/PROC/WEB",
+				"1",
+			)
+		})
+
+		When("no criteria are set", func() {
+			It("matches every message", func() {
+				Expect(filter.Matches(message)).To(BeTrue())
+			})
+		})
+
+		When("source types are set", func() {
+			It("matches the leading segment of the source type case-insensitively", func() {
+				filter.SourceTypes = []string{"RTR", "app"}
+				Expect(filter.Matches(message)).To(BeTrue())
+
+				filter.SourceTypes = []string{"RTR", "STG"}
+				Expect(filter.Matches(message)).To(BeFalse())
+
+				filter.SourceTypes = []string{"PROC"}
+				Expect(filter.Matches(message)).To(BeFalse())
+			})
+		})
+
+		When("an instance is set", func() {
+			It("matches the source instance exactly", func() {
+				filter.Instance = "1"
+				Expect(filter.Matches(message)).To(BeTrue())
+
+				filter.Instance = "0"
+				Expect(filter.Matches(message)).To(BeFalse())
+			})
+		})
+
+		When("a pattern is set", func() {
+			It("matches against the message body", func() {
+				filter.Pattern = regexp.MustCompile(`/health \d+`)
+				Expect(filter.Matches(message)).To(BeTrue())
+
+				filter.Pattern = regexp.MustCompile(`^POST`)
+				Expect(filter.Matches(message)).To(BeFalse())
+			})
+		})
+
+		When("several criteria are set", func() {
+			It("requires all of them to match", func() {
+				filter.SourceTypes = []string{"APP"}
+				filter.Instance = "1"
+				filter.Pattern = regexp.MustCompile("health")
+				Expect(filter.Matches(message)).To(BeTrue())
+
+				filter.Instance = "2"
+				Expect(filter.Matches(message)).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("GetStreamingLogsSince", func() {
+		var (
+			messages      <-chan sharedaction.LogMessage
+			errs          <-chan error
+			stopStreaming context.CancelFunc
+			since         time.Time
+			readStarts    []time.Time
+		)
+
+		BeforeEach(func() {
+			since = time.Now().Add(-time.Hour)
+			readStarts = nil
+
+			fakeLogCacheClient.ReadStub = func(
+				ctx context.Context,
+				sourceID string,
+				start time.Time,
+				opts ...logcache.ReadOption,
+			) ([]*loggregator_v2.Envelope, error) {
+				readStarts = append(readStarts, start)
+				stopStreaming()
+				return []*loggregator_v2.Envelope{}, ctx.Err()
+			}
+		})
+
+		JustBeforeEach(func() {
+			messages, errs, stopStreaming = sharedaction.GetStreamingLogsSince("some-app-guid", fakeLogCacheClient, since)
+		})
+
+		AfterEach(func() {
+			Eventually(messages).Should(BeClosed())
+			Eventually(errs).Should(BeClosed())
+		})
+
+		It("starts walking at the provided time without peeking at the latest envelope", func() {
+			Eventually(messages).Should(BeClosed())
+			Expect(readStarts).ToNot(BeEmpty())
+			Expect(readStarts[0]).To(BeTemporally("==", since))
+		})
+	})
+
 	Describe("GetStreamingLogs", func() {
 		var (
 			expectedAppGUID string
@@ -248,6 +359,19 @@ var _ = Describe("Logging Actions", func() {
 		})
 	})
 
+	Describe("GetRecentLogsSince", func() {
+		It("reads from Log Cache starting at the provided time", func() {
+			since := time.Unix(1000, 0)
+			_, err := sharedaction.GetRecentLogsSince("some-app-guid", fakeLogCacheClient, since)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(1))
+			_, sourceID, start, _ := fakeLogCacheClient.ReadArgsForCall(0)
+			Expect(sourceID).To(Equal("some-app-guid"))
+			Expect(start).To(Equal(since))
+		})
+	})
+
 	Describe("GetRecentLogs", func() {
 		When("the application can be found", func() {
 			When("Log Cache returns logs", func() {
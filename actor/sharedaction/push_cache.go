@@ -0,0 +1,214 @@
+package sharedaction
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// PushCacheFileTTL is how long a file's checksum is kept after it was
+	// last used.
+	PushCacheFileTTL = 30 * 24 * time.Hour
+
+	// PushCacheMatchTTL is how long a checksum that the API matched is
+	// assumed to still be in its resource cache.
+	PushCacheMatchTTL = 72 * time.Hour
+
+	// pushCacheRacyWindow is how recently a file must have been modified for
+	// its checksum not to be cached, since it may still change without its
+	// modification time changing.
+	pushCacheRacyWindow = 2 * time.Second
+)
+
+// PushCache keeps the checksums of pushed files between pushes, so that
+// unchanged files are not hashed again, and the checksums that each API
+// target recently matched, so that they do not have to be matched again.
+type PushCache struct {
+	path  string
+	mutex sync.Mutex
+
+	Files   map[string]CachedFile           `json:"files"`
+	Matches map[string]map[string]time.Time `json:"matches"`
+}
+
+// CachedFile is the checksum of a file as of its modification time, size
+// and mode.
+type CachedFile struct {
+	ModTime  int64       `json:"mtime"`
+	Size     int64       `json:"size"`
+	Mode     os.FileMode `json:"mode"`
+	SHA1     string      `json:"sha1"`
+	LastUsed int64       `json:"last_used"`
+}
+
+// LoadPushCache reads the push cache at path. A cache that is missing or
+// cannot be read is treated as empty.
+func LoadPushCache(path string) *PushCache {
+	cache := &PushCache{
+		path:    path,
+		Files:   map[string]CachedFile{},
+		Matches: map[string]map[string]time.Time{},
+	}
+
+	rawCache, err := os.ReadFile(path)
+	if err != nil {
+		return cache
+	}
+
+	var stored PushCache
+	if json.Unmarshal(rawCache, &stored) != nil {
+		return cache
+	}
+	if stored.Files != nil {
+		cache.Files = stored.Files
+	}
+	if stored.Matches != nil {
+		cache.Matches = stored.Matches
+	}
+	return cache
+}
+
+// FileSHA1 returns the cached checksum of the file at fullPath if the file
+// has not changed since it was cached.
+func (cache *PushCache) FileSHA1(fullPath string, info os.FileInfo, mode os.FileMode) (string, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	file, ok := cache.Files[fullPath]
+	if !ok || file.ModTime != info.ModTime().UnixNano() || file.Size != info.Size() || file.Mode != mode {
+		return "", false
+	}
+
+	file.LastUsed = time.Now().Unix()
+	cache.Files[fullPath] = file
+	return file.SHA1, true
+}
+
+// StoreFileSHA1 caches the checksum of the file at fullPath.
+func (cache *PushCache) StoreFileSHA1(fullPath string, info os.FileInfo, mode os.FileMode, sha1 string) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	now := time.Now()
+	if now.Sub(info.ModTime()) < pushCacheRacyWindow {
+		return
+	}
+
+	cache.Files[fullPath] = CachedFile{
+		ModTime:  info.ModTime().UnixNano(),
+		Size:     info.Size(),
+		Mode:     mode,
+		SHA1:     sha1,
+		LastUsed: now.Unix(),
+	}
+}
+
+// RecentlyMatched returns whether target matched the checksum within
+// PushCacheMatchTTL.
+func (cache *PushCache) RecentlyMatched(target string, checksum string) bool {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	matchedAt, ok := cache.Matches[target][checksum]
+	return ok && time.Now().Sub(matchedAt) < PushCacheMatchTTL
+}
+
+// RecordMatches remembers that target matched the checksums.
+func (cache *PushCache) RecordMatches(target string, checksums []string) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if cache.Matches[target] == nil {
+		cache.Matches[target] = map[string]time.Time{}
+	}
+	now := time.Now()
+	for _, checksum := range checksums {
+		cache.Matches[target][checksum] = now
+	}
+}
+
+// ForgetMatches forgets every checksum that target matched.
+func (cache *PushCache) ForgetMatches(target string) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	delete(cache.Matches, target)
+}
+
+// Save removes expired entries and writes the cache to disk.
+func (cache *PushCache) Save() error {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	now := time.Now()
+	for path, file := range cache.Files {
+		if now.Sub(time.Unix(file.LastUsed, 0)) >= PushCacheFileTTL {
+			delete(cache.Files, path)
+		}
+	}
+	for target, matches := range cache.Matches {
+		for checksum, matchedAt := range matches {
+			if now.Sub(matchedAt) >= PushCacheMatchTTL {
+				delete(matches, checksum)
+			}
+		}
+		if len(matches) == 0 {
+			delete(cache.Matches, target)
+		}
+	}
+
+	rawCache, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(cache.path)
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := os.CreateTemp(dir, "temp-push-cache")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+
+	_, err = tempFile.Write(rawCache)
+	closeErr := tempFile.Close()
+	if err != nil {
+		return err
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+
+	return os.Rename(tempFile.Name(), cache.path)
+}
+
+// TargetMatches returns the matches of a single API target.
+func (cache *PushCache) TargetMatches(target string) *PushCacheTargetMatches {
+	return &PushCacheTargetMatches{cache: cache, target: target}
+}
+
+// PushCacheTargetMatches records and predicts the resource matches of a
+// single API target.
+type PushCacheTargetMatches struct {
+	cache  *PushCache
+	target string
+}
+
+func (matches *PushCacheTargetMatches) RecentlyMatched(checksum string) bool {
+	return matches.cache.RecentlyMatched(matches.target, checksum)
+}
+
+func (matches *PushCacheTargetMatches) RecordMatches(checksums []string) {
+	matches.cache.RecordMatches(matches.target, checksums)
+}
+
+func (matches *PushCacheTargetMatches) ForgetMatches() {
+	matches.cache.ForgetMatches(matches.target)
+}
@@ -0,0 +1,186 @@
+package sharedaction_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PushCache", func() {
+	var (
+		dir       string
+		cachePath string
+		cache     *PushCache
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "push-cache-test")
+		Expect(err).ToNot(HaveOccurred())
+		cachePath = filepath.Join(dir, ".cf", "push-cache.json")
+		cache = LoadPushCache(cachePath)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	writeFile := func(name string, contents string, modTime time.Time) os.FileInfo {
+		path := filepath.Join(dir, name)
+		Expect(os.WriteFile(path, []byte(contents), 0644)).To(Succeed())
+		Expect(os.Chtimes(path, modTime, modTime)).To(Succeed())
+		info, err := os.Stat(path)
+		Expect(err).ToNot(HaveOccurred())
+		return info
+	}
+
+	Describe("file checksums", func() {
+		var (
+			path    string
+			info    os.FileInfo
+			modTime time.Time
+		)
+
+		BeforeEach(func() {
+			path = filepath.Join(dir, "app.jar")
+			modTime = time.Now().Add(-time.Hour)
+			info = writeFile("app.jar", "some-contents", modTime)
+			cache.StoreFileSHA1(path, info, 0644, "some-sha")
+		})
+
+		It("returns the checksum of a file that has not changed", func() {
+			sha, ok := cache.FileSHA1(path, info, 0644)
+			Expect(ok).To(BeTrue())
+			Expect(sha).To(Equal("some-sha"))
+		})
+
+		It("keeps the checksums when saved and loaded again", func() {
+			Expect(cache.Save()).To(Succeed())
+
+			sha, ok := LoadPushCache(cachePath).FileSHA1(path, info, 0644)
+			Expect(ok).To(BeTrue())
+			Expect(sha).To(Equal("some-sha"))
+		})
+
+		When("the file was modified", func() {
+			It("does not return the checksum", func() {
+				info = writeFile("app.jar", "other-contents", modTime.Add(time.Second))
+				_, ok := cache.FileSHA1(path, info, 0644)
+				Expect(ok).To(BeFalse())
+			})
+		})
+
+		When("the file changed size", func() {
+			It("does not return the checksum", func() {
+				info = writeFile("app.jar", "longer-contents", modTime)
+				_, ok := cache.FileSHA1(path, info, 0644)
+				Expect(ok).To(BeFalse())
+			})
+		})
+
+		When("the file changed mode", func() {
+			It("does not return the checksum", func() {
+				_, ok := cache.FileSHA1(path, info, 0755)
+				Expect(ok).To(BeFalse())
+			})
+		})
+
+		When("the file was modified too recently to be sure it has not changed since", func() {
+			It("does not cache the checksum", func() {
+				info = writeFile("new.jar", "some-contents", time.Now())
+				cache.StoreFileSHA1(filepath.Join(dir, "new.jar"), info, 0644, "some-sha")
+
+				_, ok := cache.FileSHA1(filepath.Join(dir, "new.jar"), info, 0644)
+				Expect(ok).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("resource matches", func() {
+		BeforeEach(func() {
+			cache.RecordMatches("https://api.one.example.com", []string{"sha-1", "sha-2"})
+		})
+
+		It("predicts matches on the same target only", func() {
+			Expect(cache.RecentlyMatched("https://api.one.example.com", "sha-1")).To(BeTrue())
+			Expect(cache.RecentlyMatched("https://api.one.example.com", "sha-3")).To(BeFalse())
+			Expect(cache.RecentlyMatched("https://api.two.example.com", "sha-1")).To(BeFalse())
+		})
+
+		It("forgets the matches of a target", func() {
+			matches := cache.TargetMatches("https://api.one.example.com")
+			matches.ForgetMatches()
+			Expect(matches.RecentlyMatched("sha-1")).To(BeFalse())
+		})
+
+		When("the matches are too old", func() {
+			BeforeEach(func() {
+				cache.Matches["https://api.one.example.com"]["sha-1"] = time.Now().Add(-PushCacheMatchTTL)
+			})
+
+			It("does not predict them and drops them when saved", func() {
+				Expect(cache.RecentlyMatched("https://api.one.example.com", "sha-1")).To(BeFalse())
+
+				Expect(cache.Save()).To(Succeed())
+				loaded := LoadPushCache(cachePath)
+				Expect(loaded.Matches["https://api.one.example.com"]).To(HaveLen(1))
+				Expect(loaded.RecentlyMatched("https://api.one.example.com", "sha-2")).To(BeTrue())
+			})
+		})
+	})
+
+	When("the cache file is not valid", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Dir(cachePath), 0700)).To(Succeed())
+			Expect(os.WriteFile(cachePath, []byte("{not json"), 0600)).To(Succeed())
+		})
+
+		It("starts with an empty cache", func() {
+			loaded := LoadPushCache(cachePath)
+			Expect(loaded.Files).To(BeEmpty())
+			Expect(loaded.Matches).To(BeEmpty())
+		})
+	})
+
+	Describe("GatherDirectoryResources with a push cache", func() {
+		var (
+			actor  *Actor
+			srcDir string
+		)
+
+		BeforeEach(func() {
+			actor = NewActor(new(sharedactionfakes.FakeConfig))
+			actor.PushCache = cache
+
+			srcDir = filepath.Join(dir, "app")
+			Expect(os.Mkdir(srcDir, 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(srcDir, "app.jar"), []byte("contents-1"), 0644)).To(Succeed())
+			past := time.Now().Add(-time.Hour)
+			Expect(os.Chtimes(filepath.Join(srcDir, "app.jar"), past, past)).To(Succeed())
+		})
+
+		It("does not hash files that have not changed since the last push", func() {
+			resources, err := actor.GatherDirectoryResources(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resources).To(HaveLen(1))
+			Expect(resources[0].SHA1).To(Equal("3d3b08df46b5a2d970a5af67887e221e05b61d02"))
+
+			// Change the contents without changing the size or modification
+			// time, so that only the cache can return the original checksum.
+			info, err := os.Stat(filepath.Join(srcDir, "app.jar"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.WriteFile(filepath.Join(srcDir, "app.jar"), []byte("contents-2"), 0644)).To(Succeed())
+			Expect(os.Chtimes(filepath.Join(srcDir, "app.jar"), info.ModTime(), info.ModTime())).To(Succeed())
+
+			resources, err = actor.GatherDirectoryResources(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resources[0].SHA1).To(Equal("3d3b08df46b5a2d970a5af67887e221e05b61d02"))
+			Expect(resources[0].Size).To(BeEquivalentTo(10))
+		})
+	})
+})
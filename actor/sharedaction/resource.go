@@ -178,6 +178,16 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 			// any resource matching on symlinks.
 			resource.Mode = fixMode(info.Mode())
 		default:
+			resource.Mode = fixMode(info.Mode())
+			resource.Size = info.Size()
+
+			if actor.PushCache != nil {
+				if sha, ok := actor.PushCache.FileSHA1(fullPath, info, resource.Mode); ok {
+					resource.SHA1 = sha
+					break
+				}
+			}
+
 			// If the file is regular we want to open
 			// and calculate the sha of the file
 			file, err := os.Open(fullPath)
@@ -192,9 +202,10 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 				return err
 			}
 
-			resource.Mode = fixMode(info.Mode())
 			resource.SHA1 = fmt.Sprintf("%x", sum.Sum(nil))
-			resource.Size = info.Size()
+			if actor.PushCache != nil {
+				actor.PushCache.StoreFileSHA1(fullPath, info, resource.Mode, resource.SHA1)
+			}
 		}
 
 		resources = append(resources, resource)
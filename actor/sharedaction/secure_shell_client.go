@@ -1,6 +1,10 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/util/clissh"
+import (
+	"io"
+
+	"code.cloudfoundry.org/cli/util/clissh"
+)
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SecureShellClient
 
@@ -8,6 +12,11 @@ type SecureShellClient interface {
 	Connect(username string, passcode string, sshEndpoint string, sshHostKeyFingerprint string, skipHostValidation bool) error
 	Close() error
 	InteractiveSession(commands []string, terminalRequest clissh.TTYRequest) error
+	RunCommand(commands []string, stdout io.Writer, stderr io.Writer) error
 	LocalPortForward(localPortForwardSpecs []clissh.LocalPortForward) error
+	RemotePortForward(remotePortForwardSpecs []clissh.RemotePortForward) error
+	DynamicPortForward(dynamicPortForwardSpecs []clissh.DynamicPortForward) error
+	Upload(localPath string, remotePath string, recursive bool) error
+	Download(remotePath string, localPath string, recursive bool) error
 	Wait() error
 }
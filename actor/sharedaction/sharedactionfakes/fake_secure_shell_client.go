@@ -2,6 +2,7 @@
 package sharedactionfakes
 
 import (
+	"io"
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -34,6 +35,30 @@ type FakeSecureShellClient struct {
 	connectReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DownloadStub        func(string, string, bool) error
+	downloadMutex       sync.RWMutex
+	downloadArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}
+	downloadReturns struct {
+		result1 error
+	}
+	downloadReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DynamicPortForwardStub        func([]clissh.DynamicPortForward) error
+	dynamicPortForwardMutex       sync.RWMutex
+	dynamicPortForwardArgsForCall []struct {
+		arg1 []clissh.DynamicPortForward
+	}
+	dynamicPortForwardReturns struct {
+		result1 error
+	}
+	dynamicPortForwardReturnsOnCall map[int]struct {
+		result1 error
+	}
 	InteractiveSessionStub        func([]string, clissh.TTYRequest) error
 	interactiveSessionMutex       sync.RWMutex
 	interactiveSessionArgsForCall []struct {
@@ -57,6 +82,43 @@ type FakeSecureShellClient struct {
 	localPortForwardReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RemotePortForwardStub        func([]clissh.RemotePortForward) error
+	remotePortForwardMutex       sync.RWMutex
+	remotePortForwardArgsForCall []struct {
+		arg1 []clissh.RemotePortForward
+	}
+	remotePortForwardReturns struct {
+		result1 error
+	}
+	remotePortForwardReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RunCommandStub        func([]string, io.Writer, io.Writer) error
+	runCommandMutex       sync.RWMutex
+	runCommandArgsForCall []struct {
+		arg1 []string
+		arg2 io.Writer
+		arg3 io.Writer
+	}
+	runCommandReturns struct {
+		result1 error
+	}
+	runCommandReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UploadStub        func(string, string, bool) error
+	uploadMutex       sync.RWMutex
+	uploadArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}
+	uploadReturns struct {
+		result1 error
+	}
+	uploadReturnsOnCall map[int]struct {
+		result1 error
+	}
 	WaitStub        func() error
 	waitMutex       sync.RWMutex
 	waitArgsForCall []struct {
@@ -76,15 +138,16 @@ func (fake *FakeSecureShellClient) Close() error {
 	ret, specificReturn := fake.closeReturnsOnCall[len(fake.closeArgsForCall)]
 	fake.closeArgsForCall = append(fake.closeArgsForCall, struct {
 	}{})
+	stub := fake.CloseStub
+	fakeReturns := fake.closeReturns
 	fake.recordInvocation("Close", []interface{}{})
 	fake.closeMutex.Unlock()
-	if fake.CloseStub != nil {
-		return fake.CloseStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.closeReturns
 	return fakeReturns.result1
 }
 
@@ -133,15 +196,16 @@ func (fake *FakeSecureShellClient) Connect(arg1 string, arg2 string, arg3 string
 		arg4 string
 		arg5 bool
 	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ConnectStub
+	fakeReturns := fake.connectReturns
 	fake.recordInvocation("Connect", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.connectMutex.Unlock()
-	if fake.ConnectStub != nil {
-		return fake.ConnectStub(arg1, arg2, arg3, arg4, arg5)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.connectReturns
 	return fakeReturns.result1
 }
 
@@ -187,6 +251,135 @@ func (fake *FakeSecureShellClient) ConnectReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShellClient) Download(arg1 string, arg2 string, arg3 bool) error {
+	fake.downloadMutex.Lock()
+	ret, specificReturn := fake.downloadReturnsOnCall[len(fake.downloadArgsForCall)]
+	fake.downloadArgsForCall = append(fake.downloadArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.DownloadStub
+	fakeReturns := fake.downloadReturns
+	fake.recordInvocation("Download", []interface{}{arg1, arg2, arg3})
+	fake.downloadMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureShellClient) DownloadCallCount() int {
+	fake.downloadMutex.RLock()
+	defer fake.downloadMutex.RUnlock()
+	return len(fake.downloadArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) DownloadCalls(stub func(string, string, bool) error) {
+	fake.downloadMutex.Lock()
+	defer fake.downloadMutex.Unlock()
+	fake.DownloadStub = stub
+}
+
+func (fake *FakeSecureShellClient) DownloadArgsForCall(i int) (string, string, bool) {
+	fake.downloadMutex.RLock()
+	defer fake.downloadMutex.RUnlock()
+	argsForCall := fake.downloadArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSecureShellClient) DownloadReturns(result1 error) {
+	fake.downloadMutex.Lock()
+	defer fake.downloadMutex.Unlock()
+	fake.DownloadStub = nil
+	fake.downloadReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) DownloadReturnsOnCall(i int, result1 error) {
+	fake.downloadMutex.Lock()
+	defer fake.downloadMutex.Unlock()
+	fake.DownloadStub = nil
+	if fake.downloadReturnsOnCall == nil {
+		fake.downloadReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.downloadReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForward(arg1 []clissh.DynamicPortForward) error {
+	var arg1Copy []clissh.DynamicPortForward
+	if arg1 != nil {
+		arg1Copy = make([]clissh.DynamicPortForward, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.dynamicPortForwardMutex.Lock()
+	ret, specificReturn := fake.dynamicPortForwardReturnsOnCall[len(fake.dynamicPortForwardArgsForCall)]
+	fake.dynamicPortForwardArgsForCall = append(fake.dynamicPortForwardArgsForCall, struct {
+		arg1 []clissh.DynamicPortForward
+	}{arg1Copy})
+	stub := fake.DynamicPortForwardStub
+	fakeReturns := fake.dynamicPortForwardReturns
+	fake.recordInvocation("DynamicPortForward", []interface{}{arg1Copy})
+	fake.dynamicPortForwardMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardCallCount() int {
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	return len(fake.dynamicPortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardCalls(stub func([]clissh.DynamicPortForward) error) {
+	fake.dynamicPortForwardMutex.Lock()
+	defer fake.dynamicPortForwardMutex.Unlock()
+	fake.DynamicPortForwardStub = stub
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardArgsForCall(i int) []clissh.DynamicPortForward {
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	argsForCall := fake.dynamicPortForwardArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardReturns(result1 error) {
+	fake.dynamicPortForwardMutex.Lock()
+	defer fake.dynamicPortForwardMutex.Unlock()
+	fake.DynamicPortForwardStub = nil
+	fake.dynamicPortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardReturnsOnCall(i int, result1 error) {
+	fake.dynamicPortForwardMutex.Lock()
+	defer fake.dynamicPortForwardMutex.Unlock()
+	fake.DynamicPortForwardStub = nil
+	if fake.dynamicPortForwardReturnsOnCall == nil {
+		fake.dynamicPortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.dynamicPortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShellClient) InteractiveSession(arg1 []string, arg2 clissh.TTYRequest) error {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -199,15 +392,16 @@ func (fake *FakeSecureShellClient) InteractiveSession(arg1 []string, arg2 clissh
 		arg1 []string
 		arg2 clissh.TTYRequest
 	}{arg1Copy, arg2})
+	stub := fake.InteractiveSessionStub
+	fakeReturns := fake.interactiveSessionReturns
 	fake.recordInvocation("InteractiveSession", []interface{}{arg1Copy, arg2})
 	fake.interactiveSessionMutex.Unlock()
-	if fake.InteractiveSessionStub != nil {
-		return fake.InteractiveSessionStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.interactiveSessionReturns
 	return fakeReturns.result1
 }
 
@@ -264,15 +458,16 @@ func (fake *FakeSecureShellClient) LocalPortForward(arg1 []clissh.LocalPortForwa
 	fake.localPortForwardArgsForCall = append(fake.localPortForwardArgsForCall, struct {
 		arg1 []clissh.LocalPortForward
 	}{arg1Copy})
+	stub := fake.LocalPortForwardStub
+	fakeReturns := fake.localPortForwardReturns
 	fake.recordInvocation("LocalPortForward", []interface{}{arg1Copy})
 	fake.localPortForwardMutex.Unlock()
-	if fake.LocalPortForwardStub != nil {
-		return fake.LocalPortForwardStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.localPortForwardReturns
 	return fakeReturns.result1
 }
 
@@ -318,20 +513,218 @@ func (fake *FakeSecureShellClient) LocalPortForwardReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeSecureShellClient) RemotePortForward(arg1 []clissh.RemotePortForward) error {
+	var arg1Copy []clissh.RemotePortForward
+	if arg1 != nil {
+		arg1Copy = make([]clissh.RemotePortForward, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.remotePortForwardMutex.Lock()
+	ret, specificReturn := fake.remotePortForwardReturnsOnCall[len(fake.remotePortForwardArgsForCall)]
+	fake.remotePortForwardArgsForCall = append(fake.remotePortForwardArgsForCall, struct {
+		arg1 []clissh.RemotePortForward
+	}{arg1Copy})
+	stub := fake.RemotePortForwardStub
+	fakeReturns := fake.remotePortForwardReturns
+	fake.recordInvocation("RemotePortForward", []interface{}{arg1Copy})
+	fake.remotePortForwardMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardCallCount() int {
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	return len(fake.remotePortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardCalls(stub func([]clissh.RemotePortForward) error) {
+	fake.remotePortForwardMutex.Lock()
+	defer fake.remotePortForwardMutex.Unlock()
+	fake.RemotePortForwardStub = stub
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardArgsForCall(i int) []clissh.RemotePortForward {
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	argsForCall := fake.remotePortForwardArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardReturns(result1 error) {
+	fake.remotePortForwardMutex.Lock()
+	defer fake.remotePortForwardMutex.Unlock()
+	fake.RemotePortForwardStub = nil
+	fake.remotePortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardReturnsOnCall(i int, result1 error) {
+	fake.remotePortForwardMutex.Lock()
+	defer fake.remotePortForwardMutex.Unlock()
+	fake.RemotePortForwardStub = nil
+	if fake.remotePortForwardReturnsOnCall == nil {
+		fake.remotePortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.remotePortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) RunCommand(arg1 []string, arg2 io.Writer, arg3 io.Writer) error {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.runCommandMutex.Lock()
+	ret, specificReturn := fake.runCommandReturnsOnCall[len(fake.runCommandArgsForCall)]
+	fake.runCommandArgsForCall = append(fake.runCommandArgsForCall, struct {
+		arg1 []string
+		arg2 io.Writer
+		arg3 io.Writer
+	}{arg1Copy, arg2, arg3})
+	stub := fake.RunCommandStub
+	fakeReturns := fake.runCommandReturns
+	fake.recordInvocation("RunCommand", []interface{}{arg1Copy, arg2, arg3})
+	fake.runCommandMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureShellClient) RunCommandCallCount() int {
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
+	return len(fake.runCommandArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) RunCommandCalls(stub func([]string, io.Writer, io.Writer) error) {
+	fake.runCommandMutex.Lock()
+	defer fake.runCommandMutex.Unlock()
+	fake.RunCommandStub = stub
+}
+
+func (fake *FakeSecureShellClient) RunCommandArgsForCall(i int) ([]string, io.Writer, io.Writer) {
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
+	argsForCall := fake.runCommandArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSecureShellClient) RunCommandReturns(result1 error) {
+	fake.runCommandMutex.Lock()
+	defer fake.runCommandMutex.Unlock()
+	fake.RunCommandStub = nil
+	fake.runCommandReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) RunCommandReturnsOnCall(i int, result1 error) {
+	fake.runCommandMutex.Lock()
+	defer fake.runCommandMutex.Unlock()
+	fake.RunCommandStub = nil
+	if fake.runCommandReturnsOnCall == nil {
+		fake.runCommandReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.runCommandReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) Upload(arg1 string, arg2 string, arg3 bool) error {
+	fake.uploadMutex.Lock()
+	ret, specificReturn := fake.uploadReturnsOnCall[len(fake.uploadArgsForCall)]
+	fake.uploadArgsForCall = append(fake.uploadArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.UploadStub
+	fakeReturns := fake.uploadReturns
+	fake.recordInvocation("Upload", []interface{}{arg1, arg2, arg3})
+	fake.uploadMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureShellClient) UploadCallCount() int {
+	fake.uploadMutex.RLock()
+	defer fake.uploadMutex.RUnlock()
+	return len(fake.uploadArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) UploadCalls(stub func(string, string, bool) error) {
+	fake.uploadMutex.Lock()
+	defer fake.uploadMutex.Unlock()
+	fake.UploadStub = stub
+}
+
+func (fake *FakeSecureShellClient) UploadArgsForCall(i int) (string, string, bool) {
+	fake.uploadMutex.RLock()
+	defer fake.uploadMutex.RUnlock()
+	argsForCall := fake.uploadArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSecureShellClient) UploadReturns(result1 error) {
+	fake.uploadMutex.Lock()
+	defer fake.uploadMutex.Unlock()
+	fake.UploadStub = nil
+	fake.uploadReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) UploadReturnsOnCall(i int, result1 error) {
+	fake.uploadMutex.Lock()
+	defer fake.uploadMutex.Unlock()
+	fake.UploadStub = nil
+	if fake.uploadReturnsOnCall == nil {
+		fake.uploadReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uploadReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShellClient) Wait() error {
 	fake.waitMutex.Lock()
 	ret, specificReturn := fake.waitReturnsOnCall[len(fake.waitArgsForCall)]
 	fake.waitArgsForCall = append(fake.waitArgsForCall, struct {
 	}{})
+	stub := fake.WaitStub
+	fakeReturns := fake.waitReturns
 	fake.recordInvocation("Wait", []interface{}{})
 	fake.waitMutex.Unlock()
-	if fake.WaitStub != nil {
-		return fake.WaitStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.waitReturns
 	return fakeReturns.result1
 }
 
@@ -377,10 +770,20 @@ func (fake *FakeSecureShellClient) Invocations() map[string][][]interface{} {
 	defer fake.closeMutex.RUnlock()
 	fake.connectMutex.RLock()
 	defer fake.connectMutex.RUnlock()
+	fake.downloadMutex.RLock()
+	defer fake.downloadMutex.RUnlock()
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
 	fake.interactiveSessionMutex.RLock()
 	defer fake.interactiveSessionMutex.RUnlock()
 	fake.localPortForwardMutex.RLock()
 	defer fake.localPortForwardMutex.RUnlock()
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
+	fake.uploadMutex.RLock()
+	defer fake.uploadMutex.RUnlock()
 	fake.waitMutex.RLock()
 	defer fake.waitMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -1,6 +1,16 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/util/clissh"
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/clissh"
+)
 
 type TTYOption clissh.TTYRequest
 
@@ -13,16 +23,41 @@ const (
 
 type LocalPortForward clissh.LocalPortForward
 
+type RemotePortForward clissh.RemotePortForward
+
+type DynamicPortForward clissh.DynamicPortForward
+
 type SSHOptions struct {
-	Commands              []string
-	Username              string
-	Passcode              string
-	Endpoint              string
-	HostKeyFingerprint    string
-	SkipHostValidation    bool
-	SkipRemoteExecution   bool
-	TTYOption             TTYOption
-	LocalPortForwardSpecs []LocalPortForward
+	Commands                []string
+	Username                string
+	Passcode                string
+	Endpoint                string
+	HostKeyFingerprint      string
+	SkipHostValidation      bool
+	SkipRemoteExecution     bool
+	TTYOption               TTYOption
+	LocalPortForwardSpecs   []LocalPortForward
+	RemotePortForwardSpecs  []RemotePortForward
+	DynamicPortForwardSpecs []DynamicPortForward
+}
+
+type SecureCopyDirection int
+
+const (
+	SecureCopyUpload SecureCopyDirection = iota
+	SecureCopyDownload
+)
+
+type SecureCopyOptions struct {
+	Username           string
+	Passcode           string
+	Endpoint           string
+	HostKeyFingerprint string
+	SkipHostValidation bool
+	Direction          SecureCopyDirection
+	LocalPath          string
+	RemotePath         string
+	Recursive          bool
 }
 
 func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SSHOptions) error {
@@ -37,6 +72,16 @@ func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SS
 		return err
 	}
 
+	err = sshClient.RemotePortForward(convertActorToSSHPackageRemoteForwardingSpecs(sshOptions.RemotePortForwardSpecs))
+	if err != nil {
+		return err
+	}
+
+	err = sshClient.DynamicPortForward(convertActorToSSHPackageDynamicForwardingSpecs(sshOptions.DynamicPortForwardSpecs))
+	if err != nil {
+		return err
+	}
+
 	if sshOptions.SkipRemoteExecution {
 		err = sshClient.Wait()
 	} else {
@@ -45,6 +90,101 @@ func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SS
 	return err
 }
 
+// ExecuteSecureCopy connects to an app instance and copies files between the
+// local machine and the instance in the given direction.
+func (actor Actor) ExecuteSecureCopy(sshClient SecureShellClient, copyOptions SecureCopyOptions) error {
+	err := sshClient.Connect(copyOptions.Username, copyOptions.Passcode, copyOptions.Endpoint, copyOptions.HostKeyFingerprint, copyOptions.SkipHostValidation)
+	if err != nil {
+		return err
+	}
+	defer sshClient.Close()
+
+	if copyOptions.Direction == SecureCopyDownload {
+		return sshClient.Download(copyOptions.RemotePath, copyOptions.LocalPath, copyOptions.Recursive)
+	}
+	return sshClient.Upload(copyOptions.LocalPath, copyOptions.RemotePath, copyOptions.Recursive)
+}
+
+// SecureSyncOptions describes the files to copy to and remove from an app
+// instance. Changed and Removed are slash-separated paths relative to
+// LocalDir and RemoteDir.
+type SecureSyncOptions struct {
+	Username           string
+	Passcode           string
+	Endpoint           string
+	HostKeyFingerprint string
+	SkipHostValidation bool
+	LocalDir           string
+	RemoteDir          string
+	Changed            []string
+	Removed            []string
+}
+
+// ExecuteSecureSync connects to an app instance once, copies the changed
+// files from the local directory into the remote directory and removes the
+// removed files from it.
+func (actor Actor) ExecuteSecureSync(sshClient SecureShellClient, syncOptions SecureSyncOptions) error {
+	err := sshClient.Connect(syncOptions.Username, syncOptions.Passcode, syncOptions.Endpoint, syncOptions.HostKeyFingerprint, syncOptions.SkipHostValidation)
+	if err != nil {
+		return err
+	}
+	defer sshClient.Close()
+
+	dirs := map[string]bool{}
+	for _, file := range syncOptions.Changed {
+		if dir := path.Dir(file); dir != "." {
+			dirs[path.Join(syncOptions.RemoteDir, dir)] = true
+		}
+	}
+	if len(dirs) > 0 {
+		var remoteDirs []string
+		for dir := range dirs {
+			remoteDirs = append(remoteDirs, dir)
+		}
+		sort.Strings(remoteDirs)
+
+		err = runRemoteCommand(sshClient, "mkdir", "-p", remoteDirs)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, file := range syncOptions.Changed {
+		err = sshClient.Upload(filepath.Join(syncOptions.LocalDir, filepath.FromSlash(file)), path.Join(syncOptions.RemoteDir, file), false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(syncOptions.Removed) > 0 {
+		var remoteFiles []string
+		for _, file := range syncOptions.Removed {
+			remoteFiles = append(remoteFiles, path.Join(syncOptions.RemoteDir, file))
+		}
+
+		err = runRemoteCommand(sshClient, "rm", "-f", remoteFiles)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func runRemoteCommand(sshClient SecureShellClient, command string, option string, paths []string) error {
+	commands := []string{command, option, "--"}
+	for _, remotePath := range paths {
+		commands = append(commands, "'"+strings.ReplaceAll(remotePath, "'", `'\''`)+"'")
+	}
+
+	var stderr bytes.Buffer
+	err := sshClient.RunCommand(commands, io.Discard, &stderr)
+	if err != nil {
+		return fmt.Errorf("%s failed: %s %s", command, err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
 func convertActorToSSHPackageForwardingSpecs(actorSpecs []LocalPortForward) []clissh.LocalPortForward {
 	sshPackageSpecs := []clissh.LocalPortForward{}
 
@@ -54,3 +194,23 @@ func convertActorToSSHPackageForwardingSpecs(actorSpecs []LocalPortForward) []cl
 
 	return sshPackageSpecs
 }
+
+func convertActorToSSHPackageRemoteForwardingSpecs(actorSpecs []RemotePortForward) []clissh.RemotePortForward {
+	sshPackageSpecs := []clissh.RemotePortForward{}
+
+	for _, spec := range actorSpecs {
+		sshPackageSpecs = append(sshPackageSpecs, clissh.RemotePortForward(spec))
+	}
+
+	return sshPackageSpecs
+}
+
+func convertActorToSSHPackageDynamicForwardingSpecs(actorSpecs []DynamicPortForward) []clissh.DynamicPortForward {
+	sshPackageSpecs := []clissh.DynamicPortForward{}
+
+	for _, spec := range actorSpecs {
+		sshPackageSpecs = append(sshPackageSpecs, clissh.DynamicPortForward(spec))
+	}
+
+	return sshPackageSpecs
+}
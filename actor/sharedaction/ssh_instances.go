@@ -0,0 +1,161 @@
+package sharedaction
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// DefaultSSHMaxInFlight is the number of instances a command is run on at
+// once when no limit is given.
+const DefaultSSHMaxInFlight = 10
+
+// SSHConnectionFailedExitStatus is the exit status reported for an instance
+// when the command could not be run on it at all, matching the ssh client.
+const SSHConnectionFailedExitStatus = 255
+
+// InstanceSSHOptions are the SSH options for a single app instance.
+// GetPasscode, when set, is called right before connecting to the instance
+// for the one-time passcode to connect with, since a passcode expires soon
+// after it is issued.
+type InstanceSSHOptions struct {
+	Index       int64
+	GetPasscode func() (string, error)
+	SSHOptions
+}
+
+// InstanceSSHResult is the outcome of running a command on a single app
+// instance. Err is nil when the command exited successfully.
+type InstanceSSHResult struct {
+	Index      int64
+	ExitStatus int
+	Err        error
+}
+
+// Failed returns true if the command did not exit successfully.
+func (result InstanceSSHResult) Failed() bool {
+	return result.Err != nil
+}
+
+// ExecuteSecureShellOnInstances runs sshOptions.Commands on every given
+// instance, with at most maxInFlight sessions open at a time. Each line of
+// output is written to stdout or stderr prefixed with "[instance N] ". The
+// results are returned in the same order as instances.
+func (actor Actor) ExecuteSecureShellOnInstances(
+	newClient func() SecureShellClient,
+	instances []InstanceSSHOptions,
+	maxInFlight int,
+	stdout io.Writer,
+	stderr io.Writer,
+) []InstanceSSHResult {
+	if maxInFlight < 1 {
+		maxInFlight = DefaultSSHMaxInFlight
+	}
+
+	results := make([]InstanceSSHResult, len(instances))
+	outputLock := new(sync.Mutex)
+	slots := make(chan struct{}, maxInFlight)
+
+	wg := new(sync.WaitGroup)
+	for i, instance := range instances {
+		wg.Add(1)
+		slots <- struct{}{}
+
+		go func(i int, instance InstanceSSHOptions) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			prefix := fmt.Sprintf("[instance %d] ", instance.Index)
+			instanceOut := &prefixedLineWriter{prefix: prefix, out: stdout, lock: outputLock}
+			instanceErr := &prefixedLineWriter{prefix: prefix, out: stderr, lock: outputLock}
+
+			var err error
+			if instance.GetPasscode != nil {
+				instance.Passcode, err = instance.GetPasscode()
+			}
+			if err == nil {
+				err = actor.executeSecureShellCommand(newClient(), instance.SSHOptions, instanceOut, instanceErr)
+				instanceOut.Flush()
+				instanceErr.Flush()
+			}
+
+			results[i] = InstanceSSHResult{
+				Index:      instance.Index,
+				ExitStatus: exitStatus(err),
+				Err:        err,
+			}
+		}(i, instance)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func (actor Actor) executeSecureShellCommand(sshClient SecureShellClient, sshOptions SSHOptions, stdout io.Writer, stderr io.Writer) error {
+	err := sshClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	if err != nil {
+		return err
+	}
+	defer sshClient.Close()
+
+	return sshClient.RunCommand(sshOptions.Commands, stdout, stderr)
+}
+
+// exitStatus returns the remote exit status carried by errors such as
+// *ssh.ExitError, or SSHConnectionFailedExitStatus for any other error.
+func exitStatus(err error) int {
+	if err == nil {
+		return 0
+	}
+	if exitErr, ok := err.(interface{ ExitStatus() int }); ok {
+		return exitErr.ExitStatus()
+	}
+	return SSHConnectionFailedExitStatus
+}
+
+// prefixedLineWriter writes each complete line it receives to out with a
+// prefix. The lock is shared between writers so lines from different
+// instances are never interleaved.
+type prefixedLineWriter struct {
+	prefix string
+	out    io.Writer
+	lock   *sync.Mutex
+	buffer []byte
+}
+
+func (w *prefixedLineWriter) Write(p []byte) (int, error) {
+	w.buffer = append(w.buffer, p...)
+
+	for {
+		end := bytes.IndexByte(w.buffer, '\n')
+		if end < 0 {
+			break
+		}
+
+		err := w.writeLine(w.buffer[:end+1])
+		w.buffer = w.buffer[end+1:]
+		if err != nil {
+			return len(p), err
+		}
+	}
+
+	return len(p), nil
+}
+
+// Flush writes any trailing output that did not end with a newline.
+func (w *prefixedLineWriter) Flush() {
+	if len(w.buffer) == 0 {
+		return
+	}
+	_ = w.writeLine(append(w.buffer, '\n'))
+	w.buffer = nil
+}
+
+func (w *prefixedLineWriter) writeLine(line []byte) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	_, err := fmt.Fprintf(w.out, "%s%s", w.prefix, line)
+	return err
+}
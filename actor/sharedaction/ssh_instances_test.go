@@ -0,0 +1,229 @@
+package sharedaction_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+type remoteExitError struct {
+	status int
+}
+
+func (e remoteExitError) Error() string {
+	return fmt.Sprintf("Process exited with status %d", e.status)
+}
+
+func (e remoteExitError) ExitStatus() int {
+	return e.status
+}
+
+var _ = Describe("SSH on multiple instances", func() {
+	var (
+		actor *Actor
+
+		clientsLock sync.Mutex
+		clients     []*sharedactionfakes.FakeSecureShellClient
+		newClient   func() SecureShellClient
+		runStub     func(index int) func([]string, io.Writer, io.Writer) error
+
+		instances   []InstanceSSHOptions
+		maxInFlight int
+		stdout      *Buffer
+		stderr      *Buffer
+		results     []InstanceSSHResult
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(new(sharedactionfakes.FakeConfig))
+
+		clients = nil
+		runStub = func(index int) func([]string, io.Writer, io.Writer) error {
+			return func(commands []string, out io.Writer, errOut io.Writer) error {
+				_, _ = fmt.Fprintf(out, "out from %d\nmore", index)
+				_, _ = fmt.Fprintf(errOut, "err from %d\n", index)
+				return nil
+			}
+		}
+		newClient = func() SecureShellClient {
+			clientsLock.Lock()
+			defer clientsLock.Unlock()
+
+			client := new(sharedactionfakes.FakeSecureShellClient)
+			client.RunCommandStub = runStub(len(clients))
+			clients = append(clients, client)
+			return client
+		}
+
+		instances = []InstanceSSHOptions{
+			{Index: 0, SSHOptions: SSHOptions{Username: "cf:process-guid/0", Passcode: "passcode-0", Endpoint: "some-endpoint", HostKeyFingerprint: "some-fingerprint", Commands: []string{"ps", "aux"}}},
+			{Index: 1, SSHOptions: SSHOptions{Username: "cf:process-guid/1", Passcode: "passcode-1", Endpoint: "some-endpoint", HostKeyFingerprint: "some-fingerprint", Commands: []string{"ps", "aux"}}},
+		}
+		maxInFlight = 0
+		stdout = NewBuffer()
+		stderr = NewBuffer()
+	})
+
+	JustBeforeEach(func() {
+		results = actor.ExecuteSecureShellOnInstances(newClient, instances, maxInFlight, stdout, stderr)
+	})
+
+	It("connects to every instance with its own credentials and runs the command", func() {
+		Expect(clients).To(HaveLen(2))
+
+		var usernames, passcodes []string
+		for _, client := range clients {
+			Expect(client.ConnectCallCount()).To(Equal(1))
+			username, passcode, endpoint, fingerprint, _ := client.ConnectArgsForCall(0)
+			Expect(endpoint).To(Equal("some-endpoint"))
+			Expect(fingerprint).To(Equal("some-fingerprint"))
+			usernames = append(usernames, username)
+			passcodes = append(passcodes, passcode)
+
+			Expect(client.RunCommandCallCount()).To(Equal(1))
+			commands, _, _ := client.RunCommandArgsForCall(0)
+			Expect(commands).To(Equal([]string{"ps", "aux"}))
+			Expect(client.CloseCallCount()).To(Equal(1))
+		}
+		Expect(usernames).To(ConsistOf("cf:process-guid/0", "cf:process-guid/1"))
+		Expect(passcodes).To(ConsistOf("passcode-0", "passcode-1"))
+	})
+
+	It("prefixes every line of output with the instance index", func() {
+		Expect(string(stdout.Contents())).To(MatchRegexp(`(?m)^\[instance [01]\] out from [01]$`))
+		Expect(string(stdout.Contents())).To(MatchRegexp(`(?m)^\[instance [01]\] more$`))
+		Expect(string(stdout.Contents())).ToNot(MatchRegexp(`(?m)^(out|more)`))
+		Expect(string(stderr.Contents())).To(MatchRegexp(`(?m)^\[instance [01]\] err from [01]$`))
+	})
+
+	It("returns a successful result for every instance in order", func() {
+		Expect(results).To(Equal([]InstanceSSHResult{
+			{Index: 0, ExitStatus: 0},
+			{Index: 1, ExitStatus: 0},
+		}))
+	})
+
+	When("the command fails on some instances", func() {
+		BeforeEach(func() {
+			newClient = func() SecureShellClient {
+				client := new(sharedactionfakes.FakeSecureShellClient)
+				client.RunCommandStub = func([]string, io.Writer, io.Writer) error {
+					if username, _, _, _, _ := client.ConnectArgsForCall(0); username == "cf:process-guid/1" {
+						return remoteExitError{status: 3}
+					}
+					return nil
+				}
+				return client
+			}
+		})
+
+		It("returns the remote exit status for the failed instances", func() {
+			Expect(results).To(Equal([]InstanceSSHResult{
+				{Index: 0, ExitStatus: 0},
+				{Index: 1, ExitStatus: 3, Err: remoteExitError{status: 3}},
+			}))
+			Expect(results[0].Failed()).To(BeFalse())
+			Expect(results[1].Failed()).To(BeTrue())
+		})
+	})
+
+	When("connecting to an instance fails", func() {
+		BeforeEach(func() {
+			newClient = func() SecureShellClient {
+				client := new(sharedactionfakes.FakeSecureShellClient)
+				client.ConnectReturns(errors.New("some-connect-error"))
+				return client
+			}
+		})
+
+		It("reports the connection failure with the ssh error exit status", func() {
+			Expect(results).To(HaveLen(2))
+			for _, result := range results {
+				Expect(result.Failed()).To(BeTrue())
+				Expect(result.Err).To(MatchError("some-connect-error"))
+				Expect(result.ExitStatus).To(Equal(SSHConnectionFailedExitStatus))
+			}
+		})
+	})
+
+	When("the instances get their passcodes when connecting", func() {
+		var passcodeCalls int32
+
+		BeforeEach(func() {
+			passcodeCalls = 0
+			for i := range instances {
+				index := instances[i].Index
+				instances[i].Passcode = ""
+				instances[i].GetPasscode = func() (string, error) {
+					atomic.AddInt32(&passcodeCalls, 1)
+					if index == 1 {
+						return "", errors.New("some-passcode-error")
+					}
+					return fmt.Sprintf("fresh-passcode-%d", index), nil
+				}
+			}
+		})
+
+		It("connects with a passcode fetched for each instance", func() {
+			Expect(atomic.LoadInt32(&passcodeCalls)).To(Equal(int32(2)))
+			Expect(clients).To(HaveLen(1))
+			username, passcode, _, _, _ := clients[0].ConnectArgsForCall(0)
+			Expect(username).To(Equal("cf:process-guid/0"))
+			Expect(passcode).To(Equal("fresh-passcode-0"))
+		})
+
+		It("reports the instances it could not get a passcode for as connection failures", func() {
+			Expect(results).To(Equal([]InstanceSSHResult{
+				{Index: 0, ExitStatus: 0},
+				{Index: 1, ExitStatus: SSHConnectionFailedExitStatus, Err: errors.New("some-passcode-error")},
+			}))
+		})
+	})
+
+	When("a concurrency limit is given", func() {
+		var inFlight, maxSeen int32
+
+		BeforeEach(func() {
+			maxInFlight = 2
+			inFlight, maxSeen = 0, 0
+			instances = nil
+			for i := 0; i < 6; i++ {
+				instances = append(instances, InstanceSSHOptions{Index: int64(i)})
+			}
+
+			release := make(chan struct{})
+			go func() {
+				defer close(release)
+				Eventually(func() int32 { return atomic.LoadInt32(&inFlight) }).Should(Equal(int32(2)))
+			}()
+
+			runStub = func(int) func([]string, io.Writer, io.Writer) error {
+				return func([]string, io.Writer, io.Writer) error {
+					current := atomic.AddInt32(&inFlight, 1)
+					for {
+						seen := atomic.LoadInt32(&maxSeen)
+						if current <= seen || atomic.CompareAndSwapInt32(&maxSeen, seen, current) {
+							break
+						}
+					}
+					<-release
+					atomic.AddInt32(&inFlight, -1)
+					return nil
+				}
+			}
+		})
+
+		It("never runs more than that many sessions at once", func() {
+			Expect(results).To(HaveLen(6))
+			Expect(atomic.LoadInt32(&maxSeen)).To(Equal(int32(2)))
+		})
+	})
+})
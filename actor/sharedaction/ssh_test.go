@@ -2,6 +2,8 @@ package sharedaction_test
 
 import (
 	"errors"
+	"io"
+	"path/filepath"
 
 	. "code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
@@ -73,6 +75,12 @@ var _ = Describe("SSH Actions", func() {
 					{LocalAddress: "local-address-1", RemoteAddress: "remote-address-1"},
 					{LocalAddress: "local-address-2", RemoteAddress: "remote-address-2"},
 				}
+				sshOptions.RemotePortForwardSpecs = []RemotePortForward{
+					{RemoteAddress: "remote-address-3", LocalAddress: "local-address-3"},
+				}
+				sshOptions.DynamicPortForwardSpecs = []DynamicPortForward{
+					{LocalAddress: "local-address-4"},
+				}
 			})
 
 			AfterEach(func() {
@@ -89,6 +97,24 @@ var _ = Describe("SSH Actions", func() {
 				))
 			})
 
+			It("forwards the remote ports", func() {
+				Expect(fakeSecureShellClient.RemotePortForwardCallCount()).To(Equal(1))
+				Expect(fakeSecureShellClient.RemotePortForwardArgsForCall(0)).To(Equal(
+					[]clissh.RemotePortForward{
+						{RemoteAddress: "remote-address-3", LocalAddress: "local-address-3"},
+					},
+				))
+			})
+
+			It("starts the dynamic forwarding proxies", func() {
+				Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(1))
+				Expect(fakeSecureShellClient.DynamicPortForwardArgsForCall(0)).To(Equal(
+					[]clissh.DynamicPortForward{
+						{LocalAddress: "local-address-4"},
+					},
+				))
+			})
+
 			When("local port forwarding fails", func() {
 				BeforeEach(func() {
 					fakeSecureShellClient.LocalPortForwardReturns(errors.New("some-forwarding-error"))
@@ -96,6 +122,30 @@ var _ = Describe("SSH Actions", func() {
 
 				It("returns the error", func() {
 					Expect(executeErr).To(MatchError("some-forwarding-error"))
+					Expect(fakeSecureShellClient.RemotePortForwardCallCount()).To(Equal(0))
+				})
+			})
+
+			When("remote port forwarding fails", func() {
+				BeforeEach(func() {
+					fakeSecureShellClient.RemotePortForwardReturns(errors.New("some-remote-forwarding-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-remote-forwarding-error"))
+					Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(0))
+				})
+			})
+
+			When("dynamic port forwarding fails", func() {
+				BeforeEach(func() {
+					fakeSecureShellClient.DynamicPortForwardReturns(errors.New("some-dynamic-forwarding-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-dynamic-forwarding-error"))
+					Expect(fakeSecureShellClient.WaitCallCount()).To(Equal(0))
+					Expect(fakeSecureShellClient.InteractiveSessionCallCount()).To(Equal(0))
 				})
 			})
 
@@ -163,4 +213,147 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("ExecuteSecureCopy", func() {
+		var (
+			copyOptions SecureCopyOptions
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			copyOptions = SecureCopyOptions{
+				Username:           "some-user",
+				Passcode:           "some-passcode",
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				SkipHostValidation: true,
+				LocalPath:          "some-local-path",
+				RemotePath:         "some-remote-path",
+				Recursive:          true,
+			}
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.ExecuteSecureCopy(fakeSecureShellClient, copyOptions)
+		})
+
+		It("calls connect with the provided authorization info", func() {
+			Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(1))
+			usernameArg, passcodeArg, endpointArg, fingerprintArg, skipHostValidationArg := fakeSecureShellClient.ConnectArgsForCall(0)
+			Expect(usernameArg).To(Equal("some-user"))
+			Expect(passcodeArg).To(Equal("some-passcode"))
+			Expect(endpointArg).To(Equal("some-endpoint"))
+			Expect(fingerprintArg).To(Equal("some-fingerprint"))
+			Expect(skipHostValidationArg).To(BeTrue())
+		})
+
+		When("connecting fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.ConnectReturns(errors.New("some-connect-error"))
+			})
+
+			It("returns the error without copying or closing", func() {
+				Expect(executeErr).To(MatchError("some-connect-error"))
+				Expect(fakeSecureShellClient.UploadCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.DownloadCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(0))
+			})
+		})
+
+		When("uploading", func() {
+			BeforeEach(func() {
+				copyOptions.Direction = SecureCopyUpload
+				fakeSecureShellClient.UploadReturns(errors.New("some-upload-error"))
+			})
+
+			It("uploads the local path to the remote path and closes the connection", func() {
+				Expect(executeErr).To(MatchError("some-upload-error"))
+				Expect(fakeSecureShellClient.UploadCallCount()).To(Equal(1))
+				localPath, remotePath, recursive := fakeSecureShellClient.UploadArgsForCall(0)
+				Expect(localPath).To(Equal("some-local-path"))
+				Expect(remotePath).To(Equal("some-remote-path"))
+				Expect(recursive).To(BeTrue())
+				Expect(fakeSecureShellClient.DownloadCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+
+		When("downloading", func() {
+			BeforeEach(func() {
+				copyOptions.Direction = SecureCopyDownload
+			})
+
+			It("downloads the remote path to the local path and closes the connection", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeSecureShellClient.DownloadCallCount()).To(Equal(1))
+				remotePath, localPath, recursive := fakeSecureShellClient.DownloadArgsForCall(0)
+				Expect(remotePath).To(Equal("some-remote-path"))
+				Expect(localPath).To(Equal("some-local-path"))
+				Expect(recursive).To(BeTrue())
+				Expect(fakeSecureShellClient.UploadCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("ExecuteSecureSync", func() {
+		var (
+			syncOptions SecureSyncOptions
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			syncOptions = SecureSyncOptions{
+				Username:           "some-user",
+				Passcode:           "some-passcode",
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				LocalDir:           "some-local-dir",
+				RemoteDir:          "/home/vcap/app",
+				Changed:            []string{"index.js", "views/it's.html"},
+				Removed:            []string{"old.js"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.ExecuteSecureSync(fakeSecureShellClient, syncOptions)
+		})
+
+		It("creates the directories, uploads the changed files and removes the removed files over one connection", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(1))
+
+			Expect(fakeSecureShellClient.RunCommandCallCount()).To(Equal(2))
+			commands, _, _ := fakeSecureShellClient.RunCommandArgsForCall(0)
+			Expect(commands).To(Equal([]string{"mkdir", "-p", "--", "'/home/vcap/app/views'"}))
+			commands, _, _ = fakeSecureShellClient.RunCommandArgsForCall(1)
+			Expect(commands).To(Equal([]string{"rm", "-f", "--", "'/home/vcap/app/old.js'"}))
+
+			Expect(fakeSecureShellClient.UploadCallCount()).To(Equal(2))
+			localPath, remotePath, recursive := fakeSecureShellClient.UploadArgsForCall(0)
+			Expect(localPath).To(Equal(filepath.Join("some-local-dir", "index.js")))
+			Expect(remotePath).To(Equal("/home/vcap/app/index.js"))
+			Expect(recursive).To(BeFalse())
+			localPath, remotePath, _ = fakeSecureShellClient.UploadArgsForCall(1)
+			Expect(localPath).To(Equal(filepath.Join("some-local-dir", "views", "it's.html")))
+			Expect(remotePath).To(Equal("/home/vcap/app/views/it's.html"))
+
+			Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+		})
+
+		When("a remote command fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.RunCommandStub = func(commands []string, stdout io.Writer, stderr io.Writer) error {
+					_, _ = stderr.Write([]byte("read-only file system\n"))
+					return errors.New("exit status 1")
+				}
+			})
+
+			It("returns the error with the command output and does not upload", func() {
+				Expect(executeErr).To(MatchError("mkdir failed: exit status 1 read-only file system"))
+				Expect(fakeSecureShellClient.UploadCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+	})
 })
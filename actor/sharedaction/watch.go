@@ -0,0 +1,131 @@
+package sharedaction
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// DirectorySnapshot is the modification time, size and mode of every file in
+// a directory that push would upload, keyed by slash-separated relative path.
+type DirectorySnapshot map[string]WatchedFile
+
+type WatchedFile struct {
+	ModTime time.Time
+	Size    int64
+	Mode    os.FileMode
+}
+
+// DirectoryChanges lists the files that were added or modified and the files
+// that were removed between two snapshots, sorted by path.
+type DirectoryChanges struct {
+	Changed []string
+	Removed []string
+}
+
+func (changes DirectoryChanges) Empty() bool {
+	return len(changes.Changed) == 0 && len(changes.Removed) == 0
+}
+
+// SnapshotDirectory records the files in sourceDir that are not excluded by
+// its .cfignore file.
+func (actor Actor) SnapshotDirectory(sourceDir string) (DirectorySnapshot, error) {
+	gitIgnore, err := actor.generateDirectoryCFIgnoreMatcher(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+
+	evalDir, err := filepath.EvalSymlinks(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := DirectorySnapshot{}
+	err = filepath.Walk(evalDir, func(fullPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			// Files that are removed while the directory is walked are
+			// picked up by the next snapshot.
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		relPath, err := filepath.Rel(evalDir, fullPath)
+		if err != nil {
+			return err
+		}
+
+		if relPath == "." || info.IsDir() || gitIgnore.MatchesPath(relPath) {
+			return nil
+		}
+
+		snapshot[filepath.ToSlash(relPath)] = WatchedFile{
+			ModTime: info.ModTime(),
+			Size:    info.Size(),
+			Mode:    info.Mode(),
+		}
+		return nil
+	})
+	return snapshot, err
+}
+
+// WaitForDirectoryChanges polls sourceDir every pollInterval until its files
+// differ from the snapshot and have then stayed the same for debounce, so
+// that saving several files at once results in a single change. It returns
+// the new snapshot and the changes, or the snapshot and no changes when stop
+// is closed first.
+func (actor Actor) WaitForDirectoryChanges(sourceDir string, snapshot DirectorySnapshot, pollInterval time.Duration, debounce time.Duration, stop <-chan struct{}) (DirectorySnapshot, DirectoryChanges, error) {
+	current := snapshot
+	var lastChange time.Time
+
+	for {
+		select {
+		case <-stop:
+			return snapshot, DirectoryChanges{}, nil
+		case <-actor.Clock.After(pollInterval):
+		}
+
+		latest, err := actor.SnapshotDirectory(sourceDir)
+		if err != nil {
+			return snapshot, DirectoryChanges{}, err
+		}
+
+		if !compareSnapshots(current, latest).Empty() {
+			current = latest
+			lastChange = actor.Clock.Now()
+			continue
+		}
+
+		if lastChange.IsZero() || actor.Clock.Since(lastChange) < debounce {
+			continue
+		}
+
+		changes := compareSnapshots(snapshot, current)
+		if changes.Empty() {
+			// The files were changed back to how they were.
+			lastChange = time.Time{}
+			continue
+		}
+		return current, changes, nil
+	}
+}
+
+func compareSnapshots(older DirectorySnapshot, newer DirectorySnapshot) DirectoryChanges {
+	var changes DirectoryChanges
+	for path, file := range newer {
+		if olderFile, ok := older[path]; !ok || !olderFile.ModTime.Equal(file.ModTime) || olderFile.Size != file.Size || olderFile.Mode != file.Mode {
+			changes.Changed = append(changes.Changed, path)
+		}
+	}
+	for path := range older {
+		if _, ok := newer[path]; !ok {
+			changes.Removed = append(changes.Removed, path)
+		}
+	}
+
+	sort.Strings(changes.Changed)
+	sort.Strings(changes.Removed)
+	return changes
+}
@@ -0,0 +1,123 @@
+package sharedaction_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Watch Actions", func() {
+	var (
+		actor  *Actor
+		srcDir string
+	)
+
+	writeFile := func(name string, contents string) {
+		path := filepath.Join(srcDir, filepath.FromSlash(name))
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(contents), 0644)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		actor = NewActor(new(sharedactionfakes.FakeConfig))
+
+		var err error
+		srcDir, err = os.MkdirTemp("", "watch-test")
+		Expect(err).ToNot(HaveOccurred())
+
+		writeFile("index.js", "v1")
+		writeFile("lib/util.js", "v1")
+		writeFile("tmp/cache.bin", "v1")
+		writeFile(".cfignore", "tmp/\n")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(srcDir)).To(Succeed())
+	})
+
+	Describe("SnapshotDirectory", func() {
+		It("records the files that are not ignored", func() {
+			snapshot, err := actor.SnapshotDirectory(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(snapshot).To(HaveLen(2))
+			Expect(snapshot).To(HaveKey("index.js"))
+			Expect(snapshot).To(HaveKey("lib/util.js"))
+			Expect(snapshot["index.js"].Size).To(BeEquivalentTo(2))
+		})
+	})
+
+	Describe("WaitForDirectoryChanges", func() {
+		type result struct {
+			snapshot DirectorySnapshot
+			changes  DirectoryChanges
+			err      error
+		}
+
+		var (
+			fakeClock *fakeclock.FakeClock
+			snapshot  DirectorySnapshot
+			stop      chan struct{}
+			results   chan result
+		)
+
+		BeforeEach(func() {
+			fakeClock = fakeclock.NewFakeClock(time.Now())
+			actor.Clock = fakeClock
+
+			var err error
+			snapshot, err = actor.SnapshotDirectory(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			stop = make(chan struct{})
+			results = make(chan result, 1)
+			go func() {
+				newSnapshot, changes, err := actor.WaitForDirectoryChanges(srcDir, snapshot, time.Second, 3*time.Second, stop)
+				results <- result{newSnapshot, changes, err}
+			}()
+		})
+
+		It("returns the changes once the files stop changing", func() {
+			writeFile("index.js", "version 2")
+			writeFile("tmp/cache.bin", "v2")
+			Expect(os.Remove(filepath.Join(srcDir, "lib", "util.js"))).To(Succeed())
+			fakeClock.WaitForWatcherAndIncrement(time.Second)
+
+			Eventually(fakeClock.WatcherCount).Should(Equal(1))
+			writeFile("lib/new.js", "v1")
+			fakeClock.WaitForWatcherAndIncrement(time.Second)
+
+			fakeClock.WaitForWatcherAndIncrement(2 * time.Second)
+			Eventually(fakeClock.WatcherCount).Should(Equal(1))
+			Expect(results).ToNot(Receive())
+
+			fakeClock.WaitForWatcherAndIncrement(time.Second)
+			var r result
+			Eventually(results).Should(Receive(&r))
+			Expect(r.err).ToNot(HaveOccurred())
+			Expect(r.changes).To(Equal(DirectoryChanges{
+				Changed: []string{"index.js", "lib/new.js"},
+				Removed: []string{"lib/util.js"},
+			}))
+			Expect(r.snapshot).To(HaveKey("lib/new.js"))
+			Expect(r.snapshot).ToNot(HaveKey("lib/util.js"))
+		})
+
+		When("stop is closed", func() {
+			It("returns the snapshot and no changes", func() {
+				close(stop)
+
+				var r result
+				Eventually(results).Should(Receive(&r))
+				Expect(r.err).ToNot(HaveOccurred())
+				Expect(r.changes.Empty()).To(BeTrue())
+				Expect(r.snapshot).To(Equal(snapshot))
+			})
+		})
+	})
+})
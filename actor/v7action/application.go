@@ -109,19 +109,30 @@ func (actor Actor) GetApplicationsByGUIDs(appGUIDs []string) ([]resources.Applic
 func (actor Actor) GetApplicationsByNamesAndSpace(appNames []string, spaceGUID string) ([]resources.Application, Warnings, error) {
 	uniqueAppNames := unique.StringSlice(appNames)
 
+	apps, warnings, err := actor.GetExistingApplicationsByNamesAndSpace(appNames, spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	if len(apps) < len(uniqueAppNames) {
+		return nil, warnings, actionerror.ApplicationsNotFoundError{}
+	}
+
+	return apps, warnings, nil
+}
+
+// GetExistingApplicationsByNamesAndSpace returns the applications in the
+// given space that have one of the given names. Names that do not match an
+// application are left out of the result rather than causing an error.
+func (actor Actor) GetExistingApplicationsByNamesAndSpace(appNames []string, spaceGUID string) ([]resources.Application, Warnings, error) {
 	apps, warnings, err := actor.CloudControllerClient.GetApplications(
 		ccv3.Query{Key: ccv3.NameFilter, Values: appNames},
 		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
 	)
-
 	if err != nil {
 		return nil, Warnings(warnings), err
 	}
 
-	if len(apps) < len(uniqueAppNames) {
-		return nil, Warnings(warnings), actionerror.ApplicationsNotFoundError{}
-	}
-
 	return apps, Warnings(warnings), nil
 }
 
@@ -472,6 +472,57 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("GetExistingApplicationsByNamesAndSpace", func() {
+		When("some of the requested apps do not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{
+						{
+							Name: "some-app-name",
+							GUID: "some-app-guid",
+						},
+					},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the apps that exist and the warnings", func() {
+				apps, warnings, err := actor.GetExistingApplicationsByNamesAndSpace([]string{"some-app-name", "other-app-name"}, "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(apps).To(ConsistOf(resources.Application{
+					Name: "some-app-name",
+					GUID: "some-app-guid",
+				}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-app-name", "other-app-name"}},
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+				))
+			})
+		})
+
+		When("the cloud controller client returns an error", func() {
+			var expectedError error
+
+			BeforeEach(func() {
+				expectedError = errors.New("I am a CloudControllerClient Error")
+				fakeCloudControllerClient.GetApplicationsReturns(
+					nil,
+					ccv3.Warnings{"some-warning"},
+					expectedError)
+			})
+
+			It("returns the warnings and the error", func() {
+				_, warnings, err := actor.GetExistingApplicationsByNamesAndSpace([]string{"some-app-name"}, "some-space-guid")
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(err).To(MatchError(expectedError))
+			})
+		})
+	})
+
 	Describe("GetApplicationByNameAndSpace", func() {
 		When("the app exists", func() {
 			BeforeEach(func() {
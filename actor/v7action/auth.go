@@ -25,7 +25,8 @@ func NewDefaultAuthActor(config Config, uaaClient UAAClient) AuthActor {
 }
 
 func (actor defaultAuthActor) Authenticate(credentials map[string]string, origin string, grantType constant.GrantType) error {
-	if grantType == constant.GrantTypePassword && actor.config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
+	isUserGrant := grantType == constant.GrantTypePassword || grantType == constant.GrantTypeAuthorizationCode || grantType == constant.GrantTypeDeviceCode
+	if isUserGrant && actor.config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
 		return actionerror.PasswordGrantTypeLogoutRequiredError{}
 	}
 
@@ -39,7 +40,7 @@ func (actor defaultAuthActor) Authenticate(credentials map[string]string, origin
 	accessToken = fmt.Sprintf("bearer %s", accessToken)
 	actor.config.SetTokenInformation(accessToken, refreshToken, "")
 
-	if grantType == constant.GrantTypePassword {
+	if isUserGrant {
 		actor.config.SetUAAGrantType("")
 	} else {
 		actor.config.SetUAAGrantType(string(grantType))
@@ -109,6 +109,27 @@ var _ = Describe("Default Auth Actions", func() {
 				})
 			})
 
+			When("the grant type is a JWT bearer grant", func() {
+				BeforeEach(func() {
+					grantType = constant.GrantTypeJWTBearer
+					creds = map[string]string{
+						"assertion": "some-assertion",
+					}
+				})
+
+				It("stores the grant type so that the token is refreshed accordingly", func() {
+					Expect(actualErr).NotTo(HaveOccurred())
+
+					uaaCredentials, _, passedGrantType := fakeUAAClient.AuthenticateArgsForCall(0)
+					Expect(uaaCredentials).To(Equal(map[string]string{"assertion": "some-assertion"}))
+					Expect(passedGrantType).To(Equal(constant.GrantTypeJWTBearer))
+
+					Expect(fakeConfig.SetUAAClientCredentialsCallCount()).To(Equal(0))
+					Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(1))
+					Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(Equal(string(constant.GrantTypeJWTBearer)))
+				})
+			})
+
 			When("extra information is needed to authenticate, e.g., MFA", func() {
 				BeforeEach(func() {
 					creds = map[string]string{
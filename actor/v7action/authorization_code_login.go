@@ -0,0 +1,150 @@
+package v7action
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+)
+
+const authorizationCodeCallbackPath = "/callback"
+
+// AuthorizationCodeLogin is a browser login in progress. The user logs in by
+// visiting URL, after which the login server redirects the browser to a
+// listener on the loopback interface with an authorization code.
+type AuthorizationCodeLogin struct {
+	URL string
+
+	origin       string
+	redirectURI  string
+	state        string
+	codeVerifier string
+	server       *http.Server
+	results      chan authorizationCodeResult
+}
+
+type authorizationCodeResult struct {
+	code string
+	err  error
+}
+
+// Close stops listening for the authorization code.
+func (login *AuthorizationCodeLogin) Close() error {
+	if login == nil || login.server == nil {
+		return nil
+	}
+	return login.server.Close()
+}
+
+// StartAuthorizationCodeLogin listens on a random loopback port for the
+// authorization code and returns the login with the authorize URL the user
+// must visit. The grant is protected with PKCE.
+func (actor Actor) StartAuthorizationCodeLogin(origin string) (*AuthorizationCodeLogin, error) {
+	state, err := randomURLSafeString()
+	if err != nil {
+		return nil, err
+	}
+	codeVerifier, err := randomURLSafeString()
+	if err != nil {
+		return nil, err
+	}
+	challenge := sha256.Sum256([]byte(codeVerifier))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	login := &AuthorizationCodeLogin{
+		origin:       origin,
+		redirectURI:  fmt.Sprintf("http://%s%s", listener.Addr().String(), authorizationCodeCallbackPath),
+		state:        state,
+		codeVerifier: codeVerifier,
+		results:      make(chan authorizationCodeResult, 1),
+	}
+
+	login.URL, err = actor.UAAClient.AuthorizationCodeURL(login.redirectURI, state, base64.RawURLEncoding.EncodeToString(challenge[:]), origin)
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(authorizationCodeCallbackPath, login.handleCallback)
+	login.server = &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		_ = login.server.Serve(listener)
+	}()
+
+	return login, nil
+}
+
+// FinishAuthorizationCodeLogin waits up to timeout for the authorization code
+// of the login and exchanges it for tokens.
+func (actor Actor) FinishAuthorizationCodeLogin(login *AuthorizationCodeLogin, timeout time.Duration) error {
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_ = login.server.Shutdown(ctx)
+	}()
+
+	var result authorizationCodeResult
+	select {
+	case result = <-login.results:
+	case <-actor.Clock.After(timeout):
+		return errors.New("timed out waiting for the browser login to complete")
+	}
+	if result.err != nil {
+		return result.err
+	}
+
+	credentials := map[string]string{
+		"code":          result.code,
+		"code_verifier": login.codeVerifier,
+		"redirect_uri":  login.redirectURI,
+	}
+	return actor.Authenticate(credentials, login.origin, constant.GrantTypeAuthorizationCode)
+}
+
+func (login *AuthorizationCodeLogin) handleCallback(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var result authorizationCodeResult
+	switch {
+	case query.Get("state") != login.state:
+		http.Error(w, "The login state does not match. Start the login again.", http.StatusBadRequest)
+		return
+	case query.Get("error") != "":
+		result.err = fmt.Errorf("the login server denied the login: %s", strings.TrimSpace(query.Get("error")+" "+query.Get("error_description")))
+		http.Error(w, "Login failed. Return to the CLI for details.", http.StatusUnauthorized)
+	case query.Get("code") == "":
+		http.Error(w, "The login server did not return an authorization code.", http.StatusBadRequest)
+		return
+	default:
+		result.code = query.Get("code")
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = w.Write([]byte("Login complete. You can close this window and return to the CLI.\n"))
+	}
+
+	select {
+	case login.results <- result:
+	default:
+	}
+}
+
+func randomURLSafeString() (string, error) {
+	bytes := make([]byte, 32)
+	_, err := rand.Read(bytes)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
@@ -0,0 +1,150 @@
+package v7action_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Authorization code login actions", func() {
+	var (
+		actor         *Actor
+		fakeConfig    *v7actionfakes.FakeConfig
+		fakeUAAClient *v7actionfakes.FakeUAAClient
+		fakeClock     *fakeclock.FakeClock
+
+		login    *AuthorizationCodeLogin
+		startErr error
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(v7actionfakes.FakeConfig)
+		fakeUAAClient = new(v7actionfakes.FakeUAAClient)
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		actor = NewActor(nil, fakeConfig, nil, fakeUAAClient, nil, fakeClock)
+
+		fakeUAAClient.AuthorizationCodeURLReturns("https://login.example.com/oauth/authorize?some=query", nil)
+		fakeUAAClient.AuthenticateReturns("some-access-token", "some-refresh-token", nil)
+	})
+
+	JustBeforeEach(func() {
+		login, startErr = actor.StartAuthorizationCodeLogin("some-origin")
+	})
+
+	AfterEach(func() {
+		Expect(login.Close()).To(Succeed())
+	})
+
+	// visitRedirect plays the part of the browser being redirected back by the
+	// login server.
+	visitRedirect := func(query url.Values) *http.Response {
+		redirectURI, _, _, _ := fakeUAAClient.AuthorizationCodeURLArgsForCall(0)
+		response, err := http.Get(redirectURI + "?" + query.Encode())
+		Expect(err).ToNot(HaveOccurred())
+		response.Body.Close()
+		return response
+	}
+
+	It("returns the authorize URL for a loopback redirect URI", func() {
+		Expect(startErr).ToNot(HaveOccurred())
+		Expect(login.URL).To(Equal("https://login.example.com/oauth/authorize?some=query"))
+
+		Expect(fakeUAAClient.AuthorizationCodeURLCallCount()).To(Equal(1))
+		redirectURI, state, challenge, origin := fakeUAAClient.AuthorizationCodeURLArgsForCall(0)
+		Expect(redirectURI).To(MatchRegexp(`^http://127\.0\.0\.1:\d+/callback$`))
+		Expect(state).ToNot(BeEmpty())
+		Expect(challenge).ToNot(BeEmpty())
+		Expect(origin).To(Equal("some-origin"))
+	})
+
+	When("the browser is redirected back with a code", func() {
+		var finishErr error
+
+		JustBeforeEach(func() {
+			_, state, _, _ := fakeUAAClient.AuthorizationCodeURLArgsForCall(0)
+			response := visitRedirect(url.Values{"code": {"some-code"}, "state": {state}})
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+			finishErr = actor.FinishAuthorizationCodeLogin(login, time.Minute)
+		})
+
+		It("exchanges the code and its PKCE verifier for tokens", func() {
+			Expect(finishErr).ToNot(HaveOccurred())
+
+			Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(1))
+			credentials, origin, grantType := fakeUAAClient.AuthenticateArgsForCall(0)
+			Expect(origin).To(Equal("some-origin"))
+			Expect(grantType).To(Equal(constant.GrantTypeAuthorizationCode))
+			Expect(credentials["code"]).To(Equal("some-code"))
+
+			redirectURI, _, challenge, _ := fakeUAAClient.AuthorizationCodeURLArgsForCall(0)
+			Expect(credentials["redirect_uri"]).To(Equal(redirectURI))
+			verifierHash := sha256.Sum256([]byte(credentials["code_verifier"]))
+			Expect(base64.RawURLEncoding.EncodeToString(verifierHash[:])).To(Equal(challenge))
+
+			Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+			accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+			Expect(accessToken).To(Equal("bearer some-access-token"))
+			Expect(refreshToken).To(Equal("some-refresh-token"))
+			Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(BeEmpty())
+		})
+
+		When("exchanging the code fails", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateReturns("", "", errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(finishErr).To(MatchError("some-error"))
+			})
+		})
+	})
+
+	When("the browser is redirected back with the wrong state", func() {
+		It("rejects the redirect and keeps waiting", func() {
+			response := visitRedirect(url.Values{"code": {"some-code"}, "state": {"other-state"}})
+			Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+			done := make(chan error)
+			go func() {
+				done <- actor.FinishAuthorizationCodeLogin(login, time.Minute)
+			}()
+			fakeClock.WaitForWatcherAndIncrement(time.Minute)
+			Eventually(done).Should(Receive(MatchError("timed out waiting for the browser login to complete")))
+			Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the login server returns an error", func() {
+		It("returns the error without exchanging a code", func() {
+			_, state, _, _ := fakeUAAClient.AuthorizationCodeURLArgsForCall(0)
+			response := visitRedirect(url.Values{"error": {"access_denied"}, "error_description": {"User denied access"}, "state": {state}})
+			Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+
+			err := actor.FinishAuthorizationCodeLogin(login, time.Minute)
+			Expect(err).To(MatchError("the login server denied the login: access_denied User denied access"))
+			Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(0))
+		})
+	})
+
+	When("building the authorize URL fails", func() {
+		BeforeEach(func() {
+			fakeUAAClient.AuthorizationCodeURLReturns("", errors.New("some-url-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(startErr).To(MatchError("some-url-error"))
+			Expect(login).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,118 @@
+package v7action
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// CanaryHealthCriteria are the thresholds a canary must stay within for the
+// whole observation period to be considered healthy.
+type CanaryHealthCriteria struct {
+	ObservationPeriod time.Duration
+	// MaxCrashes is the number of canary instance crashes tolerated.
+	MaxCrashes int
+	// MaxErrorRate is the highest percentage of HTTP requests that may fail
+	// with a 5xx status code. The error rate is not checked when it is nil.
+	MaxErrorRate *float64
+}
+
+// CanaryHealthReport describes what was observed while evaluating a canary.
+// Reason explains why the canary is unhealthy and is empty otherwise.
+type CanaryHealthReport struct {
+	Healthy      bool
+	Instances    int
+	Crashes      int
+	HTTPRequests sharedaction.HTTPRequestStats
+	Reason       string
+}
+
+// EvaluateCanaryHealth watches the instances of the deployment's new
+// processes for criteria.ObservationPeriod. The canary is unhealthy as soon as
+// more than criteria.MaxCrashes crashes are seen; a crash is either an
+// instance entering the CRASHED state or an instance restarting between
+// polls. When criteria.MaxErrorRate is set, the HTTP error rate of the
+// requests routed to the canary instances over the observation period is
+// checked once the period is over.
+func (actor Actor) EvaluateCanaryHealth(app resources.Application, deployment resources.Deployment, criteria CanaryHealthCriteria, client sharedaction.LogCacheClient) (CanaryHealthReport, Warnings, error) {
+	var (
+		report      CanaryHealthReport
+		allWarnings Warnings
+	)
+
+	observationStart := actor.Clock.Now()
+	uptimes := map[string]time.Duration{}
+	crashed := map[string]bool{}
+
+	timer := actor.Clock.NewTimer(time.Millisecond)
+	defer timer.Stop()
+	observationEnd := actor.Clock.After(criteria.ObservationPeriod)
+
+	for {
+		select {
+		case <-observationEnd:
+			if criteria.MaxErrorRate != nil {
+				var processGUIDs []string
+				for _, process := range deployment.NewProcesses {
+					processGUIDs = append(processGUIDs, process.GUID)
+				}
+
+				stats, err := sharedaction.GetHTTPRequestStatsSince(app.GUID, processGUIDs, client, observationStart)
+				if err != nil {
+					return report, allWarnings, err
+				}
+				report.HTTPRequests = stats
+
+				if stats.ErrorRate() > *criteria.MaxErrorRate {
+					report.Reason = fmt.Sprintf(
+						"HTTP error rate of %.1f%% (%d of %d requests) exceeded the maximum of %.1f%%",
+						stats.ErrorRate(), stats.ServerErrors, stats.Requests, *criteria.MaxErrorRate,
+					)
+					return report, allWarnings, nil
+				}
+			}
+
+			report.Healthy = true
+			return report, allWarnings, nil
+		case <-timer.C():
+			report.Instances = 0
+			for _, process := range deployment.NewProcesses {
+				instances, warnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+				allWarnings = append(allWarnings, warnings...)
+				if err != nil {
+					return report, allWarnings, err
+				}
+
+				report.Instances += len(instances)
+				for _, instance := range instances {
+					key := fmt.Sprintf("%s/%d", process.GUID, instance.Index)
+
+					if instance.State == constant.ProcessInstanceCrashed {
+						if !crashed[key] {
+							report.Crashes++
+						}
+						crashed[key] = true
+					} else if previousUptime, seen := uptimes[key]; seen && !crashed[key] && instance.Uptime < previousUptime {
+						report.Crashes++
+					} else {
+						crashed[key] = false
+					}
+					uptimes[key] = instance.Uptime
+				}
+			}
+
+			if report.Crashes > criteria.MaxCrashes {
+				report.Reason = fmt.Sprintf(
+					"%d crash(es) observed across %d canary instance(s), more than the %d allowed",
+					report.Crashes, report.Instances, criteria.MaxCrashes,
+				)
+				return report, allWarnings, nil
+			}
+
+			timer.Reset(actor.Config.PollingInterval())
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Canary health actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeConfig                *v7actionfakes.FakeConfig
+		fakeClock                 *fakeclock.FakeClock
+		fakeLogCacheClient        *sharedactionfakes.FakeLogCacheClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, fakeConfig, _, _, _, fakeClock = NewTestActor()
+		fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+		fakeConfig.PollingIntervalReturns(time.Second)
+	})
+
+	Describe("EvaluateCanaryHealth", func() {
+		var (
+			app              resources.Application
+			deployment       resources.Deployment
+			criteria         CanaryHealthCriteria
+			observationStart time.Time
+
+			report     CanaryHealthReport
+			warnings   Warnings
+			executeErr error
+			done       chan bool
+		)
+
+		running := func(index int64, uptime time.Duration) ccv3.ProcessInstance {
+			return ccv3.ProcessInstance{Index: index, State: constant.ProcessInstanceRunning, Uptime: uptime}
+		}
+
+		// pollTwiceAndFinishObserving polls the instances at 1ms and 1.001s and
+		// then ends the 1.5s observation period.
+		pollTwiceAndFinishObserving := func() {
+			fakeClock.WaitForNWatchersAndIncrement(time.Millisecond, 2)
+			Eventually(fakeConfig.PollingIntervalCallCount).Should(Equal(1))
+			fakeClock.Increment(time.Second)
+			Eventually(fakeConfig.PollingIntervalCallCount).Should(Equal(2))
+			fakeClock.Increment(500 * time.Millisecond)
+		}
+
+		BeforeEach(func() {
+			done = make(chan bool)
+			app = resources.Application{GUID: "some-app-guid"}
+			deployment = resources.Deployment{
+				GUID:         "some-deployment-guid",
+				NewProcesses: []resources.Process{{GUID: "canary-process-guid", Type: constant.ProcessTypeWeb}},
+			}
+			criteria = CanaryHealthCriteria{ObservationPeriod: 1500 * time.Millisecond}
+			observationStart = fakeClock.Now()
+
+			fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(0,
+				[]ccv3.ProcessInstance{running(0, time.Second), running(1, time.Second)},
+				ccv3.Warnings{"instances-warning-1"},
+				nil,
+			)
+			fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(1,
+				[]ccv3.ProcessInstance{running(0, 2*time.Second), running(1, 2*time.Second)},
+				ccv3.Warnings{"instances-warning-2"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			go func() {
+				defer close(done)
+				report, warnings, executeErr = actor.EvaluateCanaryHealth(app, deployment, criteria, fakeLogCacheClient)
+				done <- true
+			}()
+		})
+
+		When("the canary instances stay up for the whole observation period", func() {
+			It("reports the canary as healthy", func() {
+				pollTwiceAndFinishObserving()
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("instances-warning-1", "instances-warning-2"))
+				Expect(report).To(Equal(CanaryHealthReport{Healthy: true, Instances: 2}))
+
+				Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("canary-process-guid"))
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a canary instance crashes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(0,
+					[]ccv3.ProcessInstance{running(0, time.Second), {Index: 1, State: constant.ProcessInstanceCrashed}},
+					ccv3.Warnings{"instances-warning-1"},
+					nil,
+				)
+			})
+
+			It("reports the canary as unhealthy without waiting for the observation period to end", func() {
+				fakeClock.WaitForNWatchersAndIncrement(time.Millisecond, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("instances-warning-1"))
+				Expect(report.Healthy).To(BeFalse())
+				Expect(report.Crashes).To(Equal(1))
+				Expect(report.Reason).To(Equal("1 crash(es) observed across 2 canary instance(s), more than the 0 allowed"))
+			})
+
+			When("the crash is within the number allowed", func() {
+				BeforeEach(func() {
+					criteria.MaxCrashes = 1
+				})
+
+				It("reports the canary as healthy", func() {
+					pollTwiceAndFinishObserving()
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(report.Healthy).To(BeTrue())
+					Expect(report.Crashes).To(Equal(1))
+				})
+			})
+		})
+
+		When("a canary instance restarts between polls", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(1,
+					[]ccv3.ProcessInstance{running(0, 2*time.Second), running(1, 100*time.Millisecond)},
+					ccv3.Warnings{"instances-warning-2"},
+					nil,
+				)
+			})
+
+			It("counts the restart as a crash", func() {
+				fakeClock.WaitForNWatchersAndIncrement(time.Millisecond, 2)
+				Eventually(fakeConfig.PollingIntervalCallCount).Should(Equal(1))
+				fakeClock.Increment(time.Second)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(report.Healthy).To(BeFalse())
+				Expect(report.Crashes).To(Equal(1))
+			})
+		})
+
+		When("getting the canary instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(0, nil, ccv3.Warnings{"instances-warning-1"}, errors.New("instances-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				fakeClock.WaitForNWatchersAndIncrement(time.Millisecond, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).To(MatchError("instances-error"))
+				Expect(warnings).To(ConsistOf("instances-warning-1"))
+			})
+		})
+
+		When("a maximum error rate is given", func() {
+			var envelopes []*loggregator_v2.Envelope
+
+			BeforeEach(func() {
+				maxErrorRate := 10.0
+				criteria.MaxErrorRate = &maxErrorRate
+
+				envelopes = nil
+				for _, statusCode := range []string{"200", "200", "200", "502"} {
+					envelopes = append(envelopes, &loggregator_v2.Envelope{
+						Tags:    map[string]string{"status_code": statusCode, "process_id": "canary-process-guid"},
+						Message: &loggregator_v2.Envelope_Timer{Timer: &loggregator_v2.Timer{Name: "http"}},
+					})
+				}
+				fakeLogCacheClient.ReadReturns(envelopes, nil)
+			})
+
+			It("checks the HTTP error rate over the observation period", func() {
+				pollTwiceAndFinishObserving()
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(report.Healthy).To(BeFalse())
+				Expect(report.HTTPRequests).To(Equal(sharedaction.HTTPRequestStats{Requests: 4, ServerErrors: 1}))
+				Expect(report.Reason).To(Equal("HTTP error rate of 25.0% (1 of 4 requests) exceeded the maximum of 10.0%"))
+
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(1))
+				_, sourceID, start, _ := fakeLogCacheClient.ReadArgsForCall(0)
+				Expect(sourceID).To(Equal("some-app-guid"))
+				Expect(start).To(Equal(observationStart))
+			})
+
+			When("the error rate is within the maximum", func() {
+				BeforeEach(func() {
+					fakeLogCacheClient.ReadReturns(envelopes[:3], nil)
+				})
+
+				It("reports the canary as healthy", func() {
+					pollTwiceAndFinishObserving()
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(report.Healthy).To(BeTrue())
+					Expect(report.HTTPRequests).To(Equal(sharedaction.HTTPRequestStats{Requests: 3}))
+				})
+			})
+
+			When("requests were also routed to the instances of the previous deployment", func() {
+				BeforeEach(func() {
+					for _, statusCode := range []string{"500", "502", "503"} {
+						envelopes = append(envelopes, &loggregator_v2.Envelope{
+							Tags:    map[string]string{"status_code": statusCode, "process_id": "old-process-guid"},
+							Message: &loggregator_v2.Envelope_Timer{Timer: &loggregator_v2.Timer{Name: "http"}},
+						})
+					}
+					fakeLogCacheClient.ReadReturns(append(envelopes[:3:3], envelopes[4:]...), nil)
+				})
+
+				It("only counts the requests routed to the canary instances", func() {
+					pollTwiceAndFinishObserving()
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(report.Healthy).To(BeTrue())
+					Expect(report.HTTPRequests).To(Equal(sharedaction.HTTPRequestStats{Requests: 3}))
+				})
+			})
+
+			When("reading from log cache fails", func() {
+				BeforeEach(func() {
+					fakeLogCacheClient.ReadReturns(nil, errors.New("log-cache-error"))
+				})
+
+				It("returns the error", func() {
+					pollTwiceAndFinishObserving()
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).To(MatchError("Failed to retrieve HTTP metrics from Log Cache: log-cache-error"))
+				})
+			})
+		})
+	})
+})
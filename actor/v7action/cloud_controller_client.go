@@ -23,6 +23,7 @@ type CloudControllerClient interface {
 	CreateApplication(app resources.Application) (resources.Application, ccv3.Warnings, error)
 	CreateApplicationDeployment(dep resources.Deployment) (string, ccv3.Warnings, error)
 	CreateApplicationProcessScale(appGUID string, process resources.Process) (resources.Process, ccv3.Warnings, error)
+	CreateApplicationSidecar(appGUID string, sidecar resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)
 	CreateApplicationTask(appGUID string, task resources.Task) (resources.Task, ccv3.Warnings, error)
 	CreateBuild(build resources.Build) (resources.Build, ccv3.Warnings, error)
 	CreateBuildpack(bp resources.Buildpack) (resources.Buildpack, ccv3.Warnings, error)
@@ -58,6 +59,7 @@ type CloudControllerClient interface {
 	DeleteServiceCredentialBinding(guid string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteServiceBroker(serviceBrokerGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteServiceInstance(serviceInstanceGUID string, query ...ccv3.Query) (ccv3.JobURL, ccv3.Warnings, error)
+	DeleteSidecar(sidecarGUID string) (ccv3.Warnings, error)
 	DeleteSpaceQuota(spaceQuotaGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteSpace(guid string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteUser(userGUID string) (ccv3.JobURL, ccv3.Warnings, error)
@@ -72,6 +74,7 @@ type CloudControllerClient interface {
 	GetApplicationRevisions(appGUID string, query ...ccv3.Query) ([]resources.Revision, ccv3.Warnings, error)
 	GetApplicationRevisionsDeployed(appGUID string) ([]resources.Revision, ccv3.Warnings, error)
 	GetApplicationRoutes(appGUID string) ([]resources.Route, ccv3.Warnings, error)
+	GetApplicationSidecars(appGUID string) ([]resources.Sidecar, ccv3.Warnings, error)
 	GetApplicationTasks(appGUID string, query ...ccv3.Query) ([]resources.Task, ccv3.Warnings, error)
 	GetApplications(query ...ccv3.Query) ([]resources.Application, ccv3.Warnings, error)
 	GetBuild(guid string) (resources.Build, ccv3.Warnings, error)
@@ -115,6 +118,7 @@ type CloudControllerClient interface {
 	GetServiceCredentialBindings(query ...ccv3.Query) ([]resources.ServiceCredentialBinding, ccv3.Warnings, error)
 	GetServiceCredentialBindingDetails(guid string) (resources.ServiceCredentialBindingDetails, ccv3.Warnings, error)
 	GetServiceInstanceByNameAndSpace(name, spaceGUID string, query ...ccv3.Query) (resources.ServiceInstance, ccv3.IncludedResources, ccv3.Warnings, error)
+	GetServiceInstanceCredentials(serviceInstanceGUID string) (types.JSONObject, ccv3.Warnings, error)
 	GetServiceInstanceParameters(serviceInstanceGUID string) (types.JSONObject, ccv3.Warnings, error)
 	GetServiceInstanceSharedSpaces(serviceInstanceGUID string) ([]ccv3.SpaceWithOrganization, ccv3.Warnings, error)
 	GetServiceInstanceUsageSummary(serviceInstanceGUID string) ([]resources.ServiceInstanceUsageSummary, ccv3.Warnings, error)
@@ -180,6 +184,7 @@ type CloudControllerClient interface {
 	UpdateSecurityGroupStagingSpace(securityGroupGUID string, spaceGUIDs []string) (ccv3.Warnings, error)
 	UpdateSecurityGroup(securityGroup resources.SecurityGroup) (resources.SecurityGroup, ccv3.Warnings, error)
 	UpdateServiceInstance(serviceInstanceGUID string, serviceInstanceUpdates resources.ServiceInstance) (ccv3.JobURL, ccv3.Warnings, error)
+	UpdateSidecar(sidecar resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)
 	UpdateSpace(space resources.Space) (resources.Space, ccv3.Warnings, error)
 	UpdateSpaceApplyManifest(spaceGUID string, rawManifest []byte) (ccv3.JobURL, ccv3.Warnings, error)
 	UpdateSpaceFeature(spaceGUID string, enabled bool, featureName string) (ccv3.Warnings, error)
@@ -13,6 +13,7 @@ type Config interface {
 	APIVersion() string
 	CurrentUser() (configv3.User, error)
 	DialTimeout() time.Duration
+	OverallPollingTimeout() time.Duration
 	PollingInterval() time.Duration
 	RefreshToken() string
 	SSHOAuthClient() string
@@ -12,6 +12,11 @@ func (actor Actor) CreateDeployment(dep resources.Deployment) (string, Warnings,
 	return deploymentGUID, Warnings(warnings), err
 }
 
+func (actor Actor) GetDeployment(deploymentGUID string) (resources.Deployment, Warnings, error) {
+	deployment, warnings, err := actor.CloudControllerClient.GetDeployment(deploymentGUID)
+	return deployment, Warnings(warnings), err
+}
+
 func (actor Actor) GetLatestActiveDeploymentForApp(appGUID string) (resources.Deployment, Warnings, error) {
 	ccDeployments, warnings, err := actor.CloudControllerClient.GetDeployments(
 		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{appGUID}},
@@ -0,0 +1,211 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+const deploymentCreateEventType = "audit.app.deployment.create"
+
+// DeploymentSummary is a deployment of an app along with the name of the user
+// who created it. CreatedBy is empty when the audit event recording the
+// creation is no longer available.
+type DeploymentSummary struct {
+	resources.Deployment
+	CreatedBy string
+}
+
+// DeploymentProcessSummary holds the instance counts of a process involved in
+// a deployment. Processes that have since been removed have Removed set and
+// no instance counts.
+type DeploymentProcessSummary struct {
+	GUID             string
+	Type             string
+	DesiredInstances int
+	RunningInstances int
+	Removed          bool
+}
+
+// DetailedDeploymentSummary is a deployment along with the processes it is
+// replacing and the processes it created. OldProcesses is only populated
+// while the deployment is active; the old processes are removed once it
+// finishes.
+type DetailedDeploymentSummary struct {
+	DeploymentSummary
+	OldProcesses []DeploymentProcessSummary
+	NewProcesses []DeploymentProcessSummary
+}
+
+// GetDeploymentSummariesForApp returns every deployment of the app, most
+// recent first.
+func (actor Actor) GetDeploymentSummariesForApp(appGUID string) ([]DeploymentSummary, Warnings, error) {
+	deployments, ccWarnings, err := actor.CloudControllerClient.GetDeployments(
+		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{appGUID}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	allWarnings := Warnings(ccWarnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if len(deployments) == 0 {
+		return nil, allWarnings, nil
+	}
+
+	creators, warnings, err := actor.getDeploymentCreators(appGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var summaries []DeploymentSummary
+	for _, deployment := range deployments {
+		summaries = append(summaries, DeploymentSummary{Deployment: deployment, CreatedBy: creators[deployment.GUID]})
+	}
+
+	return summaries, allWarnings, nil
+}
+
+// GetDetailedDeploymentSummary returns the given deployment of the app, or
+// its most recent deployment when deploymentGUID is empty, along with the
+// instance counts of the processes involved.
+func (actor Actor) GetDetailedDeploymentSummary(appGUID string, deploymentGUID string) (DetailedDeploymentSummary, Warnings, error) {
+	deployment, allWarnings, err := actor.getDeploymentOfApp(appGUID, deploymentGUID)
+	if err != nil {
+		return DetailedDeploymentSummary{}, allWarnings, err
+	}
+
+	creators, warnings, err := actor.getDeploymentCreators(appGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return DetailedDeploymentSummary{}, allWarnings, err
+	}
+
+	summary := DetailedDeploymentSummary{
+		DeploymentSummary: DeploymentSummary{Deployment: deployment, CreatedBy: creators[deployment.GUID]},
+	}
+
+	newProcessGUIDs := map[string]bool{}
+	for _, process := range deployment.NewProcesses {
+		newProcessGUIDs[process.GUID] = true
+
+		processSummary, warnings, err := actor.getDeploymentProcessSummary(process)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return DetailedDeploymentSummary{}, allWarnings, err
+		}
+		summary.NewProcesses = append(summary.NewProcesses, processSummary)
+	}
+
+	if deployment.StatusValue != constant.DeploymentStatusValueActive {
+		return summary, allWarnings, nil
+	}
+
+	processes, ccWarnings, err := actor.CloudControllerClient.GetApplicationProcesses(appGUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return DetailedDeploymentSummary{}, allWarnings, err
+	}
+
+	for _, process := range processes {
+		if process.Type != constant.ProcessTypeWeb || newProcessGUIDs[process.GUID] {
+			continue
+		}
+
+		processSummary, warnings, err := actor.getDeploymentProcessSummary(process)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return DetailedDeploymentSummary{}, allWarnings, err
+		}
+		summary.OldProcesses = append(summary.OldProcesses, processSummary)
+	}
+
+	return summary, allWarnings, nil
+}
+
+func (actor Actor) getDeploymentOfApp(appGUID string, deploymentGUID string) (resources.Deployment, Warnings, error) {
+	if deploymentGUID == "" {
+		deployments, warnings, err := actor.CloudControllerClient.GetDeployments(
+			ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{appGUID}},
+			ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+			ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
+			ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+		)
+		if err != nil {
+			return resources.Deployment{}, Warnings(warnings), err
+		}
+		if len(deployments) == 0 {
+			return resources.Deployment{}, Warnings(warnings), actionerror.DeploymentNotFoundError{}
+		}
+		return deployments[0], Warnings(warnings), nil
+	}
+
+	deployment, warnings, err := actor.CloudControllerClient.GetDeployment(deploymentGUID)
+	if _, ok := err.(ccerror.DeploymentNotFoundError); ok {
+		return resources.Deployment{}, Warnings(warnings), actionerror.DeploymentNotFoundError{GUID: deploymentGUID}
+	}
+	if err != nil {
+		return resources.Deployment{}, Warnings(warnings), err
+	}
+
+	if deployment.Relationships[constant.RelationshipTypeApplication].GUID != appGUID {
+		return resources.Deployment{}, Warnings(warnings), actionerror.DeploymentNotFoundError{GUID: deploymentGUID}
+	}
+
+	return deployment, Warnings(warnings), nil
+}
+
+// getDeploymentCreators maps the GUIDs of the app's deployments to the names
+// of the users who created them, using the app's audit events.
+func (actor Actor) getDeploymentCreators(appGUID string) (map[string]string, Warnings, error) {
+	events, warnings, err := actor.CloudControllerClient.GetEvents(
+		ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{appGUID}},
+		ccv3.Query{Key: ccv3.EventTypesFilter, Values: []string{deploymentCreateEventType}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	creators := map[string]string{}
+	for _, event := range events {
+		if deploymentGUID, ok := event.Data["deployment_guid"].(string); ok {
+			creators[deploymentGUID] = event.ActorName
+		}
+	}
+
+	return creators, Warnings(warnings), nil
+}
+
+func (actor Actor) getDeploymentProcessSummary(process resources.Process) (DeploymentProcessSummary, Warnings, error) {
+	summary := DeploymentProcessSummary{GUID: process.GUID, Type: process.Type}
+
+	ccProcess, ccWarnings, err := actor.CloudControllerClient.GetProcess(process.GUID)
+	allWarnings := Warnings(ccWarnings)
+	if _, ok := err.(ccerror.ProcessNotFoundError); ok {
+		summary.Removed = true
+		return summary, allWarnings, nil
+	}
+	if err != nil {
+		return summary, allWarnings, err
+	}
+	summary.DesiredInstances = ccProcess.Instances.Value
+
+	instances, ccWarnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return summary, allWarnings, err
+	}
+
+	for _, instance := range instances {
+		if instance.State == constant.ProcessInstanceRunning {
+			summary.RunningInstances++
+		}
+	}
+
+	return summary, allWarnings, nil
+}
@@ -0,0 +1,293 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Deployment summary actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	appRelationship := resources.Relationships{
+		constant.RelationshipTypeApplication: resources.Relationship{GUID: "some-app-guid"},
+	}
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+
+		fakeCloudControllerClient.GetEventsReturns(
+			[]ccv3.Event{
+				{ActorName: "alice", Data: map[string]interface{}{"deployment_guid": "deployment-2"}},
+				{ActorName: "bob", Data: map[string]interface{}{"deployment_guid": "deployment-1"}},
+				{ActorName: "carol", Data: map[string]interface{}{}},
+			},
+			ccv3.Warnings{"events-warning"},
+			nil,
+		)
+	})
+
+	Describe("GetDeploymentSummariesForApp", func() {
+		var (
+			summaries  []DeploymentSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			summaries, warnings, executeErr = actor.GetDeploymentSummariesForApp("some-app-guid")
+		})
+
+		When("the app has deployments", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentsReturns(
+					[]resources.Deployment{
+						{GUID: "deployment-3"},
+						{GUID: "deployment-2"},
+						{GUID: "deployment-1"},
+					},
+					ccv3.Warnings{"deployments-warning"},
+					nil,
+				)
+			})
+
+			It("returns every deployment of the app with the user who created it", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("deployments-warning", "events-warning"))
+				Expect(summaries).To(Equal([]DeploymentSummary{
+					{Deployment: resources.Deployment{GUID: "deployment-3"}},
+					{Deployment: resources.Deployment{GUID: "deployment-2"}, CreatedBy: "alice"},
+					{Deployment: resources.Deployment{GUID: "deployment-1"}, CreatedBy: "bob"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetDeploymentsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetDeploymentsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				))
+
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{"some-app-guid"}},
+					ccv3.Query{Key: ccv3.EventTypesFilter, Values: []string{"audit.app.deployment.create"}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				))
+			})
+
+			When("getting the events fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetEventsReturns(nil, ccv3.Warnings{"events-warning"}, errors.New("events-error"))
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError("events-error"))
+					Expect(warnings).To(ConsistOf("deployments-warning", "events-warning"))
+				})
+			})
+		})
+
+		When("the app has no deployments", func() {
+			It("returns no summaries without looking up events", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(summaries).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the deployments fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentsReturns(nil, ccv3.Warnings{"deployments-warning"}, errors.New("deployments-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("deployments-error"))
+				Expect(warnings).To(ConsistOf("deployments-warning"))
+			})
+		})
+	})
+
+	Describe("GetDetailedDeploymentSummary", func() {
+		var (
+			deploymentGUID string
+
+			summary    DetailedDeploymentSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			deploymentGUID = "deployment-2"
+
+			fakeCloudControllerClient.GetProcessStub = func(processGUID string) (resources.Process, ccv3.Warnings, error) {
+				switch processGUID {
+				case "new-web-guid":
+					return resources.Process{GUID: processGUID, Instances: types.NullInt{Value: 4, IsSet: true}}, ccv3.Warnings{"new-process-warning"}, nil
+				case "old-web-guid":
+					return resources.Process{GUID: processGUID, Instances: types.NullInt{Value: 2, IsSet: true}}, ccv3.Warnings{"old-process-warning"}, nil
+				}
+				return resources.Process{}, nil, ccerror.ProcessNotFoundError{}
+			}
+			fakeCloudControllerClient.GetProcessInstancesStub = func(processGUID string) ([]ccv3.ProcessInstance, ccv3.Warnings, error) {
+				if processGUID == "new-web-guid" {
+					return []ccv3.ProcessInstance{
+						{State: constant.ProcessInstanceRunning},
+						{State: constant.ProcessInstanceStarting},
+					}, nil, nil
+				}
+				return []ccv3.ProcessInstance{
+					{State: constant.ProcessInstanceRunning},
+					{State: constant.ProcessInstanceRunning},
+				}, nil, nil
+			}
+			fakeCloudControllerClient.GetApplicationProcessesReturns(
+				[]resources.Process{
+					{GUID: "old-web-guid", Type: constant.ProcessTypeWeb},
+					{GUID: "new-web-guid", Type: constant.ProcessTypeWeb},
+					{GUID: "worker-guid", Type: "worker"},
+				},
+				ccv3.Warnings{"app-processes-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			summary, warnings, executeErr = actor.GetDetailedDeploymentSummary("some-app-guid", deploymentGUID)
+		})
+
+		When("the deployment is active", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturns(
+					resources.Deployment{
+						GUID:          "deployment-2",
+						StatusValue:   constant.DeploymentStatusValueActive,
+						Relationships: appRelationship,
+						NewProcesses:  []resources.Process{{GUID: "new-web-guid", Type: constant.ProcessTypeWeb}},
+					},
+					ccv3.Warnings{"deployment-warning"},
+					nil,
+				)
+			})
+
+			It("returns the instance counts of the old and new web processes", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("deployment-warning", "events-warning", "new-process-warning", "app-processes-warning", "old-process-warning"))
+
+				Expect(summary.GUID).To(Equal("deployment-2"))
+				Expect(summary.CreatedBy).To(Equal("alice"))
+				Expect(summary.NewProcesses).To(Equal([]DeploymentProcessSummary{
+					{GUID: "new-web-guid", Type: constant.ProcessTypeWeb, DesiredInstances: 4, RunningInstances: 1},
+				}))
+				Expect(summary.OldProcesses).To(Equal([]DeploymentProcessSummary{
+					{GUID: "old-web-guid", Type: constant.ProcessTypeWeb, DesiredInstances: 2, RunningInstances: 2},
+				}))
+
+				Expect(fakeCloudControllerClient.GetDeploymentArgsForCall(0)).To(Equal("deployment-2"))
+				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		When("the deployment has finished", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturns(
+					resources.Deployment{
+						GUID:          "deployment-2",
+						StatusValue:   constant.DeploymentStatusValueFinalized,
+						Relationships: appRelationship,
+						NewProcesses:  []resources.Process{{GUID: "deleted-web-guid", Type: constant.ProcessTypeWeb}},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("marks removed processes and does not look up old processes", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(summary.NewProcesses).To(Equal([]DeploymentProcessSummary{
+					{GUID: "deleted-web-guid", Type: constant.ProcessTypeWeb, Removed: true},
+				}))
+				Expect(summary.OldProcesses).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the deployment belongs to another app", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturns(
+					resources.Deployment{
+						GUID: "deployment-2",
+						Relationships: resources.Relationships{
+							constant.RelationshipTypeApplication: resources.Relationship{GUID: "other-app-guid"},
+						},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("returns a DeploymentNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.DeploymentNotFoundError{GUID: "deployment-2"}))
+			})
+		})
+
+		When("the deployment does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturns(resources.Deployment{}, ccv3.Warnings{"deployment-warning"}, ccerror.DeploymentNotFoundError{})
+			})
+
+			It("returns a DeploymentNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.DeploymentNotFoundError{GUID: "deployment-2"}))
+				Expect(warnings).To(ConsistOf("deployment-warning"))
+			})
+		})
+
+		When("no deployment GUID is given", func() {
+			BeforeEach(func() {
+				deploymentGUID = ""
+				fakeCloudControllerClient.GetDeploymentsReturns(
+					[]resources.Deployment{{GUID: "deployment-1", StatusValue: constant.DeploymentStatusValueFinalized}},
+					ccv3.Warnings{"deployments-warning"},
+					nil,
+				)
+			})
+
+			It("returns the most recent deployment of the app", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(summary.GUID).To(Equal("deployment-1"))
+				Expect(summary.CreatedBy).To(Equal("bob"))
+				Expect(warnings).To(ConsistOf("deployments-warning", "events-warning"))
+
+				Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetDeploymentsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
+					ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+				))
+			})
+
+			When("the app has no deployments", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetDeploymentsReturns(nil, ccv3.Warnings{"deployments-warning"}, nil)
+				})
+
+				It("returns a DeploymentNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.DeploymentNotFoundError{}))
+					Expect(warnings).To(ConsistOf("deployments-warning"))
+				})
+			})
+		})
+	})
+})
@@ -170,6 +170,46 @@ var _ = Describe("Deployment Actions", func() {
 		})
 	})
 
+	Describe("GetDeployment", func() {
+		var (
+			deployment resources.Deployment
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetDeploymentReturns(
+				resources.Deployment{GUID: "dep-guid", StatusValue: constant.DeploymentStatusValueActive},
+				ccv3.Warnings{"get-deployment-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			deployment, warnings, executeErr = actor.GetDeployment("dep-guid")
+		})
+
+		It("returns the deployment and warnings", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-deployment-warning"))
+			Expect(deployment).To(Equal(resources.Deployment{GUID: "dep-guid", StatusValue: constant.DeploymentStatusValueActive}))
+
+			Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetDeploymentArgsForCall(0)).To(Equal("dep-guid"))
+		})
+
+		When("the client fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturns(resources.Deployment{}, ccv3.Warnings{"get-deployment-warning"}, errors.New("get-deployment-error"))
+			})
+
+			It("returns the warnings and error", func() {
+				Expect(executeErr).To(MatchError("get-deployment-error"))
+				Expect(warnings).To(ConsistOf("get-deployment-warning"))
+			})
+		})
+	})
+
 	Describe("CancelDeployment", func() {
 		var (
 			deploymentGUID string
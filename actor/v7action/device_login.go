@@ -0,0 +1,61 @@
+package v7action
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+)
+
+// DeviceAuthorization is a device login in progress.
+type DeviceAuthorization uaa.DeviceAuthorization
+
+const (
+	defaultDevicePollInterval = 5 * time.Second
+	deviceSlowDownIncrement   = 5 * time.Second
+	defaultDeviceCodeExpiry   = 5 * time.Minute
+)
+
+// StartDeviceLogin starts a device authorization grant (RFC 8628). The user
+// approves the login on another device using the returned verification URI
+// and user code.
+func (actor Actor) StartDeviceLogin() (DeviceAuthorization, error) {
+	authorization, err := actor.UAAClient.RequestDeviceAuthorization()
+	return DeviceAuthorization(authorization), err
+}
+
+// FinishDeviceLogin polls for the tokens of the device authorization until
+// the user approves it or the device code expires, and stores them in the
+// same way as Authenticate.
+func (actor Actor) FinishDeviceLogin(authorization DeviceAuthorization) error {
+	interval := time.Duration(authorization.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultDevicePollInterval
+	}
+	expiry := time.Duration(authorization.ExpiresIn) * time.Second
+	if expiry <= 0 {
+		expiry = defaultDeviceCodeExpiry
+	}
+	expired := actor.Clock.After(expiry)
+
+	credentials := map[string]string{"device_code": authorization.DeviceCode}
+	for {
+		select {
+		case <-expired:
+			return errors.New("the device code expired before the login was approved")
+		case <-actor.Clock.After(interval):
+		}
+
+		err := actor.Authenticate(credentials, "", constant.GrantTypeDeviceCode)
+		switch err.(type) {
+		case nil:
+			return nil
+		case uaa.AuthorizationPendingError:
+		case uaa.SlowDownError:
+			interval += deviceSlowDownIncrement
+		default:
+			return err
+		}
+	}
+}
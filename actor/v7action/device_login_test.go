@@ -0,0 +1,134 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Device login actions", func() {
+	var (
+		actor         *Actor
+		fakeConfig    *v7actionfakes.FakeConfig
+		fakeUAAClient *v7actionfakes.FakeUAAClient
+		fakeClock     *fakeclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(v7actionfakes.FakeConfig)
+		fakeUAAClient = new(v7actionfakes.FakeUAAClient)
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		actor = NewActor(nil, fakeConfig, nil, fakeUAAClient, nil, fakeClock)
+	})
+
+	Describe("StartDeviceLogin", func() {
+		It("requests a device authorization", func() {
+			fakeUAAClient.RequestDeviceAuthorizationReturns(uaa.DeviceAuthorization{UserCode: "WDJB-MJHT"}, nil)
+
+			authorization, err := actor.StartDeviceLogin()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(authorization.UserCode).To(Equal("WDJB-MJHT"))
+			Expect(fakeUAAClient.RequestDeviceAuthorizationCallCount()).To(Equal(1))
+		})
+	})
+
+	Describe("FinishDeviceLogin", func() {
+		var (
+			authorization DeviceAuthorization
+			done          chan error
+		)
+
+		BeforeEach(func() {
+			authorization = DeviceAuthorization{
+				DeviceCode: "some-device-code",
+				ExpiresIn:  60,
+				Interval:   5,
+			}
+			fakeUAAClient.AuthenticateReturnsOnCall(0, "", "", uaa.AuthorizationPendingError{})
+			fakeUAAClient.AuthenticateReturnsOnCall(1, "", "", uaa.SlowDownError{})
+			fakeUAAClient.AuthenticateReturnsOnCall(2, "some-access-token", "some-refresh-token", nil)
+		})
+
+		JustBeforeEach(func() {
+			done = make(chan error, 1)
+			go func() {
+				done <- actor.FinishDeviceLogin(authorization)
+			}()
+		})
+
+		// advance waits for the poll and the expiry timers before moving the
+		// clock on.
+		advance := func(duration time.Duration) {
+			fakeClock.WaitForNWatchersAndIncrement(duration, 2)
+		}
+
+		It("polls at the interval, slowing down when asked to, and stores the tokens", func() {
+			advance(5 * time.Second)
+			Eventually(fakeUAAClient.AuthenticateCallCount).Should(Equal(1))
+			credentials, origin, grantType := fakeUAAClient.AuthenticateArgsForCall(0)
+			Expect(credentials).To(Equal(map[string]string{"device_code": "some-device-code"}))
+			Expect(origin).To(BeEmpty())
+			Expect(grantType).To(Equal(constant.GrantTypeDeviceCode))
+
+			advance(5 * time.Second)
+			Eventually(fakeUAAClient.AuthenticateCallCount).Should(Equal(2))
+
+			advance(5 * time.Second)
+			Consistently(fakeUAAClient.AuthenticateCallCount).Should(Equal(2))
+			fakeClock.Increment(5 * time.Second)
+			Eventually(fakeUAAClient.AuthenticateCallCount).Should(Equal(3))
+
+			Eventually(done).Should(Receive(BeNil()))
+			accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(fakeConfig.SetTokenInformationCallCount() - 1)
+			Expect(accessToken).To(Equal("bearer some-access-token"))
+			Expect(refreshToken).To(Equal("some-refresh-token"))
+			Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(BeEmpty())
+		})
+
+		When("the user denies the login", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateReturnsOnCall(0, "", "", errors.New("access_denied"))
+			})
+
+			It("returns the error", func() {
+				advance(5 * time.Second)
+				Eventually(done).Should(Receive(MatchError("access_denied")))
+			})
+		})
+
+		When("the device code expires", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateReturns("", "", uaa.AuthorizationPendingError{})
+				fakeUAAClient.AuthenticateReturnsOnCall(1, "", "", uaa.AuthorizationPendingError{})
+				fakeUAAClient.AuthenticateReturnsOnCall(2, "", "", uaa.AuthorizationPendingError{})
+			})
+
+			It("stops polling and returns an error", func() {
+				advance(60 * time.Second)
+				Eventually(done).Should(Receive(MatchError("the device code expired before the login was approved")))
+			})
+
+			When("the server does not say when the device code expires", func() {
+				BeforeEach(func() {
+					authorization.ExpiresIn = 0
+				})
+
+				It("keeps polling until the default expiry", func() {
+					advance(5 * time.Second)
+					Eventually(fakeUAAClient.AuthenticateCallCount).Should(Equal(1))
+					Consistently(done).ShouldNot(Receive())
+
+					advance(5 * time.Minute)
+					Eventually(done).Should(Receive(MatchError("the device code expired before the login was approved")))
+				})
+			})
+		})
+	})
+})
@@ -15,44 +15,192 @@ type Event struct {
 	Time        time.Time
 	Type        string
 	ActorName   string
+	TargetType  string
+	TargetName  string
 	Description string
 }
 
-func (actor Actor) GetRecentEventsByApplicationNameAndSpace(appName string, spaceGUID string) ([]Event, Warnings, error) {
-	var allWarnings Warnings
+// EventFilters narrows down the events returned by GetEventsByApplicationNameAndSpace,
+// GetEventsBySpace and GetEventsByOrganization. Zero values do not filter.
+type EventFilters struct {
+	// Types are the event types to return, e.g. "audit.app.update".
+	Types []string
+	// ActorName is the name of the user or client that triggered the events.
+	ActorName string
+	// Since and Until bound the creation time of the events, inclusively.
+	Since time.Time
+	Until time.Time
+	// Limit is the maximum number of the most recent events to return.
+	Limit int
+}
+
+// maxActorEventPages is the number of pages of events searched for the events
+// of an actor, since the API cannot filter by actor.
+const maxActorEventPages = 10
 
-	app, appWarnings, appErr := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
-	allWarnings = append(allWarnings, appWarnings...)
-	if appErr != nil {
-		return nil, allWarnings, appErr
+// GetEventsByApplicationNameAndSpace returns the events of the app, most
+// recent first.
+func (actor Actor) GetEventsByApplicationNameAndSpace(appName string, spaceGUID string, filters EventFilters) ([]Event, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
 	}
 
-	ccEvents, warnings, err := actor.CloudControllerClient.GetEvents(
-		ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{app.GUID}},
-		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
-		ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
-	)
+	events, warnings, err := actor.getFilteredEvents(filters, ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{app.GUID}})
 	allWarnings = append(allWarnings, warnings...)
+	return events, allWarnings, err
+}
+
+// GetEventsBySpace returns the events of all resources in the space, most
+// recent first.
+func (actor Actor) GetEventsBySpace(spaceGUID string, filters EventFilters) ([]Event, Warnings, error) {
+	return actor.getFilteredEvents(filters, ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}})
+}
+
+// GetEventsByOrganization returns the events of all resources in the
+// organization, most recent first.
+func (actor Actor) GetEventsByOrganization(orgGUID string, filters EventFilters) ([]Event, Warnings, error) {
+	return actor.getFilteredEvents(filters, ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}})
+}
+
+func (actor Actor) getFilteredEvents(filters EventFilters, scope ccv3.Query) ([]Event, Warnings, error) {
+	queries := []ccv3.Query{
+		scope,
+		{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+	}
+	if len(filters.Types) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.EventTypesFilter, Values: filters.Types})
+	}
+	if !filters.Since.IsZero() {
+		queries = append(queries, ccv3.Query{Key: ccv3.CreatedAtsAfterOrEqualFilter, Values: []string{filters.Since.UTC().Format(time.RFC3339)}})
+	}
+	if !filters.Until.IsZero() {
+		queries = append(queries, ccv3.Query{Key: ccv3.CreatedAtsBeforeOrEqualFilter, Values: []string{filters.Until.UTC().Format(time.RFC3339)}})
+	}
+
+	if filters.ActorName != "" {
+		return actor.getEventsByActor(filters, queries)
+	}
+
+	// A single page holds the requested events when there are few enough.
+	maxPerPage, _ := strconv.Atoi(ccv3.MaxPerPage)
+	switch {
+	case filters.Limit > maxPerPage:
+		return actor.getEventsUpToLimit(filters, queries)
+	case filters.Limit > 0:
+		queries = append(queries,
+			ccv3.Query{Key: ccv3.PerPage, Values: []string{strconv.Itoa(filters.Limit)}},
+			ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+		)
+	default:
+		queries = append(queries, ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}})
+	}
 
+	ccEvents, warnings, err := actor.CloudControllerClient.GetEvents(queries...)
 	if err != nil {
-		return nil, allWarnings, err
+		return nil, Warnings(warnings), err
 	}
 
 	var events []Event
 	for _, ccEvent := range ccEvents {
-		events = append(events, Event{
-			GUID:        ccEvent.GUID,
-			Time:        ccEvent.CreatedAt,
-			Type:        ccEvent.Type,
-			ActorName:   ccEvent.ActorName,
-			Description: generateDescription(ccEvent.Data),
-		})
+		if filters.Limit > 0 && len(events) == filters.Limit {
+			break
+		}
+		events = append(events, convertEvent(ccEvent))
+	}
 
+	return events, Warnings(warnings), nil
+}
+
+// getEventsUpToLimit fetches the events one page at a time until the limit is
+// reached or there are no more events, rather than fetching every page.
+func (actor Actor) getEventsUpToLimit(filters EventFilters, queries []ccv3.Query) ([]Event, Warnings, error) {
+	var (
+		events      []Event
+		allWarnings Warnings
+	)
+
+	maxPerPage, _ := strconv.Atoi(ccv3.MaxPerPage)
+	for page := 1; ; page++ {
+		pageQueries := append(queries[:len(queries):len(queries)],
+			ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			ccv3.Query{Key: ccv3.Page, Values: []string{strconv.Itoa(page)}},
+		)
+
+		ccEvents, warnings, err := actor.CloudControllerClient.GetEvents(pageQueries...)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, ccEvent := range ccEvents {
+			events = append(events, convertEvent(ccEvent))
+			if len(events) == filters.Limit {
+				return events, allWarnings, nil
+			}
+		}
+
+		if len(ccEvents) < maxPerPage {
+			return events, allWarnings, nil
+		}
 	}
+}
+
+// getEventsByActor filters the events by actor one page at a time, until
+// enough events are found or maxActorEventPages pages have been searched.
+func (actor Actor) getEventsByActor(filters EventFilters, queries []ccv3.Query) ([]Event, Warnings, error) {
+	var (
+		events      []Event
+		allWarnings Warnings
+	)
 
+	maxPerPage, _ := strconv.Atoi(ccv3.MaxPerPage)
+	for page := 1; page <= maxActorEventPages; page++ {
+		pageQueries := append(queries[:len(queries):len(queries)],
+			ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			ccv3.Query{Key: ccv3.Page, Values: []string{strconv.Itoa(page)}},
+		)
+
+		ccEvents, warnings, err := actor.CloudControllerClient.GetEvents(pageQueries...)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, ccEvent := range ccEvents {
+			if ccEvent.ActorName != filters.ActorName {
+				continue
+			}
+			events = append(events, convertEvent(ccEvent))
+			if filters.Limit > 0 && len(events) == filters.Limit {
+				return events, allWarnings, nil
+			}
+		}
+
+		if len(ccEvents) < maxPerPage {
+			return events, allWarnings, nil
+		}
+	}
+
+	allWarnings = append(allWarnings, fmt.Sprintf(
+		"Only the %d most recent events were searched for events by %s.",
+		maxActorEventPages*maxPerPage, filters.ActorName,
+	))
 	return events, allWarnings, nil
 }
 
+func convertEvent(ccEvent ccv3.Event) Event {
+	return Event{
+		GUID:        ccEvent.GUID,
+		Time:        ccEvent.CreatedAt,
+		Type:        ccEvent.Type,
+		ActorName:   ccEvent.ActorName,
+		TargetType:  ccEvent.TargetType,
+		TargetName:  ccEvent.TargetName,
+		Description: generateDescription(ccEvent.Data),
+	}
+}
+
 var knownMetadataKeys = []string{
 	"index",
 	"reason",
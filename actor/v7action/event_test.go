@@ -2,6 +2,8 @@ package v7action_test
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
@@ -21,106 +23,267 @@ var _ = Describe("Event Actions", func() {
 		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
 	})
 
-	Describe("GetRecentEventsForApp", func() {
+	Describe("GetEventsByApplicationNameAndSpace", func() {
 		var (
-			appName   string
-			spaceGUID string
-			events    []Event
-			warnings  Warnings
-			err       error
+			filters  EventFilters
+			events   []Event
+			warnings Warnings
+			err      error
 		)
 
 		BeforeEach(func() {
-			appName = "some-app-name"
-			spaceGUID = "some-space-guid"
+			filters = EventFilters{}
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{GUID: "some-app-guid"}},
+				ccv3.Warnings{"some-app-warnings"},
+				nil,
+			)
+			fakeCloudControllerClient.GetEventsReturns(
+				[]ccv3.Event{
+					{GUID: "event-1", Type: "audit.app.update", ActorName: "admin", TargetType: "app", TargetName: "some-app"},
+					{GUID: "event-2", Type: "audit.app.update", ActorName: "ci-client", TargetType: "app", TargetName: "some-app"},
+					{GUID: "event-3", Type: "audit.app.update", ActorName: "admin", TargetType: "app", TargetName: "some-app"},
+				},
+				ccv3.Warnings{"some-event-warnings"},
+				nil,
+			)
 		})
 
 		JustBeforeEach(func() {
-			events, warnings, err = actor.GetRecentEventsByApplicationNameAndSpace(appName, spaceGUID)
+			events, warnings, err = actor.GetEventsByApplicationNameAndSpace("some-app", "some-space-guid", filters)
 		})
 
-		When("getting the app succeeds", func() {
+		When("no filters are given", func() {
+			It("returns all events of the app, most recent first", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warnings", "some-event-warnings"))
+				Expect(events).To(HaveLen(3))
+				Expect(events[0]).To(Equal(Event{GUID: "event-1", Type: "audit.app.update", ActorName: "admin", TargetType: "app", TargetName: "some-app"}))
+
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{"some-app-guid"}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				))
+			})
+		})
+
+		When("types, a time window and a limit are given", func() {
 			BeforeEach(func() {
-				apps := []resources.Application{
-					{GUID: "some-app-guid"},
+				filters = EventFilters{
+					Types: []string{"audit.app.update", "audit.app.delete-request"},
+					Since: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+					Until: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+					Limit: 2,
 				}
+			})
 
-				ccWarnings := ccv3.Warnings{
-					"some-app-warnings",
-				}
+			It("filters the events in the request and only fetches the first page", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(events).To(HaveLen(2))
 
-				fakeCloudControllerClient.GetApplicationsReturns(
-					apps,
-					ccWarnings,
-					nil,
-				)
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{"some-app-guid"}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+					ccv3.Query{Key: ccv3.EventTypesFilter, Values: []string{"audit.app.update", "audit.app.delete-request"}},
+					ccv3.Query{Key: ccv3.CreatedAtsAfterOrEqualFilter, Values: []string{"2024-01-02T00:00:00Z"}},
+					ccv3.Query{Key: ccv3.CreatedAtsBeforeOrEqualFilter, Values: []string{"2024-01-03T00:00:00Z"}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{"2"}},
+					ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+				))
 			})
+		})
 
-			When("the cc client returns the list of events", func() {
-				BeforeEach(func() {
-					ccEvents := []ccv3.Event{
+		When("the events have data", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(
+					[]ccv3.Event{
 						{GUID: "event-1", Type: "audit.app.wow", Data: map[string]interface{}{"index": "17"}},
 						{GUID: "event-2", Type: "audit.app.cool", Data: map[string]interface{}{"unimportant_key": "23"}},
-					}
+					},
+					ccv3.Warnings{"some-event-warnings"},
+					nil,
+				)
+			})
 
-					ccWarnings := ccv3.Warnings{
-						"some-event-warnings",
-					}
+			It("describes the events with their known data", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(events).To(Equal([]Event{
+					{GUID: "event-1", Type: "audit.app.wow", Description: "index: 17"},
+					{GUID: "event-2", Type: "audit.app.cool", Description: ""},
+				}))
+			})
+		})
 
-					fakeCloudControllerClient.GetEventsReturns(
-						ccEvents,
-						ccWarnings,
-						nil,
-					)
+		When("an actor and a limit are given", func() {
+			BeforeEach(func() {
+				filters = EventFilters{ActorName: "admin", Limit: 1}
+			})
+
+			It("fetches the first page and returns only the events of the actor, up to the limit", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(events).To(HaveLen(1))
+				Expect(events[0].GUID).To(Equal("event-1"))
+
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ContainElements(
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+					ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+				))
+			})
+		})
+
+		When("an actor is given and the events span several pages", func() {
+			var fullPage []ccv3.Event
+
+			BeforeEach(func() {
+				filters = EventFilters{ActorName: "admin", Limit: 2}
+
+				fullPage = make([]ccv3.Event, 5000)
+				for i := range fullPage {
+					fullPage[i] = ccv3.Event{GUID: fmt.Sprintf("ci-event-%d", i), ActorName: "ci-client"}
+				}
+				fullPage[4999] = ccv3.Event{GUID: "admin-event-1", ActorName: "admin"}
+				fakeCloudControllerClient.GetEventsReturns(fullPage, ccv3.Warnings{"some-event-warnings"}, nil)
+				fakeCloudControllerClient.GetEventsReturnsOnCall(1, []ccv3.Event{
+					{GUID: "admin-event-2", ActorName: "admin"},
+					{GUID: "admin-event-3", ActorName: "admin"},
+				}, ccv3.Warnings{"more-event-warnings"}, nil)
+			})
+
+			It("fetches one page at a time until the limit is reached", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warnings", "some-event-warnings", "more-event-warnings"))
+				Expect(events).To(HaveLen(2))
+				Expect(events[0].GUID).To(Equal("admin-event-1"))
+				Expect(events[1].GUID).To(Equal("admin-event-2"))
+
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(1)).To(ContainElement(
+					ccv3.Query{Key: ccv3.Page, Values: []string{"2"}},
+				))
+			})
+
+			When("the actor has no more events", func() {
+				BeforeEach(func() {
+					filters.Limit = 0
+					fakeCloudControllerClient.GetEventsReturnsOnCall(1, nil, nil, nil)
 				})
 
-				It("returns the events and warnings", func() {
-					Expect(events).To(Equal([]Event{
-						{GUID: "event-1", Type: "audit.app.wow", Description: "index: 17"},
-						{GUID: "event-2", Type: "audit.app.cool", Description: ""},
-					}))
-					Expect(warnings).To(ConsistOf("some-app-warnings", "some-event-warnings"))
+				It("stops at the last page", func() {
 					Expect(err).NotTo(HaveOccurred())
+					Expect(events).To(HaveLen(1))
+					Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(2))
 				})
 			})
 
-			When("the cc client returns an error", func() {
+			When("the actor's events are not found in the most recent pages", func() {
 				BeforeEach(func() {
-					ccWarnings := ccv3.Warnings{
-						"some-event-warnings",
-					}
-
-					fakeCloudControllerClient.GetEventsReturns(
-						nil,
-						ccWarnings,
-						errors.New("failed to get events"),
-					)
+					fakeCloudControllerClient.GetEventsReturnsOnCall(1, fullPage, nil, nil)
+					filters.ActorName = "someone-else"
 				})
 
-				It("returns the err and warnings", func() {
-					Expect(warnings).To(ConsistOf("some-app-warnings", "some-event-warnings"))
-					Expect(err).To(MatchError("failed to get events"))
+				It("stops searching after 10 pages and warns about it", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(events).To(BeEmpty())
+					Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(10))
+					Expect(warnings).To(ContainElement("Only the 50000 most recent events were searched for events by someone-else."))
 				})
 			})
 		})
 
-		When("getting the app fails", func() {
+		When("the limit is more than a page of events", func() {
 			BeforeEach(func() {
-				ccWarnings := ccv3.Warnings{
-					"some-app-warnings",
+				filters = EventFilters{Limit: 5001}
+
+				fullPage := make([]ccv3.Event, 5000)
+				for i := range fullPage {
+					fullPage[i] = ccv3.Event{GUID: fmt.Sprintf("event-%d", i)}
 				}
+				fakeCloudControllerClient.GetEventsReturns(fullPage, ccv3.Warnings{"some-event-warnings"}, nil)
+			})
 
-				fakeCloudControllerClient.GetApplicationsReturns(
-					nil,
-					ccWarnings,
-					errors.New("failed to get app"),
-				)
+			It("fetches one page at a time until the limit is reached", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warnings", "some-event-warnings", "some-event-warnings"))
+				Expect(events).To(HaveLen(5001))
+
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ContainElements(
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+					ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+				))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(1)).To(ContainElement(
+					ccv3.Query{Key: ccv3.Page, Values: []string{"2"}},
+				))
+			})
+
+			When("there are fewer events than the limit", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetEventsReturnsOnCall(1, []ccv3.Event{{GUID: "last-event"}}, nil, nil)
+					filters.Limit = 6000
+				})
+
+				It("stops at the last page", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(events).To(HaveLen(5001))
+					Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(2))
+				})
+			})
+		})
+
+		When("getting the app fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-app-warnings"}, errors.New("failed to get app"))
 			})
 
 			It("returns the err and warnings", func() {
-				Expect(warnings).To(ConsistOf("some-app-warnings"))
 				Expect(err).To(MatchError("failed to get app"))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the events fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv3.Warnings{"some-event-warnings"}, errors.New("failed to get events"))
 			})
+
+			It("returns the err and warnings", func() {
+				Expect(err).To(MatchError("failed to get events"))
+				Expect(warnings).To(ConsistOf("some-app-warnings", "some-event-warnings"))
+			})
+		})
+	})
+
+	Describe("GetEventsBySpace", func() {
+		It("gets the events of the space", func() {
+			fakeCloudControllerClient.GetEventsReturns(
+				[]ccv3.Event{{GUID: "event-1", ActorName: "admin"}},
+				ccv3.Warnings{"some-event-warnings"},
+				nil,
+			)
+
+			events, warnings, err := actor.GetEventsBySpace("some-space-guid", EventFilters{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("some-event-warnings"))
+			Expect(events).To(Equal([]Event{{GUID: "event-1", ActorName: "admin"}}))
+
+			Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ContainElement(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+			))
+		})
+	})
+
+	Describe("GetEventsByOrganization", func() {
+		It("gets the events of the organization", func() {
+			_, _, err := actor.GetEventsByOrganization("some-org-guid", EventFilters{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ContainElement(
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+			))
 		})
 	})
 })
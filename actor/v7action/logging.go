@@ -2,14 +2,54 @@ package v7action
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/resources"
 	"github.com/SermoDigital/jose/jws"
 )
 
+// LogOptions configures which log messages are retrieved for a set of
+// applications.
+type LogOptions struct {
+	// Since is the earliest time to retrieve logs from. The zero value
+	// retrieves the most recent logs only.
+	Since  time.Time
+	Filter sharedaction.LogFilter
+}
+
+// AppLogMessage is a log message along with the name of the application that
+// emitted it.
+type AppLogMessage struct {
+	AppName string
+	sharedaction.LogMessage
+}
+
+// MarshalJSON renders the message as a single JSON object, suitable for
+// newline delimited JSON output.
+func (message AppLogMessage) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		AppName        string    `json:"app_name"`
+		Timestamp      time.Time `json:"timestamp"`
+		SourceType     string    `json:"source_type"`
+		SourceInstance string    `json:"source_instance"`
+		Type           string    `json:"type"`
+		Message        string    `json:"message"`
+	}{
+		AppName:        message.AppName,
+		Timestamp:      message.Timestamp().UTC(),
+		SourceType:     message.SourceType(),
+		SourceInstance: message.SourceInstance(),
+		Type:           message.Type(),
+		Message:        message.Message(),
+	})
+}
+
 func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, Warnings, error) {
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
@@ -47,6 +87,85 @@ func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spa
 	return logMessages, allWarnings, nil
 }
 
+// GetStreamingLogsForApplications tails the logs of all of the given
+// applications as a single stream. Only messages matching options.Filter are
+// sent. Cancelling stops the tailing of every application.
+func (actor Actor) GetStreamingLogsForApplications(apps []resources.Application, client sharedaction.LogCacheClient, options LogOptions) (<-chan AppLogMessage, <-chan error, context.CancelFunc) {
+	outgoingLogStream := make(chan AppLogMessage, 1000)
+	outgoingErrStream := make(chan error, 1000)
+	ctx, cancelFunc := context.WithCancel(context.Background())
+
+	var wg sync.WaitGroup
+	var stopFuncs []context.CancelFunc
+	for _, app := range apps {
+		messages, logErrs, stopStreaming := sharedaction.GetStreamingLogsSince(app.GUID, client, options.Since)
+		stopFuncs = append(stopFuncs, stopStreaming)
+
+		wg.Add(2)
+		go func(appName string) {
+			defer wg.Done()
+			for message := range messages {
+				if !options.Filter.Matches(message) {
+					continue
+				}
+				select {
+				case outgoingLogStream <- AppLogMessage{AppName: appName, LogMessage: message}:
+				case <-ctx.Done():
+				}
+			}
+		}(app.Name)
+		go func() {
+			defer wg.Done()
+			for logErr := range logErrs {
+				select {
+				case outgoingErrStream <- logErr:
+				case <-ctx.Done():
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(outgoingLogStream)
+		close(outgoingErrStream)
+	}()
+
+	stopAll := func() {
+		cancelFunc()
+		for _, stopStreaming := range stopFuncs {
+			stopStreaming()
+		}
+	}
+
+	return outgoingLogStream, outgoingErrStream, stopAll
+}
+
+// GetRecentLogsForApplications returns the recent logs of all of the given
+// applications that match options.Filter, ordered by timestamp.
+func (actor Actor) GetRecentLogsForApplications(apps []resources.Application, client sharedaction.LogCacheClient, options LogOptions) ([]AppLogMessage, error) {
+	var logMessages []AppLogMessage
+
+	for _, app := range apps {
+		messages, err := sharedaction.GetRecentLogsSince(app.GUID, client, options.Since)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, message := range messages {
+			if options.Filter.Matches(message) {
+				logMessages = append(logMessages, AppLogMessage{AppName: app.Name, LogMessage: message})
+			}
+		}
+	}
+
+	sort.SliceStable(logMessages, func(i, j int) bool {
+		return logMessages[i].Timestamp().Before(logMessages[j].Timestamp())
+	})
+
+	return logMessages, nil
+}
+
 func (actor Actor) ScheduleTokenRefresh(
 	after func(time.Duration) <-chan time.Time,
 	stop chan struct{},
@@ -2,7 +2,9 @@ package v7action_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"regexp"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -248,4 +250,213 @@ var _ = Describe("Logging Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetRecentLogsForApplications", func() {
+		var (
+			apps     []resources.Application
+			options  LogOptions
+			messages []AppLogMessage
+			err      error
+		)
+
+		BeforeEach(func() {
+			apps = []resources.Application{
+				{Name: "app-1", GUID: "app-1-guid"},
+				{Name: "app-2", GUID: "app-2-guid"},
+			}
+			options = LogOptions{}
+
+			fakeLogCacheClient.ReadStub = func(
+				ctx context.Context,
+				sourceID string,
+				start time.Time,
+				opts ...logcache.ReadOption,
+			) ([]*loggregator_v2.Envelope, error) {
+				var first, second int64
+				if sourceID == "app-1-guid" {
+					first, second = 10, 30
+				} else {
+					first, second = 20, 40
+				}
+				return []*loggregator_v2.Envelope{
+					{
+						Timestamp:  second,
+						SourceId:   sourceID,
+						InstanceId: "1",
+						Message: &loggregator_v2.Envelope_Log{
+							Log: &loggregator_v2.Log{Payload: []byte(sourceID + " second"), Type: loggregator_v2.Log_ERR},
+						},
+						Tags: map[string]string{"source_type": "RTR"},
+					},
+					{
+						Timestamp:  first,
+						SourceId:   sourceID,
+						InstanceId: "0",
+						Message: &loggregator_v2.Envelope_Log{
+							Log: &loggregator_v2.Log{Payload: []byte(sourceID + " first"), Type: loggregator_v2.Log_OUT},
+						},
+						Tags: map[string]string{"source_type": "APP/PROC/WEB"},
+					},
+				}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			messages, err = actor.GetRecentLogsForApplications(apps, fakeLogCacheClient, options)
+		})
+
+		It("returns the logs of every app interleaved by timestamp", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(messages).To(HaveLen(4))
+
+			Expect(messages[0].AppName).To(Equal("app-1"))
+			Expect(messages[0].Message()).To(Equal("app-1-guid first"))
+			Expect(messages[1].AppName).To(Equal("app-2"))
+			Expect(messages[1].Message()).To(Equal("app-2-guid first"))
+			Expect(messages[2].AppName).To(Equal("app-1"))
+			Expect(messages[2].Message()).To(Equal("app-1-guid second"))
+			Expect(messages[3].AppName).To(Equal("app-2"))
+			Expect(messages[3].Message()).To(Equal("app-2-guid second"))
+
+			Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(2))
+		})
+
+		When("a start time and filter are provided", func() {
+			BeforeEach(func() {
+				options = LogOptions{
+					Since: time.Unix(1000, 0),
+					Filter: sharedaction.LogFilter{
+						SourceTypes: []string{"APP"},
+						Pattern:     regexp.MustCompile("^app-2"),
+					},
+				}
+			})
+
+			It("reads from the start time and only returns matching logs", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(messages).To(HaveLen(1))
+				Expect(messages[0].AppName).To(Equal("app-2"))
+				Expect(messages[0].Message()).To(Equal("app-2-guid first"))
+
+				_, _, start, _ := fakeLogCacheClient.ReadArgsForCall(0)
+				Expect(start).To(Equal(time.Unix(1000, 0)))
+			})
+		})
+
+		When("Log Cache errors", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadStub = nil
+				fakeLogCacheClient.ReadReturns(nil, errors.New("failure-to-read-from-log-cache"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("Failed to retrieve logs from Log Cache: failure-to-read-from-log-cache"))
+			})
+		})
+	})
+
+	Describe("GetStreamingLogsForApplications", func() {
+		var (
+			messages      <-chan AppLogMessage
+			logErrs       <-chan error
+			stopStreaming context.CancelFunc
+			since         time.Time
+		)
+
+		BeforeEach(func() {
+			since = time.Now().Add(-time.Minute)
+
+			fakeLogCacheClient.ReadStub = func(
+				ctx context.Context,
+				sourceID string,
+				start time.Time,
+				opts ...logcache.ReadOption,
+			) ([]*loggregator_v2.Envelope, error) {
+				if !start.Equal(since) {
+					return []*loggregator_v2.Envelope{}, ctx.Err()
+				}
+
+				return []*loggregator_v2.Envelope{
+					{
+						Timestamp:  since.Add(time.Second).UnixNano(),
+						SourceId:   sourceID,
+						InstanceId: "0",
+						Message: &loggregator_v2.Envelope_Log{
+							Log: &loggregator_v2.Log{Payload: []byte("kept " + sourceID), Type: loggregator_v2.Log_OUT},
+						},
+						Tags: map[string]string{"source_type": "APP/PROC/WEB"},
+					},
+					{
+						Timestamp:  since.Add(2 * time.Second).UnixNano(),
+						SourceId:   sourceID,
+						InstanceId: "1",
+						Message: &loggregator_v2.Envelope_Log{
+							Log: &loggregator_v2.Log{Payload: []byte("dropped " + sourceID), Type: loggregator_v2.Log_OUT},
+						},
+						Tags: map[string]string{"source_type": "APP/PROC/WEB"},
+					},
+				}, ctx.Err()
+			}
+
+			messages, logErrs, stopStreaming = actor.GetStreamingLogsForApplications(
+				[]resources.Application{
+					{Name: "app-1", GUID: "app-1-guid"},
+					{Name: "app-2", GUID: "app-2-guid"},
+				},
+				fakeLogCacheClient,
+				LogOptions{Since: since, Filter: sharedaction.LogFilter{Instance: "0"}},
+			)
+		})
+
+		AfterEach(func() {
+			stopStreaming()
+			Eventually(messages).Should(BeClosed())
+			Eventually(logErrs).Should(BeClosed())
+		})
+
+		It("streams the matching logs of every app tagged with the app name", func() {
+			var received []AppLogMessage
+			Eventually(func() int {
+				select {
+				case message := <-messages:
+					received = append(received, message)
+				default:
+				}
+				return len(received)
+			}).Should(Equal(2))
+
+			var pairs []string
+			for _, message := range received {
+				pairs = append(pairs, message.AppName+": "+message.Message())
+			}
+			Expect(pairs).To(ConsistOf("app-1: kept app-1-guid", "app-2: kept app-2-guid"))
+			Consistently(messages, 500*time.Millisecond).ShouldNot(Receive())
+		})
+	})
+
+	Describe("AppLogMessage", func() {
+		It("marshals to a single JSON object with the app name", func() {
+			message := AppLogMessage{
+				AppName: "some-app",
+				LogMessage: *sharedaction.NewLogMessage(
+					"some message",
+					"ERR",
+					time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+					"APP/PROC/WEB",
+					"2",
+				),
+			}
+
+			raw, err := json.Marshal(message)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(raw)).To(MatchJSON(`{
+				"app_name": "some-app",
+				"timestamp": "2024-01-02T03:04:05Z",
+				"source_type": "APP/PROC/WEB",
+				"source_instance": "2",
+				"type": "ERR",
+				"message": "some message"
+			}`))
+		})
+	})
 })
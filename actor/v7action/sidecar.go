@@ -0,0 +1,77 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// CreateApplicationSidecarByNameAndSpace creates a sidecar for the app. The
+// app must be restarted for the sidecar to run.
+func (actor Actor) CreateApplicationSidecarByNameAndSpace(appName string, spaceGUID string, sidecar resources.Sidecar) (resources.Sidecar, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return resources.Sidecar{}, allWarnings, err
+	}
+
+	createdSidecar, warnings, err := actor.CloudControllerClient.CreateApplicationSidecar(app.GUID, sidecar)
+	allWarnings = append(allWarnings, warnings...)
+	return createdSidecar, allWarnings, err
+}
+
+// DeleteApplicationSidecarByNameAndSpace deletes the sidecar with the given
+// name from the app. It returns a SidecarNotFoundError if the app has no such
+// sidecar.
+func (actor Actor) DeleteApplicationSidecarByNameAndSpace(appName string, spaceGUID string, sidecarName string) (Warnings, error) {
+	sidecar, allWarnings, err := actor.getApplicationSidecarByName(appName, spaceGUID, sidecarName)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err := actor.CloudControllerClient.DeleteSidecar(sidecar.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// GetApplicationSidecarsByNameAndSpace returns all the sidecars of the app,
+// including the ones provided by buildpacks.
+func (actor Actor) GetApplicationSidecarsByNameAndSpace(appName string, spaceGUID string) ([]resources.Sidecar, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	sidecars, warnings, err := actor.CloudControllerClient.GetApplicationSidecars(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	return sidecars, allWarnings, err
+}
+
+// UpdateApplicationSidecarByNameAndSpace updates the app's sidecar with the
+// same name as the given sidecar. Only the fields that are set are updated.
+// The app must be restarted for the changes to take effect.
+func (actor Actor) UpdateApplicationSidecarByNameAndSpace(appName string, spaceGUID string, sidecar resources.Sidecar) (resources.Sidecar, Warnings, error) {
+	existingSidecar, allWarnings, err := actor.getApplicationSidecarByName(appName, spaceGUID, sidecar.Name)
+	if err != nil {
+		return resources.Sidecar{}, allWarnings, err
+	}
+
+	sidecar.GUID = existingSidecar.GUID
+	sidecar.Name = ""
+	updatedSidecar, warnings, err := actor.CloudControllerClient.UpdateSidecar(sidecar)
+	allWarnings = append(allWarnings, warnings...)
+	return updatedSidecar, allWarnings, err
+}
+
+func (actor Actor) getApplicationSidecarByName(appName string, spaceGUID string, sidecarName string) (resources.Sidecar, Warnings, error) {
+	sidecars, warnings, err := actor.GetApplicationSidecarsByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return resources.Sidecar{}, warnings, err
+	}
+
+	for _, sidecar := range sidecars {
+		if sidecar.Name == sidecarName {
+			return sidecar, warnings, nil
+		}
+	}
+
+	return resources.Sidecar{}, warnings, actionerror.SidecarNotFoundError{Name: sidecarName}
+}
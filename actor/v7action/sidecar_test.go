@@ -0,0 +1,230 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sidecar Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		warnings                  Warnings
+		executeErr                error
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetApplicationSidecarsByNameAndSpace", func() {
+		var sidecars []resources.Sidecar
+
+		JustBeforeEach(func() {
+			sidecars, warnings, executeErr = actor.GetApplicationSidecarsByNameAndSpace("some-app", "some-space-guid")
+		})
+
+		When("the app exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{GUID: "some-app-guid", Name: "some-app"}},
+					ccv3.Warnings{"get-app-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationSidecarsReturns(
+					[]resources.Sidecar{{GUID: "sidecar-guid", Name: "envoy"}},
+					ccv3.Warnings{"get-sidecars-warning"},
+					nil,
+				)
+			})
+
+			It("returns the app's sidecars and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning"))
+				Expect(sidecars).To(ConsistOf(resources.Sidecar{GUID: "sidecar-guid", Name: "envoy"}))
+
+				Expect(fakeCloudControllerClient.GetApplicationSidecarsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationSidecarsArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationSidecarsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("CreateApplicationSidecarByNameAndSpace", func() {
+		var sidecar resources.Sidecar
+
+		JustBeforeEach(func() {
+			sidecar, warnings, executeErr = actor.CreateApplicationSidecarByNameAndSpace("some-app", "some-space-guid", resources.Sidecar{
+				Name:    "envoy",
+				Command: types.FilteredString{IsSet: true, Value: "start-envoy"},
+			})
+		})
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{GUID: "some-app-guid", Name: "some-app"}},
+				ccv3.Warnings{"get-app-warning"},
+				nil,
+			)
+		})
+
+		When("creating the sidecar succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateApplicationSidecarReturns(
+					resources.Sidecar{GUID: "sidecar-guid", Name: "envoy"},
+					ccv3.Warnings{"create-sidecar-warning"},
+					nil,
+				)
+			})
+
+			It("creates the sidecar for the app", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "create-sidecar-warning"))
+				Expect(sidecar).To(Equal(resources.Sidecar{GUID: "sidecar-guid", Name: "envoy"}))
+
+				Expect(fakeCloudControllerClient.CreateApplicationSidecarCallCount()).To(Equal(1))
+				appGUID, requestedSidecar := fakeCloudControllerClient.CreateApplicationSidecarArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(requestedSidecar).To(Equal(resources.Sidecar{
+					Name:    "envoy",
+					Command: types.FilteredString{IsSet: true, Value: "start-envoy"},
+				}))
+			})
+		})
+
+		When("creating the sidecar fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateApplicationSidecarReturns(
+					resources.Sidecar{},
+					ccv3.Warnings{"create-sidecar-warning"},
+					errors.New("create-sidecar-error"),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("create-sidecar-error"))
+				Expect(warnings).To(ConsistOf("get-app-warning", "create-sidecar-warning"))
+			})
+		})
+	})
+
+	Describe("UpdateApplicationSidecarByNameAndSpace", func() {
+		JustBeforeEach(func() {
+			_, warnings, executeErr = actor.UpdateApplicationSidecarByNameAndSpace("some-app", "some-space-guid", resources.Sidecar{
+				Name:       "envoy",
+				MemoryInMB: types.NullUint64{IsSet: true, Value: 256},
+			})
+		})
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{GUID: "some-app-guid", Name: "some-app"}},
+				ccv3.Warnings{"get-app-warning"},
+				nil,
+			)
+		})
+
+		When("the sidecar exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationSidecarsReturns(
+					[]resources.Sidecar{{GUID: "other-guid", Name: "agent"}, {GUID: "sidecar-guid", Name: "envoy"}},
+					ccv3.Warnings{"get-sidecars-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateSidecarReturns(
+					resources.Sidecar{GUID: "sidecar-guid", Name: "envoy"},
+					ccv3.Warnings{"update-sidecar-warning"},
+					nil,
+				)
+			})
+
+			It("updates only the given fields of the sidecar", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning", "update-sidecar-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateSidecarCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateSidecarArgsForCall(0)).To(Equal(resources.Sidecar{
+					GUID:       "sidecar-guid",
+					MemoryInMB: types.NullUint64{IsSet: true, Value: 256},
+				}))
+			})
+		})
+
+		When("the sidecar does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationSidecarsReturns(nil, ccv3.Warnings{"get-sidecars-warning"}, nil)
+			})
+
+			It("returns a SidecarNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.SidecarNotFoundError{Name: "envoy"}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning"))
+				Expect(fakeCloudControllerClient.UpdateSidecarCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DeleteApplicationSidecarByNameAndSpace", func() {
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeleteApplicationSidecarByNameAndSpace("some-app", "some-space-guid", "envoy")
+		})
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{GUID: "some-app-guid", Name: "some-app"}},
+				ccv3.Warnings{"get-app-warning"},
+				nil,
+			)
+		})
+
+		When("the sidecar exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationSidecarsReturns(
+					[]resources.Sidecar{{GUID: "sidecar-guid", Name: "envoy"}},
+					ccv3.Warnings{"get-sidecars-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.DeleteSidecarReturns(ccv3.Warnings{"delete-sidecar-warning"}, nil)
+			})
+
+			It("deletes the sidecar", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning", "delete-sidecar-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteSidecarCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteSidecarArgsForCall(0)).To(Equal("sidecar-guid"))
+			})
+		})
+
+		When("the sidecar does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationSidecarsReturns(nil, ccv3.Warnings{"get-sidecars-warning"}, nil)
+			})
+
+			It("returns a SidecarNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.SidecarNotFoundError{Name: "envoy"}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning"))
+				Expect(fakeCloudControllerClient.DeleteSidecarCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
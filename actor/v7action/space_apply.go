@@ -0,0 +1,285 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// ApplyServiceInstance creates the managed service instance in the space
+// unless the space already has a service instance with its name, and waits
+// for the broker to finish creating it. An instance whose creation is still
+// in progress, for example because an earlier apply was interrupted, is
+// waited for rather than created again, and an instance whose creation failed
+// is deleted and created again. The exported labels are set on the instance.
+// It returns whether the instance was created.
+func (actor Actor) ApplyServiceInstance(spaceGUID string, instance ExportedServiceInstance) (bool, Warnings, error) {
+	var allWarnings Warnings
+
+	existing, ccWarnings, err := actor.getServiceInstanceOrNothing(instance.Name, spaceGUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return false, allWarnings, err
+	}
+
+	if existing.GUID != "" {
+		err = assertServiceInstanceType(resources.ManagedServiceInstance, existing)
+		if err != nil {
+			return false, allWarnings, err
+		}
+
+		if !isFailedCreate(existing.LastOperation) {
+			warnings, err := actor.pollServiceInstanceLastOperation(existing, spaceGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return false, allWarnings, err
+			}
+
+			warnings, err = actor.applyExportedLabels("service-instance", existing.GUID, instance.Labels)
+			allWarnings = append(allWarnings, warnings...)
+			return false, allWarnings, err
+		}
+
+		stream, warnings, err := actor.DeleteServiceInstance(existing.Name, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return false, allWarnings, err
+		}
+		warnings, err = drainPollJobEvents(stream)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return false, allWarnings, err
+		}
+	}
+
+	stream, warnings, err := actor.CreateManagedServiceInstance(CreateManagedServiceInstanceParams{
+		ServiceOfferingName: instance.Offering,
+		ServicePlanName:     instance.Plan,
+		ServiceInstanceName: instance.Name,
+		ServiceBrokerName:   instance.Broker,
+		SpaceGUID:           spaceGUID,
+		Tags:                optionalStringSlice(instance.Tags),
+		Parameters:          optionalObject(instance.Parameters),
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return false, allWarnings, err
+	}
+
+	warnings, err = drainPollJobEvents(stream)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return false, allWarnings, err
+	}
+
+	created, _, createdWarnings, err := actor.getServiceInstanceByNameAndSpace(instance.Name, spaceGUID)
+	allWarnings = append(allWarnings, createdWarnings...)
+	if err != nil {
+		return false, allWarnings, err
+	}
+
+	warnings, err = actor.pollServiceInstanceLastOperation(created, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return false, allWarnings, err
+	}
+
+	warnings, err = actor.applyExportedLabels("service-instance", created.GUID, instance.Labels)
+	allWarnings = append(allWarnings, warnings...)
+	return true, allWarnings, err
+}
+
+// ApplyUserProvidedService creates the user-provided service instance in the
+// space unless the space already has a service instance with its name. The
+// exported labels are set on the instance. It returns whether the instance was
+// created.
+func (actor Actor) ApplyUserProvidedService(spaceGUID string, instance ExportedUserProvidedService) (bool, Warnings, error) {
+	var allWarnings Warnings
+
+	existing, ccWarnings, err := actor.getServiceInstanceOrNothing(instance.Name, spaceGUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return false, allWarnings, err
+	}
+
+	if existing.GUID != "" {
+		err = assertServiceInstanceType(resources.UserProvidedServiceInstance, existing)
+		if err != nil {
+			return false, allWarnings, err
+		}
+
+		warnings, err := actor.applyExportedLabels("service-instance", existing.GUID, instance.Labels)
+		allWarnings = append(allWarnings, warnings...)
+		return false, allWarnings, err
+	}
+
+	serviceInstance := resources.ServiceInstance{
+		Name:        instance.Name,
+		SpaceGUID:   spaceGUID,
+		Tags:        optionalStringSlice(instance.Tags),
+		Credentials: optionalObject(instance.Credentials),
+	}
+	if instance.SyslogDrainURL != "" {
+		serviceInstance.SyslogDrainURL = types.NewOptionalString(instance.SyslogDrainURL)
+	}
+	if instance.RouteServiceURL != "" {
+		serviceInstance.RouteServiceURL = types.NewOptionalString(instance.RouteServiceURL)
+	}
+	if len(instance.Labels) > 0 {
+		serviceInstance.Metadata = exportedMetadata(instance.Labels)
+	}
+
+	warnings, err := actor.CreateUserProvidedServiceInstance(serviceInstance)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return false, allWarnings, err
+	}
+
+	return true, allWarnings, nil
+}
+
+// ApplyRoute creates the route in the space unless it already exists. The
+// exported labels are set on the route. It returns whether the route was
+// created.
+func (actor Actor) ApplyRoute(spaceGUID string, route ExportedRoute) (bool, Warnings, error) {
+	var allWarnings Warnings
+
+	domain, warnings, err := actor.GetDomainByName(route.Domain)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return false, allWarnings, err
+	}
+
+	existing, warnings, err := actor.GetRouteByAttributes(domain, route.Host, route.Path, route.Port)
+	allWarnings = append(allWarnings, warnings...)
+	switch err.(type) {
+	case nil:
+		if existing.SpaceGUID != spaceGUID {
+			return false, allWarnings, actionerror.RouteInDifferentSpaceError{Route: existing.URL}
+		}
+
+		warnings, err = actor.applyExportedLabels("route", existing.GUID, route.Labels)
+		allWarnings = append(allWarnings, warnings...)
+		return false, allWarnings, err
+	case actionerror.RouteNotFoundError:
+	default:
+		return false, allWarnings, err
+	}
+
+	created, warnings, err := actor.CreateRoute(spaceGUID, route.Domain, route.Host, route.Path, route.Port)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return false, allWarnings, err
+	}
+
+	warnings, err = actor.applyExportedLabels("route", created.GUID, route.Labels)
+	allWarnings = append(allWarnings, warnings...)
+	return true, allWarnings, err
+}
+
+// applyExportedLabels adds the exported labels to the resource. Labels the
+// resource already has and that were not exported are kept.
+func (actor Actor) applyExportedLabels(resourceType, resourceGUID string, labels map[string]string) (Warnings, error) {
+	if len(labels) == 0 {
+		return nil, nil
+	}
+	return actor.updateResourceMetadata(resourceType, resourceGUID, *exportedMetadata(labels), nil)
+}
+
+func exportedMetadata(labels map[string]string) *resources.Metadata {
+	metadata := resources.Metadata{Labels: map[string]types.NullString{}}
+	for key, value := range labels {
+		metadata.Labels[key] = types.NewNullString(value)
+	}
+	return &metadata
+}
+
+func (actor Actor) getServiceInstanceOrNothing(name, spaceGUID string) (resources.ServiceInstance, Warnings, error) {
+	instance, _, warnings, err := actor.getServiceInstanceByNameAndSpace(name, spaceGUID)
+	switch err.(type) {
+	case nil:
+		return instance, Warnings(warnings), nil
+	case actionerror.ServiceInstanceNotFoundError:
+		return resources.ServiceInstance{}, Warnings(warnings), nil
+	default:
+		return resources.ServiceInstance{}, Warnings(warnings), err
+	}
+}
+
+func (actor Actor) pollServiceInstanceLastOperation(instance resources.ServiceInstance, spaceGUID string) (Warnings, error) {
+	var allWarnings Warnings
+
+	timer := actor.Clock.NewTimer(actor.Config.PollingInterval())
+	defer timer.Stop()
+	timeout := actor.Clock.After(actor.Config.OverallPollingTimeout())
+
+	for {
+		switch instance.LastOperation.State {
+		case resources.OperationFailed:
+			// An instance whose update failed can still be used.
+			if !isFailedCreate(instance.LastOperation) {
+				return allWarnings, nil
+			}
+			return allWarnings, actionerror.ServiceInstanceOperationFailedError{
+				Name:        instance.Name,
+				Operation:   instance.LastOperation.Type,
+				Description: instance.LastOperation.Description,
+			}
+		case resources.OperationInProgress:
+		default:
+			return allWarnings, nil
+		}
+
+		select {
+		case <-timeout:
+			return allWarnings, actionerror.ServiceInstanceOperationTimeoutError{Name: instance.Name}
+		case <-timer.C():
+			var (
+				ccWarnings ccv3.Warnings
+				err        error
+			)
+			instance, _, ccWarnings, err = actor.getServiceInstanceByNameAndSpace(instance.Name, spaceGUID)
+			allWarnings = append(allWarnings, ccWarnings...)
+			if err != nil {
+				return allWarnings, err
+			}
+
+			timer.Reset(actor.Config.PollingInterval())
+		}
+	}
+}
+
+func isFailedCreate(operation resources.LastOperation) bool {
+	return operation.Type == resources.CreateOperation && operation.State == resources.OperationFailed
+}
+
+// drainPollJobEvents waits for the job of the stream, if there is one, to
+// finish.
+func drainPollJobEvents(stream chan PollJobEvent) (Warnings, error) {
+	var allWarnings Warnings
+	if stream == nil {
+		return allWarnings, nil
+	}
+	for event := range stream {
+		allWarnings = append(allWarnings, event.Warnings...)
+		if event.Err != nil {
+			return allWarnings, event.Err
+		}
+	}
+	return allWarnings, nil
+}
+
+func optionalStringSlice(values []string) types.OptionalStringSlice {
+	if values == nil {
+		return types.OptionalStringSlice{}
+	}
+	return types.NewOptionalStringSlice(values...)
+}
+
+func optionalObject(value map[string]interface{}) types.OptionalObject {
+	if value == nil {
+		return types.OptionalObject{}
+	}
+	return types.NewOptionalObject(value)
+}
@@ -0,0 +1,560 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space apply actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeConfig                *v7actionfakes.FakeConfig
+		fakeClock                 *fakeclock.FakeClock
+
+		created    bool
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, fakeConfig, _, _, _, fakeClock = NewTestActor()
+		fakeConfig.PollingIntervalReturns(time.Second)
+		fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+	})
+
+	Describe("ApplyServiceInstance", func() {
+		var (
+			instance ExportedServiceInstance
+			done     chan struct{}
+		)
+
+		BeforeEach(func() {
+			instance = ExportedServiceInstance{
+				Name:       "db",
+				Offering:   "postgres",
+				Plan:       "small",
+				Tags:       []string{"sql"},
+				Parameters: map[string]interface{}{"size": "10G"},
+			}
+
+			fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturnsOnCall(
+				0,
+				resources.ServiceInstance{},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"get-instance-warning"},
+				ccerror.ServiceInstanceNotFoundError{Name: "db"},
+			)
+			fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+				resources.ServiceInstance{
+					Type:          resources.ManagedServiceInstance,
+					GUID:          "db-guid",
+					Name:          "db",
+					LastOperation: resources.LastOperation{Type: resources.CreateOperation, State: resources.OperationSucceeded},
+				},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"created-instance-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServicePlansReturns(
+				[]resources.ServicePlan{{GUID: "small-plan-guid"}},
+				ccv3.Warnings{"plans-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.CreateServiceInstanceReturns("some-job-url", ccv3.Warnings{"create-warning"}, nil)
+
+			stream := make(chan ccv3.PollJobEvent)
+			go func() {
+				stream <- ccv3.PollJobEvent{State: constant.JobComplete, Warnings: ccv3.Warnings{"job-warning"}}
+				close(stream)
+			}()
+			fakeCloudControllerClient.PollJobToEventStreamReturns(stream)
+		})
+
+		JustBeforeEach(func() {
+			done = make(chan struct{})
+			go func() {
+				defer close(done)
+				created, warnings, executeErr = actor.ApplyServiceInstance("some-space-guid", instance)
+			}()
+		})
+
+		When("the service instance does not exist", func() {
+			It("creates it and waits for the job", func() {
+				Eventually(done).Should(BeClosed())
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(created).To(BeTrue())
+				Expect(warnings).To(ConsistOf("get-instance-warning", "plans-warning", "create-warning", "job-warning", "created-instance-warning"))
+
+				Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.CreateServiceInstanceArgsForCall(0)).To(Equal(resources.ServiceInstance{
+					Type:            resources.ManagedServiceInstance,
+					Name:            "db",
+					ServicePlanGUID: "small-plan-guid",
+					SpaceGUID:       "some-space-guid",
+					Tags:            types.NewOptionalStringSlice("sql"),
+					Parameters:      types.NewOptionalObject(map[string]interface{}{"size": "10G"}),
+				}))
+			})
+
+			When("the instance has labels", func() {
+				BeforeEach(func() {
+					instance.Labels = map[string]string{"team": "data"}
+					fakeCloudControllerClient.UpdateResourceMetadataReturns("", ccv3.Warnings{"labels-warning"}, nil)
+				})
+
+				It("sets the labels on the created instance", func() {
+					Eventually(done).Should(BeClosed())
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(warnings).To(ContainElement("labels-warning"))
+
+					Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(1))
+					resourceType, guid, metadata := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+					Expect(resourceType).To(Equal("service-instance"))
+					Expect(guid).To(Equal("db-guid"))
+					Expect(metadata.Labels).To(Equal(map[string]types.NullString{"team": types.NewNullString("data")}))
+				})
+
+				When("setting the labels fails", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.UpdateResourceMetadataReturns("", ccv3.Warnings{"labels-warning"}, errors.New("labels-error"))
+					})
+
+					It("returns the error and warnings", func() {
+						Eventually(done).Should(BeClosed())
+						Expect(executeErr).To(MatchError("labels-error"))
+						Expect(warnings).To(ContainElement("labels-warning"))
+					})
+				})
+			})
+
+			When("the instance has no labels", func() {
+				It("does not update its metadata", func() {
+					Eventually(done).Should(BeClosed())
+					Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(0))
+				})
+			})
+
+			When("the broker creates the instance asynchronously", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturnsOnCall(
+						1,
+						resources.ServiceInstance{
+							Type:          resources.ManagedServiceInstance,
+							Name:          "db",
+							LastOperation: resources.LastOperation{Type: resources.CreateOperation, State: resources.OperationInProgress},
+						},
+						ccv3.IncludedResources{},
+						ccv3.Warnings{"in-progress-warning"},
+						nil,
+					)
+				})
+
+				It("polls the last operation until it finishes", func() {
+					fakeClock.WaitForNWatchersAndIncrement(time.Second, 2)
+					Eventually(done).Should(BeClosed())
+
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(warnings).To(ContainElements("in-progress-warning", "created-instance-warning"))
+					Expect(fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(3))
+				})
+			})
+
+			When("the broker fails to create the instance", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturnsOnCall(
+						1,
+						resources.ServiceInstance{
+							Type: resources.ManagedServiceInstance,
+							Name: "db",
+							LastOperation: resources.LastOperation{
+								Type:        resources.CreateOperation,
+								State:       resources.OperationFailed,
+								Description: "out of disks",
+							},
+						},
+						ccv3.IncludedResources{},
+						nil,
+						nil,
+					)
+				})
+
+				It("returns a ServiceInstanceOperationFailedError", func() {
+					Eventually(done).Should(BeClosed())
+					Expect(executeErr).To(MatchError(actionerror.ServiceInstanceOperationFailedError{
+						Name:        "db",
+						Operation:   resources.CreateOperation,
+						Description: "out of disks",
+					}))
+				})
+			})
+
+			When("the job fails", func() {
+				BeforeEach(func() {
+					stream := make(chan ccv3.PollJobEvent)
+					go func() {
+						stream <- ccv3.PollJobEvent{State: constant.JobFailed, Err: errors.New("job-error"), Warnings: ccv3.Warnings{"job-warning"}}
+						close(stream)
+					}()
+					fakeCloudControllerClient.PollJobToEventStreamReturns(stream)
+				})
+
+				It("returns the error and warnings", func() {
+					Eventually(done).Should(BeClosed())
+					Expect(executeErr).To(MatchError("job-error"))
+					Expect(warnings).To(ContainElement("job-warning"))
+				})
+			})
+		})
+
+		When("the service instance is still being created", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturnsOnCall(
+					0,
+					resources.ServiceInstance{
+						Type:          resources.ManagedServiceInstance,
+						GUID:          "db-guid",
+						Name:          "db",
+						LastOperation: resources.LastOperation{Type: resources.CreateOperation, State: resources.OperationInProgress},
+					},
+					ccv3.IncludedResources{},
+					nil,
+					nil,
+				)
+			})
+
+			It("waits for it without creating it again", func() {
+				fakeClock.WaitForNWatchersAndIncrement(time.Second, 2)
+				Eventually(done).Should(BeClosed())
+
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(created).To(BeFalse())
+				Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceCallCount()).To(Equal(2))
+			})
+
+			When("the instance has labels", func() {
+				BeforeEach(func() {
+					instance.Labels = map[string]string{"team": "data"}
+				})
+
+				It("sets the labels once the instance is created", func() {
+					fakeClock.WaitForNWatchersAndIncrement(time.Second, 2)
+					Eventually(done).Should(BeClosed())
+
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(1))
+					resourceType, guid, _ := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+					Expect(resourceType).To(Equal("service-instance"))
+					Expect(guid).To(Equal("db-guid"))
+				})
+			})
+
+			When("the operation does not finish in time", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+						resources.ServiceInstance{
+							Type:          resources.ManagedServiceInstance,
+							Name:          "db",
+							LastOperation: resources.LastOperation{Type: resources.CreateOperation, State: resources.OperationInProgress},
+						},
+						ccv3.IncludedResources{},
+						nil,
+						nil,
+					)
+				})
+
+				It("returns a ServiceInstanceOperationTimeoutError", func() {
+					fakeClock.WaitForNWatchersAndIncrement(time.Minute, 2)
+					Eventually(done).Should(BeClosed())
+					Expect(executeErr).To(MatchError(actionerror.ServiceInstanceOperationTimeoutError{Name: "db"}))
+				})
+			})
+		})
+
+		When("an earlier apply failed to create the service instance", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturnsOnCall(
+					0,
+					resources.ServiceInstance{
+						Type: resources.ManagedServiceInstance,
+						GUID: "failed-db-guid",
+						Name: "db",
+						LastOperation: resources.LastOperation{
+							Type:        resources.CreateOperation,
+							State:       resources.OperationFailed,
+							Description: "out of disks",
+						},
+					},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"get-instance-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturnsOnCall(
+					1,
+					resources.ServiceInstance{
+						Type: resources.ManagedServiceInstance,
+						GUID: "failed-db-guid",
+						Name: "db",
+					},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"get-for-delete-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.DeleteServiceInstanceReturns("", ccv3.Warnings{"delete-warning"}, nil)
+			})
+
+			It("deletes the failed instance and creates it again", func() {
+				Eventually(done).Should(BeClosed())
+
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(created).To(BeTrue())
+				Expect(warnings).To(ContainElements("get-instance-warning", "get-for-delete-warning", "delete-warning", "create-warning", "job-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceCallCount()).To(Equal(1))
+				deletedGUID, _ := fakeCloudControllerClient.DeleteServiceInstanceArgsForCall(0)
+				Expect(deletedGUID).To(Equal("failed-db-guid"))
+				Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(Equal(1))
+			})
+
+			When("deleting the failed instance fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.DeleteServiceInstanceReturns("", ccv3.Warnings{"delete-warning"}, errors.New("delete-error"))
+				})
+
+				It("returns the error without creating the instance", func() {
+					Eventually(done).Should(BeClosed())
+
+					Expect(executeErr).To(MatchError("delete-error"))
+					Expect(warnings).To(ContainElement("delete-warning"))
+					Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("a user-provided service instance has the same name", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturnsOnCall(
+					0,
+					resources.ServiceInstance{Type: resources.UserProvidedServiceInstance, GUID: "ups-guid", Name: "db"},
+					ccv3.IncludedResources{},
+					nil,
+					nil,
+				)
+			})
+
+			It("returns a ServiceInstanceTypeError", func() {
+				Eventually(done).Should(BeClosed())
+				Expect(executeErr).To(MatchError(actionerror.ServiceInstanceTypeError{Name: "db", RequiredType: resources.ManagedServiceInstance}))
+			})
+		})
+	})
+
+	Describe("ApplyUserProvidedService", func() {
+		var instance ExportedUserProvidedService
+
+		BeforeEach(func() {
+			instance = ExportedUserProvidedService{
+				Name:           "ups",
+				Credentials:    map[string]interface{}{"password": "secret"},
+				SyslogDrainURL: "syslog://logs.example.com",
+			}
+			fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+				resources.ServiceInstance{},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"get-instance-warning"},
+				ccerror.ServiceInstanceNotFoundError{Name: "ups"},
+			)
+			fakeCloudControllerClient.CreateServiceInstanceReturns("", ccv3.Warnings{"create-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			created, warnings, executeErr = actor.ApplyUserProvidedService("some-space-guid", instance)
+		})
+
+		It("creates the user-provided service instance", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(created).To(BeTrue())
+			Expect(warnings).To(ConsistOf("get-instance-warning", "create-warning"))
+			Expect(fakeCloudControllerClient.CreateServiceInstanceArgsForCall(0)).To(Equal(resources.ServiceInstance{
+				Type:           resources.UserProvidedServiceInstance,
+				Name:           "ups",
+				SpaceGUID:      "some-space-guid",
+				Credentials:    types.NewOptionalObject(map[string]interface{}{"password": "secret"}),
+				SyslogDrainURL: types.NewOptionalString("syslog://logs.example.com"),
+			}))
+		})
+
+		When("it has labels", func() {
+			BeforeEach(func() {
+				instance.Labels = map[string]string{"team": "data"}
+			})
+
+			It("creates the instance with the labels", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.CreateServiceInstanceArgsForCall(0).Metadata).To(Equal(&resources.Metadata{
+					Labels: map[string]types.NullString{"team": types.NewNullString("data")},
+				}))
+			})
+		})
+
+		When("it already exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+					resources.ServiceInstance{Type: resources.UserProvidedServiceInstance, GUID: "ups-guid", Name: "ups"},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"get-instance-warning"},
+					nil,
+				)
+			})
+
+			It("does not create it", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(created).To(BeFalse())
+				Expect(fakeCloudControllerClient.CreateServiceInstanceCallCount()).To(Equal(0))
+			})
+
+			When("it has labels", func() {
+				BeforeEach(func() {
+					instance.Labels = map[string]string{"team": "data"}
+					fakeCloudControllerClient.UpdateResourceMetadataReturns("", ccv3.Warnings{"labels-warning"}, nil)
+				})
+
+				It("sets the labels on the existing instance", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-instance-warning", "labels-warning"))
+					resourceType, guid, metadata := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+					Expect(resourceType).To(Equal("service-instance"))
+					Expect(guid).To(Equal("ups-guid"))
+					Expect(metadata.Labels).To(Equal(map[string]types.NullString{"team": types.NewNullString("data")}))
+				})
+			})
+		})
+
+		When("looking up the instance fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+					resources.ServiceInstance{},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"get-instance-warning"},
+					errors.New("get-error"),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-error"))
+				Expect(warnings).To(ConsistOf("get-instance-warning"))
+			})
+		})
+	})
+
+	Describe("ApplyRoute", func() {
+		var route ExportedRoute
+
+		BeforeEach(func() {
+			route = ExportedRoute{Host: "www", Domain: "example.com", Path: "/api"}
+			fakeCloudControllerClient.GetDomainsReturns(
+				[]resources.Domain{{GUID: "domain-guid", Name: "example.com"}},
+				ccv3.Warnings{"domains-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetRoutesReturns(nil, ccv3.Warnings{"routes-warning"}, nil)
+			fakeCloudControllerClient.CreateRouteReturns(resources.Route{GUID: "route-guid"}, ccv3.Warnings{"create-route-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			created, warnings, executeErr = actor.ApplyRoute("some-space-guid", route)
+		})
+
+		It("creates the route", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(created).To(BeTrue())
+			Expect(warnings).To(ContainElements("routes-warning", "create-route-warning"))
+			Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.CreateRouteArgsForCall(0)).To(Equal(resources.Route{
+				SpaceGUID:  "some-space-guid",
+				DomainGUID: "domain-guid",
+				Host:       "www",
+				Path:       "/api",
+			}))
+			Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(0))
+		})
+
+		When("the route has labels", func() {
+			BeforeEach(func() {
+				route.Labels = map[string]string{"tier": "frontend"}
+				fakeCloudControllerClient.UpdateResourceMetadataReturns("", ccv3.Warnings{"labels-warning"}, nil)
+			})
+
+			It("sets the labels on the created route", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ContainElement("labels-warning"))
+				Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(1))
+				resourceType, guid, metadata := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+				Expect(resourceType).To(Equal("route"))
+				Expect(guid).To(Equal("route-guid"))
+				Expect(metadata.Labels).To(Equal(map[string]types.NullString{"tier": types.NewNullString("frontend")}))
+			})
+		})
+
+		When("the route already exists in the space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns([]resources.Route{{GUID: "route-guid", SpaceGUID: "some-space-guid"}}, nil, nil)
+			})
+
+			It("does not create it", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(created).To(BeFalse())
+				Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(0))
+			})
+
+			When("the route has labels", func() {
+				BeforeEach(func() {
+					route.Labels = map[string]string{"tier": "frontend"}
+				})
+
+				It("sets the labels on the existing route", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(1))
+					resourceType, guid, _ := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+					Expect(resourceType).To(Equal("route"))
+					Expect(guid).To(Equal("route-guid"))
+				})
+			})
+		})
+
+		When("the route exists in another space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns([]resources.Route{{GUID: "route-guid", SpaceGUID: "other-space-guid", URL: "www.example.com/api"}}, nil, nil)
+			})
+
+			It("returns a RouteInDifferentSpaceError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteInDifferentSpaceError{Route: "www.example.com/api"}))
+				Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the domain does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDomainsReturns(nil, ccv3.Warnings{"domains-warning"}, nil)
+			})
+
+			It("returns a DomainNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.DomainNotFoundError{Name: "example.com"}))
+				Expect(warnings).To(ConsistOf("domains-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,268 @@
+package v7action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"gopkg.in/yaml.v2"
+)
+
+// SpaceExport is everything needed to recreate the apps, routes and service
+// instances of a space in another space.
+type SpaceExport struct {
+	// Manifest is a multi-app manifest for every app in the space.
+	Manifest             []byte
+	Labels               map[string]string
+	Routes               []ExportedRoute
+	ServiceInstances     []ExportedServiceInstance
+	UserProvidedServices []ExportedUserProvidedService
+}
+
+type ExportedRoute struct {
+	Host   string            `yaml:"host,omitempty"`
+	Domain string            `yaml:"domain"`
+	Path   string            `yaml:"path,omitempty"`
+	Port   int               `yaml:"port,omitempty"`
+	Labels map[string]string `yaml:"labels,omitempty"`
+}
+
+type ExportedServiceInstance struct {
+	Name       string                 `yaml:"name"`
+	Offering   string                 `yaml:"offering"`
+	Plan       string                 `yaml:"plan"`
+	Broker     string                 `yaml:"broker,omitempty"`
+	Tags       []string               `yaml:"tags,omitempty"`
+	Parameters map[string]interface{} `yaml:"parameters,omitempty"`
+	Labels     map[string]string      `yaml:"labels,omitempty"`
+}
+
+type ExportedUserProvidedService struct {
+	Name            string                 `yaml:"name"`
+	Credentials     map[string]interface{} `yaml:"credentials,omitempty"`
+	SyslogDrainURL  string                 `yaml:"syslog_drain_url,omitempty"`
+	RouteServiceURL string                 `yaml:"route_service_url,omitempty"`
+	Tags            []string               `yaml:"tags,omitempty"`
+	Labels          map[string]string      `yaml:"labels,omitempty"`
+}
+
+// ExportSpace gathers the apps, routes, service instances and labels of the
+// given space. Service instances shared into the space from elsewhere are not
+// exported. Parameters of managed service instances are exported when the
+// broker allows them to be retrieved.
+func (actor Actor) ExportSpace(spaceGUID string) (SpaceExport, Warnings, error) {
+	var (
+		export      SpaceExport
+		allWarnings Warnings
+	)
+
+	spaces, _, ccWarnings, err := actor.CloudControllerClient.GetSpaces(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{spaceGUID}},
+	)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return SpaceExport{}, allWarnings, err
+	}
+	if len(spaces) == 0 {
+		return SpaceExport{}, allWarnings, actionerror.SpaceNotFoundError{GUID: spaceGUID}
+	}
+	export.Labels = exportedLabels(spaces[0].Metadata)
+
+	manifest, warnings, err := actor.exportSpaceManifest(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceExport{}, allWarnings, err
+	}
+	export.Manifest = manifest
+
+	routes, warnings, err := actor.exportSpaceRoutes(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceExport{}, allWarnings, err
+	}
+	export.Routes = routes
+
+	managed, userProvided, warnings, err := actor.exportSpaceServiceInstances(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceExport{}, allWarnings, err
+	}
+	export.ServiceInstances = managed
+	export.UserProvidedServices = userProvided
+
+	return export, allWarnings, nil
+}
+
+func (actor Actor) exportSpaceManifest(spaceGUID string) ([]byte, Warnings, error) {
+	var allWarnings Warnings
+
+	apps, ccWarnings, err := actor.CloudControllerClient.GetApplications(
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var combined struct {
+		Applications []yaml.MapSlice `yaml:"applications"`
+	}
+	combined.Applications = []yaml.MapSlice{}
+
+	for _, app := range apps {
+		rawManifest, ccWarnings, err := actor.CloudControllerClient.GetApplicationManifest(app.GUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		var appManifest struct {
+			Applications []yaml.MapSlice `yaml:"applications"`
+		}
+		err = yaml.Unmarshal(rawManifest, &appManifest)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		combined.Applications = append(combined.Applications, appManifest.Applications...)
+	}
+
+	manifest, err := yaml.Marshal(combined)
+	return manifest, allWarnings, err
+}
+
+func (actor Actor) exportSpaceRoutes(spaceGUID string) ([]ExportedRoute, Warnings, error) {
+	var allWarnings Warnings
+
+	routes, ccWarnings, err := actor.CloudControllerClient.GetRoutes(
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	domainNames := map[string]string{}
+	var exported []ExportedRoute
+	for _, route := range routes {
+		domainName, ok := domainNames[route.DomainGUID]
+		if !ok {
+			domain, ccWarnings, err := actor.CloudControllerClient.GetDomain(route.DomainGUID)
+			allWarnings = append(allWarnings, ccWarnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			domainName = domain.Name
+			domainNames[route.DomainGUID] = domainName
+		}
+
+		exported = append(exported, ExportedRoute{
+			Host:   route.Host,
+			Domain: domainName,
+			Path:   route.Path,
+			Port:   route.Port,
+			Labels: exportedLabels(route.Metadata),
+		})
+	}
+
+	return exported, allWarnings, nil
+}
+
+func (actor Actor) exportSpaceServiceInstances(spaceGUID string) ([]ExportedServiceInstance, []ExportedUserProvidedService, Warnings, error) {
+	var allWarnings Warnings
+
+	instances, included, ccWarnings, err := actor.CloudControllerClient.GetServiceInstances(
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+		ccv3.Query{Key: ccv3.FieldsServicePlan, Values: []string{"guid", "name", "relationships.service_offering"}},
+		ccv3.Query{Key: ccv3.FieldsServicePlanServiceOffering, Values: []string{"guid", "name", "relationships.service_broker"}},
+		ccv3.Query{Key: ccv3.FieldsServicePlanServiceOfferingServiceBroker, Values: []string{"guid", "name"}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, nil, allWarnings, err
+	}
+
+	planDetailsFromPlanGUIDLookup := buildPlanDetailsLookup(included)
+
+	var (
+		managed      []ExportedServiceInstance
+		userProvided []ExportedUserProvidedService
+	)
+	for _, instance := range instances {
+		if instance.SpaceGUID != spaceGUID {
+			continue
+		}
+
+		switch instance.Type {
+		case resources.ManagedServiceInstance:
+			names := planDetailsFromPlanGUIDLookup[instance.ServicePlanGUID]
+			exported := ExportedServiceInstance{
+				Name:     instance.Name,
+				Offering: names.offering,
+				Plan:     names.plan,
+				Broker:   names.broker,
+				Tags:     instance.Tags.Value,
+				Labels:   exportedLabels(instance.Metadata),
+			}
+
+			params, ccWarnings, err := actor.getServiceInstanceParameters(instance.GUID)
+			allWarnings = append(allWarnings, ccWarnings...)
+			switch err.(type) {
+			case nil:
+				if len(params) > 0 {
+					exported.Parameters = params
+				}
+			case actionerror.ServiceInstanceParamsFetchingNotSupportedError:
+				allWarnings = append(allWarnings, fmt.Sprintf("Parameters of service instance %s could not be retrieved and were not exported.", instance.Name))
+			default:
+				return nil, nil, allWarnings, err
+			}
+
+			managed = append(managed, exported)
+		case resources.UserProvidedServiceInstance:
+			credentials, ccWarnings, err := actor.CloudControllerClient.GetServiceInstanceCredentials(instance.GUID)
+			allWarnings = append(allWarnings, ccWarnings...)
+			if err != nil {
+				return nil, nil, allWarnings, err
+			}
+
+			exported := ExportedUserProvidedService{
+				Name:            instance.Name,
+				SyslogDrainURL:  instance.SyslogDrainURL.Value,
+				RouteServiceURL: instance.RouteServiceURL.Value,
+				Tags:            instance.Tags.Value,
+				Labels:          exportedLabels(instance.Metadata),
+			}
+			if len(credentials) > 0 {
+				exported.Credentials = credentials
+			}
+
+			userProvided = append(userProvided, exported)
+		}
+	}
+
+	return managed, userProvided, allWarnings, nil
+}
+
+func exportedLabels(metadata *resources.Metadata) map[string]string {
+	if metadata == nil {
+		return nil
+	}
+
+	var labels map[string]string
+	for key, value := range metadata.Labels {
+		if !value.IsSet {
+			continue
+		}
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[key] = value.Value
+	}
+	return labels
+}
@@ -0,0 +1,242 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space export actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+	})
+
+	Describe("ExportSpace", func() {
+		var (
+			export     SpaceExport
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]resources.Space{{
+					GUID: "some-space-guid",
+					Metadata: &resources.Metadata{Labels: map[string]types.NullString{
+						"env":     types.NewNullString("staging"),
+						"removed": types.NewNullString(),
+					}},
+				}},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"space-warning"},
+				nil,
+			)
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{Name: "app-1", GUID: "app-1-guid"}, {Name: "app-2", GUID: "app-2-guid"}},
+				ccv3.Warnings{"apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationManifestStub = func(appGUID string) ([]byte, ccv3.Warnings, error) {
+				if appGUID == "app-1-guid" {
+					return []byte("applications:\n- name: app-1\n  instances: 2\n"), ccv3.Warnings{"manifest-warning"}, nil
+				}
+				return []byte("applications:\n- name: app-2\n  memory: 1G\n"), nil, nil
+			}
+
+			fakeCloudControllerClient.GetRoutesReturns(
+				[]resources.Route{
+					{Host: "www", DomainGUID: "shared-domain-guid", Path: "/api"},
+					{Host: "admin", DomainGUID: "shared-domain-guid", Metadata: &resources.Metadata{Labels: map[string]types.NullString{"tier": types.NewNullString("front")}}},
+					{DomainGUID: "tcp-domain-guid", Port: 1024},
+				},
+				ccv3.Warnings{"routes-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetDomainStub = func(guid string) (resources.Domain, ccv3.Warnings, error) {
+				if guid == "tcp-domain-guid" {
+					return resources.Domain{Name: "tcp.example.com"}, ccv3.Warnings{"domain-warning"}, nil
+				}
+				return resources.Domain{Name: "example.com"}, ccv3.Warnings{"domain-warning"}, nil
+			}
+
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{
+					{
+						Type:            resources.ManagedServiceInstance,
+						GUID:            "db-guid",
+						Name:            "db",
+						SpaceGUID:       "some-space-guid",
+						ServicePlanGUID: "small-plan-guid",
+						Tags:            types.NewOptionalStringSlice("sql"),
+					},
+					{
+						Type:      resources.ManagedServiceInstance,
+						GUID:      "shared-in-guid",
+						Name:      "shared-in",
+						SpaceGUID: "other-space-guid",
+					},
+					{
+						Type:           resources.UserProvidedServiceInstance,
+						GUID:           "ups-guid",
+						Name:           "ups",
+						SpaceGUID:      "some-space-guid",
+						SyslogDrainURL: types.NewOptionalString("syslog://logs.example.com"),
+					},
+				},
+				ccv3.IncludedResources{
+					ServicePlans:     []resources.ServicePlan{{GUID: "small-plan-guid", Name: "small", ServiceOfferingGUID: "postgres-guid"}},
+					ServiceOfferings: []resources.ServiceOffering{{GUID: "postgres-guid", Name: "postgres", ServiceBrokerGUID: "broker-guid"}},
+					ServiceBrokers:   []resources.ServiceBroker{{GUID: "broker-guid", Name: "some-broker"}},
+				},
+				ccv3.Warnings{"instances-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstanceParametersReturns(
+				types.JSONObject{"size": "10G"},
+				ccv3.Warnings{"params-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstanceCredentialsReturns(
+				types.JSONObject{"password": "secret"},
+				ccv3.Warnings{"credentials-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			export, warnings, executeErr = actor.ExportSpace("some-space-guid")
+		})
+
+		It("exports the apps, routes, service instances and labels of the space", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"space-warning", "apps-warning", "manifest-warning", "routes-warning",
+				"domain-warning", "domain-warning", "instances-warning", "params-warning", "credentials-warning",
+			))
+
+			Expect(string(export.Manifest)).To(MatchYAML(`
+applications:
+- name: app-1
+  instances: 2
+- name: app-2
+  memory: 1G
+`))
+			Expect(export.Labels).To(Equal(map[string]string{"env": "staging"}))
+			Expect(export.Routes).To(Equal([]ExportedRoute{
+				{Host: "www", Domain: "example.com", Path: "/api"},
+				{Host: "admin", Domain: "example.com", Labels: map[string]string{"tier": "front"}},
+				{Domain: "tcp.example.com", Port: 1024},
+			}))
+			Expect(export.ServiceInstances).To(Equal([]ExportedServiceInstance{{
+				Name:       "db",
+				Offering:   "postgres",
+				Plan:       "small",
+				Broker:     "some-broker",
+				Tags:       []string{"sql"},
+				Parameters: map[string]interface{}{"size": "10G"},
+			}}))
+			Expect(export.UserProvidedServices).To(Equal([]ExportedUserProvidedService{{
+				Name:           "ups",
+				Credentials:    map[string]interface{}{"password": "secret"},
+				SyslogDrainURL: "syslog://logs.example.com",
+			}}))
+
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"some-space-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ContainElement(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ContainElement(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetDomainCallCount()).To(Equal(2))
+			Expect(fakeCloudControllerClient.GetServiceInstanceParametersCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceInstanceParametersArgsForCall(0)).To(Equal("db-guid"))
+			Expect(fakeCloudControllerClient.GetServiceInstanceCredentialsArgsForCall(0)).To(Equal("ups-guid"))
+		})
+
+		When("the broker does not allow parameters to be retrieved", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceParametersReturns(nil, nil, ccerror.ServiceInstanceParametersFetchNotSupportedError{})
+			})
+
+			It("exports the service instance without parameters and warns", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(export.ServiceInstances[0].Parameters).To(BeNil())
+				Expect(warnings).To(ContainElement("Parameters of service instance db could not be retrieved and were not exported."))
+			})
+		})
+
+		When("the space has no apps", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, nil, nil)
+			})
+
+			It("exports an empty manifest", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(string(export.Manifest)).To(MatchYAML("applications: []"))
+				Expect(fakeCloudControllerClient.GetApplicationManifestCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.IncludedResources{}, ccv3.Warnings{"space-warning"}, nil)
+			})
+
+			It("returns a SpaceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{GUID: "some-space-guid"}))
+				Expect(warnings).To(ConsistOf("space-warning"))
+			})
+		})
+
+		When("getting an app manifest fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationManifestStub = nil
+				fakeCloudControllerClient.GetApplicationManifestReturns(nil, ccv3.Warnings{"manifest-warning"}, errors.New("manifest-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("manifest-error"))
+				Expect(warnings).To(ConsistOf("space-warning", "apps-warning", "manifest-warning"))
+			})
+		})
+
+		When("getting a domain fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDomainStub = nil
+				fakeCloudControllerClient.GetDomainReturns(resources.Domain{}, nil, errors.New("domain-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("domain-error"))
+			})
+		})
+
+		When("getting the credentials of a user-provided service fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceCredentialsReturns(nil, ccv3.Warnings{"credentials-warning"}, errors.New("credentials-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("credentials-error"))
+				Expect(warnings).To(ContainElement("credentials-warning"))
+			})
+		})
+	})
+})
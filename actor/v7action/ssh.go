@@ -2,8 +2,10 @@ package v7action
 
 import (
 	"fmt"
+	"sort"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
 )
 
@@ -14,6 +16,14 @@ type SSHAuthentication struct {
 	Username           string
 }
 
+// InstanceSSHAuthentication is the SSH authentication information for a
+// single process instance.
+type InstanceSSHAuthentication struct {
+	Index int64
+	State constant.ProcessInstanceState
+	SSHAuthentication
+}
+
 func (actor Actor) GetSSHPasscode() (string, error) {
 	return actor.UAAClient.GetSSHPasscode(actor.Config.AccessToken(), actor.Config.SSHOAuthClient())
 }
@@ -25,22 +35,12 @@ func (actor Actor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeA
 ) (SSHAuthentication, Warnings, error) {
 	var allWarnings Warnings
 
-	rootInfo, warnings, err := actor.CloudControllerClient.GetInfo()
+	endpoint, fingerprint, warnings, err := actor.getSSHEndpointAndFingerprint()
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
 		return SSHAuthentication{}, allWarnings, err
 	}
 
-	endpoint := rootInfo.AppSSHEndpoint()
-	if endpoint == "" {
-		return SSHAuthentication{}, nil, actionerror.SSHEndpointNotSetError{}
-	}
-
-	fingerprint := rootInfo.AppSSHHostKeyFingerprint()
-	if fingerprint == "" {
-		return SSHAuthentication{}, nil, actionerror.SSHHostKeyFingerprintNotSetError{}
-	}
-
 	passcode, err := actor.UAAClient.GetSSHPasscode(actor.Config.AccessToken(), actor.Config.SSHOAuthClient())
 	if err != nil {
 		return SSHAuthentication{}, Warnings{}, err
@@ -70,6 +70,84 @@ func (actor Actor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeA
 	}, allWarnings, err
 }
 
+// GetSecureShellConfigurationsForAllInstances returns the SSH authentication
+// information for every instance of the given process, in index order.
+// Instances that are not running are returned with only their index and
+// state set. The passcodes are left empty, since a one-time passcode expires
+// soon after it is issued; get one with GetSSHPasscode right before
+// connecting to each instance.
+func (actor Actor) GetSecureShellConfigurationsForAllInstances(
+	appName string, spaceGUID string, processType string,
+) ([]InstanceSSHAuthentication, Warnings, error) {
+	var allWarnings Warnings
+
+	endpoint, fingerprint, warnings, err := actor.getSSHEndpointAndFingerprint()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	application, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if !application.Started() {
+		return nil, allWarnings, actionerror.ApplicationNotStartedError{Name: appName}
+	}
+
+	process, warnings, err := actor.GetProcessByTypeAndApplication(processType, application.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	instances, ccWarnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	sort.Slice(instances, func(i, j int) bool { return instances[i].Index < instances[j].Index })
+
+	var instanceAuths []InstanceSSHAuthentication
+	for _, instance := range instances {
+		instanceAuth := InstanceSSHAuthentication{Index: instance.Index, State: instance.State}
+
+		if ProcessInstance(instance).Running() {
+			instanceAuth.SSHAuthentication = SSHAuthentication{
+				Endpoint:           endpoint,
+				HostKeyFingerprint: fingerprint,
+				Username:           fmt.Sprintf("cf:%s/%d", process.GUID, instance.Index),
+			}
+		}
+
+		instanceAuths = append(instanceAuths, instanceAuth)
+	}
+
+	return instanceAuths, allWarnings, nil
+}
+
+func (actor Actor) getSSHEndpointAndFingerprint() (string, string, Warnings, error) {
+	rootInfo, warnings, err := actor.CloudControllerClient.GetInfo()
+	if err != nil {
+		return "", "", Warnings(warnings), err
+	}
+
+	endpoint := rootInfo.AppSSHEndpoint()
+	if endpoint == "" {
+		return "", "", nil, actionerror.SSHEndpointNotSetError{}
+	}
+
+	fingerprint := rootInfo.AppSSHHostKeyFingerprint()
+	if fingerprint == "" {
+		return "", "", nil, actionerror.SSHHostKeyFingerprintNotSetError{}
+	}
+
+	return endpoint, fingerprint, Warnings(warnings), nil
+}
+
 func (actor Actor) getUsername(application resources.Application, processType string, processIndex uint) (string, Warnings, error) {
 	processSummaries, processWarnings, err := actor.getProcessSummariesForApp(application.GUID, false)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
@@ -267,4 +268,127 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetSecureShellConfigurationsForAllInstances", func() {
+		var instanceAuths []InstanceSSHAuthentication
+
+		BeforeEach(func() {
+			fakeConfig.AccessTokenReturns("some-access-token")
+			fakeConfig.SSHOAuthClientReturns("some-access-oauth-client")
+
+			fakeCloudControllerClient.GetInfoReturns(ccv3.Info{
+				Links: ccv3.InfoLinks{
+					AppSSH: resources.APILink{
+						HREF: "some-app-ssh-endpoint",
+						Meta: resources.APILinkMeta{HostKeyFingerprint: "some-app-ssh-fingerprint"},
+					},
+				},
+			}, ccv3.Warnings{"some-info-warnings"}, nil)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{
+					{Name: "some-app", GUID: "some-app-guid", State: constant.ApplicationStarted},
+				},
+				ccv3.Warnings{"some-app-warnings"},
+				nil)
+			fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+				resources.Process{GUID: "some-process-guid", Type: "worker"},
+				ccv3.Warnings{"some-process-warnings"},
+				nil)
+			fakeCloudControllerClient.GetProcessInstancesReturns(
+				[]ccv3.ProcessInstance{
+					{Index: 2, State: constant.ProcessInstanceRunning},
+					{Index: 0, State: constant.ProcessInstanceRunning},
+					{Index: 1, State: constant.ProcessInstanceCrashed},
+				},
+				ccv3.Warnings{"some-instance-warnings"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			instanceAuths, warnings, executeErr = actor.GetSecureShellConfigurationsForAllInstances("some-app", "some-space-guid", "worker")
+		})
+
+		It("returns the authentication for every running instance in index order without getting passcodes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("some-info-warnings", "some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+
+			Expect(fakeCloudControllerClient.GetApplicationProcessByTypeCallCount()).To(Equal(1))
+			appGUID, processType := fakeCloudControllerClient.GetApplicationProcessByTypeArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(processType).To(Equal("worker"))
+			Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("some-process-guid"))
+
+			Expect(instanceAuths).To(Equal([]InstanceSSHAuthentication{
+				{
+					Index: 0,
+					State: constant.ProcessInstanceRunning,
+					SSHAuthentication: SSHAuthentication{
+						Endpoint:           "some-app-ssh-endpoint",
+						HostKeyFingerprint: "some-app-ssh-fingerprint",
+						Username:           "cf:some-process-guid/0",
+					},
+				},
+				{Index: 1, State: constant.ProcessInstanceCrashed},
+				{
+					Index: 2,
+					State: constant.ProcessInstanceRunning,
+					SSHAuthentication: SSHAuthentication{
+						Endpoint:           "some-app-ssh-endpoint",
+						HostKeyFingerprint: "some-app-ssh-fingerprint",
+						Username:           "cf:some-process-guid/2",
+					},
+				},
+			}))
+			Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(0))
+		})
+
+		When("the app ssh endpoint is empty", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetInfoReturns(ccv3.Info{}, nil, nil)
+			})
+
+			It("returns an ssh-endpoint-not-set error", func() {
+				Expect(executeErr).To(MatchError(actionerror.SSHEndpointNotSetError{}))
+			})
+		})
+
+		When("the application is not started", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{Name: "some-app", State: constant.ApplicationStopped}},
+					ccv3.Warnings{"some-app-warnings"},
+					nil)
+			})
+
+			It("returns an application-not-started error", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotStartedError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("some-info-warnings", "some-app-warnings"))
+			})
+		})
+
+		When("the process does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+					resources.Process{},
+					ccv3.Warnings{"some-process-warnings"},
+					ccerror.ProcessNotFoundError{})
+			})
+
+			It("returns a process-not-found error", func() {
+				Expect(executeErr).To(MatchError(actionerror.ProcessNotFoundError{ProcessType: "worker"}))
+				Expect(warnings).To(ConsistOf("some-info-warnings", "some-app-warnings", "some-process-warnings"))
+			})
+		})
+
+		When("getting the process instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"some-instance-warnings"}, errors.New("some-instance-error"))
+			})
+
+			It("returns all warnings and the error", func() {
+				Expect(executeErr).To(MatchError("some-instance-error"))
+				Expect(warnings).To(ConsistOf("some-info-warnings", "some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+			})
+		})
+	})
 })
@@ -152,6 +152,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateApplicationSidecarStub        func(string, resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)
+	createApplicationSidecarMutex       sync.RWMutex
+	createApplicationSidecarArgsForCall []struct {
+		arg1 string
+		arg2 resources.Sidecar
+	}
+	createApplicationSidecarReturns struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createApplicationSidecarReturnsOnCall map[int]struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateApplicationTaskStub        func(string, resources.Task) (resources.Task, ccv3.Warnings, error)
 	createApplicationTaskMutex       sync.RWMutex
 	createApplicationTaskArgsForCall []struct {
@@ -690,6 +706,19 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeleteSidecarStub        func(string) (ccv3.Warnings, error)
+	deleteSidecarMutex       sync.RWMutex
+	deleteSidecarArgsForCall []struct {
+		arg1 string
+	}
+	deleteSidecarReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	deleteSidecarReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	DeleteSpaceStub        func(string) (ccv3.JobURL, ccv3.Warnings, error)
 	deleteSpaceMutex       sync.RWMutex
 	deleteSpaceArgsForCall []struct {
@@ -920,6 +949,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetApplicationSidecarsStub        func(string) ([]resources.Sidecar, ccv3.Warnings, error)
+	getApplicationSidecarsMutex       sync.RWMutex
+	getApplicationSidecarsArgsForCall []struct {
+		arg1 string
+	}
+	getApplicationSidecarsReturns struct {
+		result1 []resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationSidecarsReturnsOnCall map[int]struct {
+		result1 []resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetApplicationTasksStub        func(string, ...ccv3.Query) ([]resources.Task, ccv3.Warnings, error)
 	getApplicationTasksMutex       sync.RWMutex
 	getApplicationTasksArgsForCall []struct {
@@ -1591,6 +1635,21 @@ type FakeCloudControllerClient struct {
 		result3 ccv3.Warnings
 		result4 error
 	}
+	GetServiceInstanceCredentialsStub        func(string) (types.JSONObject, ccv3.Warnings, error)
+	getServiceInstanceCredentialsMutex       sync.RWMutex
+	getServiceInstanceCredentialsArgsForCall []struct {
+		arg1 string
+	}
+	getServiceInstanceCredentialsReturns struct {
+		result1 types.JSONObject
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getServiceInstanceCredentialsReturnsOnCall map[int]struct {
+		result1 types.JSONObject
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetServiceInstanceParametersStub        func(string) (types.JSONObject, ccv3.Warnings, error)
 	getServiceInstanceParametersMutex       sync.RWMutex
 	getServiceInstanceParametersArgsForCall []struct {
@@ -2587,6 +2646,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateSidecarStub        func(resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)
+	updateSidecarMutex       sync.RWMutex
+	updateSidecarArgsForCall []struct {
+		arg1 resources.Sidecar
+	}
+	updateSidecarReturns struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateSidecarReturnsOnCall map[int]struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateSpaceStub        func(resources.Space) (resources.Space, ccv3.Warnings, error)
 	updateSpaceMutex       sync.RWMutex
 	updateSpaceArgsForCall []struct {
@@ -3371,6 +3445,74 @@ func (fake *FakeCloudControllerClient) CreateApplicationProcessScaleReturnsOnCal
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateApplicationSidecar(arg1 string, arg2 resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error) {
+	fake.createApplicationSidecarMutex.Lock()
+	ret, specificReturn := fake.createApplicationSidecarReturnsOnCall[len(fake.createApplicationSidecarArgsForCall)]
+	fake.createApplicationSidecarArgsForCall = append(fake.createApplicationSidecarArgsForCall, struct {
+		arg1 string
+		arg2 resources.Sidecar
+	}{arg1, arg2})
+	stub := fake.CreateApplicationSidecarStub
+	fakeReturns := fake.createApplicationSidecarReturns
+	fake.recordInvocation("CreateApplicationSidecar", []interface{}{arg1, arg2})
+	fake.createApplicationSidecarMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationSidecarCallCount() int {
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
+	return len(fake.createApplicationSidecarArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationSidecarCalls(stub func(string, resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = stub
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationSidecarArgsForCall(i int) (string, resources.Sidecar) {
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
+	argsForCall := fake.createApplicationSidecarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationSidecarReturns(result1 resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = nil
+	fake.createApplicationSidecarReturns = struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationSidecarReturnsOnCall(i int, result1 resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = nil
+	if fake.createApplicationSidecarReturnsOnCall == nil {
+		fake.createApplicationSidecarReturnsOnCall = make(map[int]struct {
+			result1 resources.Sidecar
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationSidecarReturnsOnCall[i] = struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateApplicationTask(arg1 string, arg2 resources.Task) (resources.Task, ccv3.Warnings, error) {
 	fake.createApplicationTaskMutex.Lock()
 	ret, specificReturn := fake.createApplicationTaskReturnsOnCall[len(fake.createApplicationTaskArgsForCall)]
@@ -5777,6 +5919,70 @@ func (fake *FakeCloudControllerClient) DeleteServicePlanVisibilityReturnsOnCall(
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeleteSidecar(arg1 string) (ccv3.Warnings, error) {
+	fake.deleteSidecarMutex.Lock()
+	ret, specificReturn := fake.deleteSidecarReturnsOnCall[len(fake.deleteSidecarArgsForCall)]
+	fake.deleteSidecarArgsForCall = append(fake.deleteSidecarArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DeleteSidecarStub
+	fakeReturns := fake.deleteSidecarReturns
+	fake.recordInvocation("DeleteSidecar", []interface{}{arg1})
+	fake.deleteSidecarMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteSidecarCallCount() int {
+	fake.deleteSidecarMutex.RLock()
+	defer fake.deleteSidecarMutex.RUnlock()
+	return len(fake.deleteSidecarArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteSidecarCalls(stub func(string) (ccv3.Warnings, error)) {
+	fake.deleteSidecarMutex.Lock()
+	defer fake.deleteSidecarMutex.Unlock()
+	fake.DeleteSidecarStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DeleteSidecarArgsForCall(i int) string {
+	fake.deleteSidecarMutex.RLock()
+	defer fake.deleteSidecarMutex.RUnlock()
+	argsForCall := fake.deleteSidecarArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) DeleteSidecarReturns(result1 ccv3.Warnings, result2 error) {
+	fake.deleteSidecarMutex.Lock()
+	defer fake.deleteSidecarMutex.Unlock()
+	fake.DeleteSidecarStub = nil
+	fake.deleteSidecarReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteSidecarReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.deleteSidecarMutex.Lock()
+	defer fake.deleteSidecarMutex.Unlock()
+	fake.DeleteSidecarStub = nil
+	if fake.deleteSidecarReturnsOnCall == nil {
+		fake.deleteSidecarReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.deleteSidecarReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteSpace(arg1 string) (ccv3.JobURL, ccv3.Warnings, error) {
 	fake.deleteSpaceMutex.Lock()
 	ret, specificReturn := fake.deleteSpaceReturnsOnCall[len(fake.deleteSpaceArgsForCall)]
@@ -6792,6 +6998,73 @@ func (fake *FakeCloudControllerClient) GetApplicationRoutesReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetApplicationSidecars(arg1 string) ([]resources.Sidecar, ccv3.Warnings, error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	ret, specificReturn := fake.getApplicationSidecarsReturnsOnCall[len(fake.getApplicationSidecarsArgsForCall)]
+	fake.getApplicationSidecarsArgsForCall = append(fake.getApplicationSidecarsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetApplicationSidecarsStub
+	fakeReturns := fake.getApplicationSidecarsReturns
+	fake.recordInvocation("GetApplicationSidecars", []interface{}{arg1})
+	fake.getApplicationSidecarsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsCallCount() int {
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	return len(fake.getApplicationSidecarsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsCalls(stub func(string) ([]resources.Sidecar, ccv3.Warnings, error)) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsArgsForCall(i int) string {
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	argsForCall := fake.getApplicationSidecarsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsReturns(result1 []resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = nil
+	fake.getApplicationSidecarsReturns = struct {
+		result1 []resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsReturnsOnCall(i int, result1 []resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = nil
+	if fake.getApplicationSidecarsReturnsOnCall == nil {
+		fake.getApplicationSidecarsReturnsOnCall = make(map[int]struct {
+			result1 []resources.Sidecar
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationSidecarsReturnsOnCall[i] = struct {
+		result1 []resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetApplicationTasks(arg1 string, arg2 ...ccv3.Query) ([]resources.Task, ccv3.Warnings, error) {
 	fake.getApplicationTasksMutex.Lock()
 	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
@@ -9740,6 +10013,73 @@ func (fake *FakeCloudControllerClient) GetServiceInstanceByNameAndSpaceReturnsOn
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceInstanceCredentials(arg1 string) (types.JSONObject, ccv3.Warnings, error) {
+	fake.getServiceInstanceCredentialsMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceCredentialsReturnsOnCall[len(fake.getServiceInstanceCredentialsArgsForCall)]
+	fake.getServiceInstanceCredentialsArgsForCall = append(fake.getServiceInstanceCredentialsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetServiceInstanceCredentialsStub
+	fakeReturns := fake.getServiceInstanceCredentialsReturns
+	fake.recordInvocation("GetServiceInstanceCredentials", []interface{}{arg1})
+	fake.getServiceInstanceCredentialsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceCredentialsCallCount() int {
+	fake.getServiceInstanceCredentialsMutex.RLock()
+	defer fake.getServiceInstanceCredentialsMutex.RUnlock()
+	return len(fake.getServiceInstanceCredentialsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceCredentialsCalls(stub func(string) (types.JSONObject, ccv3.Warnings, error)) {
+	fake.getServiceInstanceCredentialsMutex.Lock()
+	defer fake.getServiceInstanceCredentialsMutex.Unlock()
+	fake.GetServiceInstanceCredentialsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceCredentialsArgsForCall(i int) string {
+	fake.getServiceInstanceCredentialsMutex.RLock()
+	defer fake.getServiceInstanceCredentialsMutex.RUnlock()
+	argsForCall := fake.getServiceInstanceCredentialsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceCredentialsReturns(result1 types.JSONObject, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceInstanceCredentialsMutex.Lock()
+	defer fake.getServiceInstanceCredentialsMutex.Unlock()
+	fake.GetServiceInstanceCredentialsStub = nil
+	fake.getServiceInstanceCredentialsReturns = struct {
+		result1 types.JSONObject
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceCredentialsReturnsOnCall(i int, result1 types.JSONObject, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceInstanceCredentialsMutex.Lock()
+	defer fake.getServiceInstanceCredentialsMutex.Unlock()
+	fake.GetServiceInstanceCredentialsStub = nil
+	if fake.getServiceInstanceCredentialsReturnsOnCall == nil {
+		fake.getServiceInstanceCredentialsReturnsOnCall = make(map[int]struct {
+			result1 types.JSONObject
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceCredentialsReturnsOnCall[i] = struct {
+		result1 types.JSONObject
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetServiceInstanceParameters(arg1 string) (types.JSONObject, ccv3.Warnings, error) {
 	fake.getServiceInstanceParametersMutex.Lock()
 	ret, specificReturn := fake.getServiceInstanceParametersReturnsOnCall[len(fake.getServiceInstanceParametersArgsForCall)]
@@ -14205,6 +14545,73 @@ func (fake *FakeCloudControllerClient) UpdateServicePlanVisibilityReturnsOnCall(
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateSidecar(arg1 resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error) {
+	fake.updateSidecarMutex.Lock()
+	ret, specificReturn := fake.updateSidecarReturnsOnCall[len(fake.updateSidecarArgsForCall)]
+	fake.updateSidecarArgsForCall = append(fake.updateSidecarArgsForCall, struct {
+		arg1 resources.Sidecar
+	}{arg1})
+	stub := fake.UpdateSidecarStub
+	fakeReturns := fake.updateSidecarReturns
+	fake.recordInvocation("UpdateSidecar", []interface{}{arg1})
+	fake.updateSidecarMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSidecarCallCount() int {
+	fake.updateSidecarMutex.RLock()
+	defer fake.updateSidecarMutex.RUnlock()
+	return len(fake.updateSidecarArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSidecarCalls(stub func(resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)) {
+	fake.updateSidecarMutex.Lock()
+	defer fake.updateSidecarMutex.Unlock()
+	fake.UpdateSidecarStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateSidecarArgsForCall(i int) resources.Sidecar {
+	fake.updateSidecarMutex.RLock()
+	defer fake.updateSidecarMutex.RUnlock()
+	argsForCall := fake.updateSidecarArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) UpdateSidecarReturns(result1 resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.updateSidecarMutex.Lock()
+	defer fake.updateSidecarMutex.Unlock()
+	fake.UpdateSidecarStub = nil
+	fake.updateSidecarReturns = struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSidecarReturnsOnCall(i int, result1 resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.updateSidecarMutex.Lock()
+	defer fake.updateSidecarMutex.Unlock()
+	fake.UpdateSidecarStub = nil
+	if fake.updateSidecarReturnsOnCall == nil {
+		fake.updateSidecarReturnsOnCall = make(map[int]struct {
+			result1 resources.Sidecar
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateSidecarReturnsOnCall[i] = struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateSpace(arg1 resources.Space) (resources.Space, ccv3.Warnings, error) {
 	fake.updateSpaceMutex.Lock()
 	ret, specificReturn := fake.updateSpaceReturnsOnCall[len(fake.updateSpaceArgsForCall)]
@@ -14976,6 +15383,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.createApplicationDeploymentMutex.RUnlock()
 	fake.createApplicationProcessScaleMutex.RLock()
 	defer fake.createApplicationProcessScaleMutex.RUnlock()
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
 	fake.createApplicationTaskMutex.RLock()
 	defer fake.createApplicationTaskMutex.RUnlock()
 	fake.createBuildMutex.RLock()
@@ -15048,6 +15457,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteServiceInstanceMutex.RUnlock()
 	fake.deleteServicePlanVisibilityMutex.RLock()
 	defer fake.deleteServicePlanVisibilityMutex.RUnlock()
+	fake.deleteSidecarMutex.RLock()
+	defer fake.deleteSidecarMutex.RUnlock()
 	fake.deleteSpaceMutex.RLock()
 	defer fake.deleteSpaceMutex.RUnlock()
 	fake.deleteSpaceQuotaMutex.RLock()
@@ -15078,6 +15489,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getApplicationRevisionsDeployedMutex.RUnlock()
 	fake.getApplicationRoutesMutex.RLock()
 	defer fake.getApplicationRoutesMutex.RUnlock()
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
 	fake.getApplicationTasksMutex.RLock()
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
@@ -15166,6 +15579,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getServiceCredentialBindingsMutex.RUnlock()
 	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
 	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
+	fake.getServiceInstanceCredentialsMutex.RLock()
+	defer fake.getServiceInstanceCredentialsMutex.RUnlock()
 	fake.getServiceInstanceParametersMutex.RLock()
 	defer fake.getServiceInstanceParametersMutex.RUnlock()
 	fake.getServiceInstanceSharedSpacesMutex.RLock()
@@ -15300,6 +15715,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateServiceInstanceMutex.RUnlock()
 	fake.updateServicePlanVisibilityMutex.RLock()
 	defer fake.updateServicePlanVisibilityMutex.RUnlock()
+	fake.updateSidecarMutex.RLock()
+	defer fake.updateSidecarMutex.RUnlock()
 	fake.updateSpaceMutex.RLock()
 	defer fake.updateSpaceMutex.RUnlock()
 	fake.updateSpaceApplyManifestMutex.RLock()
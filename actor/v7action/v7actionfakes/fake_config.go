@@ -62,6 +62,16 @@ type FakeConfig struct {
 	isCFOnK8sReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	OverallPollingTimeoutStub        func() time.Duration
+	overallPollingTimeoutMutex       sync.RWMutex
+	overallPollingTimeoutArgsForCall []struct {
+	}
+	overallPollingTimeoutReturns struct {
+		result1 time.Duration
+	}
+	overallPollingTimeoutReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
 	PollingIntervalStub        func() time.Duration
 	pollingIntervalMutex       sync.RWMutex
 	pollingIntervalArgsForCall []struct {
@@ -193,15 +203,16 @@ func (fake *FakeConfig) APIVersion() string {
 	ret, specificReturn := fake.aPIVersionReturnsOnCall[len(fake.aPIVersionArgsForCall)]
 	fake.aPIVersionArgsForCall = append(fake.aPIVersionArgsForCall, struct {
 	}{})
+	stub := fake.APIVersionStub
+	fakeReturns := fake.aPIVersionReturns
 	fake.recordInvocation("APIVersion", []interface{}{})
 	fake.aPIVersionMutex.Unlock()
-	if fake.APIVersionStub != nil {
-		return fake.APIVersionStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.aPIVersionReturns
 	return fakeReturns.result1
 }
 
@@ -245,15 +256,16 @@ func (fake *FakeConfig) AccessToken() string {
 	ret, specificReturn := fake.accessTokenReturnsOnCall[len(fake.accessTokenArgsForCall)]
 	fake.accessTokenArgsForCall = append(fake.accessTokenArgsForCall, struct {
 	}{})
+	stub := fake.AccessTokenStub
+	fakeReturns := fake.accessTokenReturns
 	fake.recordInvocation("AccessToken", []interface{}{})
 	fake.accessTokenMutex.Unlock()
-	if fake.AccessTokenStub != nil {
-		return fake.AccessTokenStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.accessTokenReturns
 	return fakeReturns.result1
 }
 
@@ -297,15 +309,16 @@ func (fake *FakeConfig) CurrentUser() (configv3.User, error) {
 	ret, specificReturn := fake.currentUserReturnsOnCall[len(fake.currentUserArgsForCall)]
 	fake.currentUserArgsForCall = append(fake.currentUserArgsForCall, struct {
 	}{})
+	stub := fake.CurrentUserStub
+	fakeReturns := fake.currentUserReturns
 	fake.recordInvocation("CurrentUser", []interface{}{})
 	fake.currentUserMutex.Unlock()
-	if fake.CurrentUserStub != nil {
-		return fake.CurrentUserStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.currentUserReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -352,15 +365,16 @@ func (fake *FakeConfig) DialTimeout() time.Duration {
 	ret, specificReturn := fake.dialTimeoutReturnsOnCall[len(fake.dialTimeoutArgsForCall)]
 	fake.dialTimeoutArgsForCall = append(fake.dialTimeoutArgsForCall, struct {
 	}{})
+	stub := fake.DialTimeoutStub
+	fakeReturns := fake.dialTimeoutReturns
 	fake.recordInvocation("DialTimeout", []interface{}{})
 	fake.dialTimeoutMutex.Unlock()
-	if fake.DialTimeoutStub != nil {
-		return fake.DialTimeoutStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.dialTimeoutReturns
 	return fakeReturns.result1
 }
 
@@ -404,15 +418,16 @@ func (fake *FakeConfig) IsCFOnK8s() bool {
 	ret, specificReturn := fake.isCFOnK8sReturnsOnCall[len(fake.isCFOnK8sArgsForCall)]
 	fake.isCFOnK8sArgsForCall = append(fake.isCFOnK8sArgsForCall, struct {
 	}{})
+	stub := fake.IsCFOnK8sStub
+	fakeReturns := fake.isCFOnK8sReturns
 	fake.recordInvocation("IsCFOnK8s", []interface{}{})
 	fake.isCFOnK8sMutex.Unlock()
-	if fake.IsCFOnK8sStub != nil {
-		return fake.IsCFOnK8sStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.isCFOnK8sReturns
 	return fakeReturns.result1
 }
 
@@ -451,20 +466,74 @@ func (fake *FakeConfig) IsCFOnK8sReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) OverallPollingTimeout() time.Duration {
+	fake.overallPollingTimeoutMutex.Lock()
+	ret, specificReturn := fake.overallPollingTimeoutReturnsOnCall[len(fake.overallPollingTimeoutArgsForCall)]
+	fake.overallPollingTimeoutArgsForCall = append(fake.overallPollingTimeoutArgsForCall, struct {
+	}{})
+	stub := fake.OverallPollingTimeoutStub
+	fakeReturns := fake.overallPollingTimeoutReturns
+	fake.recordInvocation("OverallPollingTimeout", []interface{}{})
+	fake.overallPollingTimeoutMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) OverallPollingTimeoutCallCount() int {
+	fake.overallPollingTimeoutMutex.RLock()
+	defer fake.overallPollingTimeoutMutex.RUnlock()
+	return len(fake.overallPollingTimeoutArgsForCall)
+}
+
+func (fake *FakeConfig) OverallPollingTimeoutCalls(stub func() time.Duration) {
+	fake.overallPollingTimeoutMutex.Lock()
+	defer fake.overallPollingTimeoutMutex.Unlock()
+	fake.OverallPollingTimeoutStub = stub
+}
+
+func (fake *FakeConfig) OverallPollingTimeoutReturns(result1 time.Duration) {
+	fake.overallPollingTimeoutMutex.Lock()
+	defer fake.overallPollingTimeoutMutex.Unlock()
+	fake.OverallPollingTimeoutStub = nil
+	fake.overallPollingTimeoutReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) OverallPollingTimeoutReturnsOnCall(i int, result1 time.Duration) {
+	fake.overallPollingTimeoutMutex.Lock()
+	defer fake.overallPollingTimeoutMutex.Unlock()
+	fake.OverallPollingTimeoutStub = nil
+	if fake.overallPollingTimeoutReturnsOnCall == nil {
+		fake.overallPollingTimeoutReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.overallPollingTimeoutReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
 func (fake *FakeConfig) PollingInterval() time.Duration {
 	fake.pollingIntervalMutex.Lock()
 	ret, specificReturn := fake.pollingIntervalReturnsOnCall[len(fake.pollingIntervalArgsForCall)]
 	fake.pollingIntervalArgsForCall = append(fake.pollingIntervalArgsForCall, struct {
 	}{})
+	stub := fake.PollingIntervalStub
+	fakeReturns := fake.pollingIntervalReturns
 	fake.recordInvocation("PollingInterval", []interface{}{})
 	fake.pollingIntervalMutex.Unlock()
-	if fake.PollingIntervalStub != nil {
-		return fake.PollingIntervalStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.pollingIntervalReturns
 	return fakeReturns.result1
 }
 
@@ -508,15 +577,16 @@ func (fake *FakeConfig) RefreshToken() string {
 	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
 	fake.refreshTokenArgsForCall = append(fake.refreshTokenArgsForCall, struct {
 	}{})
+	stub := fake.RefreshTokenStub
+	fakeReturns := fake.refreshTokenReturns
 	fake.recordInvocation("RefreshToken", []interface{}{})
 	fake.refreshTokenMutex.Unlock()
-	if fake.RefreshTokenStub != nil {
-		return fake.RefreshTokenStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.refreshTokenReturns
 	return fakeReturns.result1
 }
 
@@ -560,15 +630,16 @@ func (fake *FakeConfig) SSHOAuthClient() string {
 	ret, specificReturn := fake.sSHOAuthClientReturnsOnCall[len(fake.sSHOAuthClientArgsForCall)]
 	fake.sSHOAuthClientArgsForCall = append(fake.sSHOAuthClientArgsForCall, struct {
 	}{})
+	stub := fake.SSHOAuthClientStub
+	fakeReturns := fake.sSHOAuthClientReturns
 	fake.recordInvocation("SSHOAuthClient", []interface{}{})
 	fake.sSHOAuthClientMutex.Unlock()
-	if fake.SSHOAuthClientStub != nil {
-		return fake.SSHOAuthClientStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.sSHOAuthClientReturns
 	return fakeReturns.result1
 }
 
@@ -612,9 +683,10 @@ func (fake *FakeConfig) SetAccessToken(arg1 string) {
 	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.SetAccessTokenStub
 	fake.recordInvocation("SetAccessToken", []interface{}{arg1})
 	fake.setAccessTokenMutex.Unlock()
-	if fake.SetAccessTokenStub != nil {
+	if stub != nil {
 		fake.SetAccessTokenStub(arg1)
 	}
 }
@@ -643,9 +715,10 @@ func (fake *FakeConfig) SetKubernetesAuthInfo(arg1 string) {
 	fake.setKubernetesAuthInfoArgsForCall = append(fake.setKubernetesAuthInfoArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.SetKubernetesAuthInfoStub
 	fake.recordInvocation("SetKubernetesAuthInfo", []interface{}{arg1})
 	fake.setKubernetesAuthInfoMutex.Unlock()
-	if fake.SetKubernetesAuthInfoStub != nil {
+	if stub != nil {
 		fake.SetKubernetesAuthInfoStub(arg1)
 	}
 }
@@ -674,9 +747,10 @@ func (fake *FakeConfig) SetRefreshToken(arg1 string) {
 	fake.setRefreshTokenArgsForCall = append(fake.setRefreshTokenArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.SetRefreshTokenStub
 	fake.recordInvocation("SetRefreshToken", []interface{}{arg1})
 	fake.setRefreshTokenMutex.Unlock()
-	if fake.SetRefreshTokenStub != nil {
+	if stub != nil {
 		fake.SetRefreshTokenStub(arg1)
 	}
 }
@@ -705,9 +779,10 @@ func (fake *FakeConfig) SetTargetInformation(arg1 configv3.TargetInformationArgs
 	fake.setTargetInformationArgsForCall = append(fake.setTargetInformationArgsForCall, struct {
 		arg1 configv3.TargetInformationArgs
 	}{arg1})
+	stub := fake.SetTargetInformationStub
 	fake.recordInvocation("SetTargetInformation", []interface{}{arg1})
 	fake.setTargetInformationMutex.Unlock()
-	if fake.SetTargetInformationStub != nil {
+	if stub != nil {
 		fake.SetTargetInformationStub(arg1)
 	}
 }
@@ -738,9 +813,10 @@ func (fake *FakeConfig) SetTokenInformation(arg1 string, arg2 string, arg3 strin
 		arg2 string
 		arg3 string
 	}{arg1, arg2, arg3})
+	stub := fake.SetTokenInformationStub
 	fake.recordInvocation("SetTokenInformation", []interface{}{arg1, arg2, arg3})
 	fake.setTokenInformationMutex.Unlock()
-	if fake.SetTokenInformationStub != nil {
+	if stub != nil {
 		fake.SetTokenInformationStub(arg1, arg2, arg3)
 	}
 }
@@ -770,9 +846,10 @@ func (fake *FakeConfig) SetUAAClientCredentials(arg1 string, arg2 string) {
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.SetUAAClientCredentialsStub
 	fake.recordInvocation("SetUAAClientCredentials", []interface{}{arg1, arg2})
 	fake.setUAAClientCredentialsMutex.Unlock()
-	if fake.SetUAAClientCredentialsStub != nil {
+	if stub != nil {
 		fake.SetUAAClientCredentialsStub(arg1, arg2)
 	}
 }
@@ -801,9 +878,10 @@ func (fake *FakeConfig) SetUAAGrantType(arg1 string) {
 	fake.setUAAGrantTypeArgsForCall = append(fake.setUAAGrantTypeArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.SetUAAGrantTypeStub
 	fake.recordInvocation("SetUAAGrantType", []interface{}{arg1})
 	fake.setUAAGrantTypeMutex.Unlock()
-	if fake.SetUAAGrantTypeStub != nil {
+	if stub != nil {
 		fake.SetUAAGrantTypeStub(arg1)
 	}
 }
@@ -832,15 +910,16 @@ func (fake *FakeConfig) SkipSSLValidation() bool {
 	ret, specificReturn := fake.skipSSLValidationReturnsOnCall[len(fake.skipSSLValidationArgsForCall)]
 	fake.skipSSLValidationArgsForCall = append(fake.skipSSLValidationArgsForCall, struct {
 	}{})
+	stub := fake.SkipSSLValidationStub
+	fakeReturns := fake.skipSSLValidationReturns
 	fake.recordInvocation("SkipSSLValidation", []interface{}{})
 	fake.skipSSLValidationMutex.Unlock()
-	if fake.SkipSSLValidationStub != nil {
-		return fake.SkipSSLValidationStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.skipSSLValidationReturns
 	return fakeReturns.result1
 }
 
@@ -884,15 +963,16 @@ func (fake *FakeConfig) StagingTimeout() time.Duration {
 	ret, specificReturn := fake.stagingTimeoutReturnsOnCall[len(fake.stagingTimeoutArgsForCall)]
 	fake.stagingTimeoutArgsForCall = append(fake.stagingTimeoutArgsForCall, struct {
 	}{})
+	stub := fake.StagingTimeoutStub
+	fakeReturns := fake.stagingTimeoutReturns
 	fake.recordInvocation("StagingTimeout", []interface{}{})
 	fake.stagingTimeoutMutex.Unlock()
-	if fake.StagingTimeoutStub != nil {
-		return fake.StagingTimeoutStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.stagingTimeoutReturns
 	return fakeReturns.result1
 }
 
@@ -936,15 +1016,16 @@ func (fake *FakeConfig) StartupTimeout() time.Duration {
 	ret, specificReturn := fake.startupTimeoutReturnsOnCall[len(fake.startupTimeoutArgsForCall)]
 	fake.startupTimeoutArgsForCall = append(fake.startupTimeoutArgsForCall, struct {
 	}{})
+	stub := fake.StartupTimeoutStub
+	fakeReturns := fake.startupTimeoutReturns
 	fake.recordInvocation("StartupTimeout", []interface{}{})
 	fake.startupTimeoutMutex.Unlock()
-	if fake.StartupTimeoutStub != nil {
-		return fake.StartupTimeoutStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.startupTimeoutReturns
 	return fakeReturns.result1
 }
 
@@ -988,15 +1069,16 @@ func (fake *FakeConfig) Target() string {
 	ret, specificReturn := fake.targetReturnsOnCall[len(fake.targetArgsForCall)]
 	fake.targetArgsForCall = append(fake.targetArgsForCall, struct {
 	}{})
+	stub := fake.TargetStub
+	fakeReturns := fake.targetReturns
 	fake.recordInvocation("Target", []interface{}{})
 	fake.targetMutex.Unlock()
-	if fake.TargetStub != nil {
-		return fake.TargetStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.targetReturns
 	return fakeReturns.result1
 }
 
@@ -1040,15 +1122,16 @@ func (fake *FakeConfig) UAAGrantType() string {
 	ret, specificReturn := fake.uAAGrantTypeReturnsOnCall[len(fake.uAAGrantTypeArgsForCall)]
 	fake.uAAGrantTypeArgsForCall = append(fake.uAAGrantTypeArgsForCall, struct {
 	}{})
+	stub := fake.UAAGrantTypeStub
+	fakeReturns := fake.uAAGrantTypeReturns
 	fake.recordInvocation("UAAGrantType", []interface{}{})
 	fake.uAAGrantTypeMutex.Unlock()
-	if fake.UAAGrantTypeStub != nil {
-		return fake.UAAGrantTypeStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.uAAGrantTypeReturns
 	return fakeReturns.result1
 }
 
@@ -1091,9 +1174,10 @@ func (fake *FakeConfig) UnsetOrganizationAndSpaceInformation() {
 	fake.unsetOrganizationAndSpaceInformationMutex.Lock()
 	fake.unsetOrganizationAndSpaceInformationArgsForCall = append(fake.unsetOrganizationAndSpaceInformationArgsForCall, struct {
 	}{})
+	stub := fake.UnsetOrganizationAndSpaceInformationStub
 	fake.recordInvocation("UnsetOrganizationAndSpaceInformation", []interface{}{})
 	fake.unsetOrganizationAndSpaceInformationMutex.Unlock()
-	if fake.UnsetOrganizationAndSpaceInformationStub != nil {
+	if stub != nil {
 		fake.UnsetOrganizationAndSpaceInformationStub()
 	}
 }
@@ -1123,6 +1207,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.dialTimeoutMutex.RUnlock()
 	fake.isCFOnK8sMutex.RLock()
 	defer fake.isCFOnK8sMutex.RUnlock()
+	fake.overallPollingTimeoutMutex.RLock()
+	defer fake.overallPollingTimeoutMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
 	defer fake.pollingIntervalMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
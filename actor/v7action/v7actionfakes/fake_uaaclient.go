@@ -27,6 +27,22 @@ type FakeUAAClient struct {
 		result2 string
 		result3 error
 	}
+	AuthorizationCodeURLStub        func(string, string, string, string) (string, error)
+	authorizationCodeURLMutex       sync.RWMutex
+	authorizationCodeURLArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	authorizationCodeURLReturns struct {
+		result1 string
+		result2 error
+	}
+	authorizationCodeURLReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	CreateUserStub        func(string, string, string) (uaa.User, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -120,6 +136,18 @@ type FakeUAAClient struct {
 		result1 uaa.RefreshedTokens
 		result2 error
 	}
+	RequestDeviceAuthorizationStub        func() (uaa.DeviceAuthorization, error)
+	requestDeviceAuthorizationMutex       sync.RWMutex
+	requestDeviceAuthorizationArgsForCall []struct {
+	}
+	requestDeviceAuthorizationReturns struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}
+	requestDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}
 	RevokeStub        func(string) error
 	revokeMutex       sync.RWMutex
 	revokeArgsForCall []struct {
@@ -167,15 +195,16 @@ func (fake *FakeUAAClient) Authenticate(arg1 map[string]string, arg2 string, arg
 		arg2 string
 		arg3 constant.GrantType
 	}{arg1, arg2, arg3})
+	stub := fake.AuthenticateStub
+	fakeReturns := fake.authenticateReturns
 	fake.recordInvocation("Authenticate", []interface{}{arg1, arg2, arg3})
 	fake.authenticateMutex.Unlock()
-	if fake.AuthenticateStub != nil {
-		return fake.AuthenticateStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.authenticateReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -227,6 +256,73 @@ func (fake *FakeUAAClient) AuthenticateReturnsOnCall(i int, result1 string, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeUAAClient) AuthorizationCodeURL(arg1 string, arg2 string, arg3 string, arg4 string) (string, error) {
+	fake.authorizationCodeURLMutex.Lock()
+	ret, specificReturn := fake.authorizationCodeURLReturnsOnCall[len(fake.authorizationCodeURLArgsForCall)]
+	fake.authorizationCodeURLArgsForCall = append(fake.authorizationCodeURLArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AuthorizationCodeURLStub
+	fakeReturns := fake.authorizationCodeURLReturns
+	fake.recordInvocation("AuthorizationCodeURL", []interface{}{arg1, arg2, arg3, arg4})
+	fake.authorizationCodeURLMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLCallCount() int {
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
+	return len(fake.authorizationCodeURLArgsForCall)
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLCalls(stub func(string, string, string, string) (string, error)) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = stub
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLArgsForCall(i int) (string, string, string, string) {
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
+	argsForCall := fake.authorizationCodeURLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLReturns(result1 string, result2 error) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = nil
+	fake.authorizationCodeURLReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLReturnsOnCall(i int, result1 string, result2 error) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = nil
+	if fake.authorizationCodeURLReturnsOnCall == nil {
+		fake.authorizationCodeURLReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.authorizationCodeURLReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) CreateUser(arg1 string, arg2 string, arg3 string) (uaa.User, error) {
 	fake.createUserMutex.Lock()
 	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
@@ -235,15 +331,16 @@ func (fake *FakeUAAClient) CreateUser(arg1 string, arg2 string, arg3 string) (ua
 		arg2 string
 		arg3 string
 	}{arg1, arg2, arg3})
+	stub := fake.CreateUserStub
+	fakeReturns := fake.createUserReturns
 	fake.recordInvocation("CreateUser", []interface{}{arg1, arg2, arg3})
 	fake.createUserMutex.Unlock()
-	if fake.CreateUserStub != nil {
-		return fake.CreateUserStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.createUserReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -298,15 +395,16 @@ func (fake *FakeUAAClient) DeleteUser(arg1 string) (uaa.User, error) {
 	fake.deleteUserArgsForCall = append(fake.deleteUserArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.DeleteUserStub
+	fakeReturns := fake.deleteUserReturns
 	fake.recordInvocation("DeleteUser", []interface{}{arg1})
 	fake.deleteUserMutex.Unlock()
-	if fake.DeleteUserStub != nil {
-		return fake.DeleteUserStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteUserReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -360,15 +458,16 @@ func (fake *FakeUAAClient) GetAPIVersion() (string, error) {
 	ret, specificReturn := fake.getAPIVersionReturnsOnCall[len(fake.getAPIVersionArgsForCall)]
 	fake.getAPIVersionArgsForCall = append(fake.getAPIVersionArgsForCall, struct {
 	}{})
+	stub := fake.GetAPIVersionStub
+	fakeReturns := fake.getAPIVersionReturns
 	fake.recordInvocation("GetAPIVersion", []interface{}{})
 	fake.getAPIVersionMutex.Unlock()
-	if fake.GetAPIVersionStub != nil {
-		return fake.GetAPIVersionStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getAPIVersionReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -415,15 +514,16 @@ func (fake *FakeUAAClient) GetLoginPrompts() (map[string][]string, error) {
 	ret, specificReturn := fake.getLoginPromptsReturnsOnCall[len(fake.getLoginPromptsArgsForCall)]
 	fake.getLoginPromptsArgsForCall = append(fake.getLoginPromptsArgsForCall, struct {
 	}{})
+	stub := fake.GetLoginPromptsStub
+	fakeReturns := fake.getLoginPromptsReturns
 	fake.recordInvocation("GetLoginPrompts", []interface{}{})
 	fake.getLoginPromptsMutex.Unlock()
-	if fake.GetLoginPromptsStub != nil {
-		return fake.GetLoginPromptsStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getLoginPromptsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -472,15 +572,16 @@ func (fake *FakeUAAClient) GetSSHPasscode(arg1 string, arg2 string) (string, err
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.GetSSHPasscodeStub
+	fakeReturns := fake.getSSHPasscodeReturns
 	fake.recordInvocation("GetSSHPasscode", []interface{}{arg1, arg2})
 	fake.getSSHPasscodeMutex.Unlock()
-	if fake.GetSSHPasscodeStub != nil {
-		return fake.GetSSHPasscodeStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getSSHPasscodeReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -536,15 +637,16 @@ func (fake *FakeUAAClient) ListUsers(arg1 string, arg2 string) ([]uaa.User, erro
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.ListUsersStub
+	fakeReturns := fake.listUsersReturns
 	fake.recordInvocation("ListUsers", []interface{}{arg1, arg2})
 	fake.listUsersMutex.Unlock()
-	if fake.ListUsersStub != nil {
-		return fake.ListUsersStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.listUsersReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -599,15 +701,16 @@ func (fake *FakeUAAClient) RefreshAccessToken(arg1 string) (uaa.RefreshedTokens,
 	fake.refreshAccessTokenArgsForCall = append(fake.refreshAccessTokenArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.RefreshAccessTokenStub
+	fakeReturns := fake.refreshAccessTokenReturns
 	fake.recordInvocation("RefreshAccessToken", []interface{}{arg1})
 	fake.refreshAccessTokenMutex.Unlock()
-	if fake.RefreshAccessTokenStub != nil {
-		return fake.RefreshAccessTokenStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.refreshAccessTokenReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -656,21 +759,78 @@ func (fake *FakeUAAClient) RefreshAccessTokenReturnsOnCall(i int, result1 uaa.Re
 	}{result1, result2}
 }
 
+func (fake *FakeUAAClient) RequestDeviceAuthorization() (uaa.DeviceAuthorization, error) {
+	fake.requestDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.requestDeviceAuthorizationReturnsOnCall[len(fake.requestDeviceAuthorizationArgsForCall)]
+	fake.requestDeviceAuthorizationArgsForCall = append(fake.requestDeviceAuthorizationArgsForCall, struct {
+	}{})
+	stub := fake.RequestDeviceAuthorizationStub
+	fakeReturns := fake.requestDeviceAuthorizationReturns
+	fake.recordInvocation("RequestDeviceAuthorization", []interface{}{})
+	fake.requestDeviceAuthorizationMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUAAClient) RequestDeviceAuthorizationCallCount() int {
+	fake.requestDeviceAuthorizationMutex.RLock()
+	defer fake.requestDeviceAuthorizationMutex.RUnlock()
+	return len(fake.requestDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeUAAClient) RequestDeviceAuthorizationCalls(stub func() (uaa.DeviceAuthorization, error)) {
+	fake.requestDeviceAuthorizationMutex.Lock()
+	defer fake.requestDeviceAuthorizationMutex.Unlock()
+	fake.RequestDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeUAAClient) RequestDeviceAuthorizationReturns(result1 uaa.DeviceAuthorization, result2 error) {
+	fake.requestDeviceAuthorizationMutex.Lock()
+	defer fake.requestDeviceAuthorizationMutex.Unlock()
+	fake.RequestDeviceAuthorizationStub = nil
+	fake.requestDeviceAuthorizationReturns = struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) RequestDeviceAuthorizationReturnsOnCall(i int, result1 uaa.DeviceAuthorization, result2 error) {
+	fake.requestDeviceAuthorizationMutex.Lock()
+	defer fake.requestDeviceAuthorizationMutex.Unlock()
+	fake.RequestDeviceAuthorizationStub = nil
+	if fake.requestDeviceAuthorizationReturnsOnCall == nil {
+		fake.requestDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 uaa.DeviceAuthorization
+			result2 error
+		})
+	}
+	fake.requestDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) Revoke(arg1 string) error {
 	fake.revokeMutex.Lock()
 	ret, specificReturn := fake.revokeReturnsOnCall[len(fake.revokeArgsForCall)]
 	fake.revokeArgsForCall = append(fake.revokeArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.RevokeStub
+	fakeReturns := fake.revokeReturns
 	fake.recordInvocation("Revoke", []interface{}{arg1})
 	fake.revokeMutex.Unlock()
-	if fake.RevokeStub != nil {
-		return fake.RevokeStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.revokeReturns
 	return fakeReturns.result1
 }
 
@@ -724,15 +884,16 @@ func (fake *FakeUAAClient) UpdatePassword(arg1 string, arg2 string, arg3 string)
 		arg2 string
 		arg3 string
 	}{arg1, arg2, arg3})
+	stub := fake.UpdatePasswordStub
+	fakeReturns := fake.updatePasswordReturns
 	fake.recordInvocation("UpdatePassword", []interface{}{arg1, arg2, arg3})
 	fake.updatePasswordMutex.Unlock()
-	if fake.UpdatePasswordStub != nil {
-		return fake.UpdatePasswordStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.updatePasswordReturns
 	return fakeReturns.result1
 }
 
@@ -784,15 +945,16 @@ func (fake *FakeUAAClient) ValidateClientUser(arg1 string) error {
 	fake.validateClientUserArgsForCall = append(fake.validateClientUserArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.ValidateClientUserStub
+	fakeReturns := fake.validateClientUserReturns
 	fake.recordInvocation("ValidateClientUser", []interface{}{arg1})
 	fake.validateClientUserMutex.Unlock()
-	if fake.ValidateClientUserStub != nil {
-		return fake.ValidateClientUserStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.validateClientUserReturns
 	return fakeReturns.result1
 }
 
@@ -843,6 +1005,8 @@ func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
 	fake.deleteUserMutex.RLock()
@@ -857,6 +1021,8 @@ func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	defer fake.listUsersMutex.RUnlock()
 	fake.refreshAccessTokenMutex.RLock()
 	defer fake.refreshAccessTokenMutex.RUnlock()
+	fake.requestDeviceAuthorizationMutex.RLock()
+	defer fake.requestDeviceAuthorizationMutex.RUnlock()
 	fake.revokeMutex.RLock()
 	defer fake.revokeMutex.RUnlock()
 	fake.updatePasswordMutex.RLock()
@@ -4,6 +4,8 @@ package v7pushaction
 
 import (
 	"regexp"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 )
 
 // Warnings is a list of warnings returned back from the cloud controller
@@ -14,6 +16,14 @@ type Actor struct {
 	SharedActor SharedActor
 	V7Actor     V7Actor
 
+	// LogCacheClient is used to read the HTTP error rate of canaries that are
+	// promoted automatically.
+	LogCacheClient sharedaction.LogCacheClient
+
+	// ResourceMatchCache, when set, predicts resource matches so that they
+	// are not sent to the API again.
+	ResourceMatchCache ResourceMatchCache
+
 	PreparePushPlanSequence   []UpdatePushPlanFunc
 	ChangeApplicationSequence func(plan PushPlan) []ChangeApplicationFunc
 	TransformManifestSequence []HandleFlagOverrideFunc
@@ -63,8 +73,10 @@ func NewActor(v3Actor V7Actor, sharedActor SharedActor) *Actor {
 		HandleStackOverride,
 		HandleBuildpacksOverride,
 		HandleStrategyOverride,
+		HandleInstanceStepsOverride,
 		HandleAppPathOverride,
 		HandleDropletPathOverride,
+		HandleWatchOverride,
 	}
 
 	actor.PreparePushPlanSequence = []UpdatePushPlanFunc{
@@ -13,13 +13,45 @@ import (
 
 const PushRetries = 3
 
+// predictedMatchesError is returned when the API fails to upload or process
+// the bits of a package after the cache predicted some of the matched
+// resources, since the API may no longer have them. Package is the package to
+// upload the bits to again, unless it failed to process.
+type predictedMatchesError struct {
+	Err     error
+	Package resources.Package
+}
+
+func (e predictedMatchesError) Error() string {
+	return e.Err.Error()
+}
+
 func (actor Actor) CreateBitsPackageForApplication(pushPlan PushPlan, eventStream chan<- *PushEvent, progressBar ProgressBar) (PushPlan, Warnings, error) {
-	pkg, warnings, err := actor.CreateAndUploadApplicationBits(pushPlan, eventStream, progressBar)
+	pushPlan, warnings, err := actor.createBitsPackageForApplication(pushPlan, eventStream, progressBar, actor.ResourceMatchCache, resources.Package{})
+	if predictedErr, ok := err.(predictedMatchesError); ok {
+		// Try again with every resource matched by the API.
+		log.WithField("error", predictedErr.Err).Warn("package with predicted resource matches failed, retrying without predictions")
+		actor.ResourceMatchCache.ForgetMatches()
+
+		var retryWarnings Warnings
+		pushPlan, retryWarnings, err = actor.createBitsPackageForApplication(pushPlan, eventStream, progressBar, nil, predictedErr.Package)
+		warnings = append(warnings, retryWarnings...)
+	}
+
+	return pushPlan, warnings, err
+}
+
+func (actor Actor) createBitsPackageForApplication(pushPlan PushPlan, eventStream chan<- *PushEvent, progressBar ProgressBar, cache ResourceMatchCache, pkg resources.Package) (PushPlan, Warnings, error) {
+	pkg, warnings, predicted, err := actor.createAndUploadApplicationBits(pushPlan, eventStream, progressBar, cache, pkg)
 	if err != nil {
 		return pushPlan, warnings, err
 	}
 
 	polledPackage, pollWarnings, err := actor.V7Actor.PollPackage(pkg)
+	if err != nil && predicted > 0 {
+		// A package that failed to process cannot be uploaded to again.
+		err = predictedMatchesError{Err: err}
+	}
 
 	pushPlan.PackageGUID = polledPackage.GUID
 
@@ -27,12 +59,25 @@ func (actor Actor) CreateBitsPackageForApplication(pushPlan PushPlan, eventStrea
 }
 
 func (actor Actor) CreateAndUploadApplicationBits(pushPlan PushPlan, eventStream chan<- *PushEvent, progressBar ProgressBar) (resources.Package, Warnings, error) {
+	pkg, warnings, _, err := actor.createAndUploadApplicationBits(pushPlan, eventStream, progressBar, actor.ResourceMatchCache, resources.Package{})
+	if predictedErr, ok := err.(predictedMatchesError); ok {
+		err = predictedErr.Err
+	}
+	return pkg, warnings, err
+}
+
+// createAndUploadApplicationBits uploads the bits of the push plan to pkg, or
+// to a new package when pkg has no GUID, and returns how many of the matched
+// resources the cache predicted.
+func (actor Actor) createAndUploadApplicationBits(pushPlan PushPlan, eventStream chan<- *PushEvent, progressBar ProgressBar, cache ResourceMatchCache, pkg resources.Package) (resources.Package, Warnings, int, error) {
 	log.WithField("Path", pushPlan.BitsPath).Info("creating archive")
 
 	var (
 		allWarnings        Warnings
 		matchedResources   []sharedaction.V3Resource
 		unmatchedResources []sharedaction.V3Resource
+		predicted          int
+		err                error
 	)
 
 	// check if all source files are empty
@@ -46,31 +91,33 @@ func (actor Actor) CreateAndUploadApplicationBits(pushPlan PushPlan, eventStream
 	if shouldResourceMatch {
 		eventStream <- &PushEvent{Plan: pushPlan, Event: ResourceMatching}
 		var warnings Warnings
-		var err error
-
-		matchedResources, unmatchedResources, warnings, err = actor.MatchResources(pushPlan.AllResources)
+		matchedResources, unmatchedResources, predicted, warnings, err = actor.matchResources(pushPlan.AllResources, cache)
 		allWarnings = append(allWarnings, warnings...)
 		if err != nil {
-			return resources.Package{}, allWarnings, err
+			return resources.Package{}, allWarnings, predicted, err
 		}
 	} else {
 		matchedResources = []sharedaction.V3Resource{}
 		unmatchedResources = pushPlan.AllResources
 	}
 
-	eventStream <- &PushEvent{Plan: pushPlan, Event: CreatingPackage}
-	log.WithField("GUID", pushPlan.Application.GUID).Info("creating package")
-	pkg, createPackageWarnings, err := actor.V7Actor.CreateBitsPackageByApplication(pushPlan.Application.GUID)
-	allWarnings = append(allWarnings, createPackageWarnings...)
-	if err != nil {
-		return resources.Package{}, allWarnings, err
+	if pkg.GUID == "" {
+		eventStream <- &PushEvent{Plan: pushPlan, Event: CreatingPackage}
+		log.WithField("GUID", pushPlan.Application.GUID).Info("creating package")
+		var createPackageWarnings v7action.Warnings
+		pkg, createPackageWarnings, err = actor.V7Actor.CreateBitsPackageByApplication(pushPlan.Application.GUID)
+		allWarnings = append(allWarnings, createPackageWarnings...)
+		if err != nil {
+			return resources.Package{}, allWarnings, predicted, err
+		}
 	}
+	packageToUpload := pkg
 
 	if len(unmatchedResources) > 0 {
 		eventStream <- &PushEvent{Plan: pushPlan, Event: CreatingArchive}
 		archivePath, archiveErr := actor.CreateAndReturnArchivePath(pushPlan, unmatchedResources)
 		if archiveErr != nil {
-			return resources.Package{}, allWarnings, archiveErr
+			return resources.Package{}, allWarnings, predicted, archiveErr
 		}
 		defer os.RemoveAll(archivePath)
 
@@ -80,7 +127,7 @@ func (actor Actor) CreateAndUploadApplicationBits(pushPlan PushPlan, eventStream
 			log.WithField("GUID", pushPlan.Application.GUID).Info("reading archive")
 			file, size, readErr := actor.SharedActor.ReadArchive(archivePath)
 			if readErr != nil {
-				return resources.Package{}, allWarnings, readErr
+				return resources.Package{}, allWarnings, predicted, readErr
 			}
 			defer file.Close()
 
@@ -99,9 +146,9 @@ func (actor Actor) CreateAndUploadApplicationBits(pushPlan PushPlan, eventStream
 
 		if err != nil {
 			if e, ok := err.(ccerror.PipeSeekError); ok {
-				return resources.Package{}, allWarnings, actionerror.UploadFailedError{Err: e.Err}
+				err = actionerror.UploadFailedError{Err: e.Err}
 			}
-			return resources.Package{}, allWarnings, err
+			return resources.Package{}, allWarnings, predicted, uploadError(err, predicted, packageToUpload)
 		}
 
 		eventStream <- &PushEvent{Plan: pushPlan, Event: UploadWithArchiveComplete}
@@ -111,11 +158,20 @@ func (actor Actor) CreateAndUploadApplicationBits(pushPlan PushPlan, eventStream
 		pkg, uploadWarnings, err = actor.V7Actor.UploadBitsPackage(pkg, matchedResources, nil, 0)
 		allWarnings = append(allWarnings, uploadWarnings...)
 		if err != nil {
-			return resources.Package{}, allWarnings, err
+			return resources.Package{}, allWarnings, predicted, uploadError(err, predicted, packageToUpload)
 		}
 	}
 
-	return pkg, allWarnings, nil
+	return pkg, allWarnings, predicted, nil
+}
+
+// uploadError returns the error of uploading bits to pkg, marked to be tried
+// again with pkg when the cache predicted some of the matched resources.
+func uploadError(err error, predicted int, pkg resources.Package) error {
+	if predicted > 0 {
+		return predictedMatchesError{Err: err, Package: pkg}
+	}
+	return err
 }
 
 func (actor Actor) CreateAndReturnArchivePath(pushPlan PushPlan, unmatchedResources []sharedaction.V3Resource) (string, error) {
@@ -425,5 +425,93 @@ var _ = Describe("CreateBitsPackageForApplication", func() {
 				Expect(executeErr).To(MatchError(someErr))
 			})
 		})
+
+		When("the resource match cache predicted matches", func() {
+			var fakeCache *v7pushactionfakes.FakeResourceMatchCache
+
+			BeforeEach(func() {
+				fakeCache = new(v7pushactionfakes.FakeResourceMatchCache)
+				fakeCache.RecentlyMatchedStub = func(checksum string) bool {
+					return checksum == "checksum-some-matching-filename"
+				}
+				actor.ResourceMatchCache = fakeCache
+
+				fakeV7Actor.ResourceMatchReturns(nil, nil, nil)
+				fakeV7Actor.PollPackageReturns(resources.Package{GUID: "some-package-guid"}, nil, nil)
+			})
+
+			It("only matches the other resources with the API", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeV7Actor.ResourceMatchCallCount()).To(Equal(1))
+				Expect(fakeV7Actor.ResourceMatchArgsForCall(0)).To(Equal(unmatches))
+
+				_, matchedResources, _, _ := fakeV7Actor.UploadBitsPackageArgsForCall(0)
+				Expect(matchedResources).To(Equal(matches))
+			})
+
+			When("the package fails", func() {
+				BeforeEach(func() {
+					fakeV7Actor.PollPackageReturnsOnCall(0, resources.Package{}, v7action.Warnings{"failed-poll-warning"}, errors.New("package failed"))
+					fakeV7Actor.PollPackageReturnsOnCall(1, resources.Package{GUID: "some-package-guid"}, v7action.Warnings{"poll-warning"}, nil)
+				})
+
+				It("forgets the predictions and creates the package again with every resource matched by the API", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(returnedPushPlan.PackageGUID).To(Equal("some-package-guid"))
+					Expect(warnings).To(ConsistOf("failed-poll-warning", "poll-warning"))
+
+					Expect(fakeCache.ForgetMatchesCallCount()).To(Equal(1))
+					Expect(fakeV7Actor.ResourceMatchCallCount()).To(Equal(2))
+					Expect(fakeV7Actor.ResourceMatchArgsForCall(1)).To(Equal(paramPlan.AllResources))
+					Expect(fakeV7Actor.CreateBitsPackageByApplicationCallCount()).To(Equal(2))
+				})
+			})
+
+			When("the upload fails", func() {
+				BeforeEach(func() {
+					fakeV7Actor.CreateBitsPackageByApplicationReturns(resources.Package{GUID: "some-package-guid"}, nil, nil)
+					fakeV7Actor.UploadBitsPackageReturnsOnCall(0, resources.Package{}, v7action.Warnings{"failed-upload-warning"}, errors.New("upload failed"))
+					fakeV7Actor.UploadBitsPackageReturnsOnCall(1, resources.Package{GUID: "some-package-guid"}, v7action.Warnings{"upload-warning"}, nil)
+				})
+
+				It("forgets the predictions and uploads to the same package with every resource matched by the API", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(returnedPushPlan.PackageGUID).To(Equal("some-package-guid"))
+					Expect(warnings).To(ConsistOf("failed-upload-warning", "upload-warning"))
+
+					Expect(fakeCache.ForgetMatchesCallCount()).To(Equal(1))
+					Expect(fakeV7Actor.ResourceMatchCallCount()).To(Equal(2))
+					Expect(fakeV7Actor.ResourceMatchArgsForCall(1)).To(Equal(paramPlan.AllResources))
+					Expect(fakeV7Actor.CreateBitsPackageByApplicationCallCount()).To(Equal(1))
+					Expect(fakeV7Actor.UploadBitsPackageCallCount()).To(Equal(2))
+					pkg, _, _, _ := fakeV7Actor.UploadBitsPackageArgsForCall(1)
+					Expect(pkg.GUID).To(Equal("some-package-guid"))
+				})
+			})
+
+			When("something other than the upload or the package fails", func() {
+				BeforeEach(func() {
+					fakeSharedActor.ReadArchiveReturns(nil, 0, errors.New("read-archive-error"))
+				})
+
+				It("returns the error without forgetting the predictions", func() {
+					Expect(executeErr).To(MatchError("read-archive-error"))
+					Expect(fakeCache.ForgetMatchesCallCount()).To(Equal(0))
+					Expect(fakeV7Actor.CreateBitsPackageByApplicationCallCount()).To(Equal(1))
+				})
+			})
+
+			When("the package fails after a retry as well", func() {
+				BeforeEach(func() {
+					fakeV7Actor.PollPackageReturns(resources.Package{}, nil, errors.New("package failed"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("package failed"))
+					Expect(fakeCache.ForgetMatchesCallCount()).To(Equal(1))
+					Expect(fakeV7Actor.PollPackageCallCount()).To(Equal(2))
+				})
+			})
+		})
 	})
 })
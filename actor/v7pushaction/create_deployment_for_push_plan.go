@@ -1,6 +1,8 @@
 package v7pushaction
 
 import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
 )
@@ -18,6 +20,14 @@ func (actor Actor) CreateDeploymentForApplication(pushPlan PushPlan, eventStream
 		dep.Options = resources.DeploymentOpts{MaxInFlight: pushPlan.MaxInFlight}
 	}
 
+	if len(pushPlan.InstanceSteps) > 0 {
+		var steps []resources.CanaryStep
+		for _, weight := range pushPlan.InstanceSteps {
+			steps = append(steps, resources.CanaryStep{InstanceWeight: weight})
+		}
+		dep.Options.CanaryDeploymentOptions = &resources.CanaryDeploymentOptions{Steps: steps}
+	}
+
 	deploymentGUID, warnings, err := actor.V7Actor.CreateDeployment(dep)
 
 	if err != nil {
@@ -36,6 +46,67 @@ func (actor Actor) CreateDeploymentForApplication(pushPlan PushPlan, eventStream
 
 	pollWarnings, err := actor.V7Actor.PollStartForDeployment(pushPlan.Application, deploymentGUID, pushPlan.NoWait, handleInstanceDetails)
 	warnings = append(warnings, pollWarnings...)
+	if err != nil || pushPlan.AutoPromoteAfter == 0 || pushPlan.NoWait {
+		return pushPlan, Warnings(warnings), err
+	}
+
+	promoteWarnings, err := actor.autoPromoteCanary(pushPlan, deploymentGUID, eventStream, handleInstanceDetails)
+	warnings = append(warnings, promoteWarnings...)
 
 	return pushPlan, Warnings(warnings), err
 }
+
+// autoPromoteCanary evaluates the canary each time the deployment pauses,
+// continuing the deployment while the canary is healthy and canceling it as
+// soon as it is not.
+func (actor Actor) autoPromoteCanary(pushPlan PushPlan, deploymentGUID string, eventStream chan<- *PushEvent, handleInstanceDetails func(string)) (v7action.Warnings, error) {
+	var allWarnings v7action.Warnings
+
+	criteria := v7action.CanaryHealthCriteria{
+		ObservationPeriod: pushPlan.AutoPromoteAfter,
+		MaxCrashes:        pushPlan.AutoPromoteMaxCrashes,
+		MaxErrorRate:      pushPlan.AutoPromoteMaxErrorRate,
+	}
+
+	for {
+		deployment, warnings, err := actor.V7Actor.GetDeployment(deploymentGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		if deployment.StatusValue != constant.DeploymentStatusValueActive || deployment.StatusReason != constant.DeploymentStatusReasonPaused {
+			return allWarnings, nil
+		}
+
+		eventStream <- &PushEvent{Plan: pushPlan, Event: EvaluatingCanary}
+		report, warnings, err := actor.V7Actor.EvaluateCanaryHealth(pushPlan.Application, deployment, criteria, actor.LogCacheClient)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		if !report.Healthy {
+			eventStream <- &PushEvent{Plan: pushPlan, Event: CancelingCanary}
+			warnings, err = actor.V7Actor.CancelDeployment(deploymentGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return allWarnings, err
+			}
+			return allWarnings, actionerror.CanaryUnhealthyError{Reason: report.Reason}
+		}
+
+		eventStream <- &PushEvent{Plan: pushPlan, Event: PromotingCanary}
+		warnings, err = actor.V7Actor.ContinueDeployment(deploymentGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		warnings, err = actor.V7Actor.PollStartForDeployment(pushPlan.Application, deploymentGUID, false, handleInstanceDetails)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+}
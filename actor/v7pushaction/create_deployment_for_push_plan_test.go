@@ -2,7 +2,10 @@ package v7pushaction_test
 
 import (
 	"errors"
+	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	. "code.cloudfoundry.org/cli/actor/v7pushaction"
 	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
@@ -152,6 +155,30 @@ var _ = Describe("CreateDeploymentForApplication()", func() {
 				Expect(events).To(ConsistOf(StartingDeployment, InstanceDetails, WaitingForDeployment))
 			})
 		})
+
+		When("a canary strategy with instance steps is provided", func() {
+			BeforeEach(func() {
+				fakeV7Actor.CreateDeploymentReturns("some-deployment-guid", nil, nil)
+				paramPlan.Strategy = constant.DeploymentStrategyCanary
+				paramPlan.InstanceSteps = []int64{10, 50, 100}
+			})
+
+			It("creates the deployment with the canary steps", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeV7Actor.CreateDeploymentCallCount()).To(Equal(1))
+				Expect(fakeV7Actor.CreateDeploymentArgsForCall(0)).To(Equal(resources.Deployment{
+					Strategy: constant.DeploymentStrategyCanary,
+					Options: resources.DeploymentOpts{
+						CanaryDeploymentOptions: &resources.CanaryDeploymentOptions{
+							Steps: []resources.CanaryStep{{InstanceWeight: 10}, {InstanceWeight: 50}, {InstanceWeight: 100}},
+						},
+					},
+					Relationships: resources.Relationships{
+						constant.RelationshipTypeApplication: resources.Relationship{GUID: "some-app-guid"},
+					},
+				}))
+			})
+		})
 	})
 
 	Describe("waiting for app to start", func() {
@@ -199,4 +226,138 @@ var _ = Describe("CreateDeploymentForApplication()", func() {
 			})
 		})
 	})
+
+	Describe("automatically promoting the canary", func() {
+		var pausedDeployment resources.Deployment
+
+		BeforeEach(func() {
+			maxErrorRate := 5.0
+			paramPlan.Strategy = constant.DeploymentStrategyCanary
+			paramPlan.AutoPromoteAfter = 5 * time.Minute
+			paramPlan.AutoPromoteMaxCrashes = 1
+			paramPlan.AutoPromoteMaxErrorRate = &maxErrorRate
+
+			actor.LogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+
+			pausedDeployment = resources.Deployment{
+				GUID:         "some-deployment-guid",
+				StatusValue:  constant.DeploymentStatusValueActive,
+				StatusReason: constant.DeploymentStatusReasonPaused,
+			}
+
+			fakeV7Actor.CreateDeploymentReturns("some-deployment-guid", v7action.Warnings{"create-warning"}, nil)
+			fakeV7Actor.PollStartForDeploymentReturns(v7action.Warnings{"poll-warning"}, nil)
+			fakeV7Actor.GetDeploymentReturnsOnCall(0, pausedDeployment, v7action.Warnings{"get-deployment-warning"}, nil)
+			fakeV7Actor.GetDeploymentReturnsOnCall(1, resources.Deployment{
+				GUID:         "some-deployment-guid",
+				StatusValue:  constant.DeploymentStatusValueFinalized,
+				StatusReason: constant.DeploymentStatusReasonDeployed,
+			}, nil, nil)
+		})
+
+		When("the canary is healthy", func() {
+			BeforeEach(func() {
+				fakeV7Actor.EvaluateCanaryHealthReturns(v7action.CanaryHealthReport{Healthy: true}, v7action.Warnings{"evaluate-warning"}, nil)
+				fakeV7Actor.ContinueDeploymentReturns(v7action.Warnings{"continue-warning"}, nil)
+			})
+
+			It("evaluates the canary with the push plan thresholds", func() {
+				Expect(fakeV7Actor.EvaluateCanaryHealthCallCount()).To(Equal(1))
+				app, deployment, criteria, client := fakeV7Actor.EvaluateCanaryHealthArgsForCall(0)
+				Expect(app).To(Equal(paramPlan.Application))
+				Expect(deployment).To(Equal(pausedDeployment))
+				Expect(criteria.ObservationPeriod).To(Equal(5 * time.Minute))
+				Expect(criteria.MaxCrashes).To(Equal(1))
+				Expect(*criteria.MaxErrorRate).To(Equal(5.0))
+				Expect(client).To(Equal(actor.LogCacheClient))
+			})
+
+			It("continues the deployment and waits for it to finish", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeV7Actor.ContinueDeploymentCallCount()).To(Equal(1))
+				Expect(fakeV7Actor.ContinueDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+				Expect(fakeV7Actor.PollStartForDeploymentCallCount()).To(Equal(2))
+				Expect(fakeV7Actor.CancelDeploymentCallCount()).To(Equal(0))
+
+				Expect(warnings).To(ConsistOf("create-warning", "poll-warning", "get-deployment-warning", "evaluate-warning", "continue-warning", "poll-warning"))
+				Expect(events).To(Equal([]Event{StartingDeployment, WaitingForDeployment, EvaluatingCanary, PromotingCanary}))
+			})
+
+			When("the deployment pauses again at the next canary step", func() {
+				BeforeEach(func() {
+					fakeV7Actor.GetDeploymentReturnsOnCall(1, pausedDeployment, nil, nil)
+					fakeV7Actor.GetDeploymentReturnsOnCall(2, resources.Deployment{StatusValue: constant.DeploymentStatusValueFinalized}, nil, nil)
+				})
+
+				It("evaluates and promotes every step", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(fakeV7Actor.EvaluateCanaryHealthCallCount()).To(Equal(2))
+					Expect(fakeV7Actor.ContinueDeploymentCallCount()).To(Equal(2))
+					Expect(fakeV7Actor.PollStartForDeploymentCallCount()).To(Equal(3))
+				})
+			})
+		})
+
+		When("the canary is unhealthy", func() {
+			BeforeEach(func() {
+				fakeV7Actor.EvaluateCanaryHealthReturns(v7action.CanaryHealthReport{Reason: "too many crashes"}, v7action.Warnings{"evaluate-warning"}, nil)
+				fakeV7Actor.CancelDeploymentReturns(v7action.Warnings{"cancel-warning"}, nil)
+			})
+
+			It("cancels the deployment and reports why", func() {
+				Expect(executeErr).To(MatchError(actionerror.CanaryUnhealthyError{Reason: "too many crashes"}))
+				Expect(fakeV7Actor.CancelDeploymentCallCount()).To(Equal(1))
+				Expect(fakeV7Actor.CancelDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+				Expect(fakeV7Actor.ContinueDeploymentCallCount()).To(Equal(0))
+
+				Expect(warnings).To(ConsistOf("create-warning", "poll-warning", "get-deployment-warning", "evaluate-warning", "cancel-warning"))
+				Expect(events).To(Equal([]Event{StartingDeployment, WaitingForDeployment, EvaluatingCanary, CancelingCanary}))
+			})
+
+			When("canceling the deployment fails", func() {
+				BeforeEach(func() {
+					fakeV7Actor.CancelDeploymentReturns(nil, errors.New("cancel-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("cancel-error"))
+				})
+			})
+		})
+
+		When("evaluating the canary fails", func() {
+			BeforeEach(func() {
+				fakeV7Actor.EvaluateCanaryHealthReturns(v7action.CanaryHealthReport{}, v7action.Warnings{"evaluate-warning"}, errors.New("evaluate-error"))
+			})
+
+			It("returns the error and leaves the deployment paused", func() {
+				Expect(executeErr).To(MatchError("evaluate-error"))
+				Expect(warnings).To(ContainElement("evaluate-warning"))
+				Expect(fakeV7Actor.CancelDeploymentCallCount()).To(Equal(0))
+				Expect(fakeV7Actor.ContinueDeploymentCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the deployment is not paused", func() {
+			BeforeEach(func() {
+				fakeV7Actor.GetDeploymentReturnsOnCall(0, resources.Deployment{StatusValue: constant.DeploymentStatusValueFinalized}, nil, nil)
+			})
+
+			It("does not evaluate the canary", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeV7Actor.EvaluateCanaryHealthCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the noWait flag is set", func() {
+			BeforeEach(func() {
+				paramPlan.NoWait = true
+			})
+
+			It("does not evaluate the canary", func() {
+				Expect(fakeV7Actor.GetDeploymentCallCount()).To(Equal(0))
+				Expect(fakeV7Actor.EvaluateCanaryHealthCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -1,7 +1,10 @@
 package v7pushaction
 
 import (
+	"fmt"
+
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/manifestparser"
 )
@@ -15,20 +18,67 @@ func (actor Actor) CreatePushPlans(
 	manifest manifestparser.Manifest,
 	overrides FlagOverrides,
 ) ([]PushPlan, v7action.Warnings, error) {
-	var pushPlans []PushPlan
-
 	apps, warnings, err := actor.V7Actor.GetApplicationsByNamesAndSpace(manifest.AppNames(), spaceGUID)
 	if err != nil {
 		return nil, warnings, err
 	}
+
+	return actor.createPushPlans(apps, spaceGUID, orgGUID, manifest, overrides, warnings)
+}
+
+// CreateDryRunPushPlans returns the PushPlan objects that CreatePushPlans
+// would return once the manifest has been applied. Since a dry run does not
+// apply the manifest, apps that do not exist yet get a plan with an
+// application that has no GUID.
+func (actor Actor) CreateDryRunPushPlans(
+	spaceGUID string,
+	orgGUID string,
+	manifest manifestparser.Manifest,
+	overrides FlagOverrides,
+) ([]PushPlan, v7action.Warnings, error) {
+	apps, warnings, err := actor.V7Actor.GetExistingApplicationsByNamesAndSpace(manifest.AppNames(), spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return actor.createPushPlans(apps, spaceGUID, orgGUID, manifest, overrides, warnings)
+}
+
+func (actor Actor) createPushPlans(
+	apps []resources.Application,
+	spaceGUID string,
+	orgGUID string,
+	manifest manifestparser.Manifest,
+	overrides FlagOverrides,
+	warnings v7action.Warnings,
+) ([]PushPlan, v7action.Warnings, error) {
+	var pushPlans []PushPlan
+
 	nameToApp := actor.generateAppNameToApplicationMapping(apps)
 
 	for _, manifestApplication := range manifest.Applications {
+		application, exists := nameToApp[manifestApplication.Name]
+		if !exists {
+			application = resources.Application{Name: manifestApplication.Name}
+		}
+
 		plan := PushPlan{
 			OrgGUID:     orgGUID,
 			SpaceGUID:   spaceGUID,
-			Application: nameToApp[manifestApplication.Name],
+			Application: application,
 			BitsPath:    manifestApplication.Path,
+
+			Strategy:      manifestApplication.Strategy,
+			InstanceSteps: manifestApplication.InstanceSteps,
+		}
+
+		// Earlier versions ignored the strategy in the manifest, so make it
+		// clear that the manifest decides how the app is deployed.
+		if manifestApplication.Strategy != constant.DeploymentStrategyDefault && overrides.Strategy == constant.DeploymentStrategyDefault {
+			warnings = append(warnings, fmt.Sprintf(
+				"Deploying app %s with the %s strategy from the manifest. Use --strategy to override it.",
+				manifestApplication.Name, manifestApplication.Strategy,
+			))
 		}
 
 		if manifestApplication.Docker != nil {
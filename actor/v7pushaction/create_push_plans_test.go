@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	. "code.cloudfoundry.org/cli/actor/v7pushaction"
 	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/manifestparser"
 	. "github.com/onsi/ginkgo/v2"
@@ -130,5 +131,114 @@ var _ = Describe("CreatePushPlans", func() {
 			Expect(pushPlans[1].BitsPath).To(Equal("path2"))
 		})
 
+		When("the manifest sets a strategy", func() {
+			BeforeEach(func() {
+				manifest.Applications[0].Strategy = constant.DeploymentStrategyCanary
+				manifest.Applications[0].InstanceSteps = []int64{20, 100}
+			})
+
+			It("uses the strategy and instance steps from the manifest", func() {
+				Expect(pushPlans[0].Strategy).To(Equal(constant.DeploymentStrategyCanary))
+				Expect(pushPlans[0].InstanceSteps).To(Equal([]int64{20, 100}))
+			})
+
+			It("warns that the strategy comes from the manifest", func() {
+				Expect(warnings).To(ConsistOf(
+					"get-apps-warning",
+					"Deploying app name-1 with the canary strategy from the manifest. Use --strategy to override it.",
+				))
+			})
+
+			When("--strategy is given", func() {
+				BeforeEach(func() {
+					flagOverrides.Strategy = constant.DeploymentStrategyRolling
+				})
+
+				It("does not warn about the strategy in the manifest", func() {
+					Expect(warnings).To(ConsistOf("get-apps-warning"))
+				})
+			})
+		})
+
+	})
+})
+
+var _ = Describe("CreateDryRunPushPlans", func() {
+	var (
+		pushActor   *Actor
+		fakeV7Actor *v7pushactionfakes.FakeV7Actor
+
+		manifest manifestparser.Manifest
+
+		pushPlans  []PushPlan
+		executeErr error
+		warnings   v7action.Warnings
+	)
+
+	BeforeEach(func() {
+		pushActor, fakeV7Actor, _ = getTestPushActor()
+		pushActor.PreparePushPlanSequence = nil
+
+		manifest = manifestparser.Manifest{
+			Applications: []manifestparser.Application{
+				{Name: "name-1", Path: "path1"},
+				{Name: "name-2", Docker: &manifestparser.Docker{Image: "image"}},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		pushPlans, warnings, executeErr = pushActor.CreateDryRunPushPlans("space", "org", manifest, FlagOverrides{})
+	})
+
+	It("looks up the apps without requiring them to exist", func() {
+		Expect(fakeV7Actor.GetExistingApplicationsByNamesAndSpaceCallCount()).To(Equal(1))
+		actualAppNames, actualSpaceGUID := fakeV7Actor.GetExistingApplicationsByNamesAndSpaceArgsForCall(0)
+		Expect(actualAppNames).To(ConsistOf("name-1", "name-2"))
+		Expect(actualSpaceGUID).To(Equal("space"))
+
+		Expect(fakeV7Actor.GetApplicationsByNamesAndSpaceCallCount()).To(Equal(0))
+	})
+
+	When("getting the apps fails", func() {
+		BeforeEach(func() {
+			fakeV7Actor.GetExistingApplicationsByNamesAndSpaceReturns(nil, v7action.Warnings{"get-apps-warning"}, errors.New("get-apps-error"))
+		})
+
+		It("returns errors and warnings", func() {
+			Expect(executeErr).To(MatchError("get-apps-error"))
+			Expect(warnings).To(ConsistOf("get-apps-warning"))
+		})
+	})
+
+	When("an app in the manifest is not in the space", func() {
+		BeforeEach(func() {
+			fakeV7Actor.GetExistingApplicationsByNamesAndSpaceReturns(
+				[]resources.Application{
+					{Name: "name-1", GUID: "app-guid-1"},
+				},
+				v7action.Warnings{"get-apps-warning"},
+				nil,
+			)
+		})
+
+		It("creates a plan for it with an app that has no GUID", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-apps-warning"))
+			Expect(pushPlans).To(HaveLen(2))
+			Expect(pushPlans[0].Application.GUID).To(Equal("app-guid-1"))
+			Expect(pushPlans[1].Application.Name).To(Equal("name-2"))
+			Expect(pushPlans[1].Application.GUID).To(BeEmpty())
+		})
+
+		It("builds a dry run plan that creates the app", func() {
+			dryRunPlan, _, err := pushActor.CreateDryRunPlan(pushPlans[1], manifest.Applications[1])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dryRunPlan.AppName).To(Equal("name-2"))
+			Expect(dryRunPlan.CreateApp).To(BeTrue())
+			Expect(dryRunPlan.Source).To(Equal(AppSourceDocker))
+
+			Expect(fakeV7Actor.GetApplicationRoutesCallCount()).To(Equal(0))
+		})
 	})
 })
@@ -0,0 +1,292 @@
+package v7pushaction
+
+import (
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+type AppSource string
+
+const (
+	AppSourceBits    AppSource = "bits"
+	AppSourceDocker  AppSource = "docker"
+	AppSourceDroplet AppSource = "droplet"
+)
+
+type RouteChange string
+
+const (
+	RouteAlreadyMapped  RouteChange = "already mapped"
+	RouteMap            RouteChange = "map"
+	RouteCreateAndMap   RouteChange = "create and map"
+	RouteInAnotherSpace RouteChange = "registered to another space"
+)
+
+// DryRunRoute is a route of the app and the change that pushing would make
+// to it.
+type DryRunRoute struct {
+	URL    string      `json:"url"`
+	Change RouteChange `json:"change"`
+}
+
+// DryRunFiles describes which of the app's files would be uploaded and which
+// are already in the Cloud Controller's resource cache.
+type DryRunFiles struct {
+	Total       int   `json:"total"`
+	Matched     int   `json:"matched"`
+	ToUpload    int   `json:"to_upload"`
+	UploadBytes int64 `json:"upload_bytes"`
+}
+
+// DryRunPlan describes what actualizing a push plan would do, without doing
+// any of it.
+type DryRunPlan struct {
+	AppName     string       `json:"name"`
+	CreateApp   bool         `json:"create"`
+	Source      AppSource    `json:"source"`
+	DockerImage string       `json:"docker_image,omitempty"`
+	DropletPath string       `json:"droplet_path,omitempty"`
+	Files       *DryRunFiles `json:"files,omitempty"`
+
+	Routes []DryRunRoute `json:"routes"`
+	// RandomRoute and DefaultRoute are set when the app has no routes and
+	// pushing would create and map a random or default route.
+	RandomRoute  bool `json:"random_route"`
+	DefaultRoute bool `json:"default_route"`
+	// NoRoute is set when pushing would unmap all of the app's routes.
+	NoRoute bool `json:"no_route"`
+
+	Strategy constant.DeploymentStrategy `json:"strategy"`
+	Stage    bool                        `json:"stage"`
+	Start    bool                        `json:"start"`
+}
+
+// CreateDryRunPlan works out what Actualize would do for the given plan and
+// the manifest application it was created from. It only reads from the Cloud
+// Controller; files are checked against the resource cache but not uploaded.
+func (actor Actor) CreateDryRunPlan(plan PushPlan, manifestApp manifestparser.Application) (DryRunPlan, v7action.Warnings, error) {
+	var allWarnings v7action.Warnings
+
+	dryRunPlan := DryRunPlan{
+		AppName:   manifestApp.Name,
+		CreateApp: plan.Application.GUID == "",
+		Strategy:  plan.Strategy,
+	}
+
+	if plan.TaskTypeApplication {
+		dryRunPlan.Stage = true
+	} else {
+		dryRunPlan.Stage = ShouldStagePackage(plan)
+		dryRunPlan.Start = ShouldRestart(plan)
+	}
+
+	switch {
+	case ShouldCreateDockerPackage(plan):
+		dryRunPlan.Source = AppSourceDocker
+		dryRunPlan.DockerImage = plan.DockerImageCredentials.Path
+	case ShouldCreateDroplet(plan):
+		dryRunPlan.Source = AppSourceDroplet
+		dryRunPlan.DropletPath = plan.DropletPath
+	default:
+		dryRunPlan.Source = AppSourceBits
+		files, warnings, err := actor.dryRunFiles(plan.AllResources)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return DryRunPlan{}, allWarnings, err
+		}
+		dryRunPlan.Files = &files
+	}
+
+	routes, warnings, err := actor.dryRunRoutes(plan, manifestApp)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return DryRunPlan{}, allWarnings, err
+	}
+	dryRunPlan.Routes = routes
+
+	switch {
+	case manifestApp.NoRoute:
+		dryRunPlan.NoRoute = true
+	case len(routes) == 0 && manifestApp.RandomRoute:
+		dryRunPlan.RandomRoute = true
+	case len(routes) == 0 && manifestApp.DefaultRoute:
+		dryRunPlan.DefaultRoute = true
+	}
+
+	return dryRunPlan, allWarnings, nil
+}
+
+func (actor Actor) dryRunFiles(allResources []sharedaction.V3Resource) (DryRunFiles, v7action.Warnings, error) {
+	var (
+		files           DryRunFiles
+		fileResources   []sharedaction.V3Resource
+		shouldMatch     bool
+		matchedChecksum = map[string]bool{}
+	)
+
+	for _, resource := range allResources {
+		if resource.Checksum.Value == "" {
+			continue
+		}
+		fileResources = append(fileResources, resource)
+		if resource.SizeInBytes != 0 {
+			shouldMatch = true
+		}
+	}
+	files.Total = len(fileResources)
+
+	var warnings v7action.Warnings
+	if shouldMatch {
+		// The matches are not recorded in the cache, since a dry run must not
+		// change anything.
+		matched, _, _, matchWarnings, err := actor.matchResources(fileResources, nil)
+		warnings = v7action.Warnings(matchWarnings)
+		if err != nil {
+			return DryRunFiles{}, warnings, err
+		}
+		for _, resource := range matched {
+			matchedChecksum[resource.Checksum.Value] = true
+		}
+	}
+
+	for _, resource := range fileResources {
+		if matchedChecksum[resource.Checksum.Value] {
+			files.Matched++
+			continue
+		}
+		files.ToUpload++
+		files.UploadBytes += resource.SizeInBytes
+	}
+
+	return files, warnings, nil
+}
+
+func (actor Actor) dryRunRoutes(plan PushPlan, manifestApp manifestparser.Application) ([]DryRunRoute, v7action.Warnings, error) {
+	var allWarnings v7action.Warnings
+
+	if manifestApp.NoRoute || plan.TaskTypeApplication {
+		return nil, nil, nil
+	}
+
+	mappedURLs := map[string]bool{}
+	var mappedRoutes []DryRunRoute
+	if plan.Application.GUID != "" {
+		appRoutes, warnings, err := actor.V7Actor.GetApplicationRoutes(plan.Application.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		for _, route := range appRoutes {
+			mappedURLs[actor.normalizeRouteURL(route.URL)] = true
+			mappedRoutes = append(mappedRoutes, DryRunRoute{URL: route.URL, Change: RouteAlreadyMapped})
+		}
+	}
+
+	manifestRoutes := manifestApp.Routes()
+	if len(manifestRoutes) == 0 {
+		return mappedRoutes, allWarnings, nil
+	}
+
+	var routes []DryRunRoute
+	inManifest := map[string]bool{}
+	for _, url := range manifestRoutes {
+		normalizedURL := actor.normalizeRouteURL(url)
+		inManifest[normalizedURL] = true
+		if mappedURLs[normalizedURL] {
+			routes = append(routes, DryRunRoute{URL: url, Change: RouteAlreadyMapped})
+			continue
+		}
+
+		change, warnings, err := actor.dryRunRouteChange(plan.SpaceGUID, normalizedURL)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		routes = append(routes, DryRunRoute{URL: url, Change: change})
+	}
+
+	// Applying a manifest never unmaps routes that it does not list.
+	for _, route := range mappedRoutes {
+		if !inManifest[actor.normalizeRouteURL(route.URL)] {
+			routes = append(routes, route)
+		}
+	}
+
+	return routes, allWarnings, nil
+}
+
+// dryRunRouteChange looks up a route that is not mapped to the app yet. A
+// route that is registered to another space cannot be mapped, so pushing
+// would fail on it.
+func (actor Actor) dryRunRouteChange(spaceGUID string, url string) (RouteChange, v7action.Warnings, error) {
+	domain, host, path, port, allWarnings, err := actor.parseDryRunRoute(url)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	route, warnings, err := actor.V7Actor.GetRouteByAttributes(domain, host, path, port)
+	allWarnings = append(allWarnings, warnings...)
+	switch err.(type) {
+	case nil:
+		if route.SpaceGUID != spaceGUID {
+			return RouteInAnotherSpace, allWarnings, nil
+		}
+		return RouteMap, allWarnings, nil
+	case actionerror.RouteNotFoundError:
+		return RouteCreateAndMap, allWarnings, nil
+	default:
+		return "", allWarnings, err
+	}
+}
+
+// parseDryRunRoute splits a normalized route URL into its domain, host, path
+// and port. The host is only split off when the whole URL is not a domain.
+func (actor Actor) parseDryRunRoute(url string) (resources.Domain, string, string, int, v7action.Warnings, error) {
+	var (
+		host string
+		path string
+		port int
+	)
+
+	routeParts := strings.SplitN(url, "/", 2)
+	if len(routeParts) > 1 {
+		path = "/" + routeParts[1]
+	}
+
+	domainAndPortParts := strings.SplitN(routeParts[0], ":", 2)
+	domainName := domainAndPortParts[0]
+	if len(domainAndPortParts) > 1 {
+		var err error
+		port, err = strconv.Atoi(domainAndPortParts[1])
+		if err != nil {
+			return resources.Domain{}, "", "", 0, nil, err
+		}
+	}
+
+	domain, allWarnings, err := actor.V7Actor.GetDomainByName(domainName)
+	if _, domainNotFound := err.(actionerror.DomainNotFoundError); domainNotFound && strings.Contains(domainName, ".") {
+		domainParts := strings.SplitN(domainName, ".", 2)
+		host = domainParts[0]
+
+		var warnings v7action.Warnings
+		domain, warnings, err = actor.V7Actor.GetDomainByName(domainParts[1])
+		allWarnings = append(allWarnings, warnings...)
+	}
+	if err != nil {
+		return resources.Domain{}, "", "", 0, allWarnings, err
+	}
+
+	return domain, host, path, port, allWarnings, nil
+}
+
+func (actor Actor) normalizeRouteURL(url string) string {
+	url = actor.startWithProtocol.ReplaceAllString(url, "")
+	return strings.ToLower(strings.TrimSuffix(url, "/"))
+}
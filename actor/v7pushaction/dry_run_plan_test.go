@@ -0,0 +1,299 @@
+package v7pushaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CreateDryRunPlan", func() {
+	var (
+		actor       *Actor
+		fakeV7Actor *v7pushactionfakes.FakeV7Actor
+
+		plan        PushPlan
+		manifestApp manifestparser.Application
+
+		dryRunPlan DryRunPlan
+		warnings   v7action.Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		actor, fakeV7Actor, _ = getTestPushActor()
+
+		plan = PushPlan{
+			SpaceGUID:   "some-space-guid",
+			Application: resources.Application{Name: "some-app", GUID: "some-app-guid"},
+			Strategy:    constant.DeploymentStrategyRolling,
+			AllResources: []sharedaction.V3Resource{
+				{FilePath: "some-dir", Mode: 0755},
+				{FilePath: "matched-file", Checksum: ccv3.Checksum{Value: "matched-sha"}, SizeInBytes: 10},
+				{FilePath: "new-file", Checksum: ccv3.Checksum{Value: "new-sha"}, SizeInBytes: 20},
+			},
+		}
+		manifestApp = manifestparser.Application{Name: "some-app", DefaultRoute: true}
+
+		fakeV7Actor.ResourceMatchReturns(
+			[]sharedaction.V3Resource{{FilePath: "matched-file", Checksum: ccv3.Checksum{Value: "matched-sha"}, SizeInBytes: 10}},
+			v7action.Warnings{"resource-match-warning"},
+			nil,
+		)
+		fakeV7Actor.GetApplicationRoutesReturns(
+			[]resources.Route{{URL: "some-app.example.com"}},
+			v7action.Warnings{"app-routes-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		dryRunPlan, warnings, executeErr = actor.CreateDryRunPlan(plan, manifestApp)
+	})
+
+	It("describes the push without making any changes", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(warnings).To(ConsistOf("resource-match-warning", "app-routes-warning"))
+		Expect(dryRunPlan).To(Equal(DryRunPlan{
+			AppName:  "some-app",
+			Source:   AppSourceBits,
+			Files:    &DryRunFiles{Total: 2, Matched: 1, ToUpload: 1, UploadBytes: 20},
+			Routes:   []DryRunRoute{{URL: "some-app.example.com", Change: RouteAlreadyMapped}},
+			Strategy: constant.DeploymentStrategyRolling,
+			Stage:    true,
+			Start:    true,
+		}))
+
+		Expect(fakeV7Actor.ResourceMatchCallCount()).To(Equal(1))
+		Expect(fakeV7Actor.ResourceMatchArgsForCall(0)).To(Equal(plan.AllResources[1:]))
+		Expect(fakeV7Actor.GetApplicationRoutesArgsForCall(0)).To(Equal("some-app-guid"))
+		Expect(fakeV7Actor.GetRouteByAttributesCallCount()).To(Equal(0))
+	})
+
+	When("all of the files are empty", func() {
+		BeforeEach(func() {
+			plan.AllResources = []sharedaction.V3Resource{
+				{FilePath: "empty-file", Checksum: ccv3.Checksum{Value: "empty-sha"}},
+			}
+		})
+
+		It("uploads them without matching resources", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(dryRunPlan.Files).To(Equal(&DryRunFiles{Total: 1, ToUpload: 1}))
+			Expect(fakeV7Actor.ResourceMatchCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the push has a resource match cache", func() {
+		var fakeCache *v7pushactionfakes.FakeResourceMatchCache
+
+		BeforeEach(func() {
+			fakeCache = new(v7pushactionfakes.FakeResourceMatchCache)
+			actor.ResourceMatchCache = fakeCache
+		})
+
+		It("does not record the matches in it", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(dryRunPlan.Files).To(Equal(&DryRunFiles{Total: 2, Matched: 1, ToUpload: 1, UploadBytes: 20}))
+			Expect(fakeCache.RecordMatchesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("matching resources fails", func() {
+		BeforeEach(func() {
+			fakeV7Actor.ResourceMatchReturns(nil, v7action.Warnings{"resource-match-warning"}, errors.New("match-error"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("match-error"))
+			Expect(warnings).To(ConsistOf("resource-match-warning"))
+		})
+	})
+
+	When("the app does not exist yet", func() {
+		BeforeEach(func() {
+			plan.Application = resources.Application{}
+		})
+
+		It("creates the app with a default route", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(dryRunPlan.AppName).To(Equal("some-app"))
+			Expect(dryRunPlan.CreateApp).To(BeTrue())
+			Expect(dryRunPlan.Routes).To(BeEmpty())
+			Expect(dryRunPlan.DefaultRoute).To(BeTrue())
+			Expect(fakeV7Actor.GetApplicationRoutesCallCount()).To(Equal(0))
+		})
+
+		When("a random route is requested", func() {
+			BeforeEach(func() {
+				manifestApp.DefaultRoute = false
+				manifestApp.RandomRoute = true
+			})
+
+			It("creates a random route", func() {
+				Expect(dryRunPlan.RandomRoute).To(BeTrue())
+				Expect(dryRunPlan.DefaultRoute).To(BeFalse())
+			})
+		})
+	})
+
+	When("the manifest lists routes", func() {
+		BeforeEach(func() {
+			manifestApp.RemainingManifestFields = map[string]interface{}{
+				"routes": []interface{}{
+					map[interface{}]interface{}{"route": "https://SOME-APP.example.com/"},
+					map[interface{}]interface{}{"route": "existing.example.com/path"},
+					map[interface{}]interface{}{"route": "new.example.com"},
+					map[interface{}]interface{}{"route": "taken.example.com"},
+				},
+			}
+			fakeV7Actor.GetApplicationRoutesReturns(
+				[]resources.Route{{URL: "some-app.example.com"}, {URL: "other.example.com"}},
+				v7action.Warnings{"app-routes-warning"},
+				nil,
+			)
+			fakeV7Actor.GetDomainByNameStub = func(domainName string) (resources.Domain, v7action.Warnings, error) {
+				if domainName != "example.com" {
+					return resources.Domain{}, v7action.Warnings{"domain-warning"}, actionerror.DomainNotFoundError{Name: domainName}
+				}
+				return resources.Domain{Name: "example.com", GUID: "domain-guid"}, v7action.Warnings{"domain-warning"}, nil
+			}
+			fakeV7Actor.GetRouteByAttributesStub = func(domain resources.Domain, hostname, path string, port int) (resources.Route, v7action.Warnings, error) {
+				switch hostname {
+				case "existing":
+					return resources.Route{GUID: "existing-guid", SpaceGUID: "some-space-guid"}, v7action.Warnings{"route-warning"}, nil
+				case "taken":
+					return resources.Route{GUID: "taken-guid", SpaceGUID: "other-space-guid"}, v7action.Warnings{"route-warning"}, nil
+				}
+				return resources.Route{}, v7action.Warnings{"route-warning"}, actionerror.RouteNotFoundError{}
+			}
+		})
+
+		It("says which routes would be created and mapped", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElements("domain-warning", "route-warning"))
+			Expect(dryRunPlan.Routes).To(Equal([]DryRunRoute{
+				{URL: "https://SOME-APP.example.com/", Change: RouteAlreadyMapped},
+				{URL: "existing.example.com/path", Change: RouteMap},
+				{URL: "new.example.com", Change: RouteCreateAndMap},
+				{URL: "taken.example.com", Change: RouteInAnotherSpace},
+				{URL: "other.example.com", Change: RouteAlreadyMapped},
+			}))
+			Expect(dryRunPlan.DefaultRoute).To(BeFalse())
+		})
+
+		It("looks up each route that is not mapped yet by its domain, host and path", func() {
+			Expect(fakeV7Actor.GetRouteByAttributesCallCount()).To(Equal(3))
+
+			domain, hostname, path, port := fakeV7Actor.GetRouteByAttributesArgsForCall(0)
+			Expect(domain.GUID).To(Equal("domain-guid"))
+			Expect(hostname).To(Equal("existing"))
+			Expect(path).To(Equal("/path"))
+			Expect(port).To(Equal(0))
+		})
+
+		When("the route has a port", func() {
+			BeforeEach(func() {
+				manifestApp.RemainingManifestFields = map[string]interface{}{
+					"routes": []interface{}{
+						map[interface{}]interface{}{"route": "example.com:1234"},
+					},
+				}
+			})
+
+			It("looks up the route on the domain by its port", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				domain, hostname, path, port := fakeV7Actor.GetRouteByAttributesArgsForCall(0)
+				Expect(domain.Name).To(Equal("example.com"))
+				Expect(hostname).To(BeEmpty())
+				Expect(path).To(BeEmpty())
+				Expect(port).To(Equal(1234))
+			})
+		})
+
+		When("the domain of a route does not exist", func() {
+			BeforeEach(func() {
+				fakeV7Actor.GetDomainByNameReturns(resources.Domain{}, v7action.Warnings{"domain-warning"}, actionerror.DomainNotFoundError{Name: "example.com"})
+				fakeV7Actor.GetDomainByNameStub = nil
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.DomainNotFoundError{Name: "example.com"}))
+				Expect(warnings).To(ConsistOf("resource-match-warning", "app-routes-warning", "domain-warning", "domain-warning"))
+			})
+		})
+
+		When("looking up a route fails", func() {
+			BeforeEach(func() {
+				fakeV7Actor.GetRouteByAttributesStub = nil
+				fakeV7Actor.GetRouteByAttributesReturns(resources.Route{}, v7action.Warnings{"route-warning"}, errors.New("routes-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("routes-error"))
+				Expect(warnings).To(ConsistOf("resource-match-warning", "app-routes-warning", "domain-warning", "domain-warning", "route-warning"))
+			})
+		})
+	})
+
+	When("the app should have no routes", func() {
+		BeforeEach(func() {
+			manifestApp.NoRoute = true
+			manifestApp.DefaultRoute = false
+		})
+
+		It("unmaps all routes", func() {
+			Expect(dryRunPlan.NoRoute).To(BeTrue())
+			Expect(dryRunPlan.Routes).To(BeEmpty())
+			Expect(fakeV7Actor.GetApplicationRoutesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("pushing a docker image", func() {
+		BeforeEach(func() {
+			plan.DockerImageCredentials = v7action.DockerImageCredentials{Path: "some-image"}
+		})
+
+		It("does not look at files", func() {
+			Expect(dryRunPlan.Source).To(Equal(AppSourceDocker))
+			Expect(dryRunPlan.DockerImage).To(Equal("some-image"))
+			Expect(dryRunPlan.Files).To(BeNil())
+			Expect(fakeV7Actor.ResourceMatchCallCount()).To(Equal(0))
+		})
+	})
+
+	When("pushing a droplet with --no-start", func() {
+		BeforeEach(func() {
+			plan.DropletPath = "some-droplet.tgz"
+			plan.NoStart = true
+		})
+
+		It("neither stages nor starts the app", func() {
+			Expect(dryRunPlan.Source).To(Equal(AppSourceDroplet))
+			Expect(dryRunPlan.DropletPath).To(Equal("some-droplet.tgz"))
+			Expect(dryRunPlan.Stage).To(BeFalse())
+			Expect(dryRunPlan.Start).To(BeFalse())
+		})
+	})
+
+	When("pushing a task app", func() {
+		BeforeEach(func() {
+			plan.TaskTypeApplication = true
+		})
+
+		It("stages but does not start the app or map routes", func() {
+			Expect(dryRunPlan.Stage).To(BeTrue())
+			Expect(dryRunPlan.Start).To(BeFalse())
+			Expect(dryRunPlan.Routes).To(BeEmpty())
+		})
+	})
+})
@@ -12,11 +12,14 @@ type Event string
 const (
 	ApplyManifest                   Event = "Applying manifest"
 	ApplyManifestComplete           Event = "Applying manifest Complete"
+	CancelingCanary                 Event = "canceling canary"
 	CreatingArchive                 Event = "creating archive"
 	CreatingDroplet                 Event = "creating droplet"
 	CreatingPackage                 Event = "creating package"
+	EvaluatingCanary                Event = "evaluating canary"
 	InstanceDetails                 Event = "instance details"
 	PollingBuild                    Event = "polling build"
+	PromotingCanary                 Event = "promoting canary"
 	ReadingArchive                  Event = "reading archive"
 	ResourceMatching                Event = "resource matching"
 	RestartingApplication           Event = "restarting application"
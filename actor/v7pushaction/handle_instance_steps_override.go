@@ -0,0 +1,19 @@
+package v7pushaction
+
+import (
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+func HandleInstanceStepsOverride(manifest manifestparser.Manifest, overrides FlagOverrides) (manifestparser.Manifest, error) {
+	if len(overrides.InstanceSteps) > 0 {
+		if manifest.ContainsMultipleApps() {
+			return manifest, translatableerror.CommandLineArgsWithMultipleAppsError{}
+		}
+
+		app := manifest.GetFirstApp()
+		app.InstanceSteps = overrides.InstanceSteps
+	}
+
+	return manifest, nil
+}
@@ -0,0 +1,67 @@
+package v7pushaction_test
+
+import (
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HandleInstanceStepsOverride", func() {
+	var (
+		transformedManifest manifestparser.Manifest
+		executeErr          error
+
+		parsedManifest manifestparser.Manifest
+		flagOverrides  FlagOverrides
+	)
+
+	BeforeEach(func() {
+		flagOverrides = FlagOverrides{}
+		parsedManifest = manifestparser.Manifest{
+			Applications: []manifestparser.Application{
+				{InstanceSteps: []int64{50, 100}},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		transformedManifest, executeErr = HandleInstanceStepsOverride(
+			parsedManifest,
+			flagOverrides,
+		)
+	})
+
+	When("the instance steps flag override is set", func() {
+		BeforeEach(func() {
+			flagOverrides.InstanceSteps = []int64{10, 25, 100}
+		})
+
+		It("replaces the instance steps from the manifest", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(transformedManifest.Applications).To(ConsistOf(
+				manifestparser.Application{InstanceSteps: []int64{10, 25, 100}},
+			))
+		})
+
+		When("there are multiple apps in the manifest", func() {
+			BeforeEach(func() {
+				parsedManifest.Applications = append(parsedManifest.Applications, manifestparser.Application{})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.CommandLineArgsWithMultipleAppsError{}))
+			})
+		})
+	})
+
+	When("the instance steps flag override is not set", func() {
+		It("keeps the instance steps from the manifest", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(transformedManifest.Applications).To(ConsistOf(
+				manifestparser.Application{InstanceSteps: []int64{50, 100}},
+			))
+		})
+	})
+})
@@ -0,0 +1,26 @@
+package v7pushaction
+
+import (
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+// HandleWatchOverride checks that the manifest has a single app that is
+// pushed from source, since push --watch re-pushes the app's files when they
+// change.
+func HandleWatchOverride(manifest manifestparser.Manifest, overrides FlagOverrides) (manifestparser.Manifest, error) {
+	if overrides.Watch {
+		if manifest.ContainsMultipleApps() {
+			return manifest, translatableerror.CommandLineArgsWithMultipleAppsError{}
+		}
+
+		if manifest.GetFirstApp().Docker != nil {
+			return manifest, translatableerror.ArgumentManifestMismatchError{
+				Arg:              "--watch",
+				ManifestProperty: "docker",
+			}
+		}
+	}
+
+	return manifest, nil
+}
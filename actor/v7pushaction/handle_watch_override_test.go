@@ -0,0 +1,101 @@
+package v7pushaction_test
+
+import (
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HandleWatchOverride", func() {
+	var (
+		transformedManifest manifestparser.Manifest
+		executeErr          error
+
+		parsedManifest manifestparser.Manifest
+		flagOverrides  FlagOverrides
+	)
+
+	JustBeforeEach(func() {
+		transformedManifest, executeErr = HandleWatchOverride(
+			parsedManifest,
+			flagOverrides,
+		)
+	})
+
+	When("the watch flag override is set", func() {
+		BeforeEach(func() {
+			flagOverrides = FlagOverrides{Watch: true}
+		})
+
+		When("there are multiple apps in the manifest", func() {
+			BeforeEach(func() {
+				parsedManifest = manifestparser.Manifest{
+					Applications: []manifestparser.Application{
+						{},
+						{},
+					},
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.CommandLineArgsWithMultipleAppsError{}))
+			})
+		})
+
+		When("there is a single app in the manifest", func() {
+			BeforeEach(func() {
+				parsedManifest = manifestparser.Manifest{
+					Applications: []manifestparser.Application{
+						{Name: "some-app", Path: "some-path"},
+					},
+				}
+			})
+
+			It("returns the unchanged manifest", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(transformedManifest).To(Equal(parsedManifest))
+			})
+		})
+
+		When("docker is set in the manifest", func() {
+			BeforeEach(func() {
+				parsedManifest = manifestparser.Manifest{
+					Applications: []manifestparser.Application{
+						{
+							Name: "some-app",
+							Docker: &manifestparser.Docker{
+								Image: "nginx:latest",
+							},
+						},
+					},
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentManifestMismatchError{
+					Arg:              "--watch",
+					ManifestProperty: "docker",
+				}))
+			})
+		})
+	})
+
+	When("the watch flag override is not set", func() {
+		BeforeEach(func() {
+			flagOverrides = FlagOverrides{}
+			parsedManifest = manifestparser.Manifest{
+				Applications: []manifestparser.Application{
+					{},
+					{},
+				},
+			}
+		})
+
+		It("does not return an error", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+		})
+	})
+})
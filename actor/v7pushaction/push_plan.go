@@ -2,6 +2,7 @@ package v7pushaction
 
 import (
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
@@ -21,8 +22,13 @@ type PushPlan struct {
 	NoWait              bool
 	Strategy            constant.DeploymentStrategy
 	MaxInFlight         int
+	InstanceSteps       []int64
 	TaskTypeApplication bool
 
+	AutoPromoteAfter        time.Duration
+	AutoPromoteMaxCrashes   int
+	AutoPromoteMaxErrorRate *float64
+
 	DockerImageCredentials v7action.DockerImageCredentials
 
 	Archive      bool
@@ -35,33 +41,39 @@ type PushPlan struct {
 }
 
 type FlagOverrides struct {
-	AppName             string
-	Buildpacks          []string
-	Stack               string
-	Disk                string
-	DropletPath         string
-	DockerImage         string
-	DockerPassword      string
-	DockerUsername      string
-	HealthCheckEndpoint string
-	HealthCheckTimeout  int64
-	HealthCheckType     constant.HealthCheckType
-	Instances           types.NullInt
-	Memory              string
-	MaxInFlight         *int
-	NoStart             bool
-	NoWait              bool
-	ProvidedAppPath     string
-	NoRoute             bool
-	RandomRoute         bool
-	StartCommand        types.FilteredString
-	Strategy            constant.DeploymentStrategy
-	ManifestPath        string
-	PathsToVarsFiles    []string
-	Vars                []template.VarKV
-	NoManifest          bool
-	Task                bool
-	LogRateLimit        string
+	AppName                 string
+	Buildpacks              []string
+	Stack                   string
+	Disk                    string
+	DropletPath             string
+	DockerImage             string
+	DockerPassword          string
+	DockerUsername          string
+	HealthCheckEndpoint     string
+	HealthCheckTimeout      int64
+	HealthCheckType         constant.HealthCheckType
+	Instances               types.NullInt
+	Memory                  string
+	MaxInFlight             *int
+	InstanceSteps           []int64
+	AutoPromoteAfter        time.Duration
+	AutoPromoteMaxCrashes   int
+	AutoPromoteMaxErrorRate *float64
+	NoStart                 bool
+	NoWait                  bool
+	ProvidedAppPath         string
+	NoRoute                 bool
+	RandomRoute             bool
+	StartCommand            types.FilteredString
+	Strategy                constant.DeploymentStrategy
+	ManifestPath            string
+	PathsToVarsFiles        []string
+	PathsToOpsFiles         []string
+	Vars                    []template.VarKV
+	NoManifest              bool
+	Task                    bool
+	LogRateLimit            string
+	Watch                   bool
 }
 
 func (state PushPlan) String() string {
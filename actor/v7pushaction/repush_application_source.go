@@ -0,0 +1,30 @@
+package v7pushaction
+
+import (
+	log "github.com/sirupsen/logrus"
+)
+
+// RepushApplicationSource gathers the current contents of the plan's bits
+// path and then actualizes the plan, so that the application is packaged,
+// staged and started with them again. It is used by push --watch after the
+// app's files change.
+func (actor Actor) RepushApplicationSource(plan PushPlan, progressBar ProgressBar) <-chan *PushEvent {
+	log.Debugln("Starting to repush the source of push plan:", plan)
+	eventStream := make(chan *PushEvent)
+
+	go func() {
+		defer close(eventStream)
+
+		plan, err := actor.SetupAllResourcesForPushPlan(plan, FlagOverrides{})
+		if err != nil {
+			eventStream <- &PushEvent{Plan: plan, Err: err}
+			return
+		}
+
+		for event := range actor.Actualize(plan, progressBar) {
+			eventStream <- event
+		}
+	}()
+
+	return eventStream
+}
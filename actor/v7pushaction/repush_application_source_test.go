@@ -0,0 +1,85 @@
+package v7pushaction_test
+
+import (
+	"errors"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RepushApplicationSource", func() {
+	var (
+		actor           *Actor
+		fakeSharedActor *v7pushactionfakes.FakeSharedActor
+		fakeProgressBar *v7pushactionfakes.FakeProgressBar
+
+		bitsPath     string
+		plan         PushPlan
+		actualized   []PushPlan
+		pushedEvents []*PushEvent
+	)
+
+	BeforeEach(func() {
+		actor, _, fakeSharedActor = getTestPushActor()
+		fakeProgressBar = new(v7pushactionfakes.FakeProgressBar)
+
+		var err error
+		bitsPath, err = os.MkdirTemp("", "repush-application-source")
+		Expect(err).ToNot(HaveOccurred())
+
+		plan = PushPlan{
+			BitsPath:     bitsPath,
+			AllResources: []sharedaction.V3Resource{buildV3Resource("old-file")},
+		}
+		fakeSharedActor.GatherDirectoryResourcesReturns([]sharedaction.Resource{
+			{Filename: "new-file", SHA1: "checksum-new-file", Size: 6},
+		}, nil)
+
+		actualized = nil
+		actor.ChangeApplicationSequence = func(plan PushPlan) []ChangeApplicationFunc {
+			return []ChangeApplicationFunc{
+				func(pushPlan PushPlan, eventStream chan<- *PushEvent, progressBar ProgressBar) (PushPlan, Warnings, error) {
+					actualized = append(actualized, pushPlan)
+					return pushPlan, Warnings{"repush-warning"}, nil
+				},
+			}
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(bitsPath)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		pushedEvents = nil
+		for event := range actor.RepushApplicationSource(plan, fakeProgressBar) {
+			pushedEvents = append(pushedEvents, event)
+		}
+	})
+
+	It("actualizes the plan with the current files in the bits path", func() {
+		Expect(fakeSharedActor.GatherDirectoryResourcesArgsForCall(0)).To(Equal(bitsPath))
+		Expect(actualized).To(HaveLen(1))
+		Expect(actualized[0].AllResources).To(HaveLen(1))
+		Expect(actualized[0].AllResources[0].FilePath).To(Equal("new-file"))
+
+		Expect(pushedEvents).To(HaveLen(1))
+		Expect(pushedEvents[0].Warnings).To(ConsistOf("repush-warning"))
+	})
+
+	When("gathering the files fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.GatherDirectoryResourcesReturns(nil, errors.New("gather-error"))
+		})
+
+		It("sends the error without actualizing the plan", func() {
+			Expect(actualized).To(BeEmpty())
+			Expect(pushedEvents).To(HaveLen(1))
+			Expect(pushedEvents[0].Err).To(MatchError("gather-error"))
+		})
+	})
+})
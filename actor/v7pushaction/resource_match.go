@@ -4,7 +4,47 @@ import "code.cloudfoundry.org/cli/actor/sharedaction"
 
 // MatchResources returns back a list of matched and unmatched resources for the provided resources.
 func (actor Actor) MatchResources(resources []sharedaction.V3Resource) ([]sharedaction.V3Resource, []sharedaction.V3Resource, Warnings, error) {
-	matches, warnings, err := actor.V7Actor.ResourceMatch(resources)
+	matches, unmatches, _, warnings, err := actor.matchResources(resources, actor.ResourceMatchCache)
+	return matches, unmatches, warnings, err
+}
+
+// matchResources matches the resources with the API. Resources that the
+// cache predicts to match are not sent to the API; the number of them is
+// returned.
+func (actor Actor) matchResources(resources []sharedaction.V3Resource, cache ResourceMatchCache) ([]sharedaction.V3Resource, []sharedaction.V3Resource, int, Warnings, error) {
+	var predicted, toMatch []sharedaction.V3Resource
+	for _, resource := range resources {
+		if cache != nil && resource.Checksum.Value != "" && cache.RecentlyMatched(resource.Checksum.Value) {
+			predicted = append(predicted, resource)
+		} else {
+			toMatch = append(toMatch, resource)
+		}
+	}
+
+	var (
+		matches  []sharedaction.V3Resource
+		warnings Warnings
+	)
+	if len(toMatch) > 0 || len(predicted) == 0 {
+		var (
+			apiWarnings []string
+			err         error
+		)
+		matches, apiWarnings, err = actor.V7Actor.ResourceMatch(toMatch)
+		warnings = Warnings(apiWarnings)
+		if err != nil {
+			return matches, nil, 0, warnings, err
+		}
+	}
+
+	if cache != nil && len(matches) > 0 {
+		var checksums []string
+		for _, resource := range matches {
+			checksums = append(checksums, resource.Checksum.Value)
+		}
+		cache.RecordMatches(checksums)
+	}
+	matches = append(predicted, matches...)
 
 	mapChecksumToResource := map[string]sharedaction.V3Resource{}
 	for _, resource := range matches {
@@ -18,5 +58,5 @@ func (actor Actor) MatchResources(resources []sharedaction.V3Resource) ([]shared
 		}
 	}
 
-	return matches, unmatches, Warnings(warnings), err
+	return matches, unmatches, len(predicted), warnings, nil
 }
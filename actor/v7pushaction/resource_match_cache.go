@@ -0,0 +1,11 @@
+package v7pushaction
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ResourceMatchCache
+
+// ResourceMatchCache remembers the checksums that the targeted API recently
+// matched, so that they can be assumed to match without asking the API.
+type ResourceMatchCache interface {
+	RecentlyMatched(checksum string) bool
+	RecordMatches(checksums []string)
+	ForgetMatches()
+}
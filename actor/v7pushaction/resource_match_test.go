@@ -114,6 +114,64 @@ var _ = Describe("MatchResources", func() {
 		})
 	})
 
+	When("a resource match cache is set", func() {
+		var fakeCache *v7pushactionfakes.FakeResourceMatchCache
+
+		BeforeEach(func() {
+			resources = []sharedaction.V3Resource{
+				{Checksum: ccv3.Checksum{Value: "file-1"}},
+				{Checksum: ccv3.Checksum{Value: "file-2"}},
+				{Checksum: ccv3.Checksum{Value: "file-3"}},
+			}
+
+			fakeCache = new(v7pushactionfakes.FakeResourceMatchCache)
+			fakeCache.RecentlyMatchedStub = func(checksum string) bool {
+				return checksum == "file-1"
+			}
+			actor.ResourceMatchCache = fakeCache
+
+			fakeV7Actor.ResourceMatchReturns(
+				[]sharedaction.V3Resource{{Checksum: ccv3.Checksum{Value: "file-3"}}},
+				v7action.Warnings{"match-warning"},
+				nil,
+			)
+		})
+
+		It("only asks the API about resources it did not recently match", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeV7Actor.ResourceMatchArgsForCall(0)).To(Equal([]sharedaction.V3Resource{
+				{Checksum: ccv3.Checksum{Value: "file-2"}},
+				{Checksum: ccv3.Checksum{Value: "file-3"}},
+			}))
+			Expect(matched).To(Equal([]sharedaction.V3Resource{
+				{Checksum: ccv3.Checksum{Value: "file-1"}},
+				{Checksum: ccv3.Checksum{Value: "file-3"}},
+			}))
+			Expect(unmatched).To(Equal([]sharedaction.V3Resource{
+				{Checksum: ccv3.Checksum{Value: "file-2"}},
+			}))
+			Expect(warnings).To(Equal(Warnings{"match-warning"}))
+		})
+
+		It("records the new matches", func() {
+			Expect(fakeCache.RecordMatchesCallCount()).To(Equal(1))
+			Expect(fakeCache.RecordMatchesArgsForCall(0)).To(Equal([]string{"file-3"}))
+		})
+
+		When("every resource was recently matched", func() {
+			BeforeEach(func() {
+				fakeCache.RecentlyMatchedReturns(true)
+				fakeCache.RecentlyMatchedStub = nil
+			})
+
+			It("does not ask the API", func() {
+				Expect(fakeV7Actor.ResourceMatchCallCount()).To(Equal(0))
+				Expect(matched).To(Equal(resources))
+				Expect(unmatched).To(BeEmpty())
+			})
+		})
+	})
+
 	When("v7actor.ResourceMatch returns an error", func() {
 		BeforeEach(func() {
 			fakeV7Actor.ResourceMatchReturns(
@@ -13,7 +13,7 @@ func (actor Actor) SetupAllResourcesForPushPlan(pushPlan PushPlan, overrides Fla
 		return pushPlan, nil
 	}
 
-	if pushPlan.Application.LifecycleType == constant.AppLifecycleTypeDocker {
+	if pushPlan.Application.LifecycleType == constant.AppLifecycleTypeDocker || pushPlan.DockerImageCredentials.Path != "" {
 		return pushPlan, nil
 	}
 
@@ -1,13 +1,66 @@
 package v7pushaction
 
-import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
+)
 
+// SetupDeploymentInformationForPushPlan sets the deployment strategy of the
+// push plan. The --strategy flag takes precedence over the strategy in the
+// manifest. --max-in-flight requires a deployment strategy, and instance
+// steps, from the flag or the manifest, and auto promotion are only allowed
+// with the canary strategy.
 func SetupDeploymentInformationForPushPlan(pushPlan PushPlan, overrides FlagOverrides) (PushPlan, error) {
-	pushPlan.Strategy = overrides.Strategy
+	if overrides.Strategy != constant.DeploymentStrategyDefault {
+		pushPlan.Strategy = overrides.Strategy
+	}
 
-	if overrides.Strategy != constant.DeploymentStrategyDefault && overrides.MaxInFlight != nil {
+	if overrides.MaxInFlight != nil {
+		if pushPlan.Strategy == constant.DeploymentStrategyDefault {
+			return pushPlan, translatableerror.IncorrectUsageError{
+				Message: "--max-in-flight requires a deployment strategy ('--strategy' or 'strategy' in the manifest)",
+			}
+		}
 		pushPlan.MaxInFlight = *overrides.MaxInFlight
 	}
 
+	if len(overrides.InstanceSteps) > 0 && pushPlan.Strategy != constant.DeploymentStrategyCanary {
+		return pushPlan, translatableerror.IncorrectUsageError{
+			Message: "--instance-steps requires the canary deployment strategy ('--strategy canary' or 'strategy: canary' in the manifest)",
+		}
+	}
+
+	if len(pushPlan.InstanceSteps) > 0 {
+		if !resources.ValidInstanceSteps(pushPlan.InstanceSteps) {
+			return pushPlan, translatableerror.IncorrectUsageError{
+				Message: "invalid value for manifest property 'instance-steps' (expected increasing percentages between 1 and 100)",
+			}
+		}
+
+		if pushPlan.Strategy != constant.DeploymentStrategyCanary {
+			if overrides.Strategy != constant.DeploymentStrategyDefault {
+				return pushPlan, translatableerror.ArgumentManifestMismatchError{
+					Arg:              "--strategy " + string(overrides.Strategy),
+					ManifestProperty: "instance-steps",
+				}
+			}
+			return pushPlan, translatableerror.IncorrectUsageError{
+				Message: "the manifest property 'instance-steps' requires the canary deployment strategy ('--strategy canary' or 'strategy: canary' in the manifest)",
+			}
+		}
+	}
+
+	if overrides.AutoPromoteAfter != 0 {
+		if pushPlan.Strategy != constant.DeploymentStrategyCanary {
+			return pushPlan, translatableerror.IncorrectUsageError{
+				Message: "--auto-promote-after requires the canary deployment strategy ('--strategy canary' or 'strategy: canary' in the manifest)",
+			}
+		}
+		pushPlan.AutoPromoteAfter = overrides.AutoPromoteAfter
+		pushPlan.AutoPromoteMaxCrashes = overrides.AutoPromoteMaxCrashes
+		pushPlan.AutoPromoteMaxErrorRate = overrides.AutoPromoteMaxErrorRate
+	}
+
 	return pushPlan, nil
 }
@@ -1,7 +1,10 @@
 package v7pushaction_test
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 
 	. "code.cloudfoundry.org/cli/actor/v7pushaction"
 
@@ -51,13 +54,131 @@ var _ = Describe("SetupDeploymentInformationForPushPlan", func() {
 			overrides.MaxInFlight = &maxInFlight
 		})
 		It("leaves the strategy as its default value on the push plan", func() {
-			Expect(executeErr).ToNot(HaveOccurred())
 			Expect(expectedPushPlan.Strategy).To(Equal(constant.DeploymentStrategyDefault))
 		})
 
-		It("does not set MaxInFlight", func() {
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{
+				Message: "--max-in-flight requires a deployment strategy ('--strategy' or 'strategy' in the manifest)",
+			}))
+		})
+	})
+
+	When("the push plan has instance steps", func() {
+		BeforeEach(func() {
+			pushPlan.InstanceSteps = []int64{10, 50, 100}
+		})
+
+		When("the strategy is canary", func() {
+			BeforeEach(func() {
+				overrides.Strategy = constant.DeploymentStrategyCanary
+			})
+
+			It("keeps the instance steps", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(expectedPushPlan.InstanceSteps).To(Equal([]int64{10, 50, 100}))
+			})
+		})
+
+		When("the manifest strategy is canary", func() {
+			BeforeEach(func() {
+				pushPlan.Strategy = constant.DeploymentStrategyCanary
+			})
+
+			It("keeps the strategy and the instance steps", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(expectedPushPlan.Strategy).To(Equal(constant.DeploymentStrategyCanary))
+				Expect(expectedPushPlan.InstanceSteps).To(Equal([]int64{10, 50, 100}))
+			})
+		})
+
+		When("the strategy flag is not canary", func() {
+			BeforeEach(func() {
+				pushPlan.Strategy = constant.DeploymentStrategyCanary
+				overrides.Strategy = constant.DeploymentStrategyRolling
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentManifestMismatchError{
+					Arg:              "--strategy rolling",
+					ManifestProperty: "instance-steps",
+				}))
+			})
+		})
+
+		When("no strategy is given", func() {
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{
+					Message: "the manifest property 'instance-steps' requires the canary deployment strategy ('--strategy canary' or 'strategy: canary' in the manifest)",
+				}))
+			})
+		})
+
+		When("the instance steps come from the flag", func() {
+			BeforeEach(func() {
+				overrides.InstanceSteps = []int64{10, 50, 100}
+			})
+
+			When("the manifest strategy is canary", func() {
+				BeforeEach(func() {
+					pushPlan.Strategy = constant.DeploymentStrategyCanary
+				})
+
+				It("keeps the instance steps", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(expectedPushPlan.InstanceSteps).To(Equal([]int64{10, 50, 100}))
+				})
+			})
+
+			When("the strategy is not canary", func() {
+				BeforeEach(func() {
+					pushPlan.Strategy = constant.DeploymentStrategyRolling
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{
+						Message: "--instance-steps requires the canary deployment strategy ('--strategy canary' or 'strategy: canary' in the manifest)",
+					}))
+				})
+			})
+		})
+
+		When("the instance steps are not increasing percentages", func() {
+			BeforeEach(func() {
+				pushPlan.Strategy = constant.DeploymentStrategyCanary
+				pushPlan.InstanceSteps = []int64{50, 20, 150}
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{
+					Message: "invalid value for manifest property 'instance-steps' (expected increasing percentages between 1 and 100)",
+				}))
+			})
+		})
+	})
+
+	When("the manifest specifies a strategy", func() {
+		BeforeEach(func() {
+			pushPlan.Strategy = constant.DeploymentStrategyCanary
+			maxInFlight := 3
+			overrides.MaxInFlight = &maxInFlight
+		})
+
+		It("keeps the manifest strategy and sets the max in flight", func() {
 			Expect(executeErr).ToNot(HaveOccurred())
-			Expect(expectedPushPlan.MaxInFlight).To(Equal(0))
+			Expect(expectedPushPlan.Strategy).To(Equal(constant.DeploymentStrategyCanary))
+			Expect(expectedPushPlan.MaxInFlight).To(Equal(3))
+		})
+
+		When("the strategy flag is given", func() {
+			BeforeEach(func() {
+				overrides.Strategy = constant.DeploymentStrategyRolling
+			})
+
+			It("uses the flag", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(expectedPushPlan.Strategy).To(Equal(constant.DeploymentStrategyRolling))
+			})
 		})
 	})
 
@@ -67,4 +188,60 @@ var _ = Describe("SetupDeploymentInformationForPushPlan", func() {
 			Expect(expectedPushPlan.MaxInFlight).To(Equal(0))
 		})
 	})
+
+	When("flag overrides specify auto promotion", func() {
+		BeforeEach(func() {
+			maxErrorRate := 5.0
+			overrides.AutoPromoteAfter = 5 * time.Minute
+			overrides.AutoPromoteMaxCrashes = 2
+			overrides.AutoPromoteMaxErrorRate = &maxErrorRate
+		})
+
+		When("the strategy is canary", func() {
+			BeforeEach(func() {
+				overrides.Strategy = constant.DeploymentStrategyCanary
+			})
+
+			It("sets the auto promotion thresholds on the push plan", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(expectedPushPlan.AutoPromoteAfter).To(Equal(5 * time.Minute))
+				Expect(expectedPushPlan.AutoPromoteMaxCrashes).To(Equal(2))
+				Expect(*expectedPushPlan.AutoPromoteMaxErrorRate).To(Equal(5.0))
+			})
+		})
+
+		When("the manifest strategy is canary", func() {
+			BeforeEach(func() {
+				pushPlan.Strategy = constant.DeploymentStrategyCanary
+			})
+
+			It("sets the auto promotion thresholds on the push plan", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(expectedPushPlan.AutoPromoteAfter).To(Equal(5 * time.Minute))
+				Expect(expectedPushPlan.AutoPromoteMaxCrashes).To(Equal(2))
+				Expect(*expectedPushPlan.AutoPromoteMaxErrorRate).To(Equal(5.0))
+			})
+		})
+
+		When("the strategy is not canary", func() {
+			BeforeEach(func() {
+				pushPlan.Strategy = constant.DeploymentStrategyCanary
+				overrides.Strategy = constant.DeploymentStrategyRolling
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{
+					Message: "--auto-promote-after requires the canary deployment strategy ('--strategy canary' or 'strategy: canary' in the manifest)",
+				}))
+			})
+		})
+
+		When("no strategy is given", func() {
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{
+					Message: "--auto-promote-after requires the canary deployment strategy ('--strategy canary' or 'strategy: canary' in the manifest)",
+				}))
+			})
+		})
+	})
 })
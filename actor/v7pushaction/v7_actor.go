@@ -11,18 +11,24 @@ import (
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . V7Actor
 
 type V7Actor interface {
+	CancelDeployment(deploymentGUID string) (v7action.Warnings, error)
+	ContinueDeployment(deploymentGUID string) (v7action.Warnings, error)
 	CreateApplicationDroplet(appGUID string) (resources.Droplet, v7action.Warnings, error)
 	CreateApplicationInSpace(app resources.Application, spaceGUID string) (resources.Application, v7action.Warnings, error)
 	CreateBitsPackageByApplication(appGUID string) (resources.Package, v7action.Warnings, error)
 	CreateDeployment(dep resources.Deployment) (string, v7action.Warnings, error)
 	CreateDockerPackageByApplication(appGUID string, dockerImageCredentials v7action.DockerImageCredentials) (resources.Package, v7action.Warnings, error)
 	CreateRoute(spaceGUID, domainName, hostname, path string, port int) (resources.Route, v7action.Warnings, error)
+	EvaluateCanaryHealth(app resources.Application, deployment resources.Deployment, criteria v7action.CanaryHealthCriteria, client sharedaction.LogCacheClient) (v7action.CanaryHealthReport, v7action.Warnings, error)
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (resources.Application, v7action.Warnings, error)
 	GetApplicationDroplets(appName string, spaceGUID string) ([]resources.Droplet, v7action.Warnings, error)
 	GetApplicationRoutes(appGUID string) ([]resources.Route, v7action.Warnings, error)
 	GetApplicationsByNamesAndSpace(appNames []string, spaceGUID string) ([]resources.Application, v7action.Warnings, error)
 	GetDefaultDomain(orgGUID string) (resources.Domain, v7action.Warnings, error)
+	GetDeployment(deploymentGUID string) (resources.Deployment, v7action.Warnings, error)
 	GetDomain(domainGUID string) (resources.Domain, v7action.Warnings, error)
+	GetDomainByName(domainName string) (resources.Domain, v7action.Warnings, error)
+	GetExistingApplicationsByNamesAndSpace(appNames []string, spaceGUID string) ([]resources.Application, v7action.Warnings, error)
 	GetRouteByAttributes(domain resources.Domain, hostname, path string, port int) (resources.Route, v7action.Warnings, error)
 	GetRouteDestinationByAppGUID(route resources.Route, appGUID string) (resources.RouteDestination, error)
 	MapRoute(routeGUID string, appGUID string, destinationProtocol string) (v7action.Warnings, error)
@@ -0,0 +1,185 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7pushactionfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7pushaction"
+)
+
+type FakeResourceMatchCache struct {
+	ForgetMatchesStub        func()
+	forgetMatchesMutex       sync.RWMutex
+	forgetMatchesArgsForCall []struct {
+	}
+	RecentlyMatchedStub        func(string) bool
+	recentlyMatchedMutex       sync.RWMutex
+	recentlyMatchedArgsForCall []struct {
+		arg1 string
+	}
+	recentlyMatchedReturns struct {
+		result1 bool
+	}
+	recentlyMatchedReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	RecordMatchesStub        func([]string)
+	recordMatchesMutex       sync.RWMutex
+	recordMatchesArgsForCall []struct {
+		arg1 []string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeResourceMatchCache) ForgetMatches() {
+	fake.forgetMatchesMutex.Lock()
+	fake.forgetMatchesArgsForCall = append(fake.forgetMatchesArgsForCall, struct {
+	}{})
+	stub := fake.ForgetMatchesStub
+	fake.recordInvocation("ForgetMatches", []interface{}{})
+	fake.forgetMatchesMutex.Unlock()
+	if stub != nil {
+		fake.ForgetMatchesStub()
+	}
+}
+
+func (fake *FakeResourceMatchCache) ForgetMatchesCallCount() int {
+	fake.forgetMatchesMutex.RLock()
+	defer fake.forgetMatchesMutex.RUnlock()
+	return len(fake.forgetMatchesArgsForCall)
+}
+
+func (fake *FakeResourceMatchCache) ForgetMatchesCalls(stub func()) {
+	fake.forgetMatchesMutex.Lock()
+	defer fake.forgetMatchesMutex.Unlock()
+	fake.ForgetMatchesStub = stub
+}
+
+func (fake *FakeResourceMatchCache) RecentlyMatched(arg1 string) bool {
+	fake.recentlyMatchedMutex.Lock()
+	ret, specificReturn := fake.recentlyMatchedReturnsOnCall[len(fake.recentlyMatchedArgsForCall)]
+	fake.recentlyMatchedArgsForCall = append(fake.recentlyMatchedArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RecentlyMatchedStub
+	fakeReturns := fake.recentlyMatchedReturns
+	fake.recordInvocation("RecentlyMatched", []interface{}{arg1})
+	fake.recentlyMatchedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceMatchCache) RecentlyMatchedCallCount() int {
+	fake.recentlyMatchedMutex.RLock()
+	defer fake.recentlyMatchedMutex.RUnlock()
+	return len(fake.recentlyMatchedArgsForCall)
+}
+
+func (fake *FakeResourceMatchCache) RecentlyMatchedCalls(stub func(string) bool) {
+	fake.recentlyMatchedMutex.Lock()
+	defer fake.recentlyMatchedMutex.Unlock()
+	fake.RecentlyMatchedStub = stub
+}
+
+func (fake *FakeResourceMatchCache) RecentlyMatchedArgsForCall(i int) string {
+	fake.recentlyMatchedMutex.RLock()
+	defer fake.recentlyMatchedMutex.RUnlock()
+	argsForCall := fake.recentlyMatchedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResourceMatchCache) RecentlyMatchedReturns(result1 bool) {
+	fake.recentlyMatchedMutex.Lock()
+	defer fake.recentlyMatchedMutex.Unlock()
+	fake.RecentlyMatchedStub = nil
+	fake.recentlyMatchedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeResourceMatchCache) RecentlyMatchedReturnsOnCall(i int, result1 bool) {
+	fake.recentlyMatchedMutex.Lock()
+	defer fake.recentlyMatchedMutex.Unlock()
+	fake.RecentlyMatchedStub = nil
+	if fake.recentlyMatchedReturnsOnCall == nil {
+		fake.recentlyMatchedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.recentlyMatchedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeResourceMatchCache) RecordMatches(arg1 []string) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.recordMatchesMutex.Lock()
+	fake.recordMatchesArgsForCall = append(fake.recordMatchesArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	stub := fake.RecordMatchesStub
+	fake.recordInvocation("RecordMatches", []interface{}{arg1Copy})
+	fake.recordMatchesMutex.Unlock()
+	if stub != nil {
+		fake.RecordMatchesStub(arg1)
+	}
+}
+
+func (fake *FakeResourceMatchCache) RecordMatchesCallCount() int {
+	fake.recordMatchesMutex.RLock()
+	defer fake.recordMatchesMutex.RUnlock()
+	return len(fake.recordMatchesArgsForCall)
+}
+
+func (fake *FakeResourceMatchCache) RecordMatchesCalls(stub func([]string)) {
+	fake.recordMatchesMutex.Lock()
+	defer fake.recordMatchesMutex.Unlock()
+	fake.RecordMatchesStub = stub
+}
+
+func (fake *FakeResourceMatchCache) RecordMatchesArgsForCall(i int) []string {
+	fake.recordMatchesMutex.RLock()
+	defer fake.recordMatchesMutex.RUnlock()
+	argsForCall := fake.recordMatchesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResourceMatchCache) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.forgetMatchesMutex.RLock()
+	defer fake.forgetMatchesMutex.RUnlock()
+	fake.recentlyMatchedMutex.RLock()
+	defer fake.recentlyMatchedMutex.RUnlock()
+	fake.recordMatchesMutex.RLock()
+	defer fake.recordMatchesMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeResourceMatchCache) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7pushaction.ResourceMatchCache = new(FakeResourceMatchCache)
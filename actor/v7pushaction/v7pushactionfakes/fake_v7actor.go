@@ -12,6 +12,32 @@ import (
 )
 
 type FakeV7Actor struct {
+	CancelDeploymentStub        func(string) (v7action.Warnings, error)
+	cancelDeploymentMutex       sync.RWMutex
+	cancelDeploymentArgsForCall []struct {
+		arg1 string
+	}
+	cancelDeploymentReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	cancelDeploymentReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	ContinueDeploymentStub        func(string) (v7action.Warnings, error)
+	continueDeploymentMutex       sync.RWMutex
+	continueDeploymentArgsForCall []struct {
+		arg1 string
+	}
+	continueDeploymentReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	continueDeploymentReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	CreateApplicationDropletStub        func(string) (resources.Droplet, v7action.Warnings, error)
 	createApplicationDropletMutex       sync.RWMutex
 	createApplicationDropletArgsForCall []struct {
@@ -108,6 +134,24 @@ type FakeV7Actor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	EvaluateCanaryHealthStub        func(resources.Application, resources.Deployment, v7action.CanaryHealthCriteria, sharedaction.LogCacheClient) (v7action.CanaryHealthReport, v7action.Warnings, error)
+	evaluateCanaryHealthMutex       sync.RWMutex
+	evaluateCanaryHealthArgsForCall []struct {
+		arg1 resources.Application
+		arg2 resources.Deployment
+		arg3 v7action.CanaryHealthCriteria
+		arg4 sharedaction.LogCacheClient
+	}
+	evaluateCanaryHealthReturns struct {
+		result1 v7action.CanaryHealthReport
+		result2 v7action.Warnings
+		result3 error
+	}
+	evaluateCanaryHealthReturnsOnCall map[int]struct {
+		result1 v7action.CanaryHealthReport
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationByNameAndSpaceStub        func(string, string) (resources.Application, v7action.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -186,6 +230,21 @@ type FakeV7Actor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetDeploymentStub        func(string) (resources.Deployment, v7action.Warnings, error)
+	getDeploymentMutex       sync.RWMutex
+	getDeploymentArgsForCall []struct {
+		arg1 string
+	}
+	getDeploymentReturns struct {
+		result1 resources.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDeploymentReturnsOnCall map[int]struct {
+		result1 resources.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetDomainStub        func(string) (resources.Domain, v7action.Warnings, error)
 	getDomainMutex       sync.RWMutex
 	getDomainArgsForCall []struct {
@@ -201,6 +260,37 @@ type FakeV7Actor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetDomainByNameStub        func(string) (resources.Domain, v7action.Warnings, error)
+	getDomainByNameMutex       sync.RWMutex
+	getDomainByNameArgsForCall []struct {
+		arg1 string
+	}
+	getDomainByNameReturns struct {
+		result1 resources.Domain
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDomainByNameReturnsOnCall map[int]struct {
+		result1 resources.Domain
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetExistingApplicationsByNamesAndSpaceStub        func([]string, string) ([]resources.Application, v7action.Warnings, error)
+	getExistingApplicationsByNamesAndSpaceMutex       sync.RWMutex
+	getExistingApplicationsByNamesAndSpaceArgsForCall []struct {
+		arg1 []string
+		arg2 string
+	}
+	getExistingApplicationsByNamesAndSpaceReturns struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	getExistingApplicationsByNamesAndSpaceReturnsOnCall map[int]struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRouteByAttributesStub        func(resources.Domain, string, string, int) (resources.Route, v7action.Warnings, error)
 	getRouteByAttributesMutex       sync.RWMutex
 	getRouteByAttributesArgsForCall []struct {
@@ -505,6 +595,134 @@ type FakeV7Actor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeV7Actor) CancelDeployment(arg1 string) (v7action.Warnings, error) {
+	fake.cancelDeploymentMutex.Lock()
+	ret, specificReturn := fake.cancelDeploymentReturnsOnCall[len(fake.cancelDeploymentArgsForCall)]
+	fake.cancelDeploymentArgsForCall = append(fake.cancelDeploymentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.CancelDeploymentStub
+	fakeReturns := fake.cancelDeploymentReturns
+	fake.recordInvocation("CancelDeployment", []interface{}{arg1})
+	fake.cancelDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeV7Actor) CancelDeploymentCallCount() int {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	return len(fake.cancelDeploymentArgsForCall)
+}
+
+func (fake *FakeV7Actor) CancelDeploymentCalls(stub func(string) (v7action.Warnings, error)) {
+	fake.cancelDeploymentMutex.Lock()
+	defer fake.cancelDeploymentMutex.Unlock()
+	fake.CancelDeploymentStub = stub
+}
+
+func (fake *FakeV7Actor) CancelDeploymentArgsForCall(i int) string {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	argsForCall := fake.cancelDeploymentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV7Actor) CancelDeploymentReturns(result1 v7action.Warnings, result2 error) {
+	fake.cancelDeploymentMutex.Lock()
+	defer fake.cancelDeploymentMutex.Unlock()
+	fake.CancelDeploymentStub = nil
+	fake.cancelDeploymentReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV7Actor) CancelDeploymentReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.cancelDeploymentMutex.Lock()
+	defer fake.cancelDeploymentMutex.Unlock()
+	fake.CancelDeploymentStub = nil
+	if fake.cancelDeploymentReturnsOnCall == nil {
+		fake.cancelDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.cancelDeploymentReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV7Actor) ContinueDeployment(arg1 string) (v7action.Warnings, error) {
+	fake.continueDeploymentMutex.Lock()
+	ret, specificReturn := fake.continueDeploymentReturnsOnCall[len(fake.continueDeploymentArgsForCall)]
+	fake.continueDeploymentArgsForCall = append(fake.continueDeploymentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ContinueDeploymentStub
+	fakeReturns := fake.continueDeploymentReturns
+	fake.recordInvocation("ContinueDeployment", []interface{}{arg1})
+	fake.continueDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeV7Actor) ContinueDeploymentCallCount() int {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	return len(fake.continueDeploymentArgsForCall)
+}
+
+func (fake *FakeV7Actor) ContinueDeploymentCalls(stub func(string) (v7action.Warnings, error)) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = stub
+}
+
+func (fake *FakeV7Actor) ContinueDeploymentArgsForCall(i int) string {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	argsForCall := fake.continueDeploymentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV7Actor) ContinueDeploymentReturns(result1 v7action.Warnings, result2 error) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = nil
+	fake.continueDeploymentReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV7Actor) ContinueDeploymentReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = nil
+	if fake.continueDeploymentReturnsOnCall == nil {
+		fake.continueDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.continueDeploymentReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV7Actor) CreateApplicationDroplet(arg1 string) (resources.Droplet, v7action.Warnings, error) {
 	fake.createApplicationDropletMutex.Lock()
 	ret, specificReturn := fake.createApplicationDropletReturnsOnCall[len(fake.createApplicationDropletArgsForCall)]
@@ -913,6 +1131,76 @@ func (fake *FakeV7Actor) CreateRouteReturnsOnCall(i int, result1 resources.Route
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV7Actor) EvaluateCanaryHealth(arg1 resources.Application, arg2 resources.Deployment, arg3 v7action.CanaryHealthCriteria, arg4 sharedaction.LogCacheClient) (v7action.CanaryHealthReport, v7action.Warnings, error) {
+	fake.evaluateCanaryHealthMutex.Lock()
+	ret, specificReturn := fake.evaluateCanaryHealthReturnsOnCall[len(fake.evaluateCanaryHealthArgsForCall)]
+	fake.evaluateCanaryHealthArgsForCall = append(fake.evaluateCanaryHealthArgsForCall, struct {
+		arg1 resources.Application
+		arg2 resources.Deployment
+		arg3 v7action.CanaryHealthCriteria
+		arg4 sharedaction.LogCacheClient
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.EvaluateCanaryHealthStub
+	fakeReturns := fake.evaluateCanaryHealthReturns
+	fake.recordInvocation("EvaluateCanaryHealth", []interface{}{arg1, arg2, arg3, arg4})
+	fake.evaluateCanaryHealthMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) EvaluateCanaryHealthCallCount() int {
+	fake.evaluateCanaryHealthMutex.RLock()
+	defer fake.evaluateCanaryHealthMutex.RUnlock()
+	return len(fake.evaluateCanaryHealthArgsForCall)
+}
+
+func (fake *FakeV7Actor) EvaluateCanaryHealthCalls(stub func(resources.Application, resources.Deployment, v7action.CanaryHealthCriteria, sharedaction.LogCacheClient) (v7action.CanaryHealthReport, v7action.Warnings, error)) {
+	fake.evaluateCanaryHealthMutex.Lock()
+	defer fake.evaluateCanaryHealthMutex.Unlock()
+	fake.EvaluateCanaryHealthStub = stub
+}
+
+func (fake *FakeV7Actor) EvaluateCanaryHealthArgsForCall(i int) (resources.Application, resources.Deployment, v7action.CanaryHealthCriteria, sharedaction.LogCacheClient) {
+	fake.evaluateCanaryHealthMutex.RLock()
+	defer fake.evaluateCanaryHealthMutex.RUnlock()
+	argsForCall := fake.evaluateCanaryHealthArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeV7Actor) EvaluateCanaryHealthReturns(result1 v7action.CanaryHealthReport, result2 v7action.Warnings, result3 error) {
+	fake.evaluateCanaryHealthMutex.Lock()
+	defer fake.evaluateCanaryHealthMutex.Unlock()
+	fake.EvaluateCanaryHealthStub = nil
+	fake.evaluateCanaryHealthReturns = struct {
+		result1 v7action.CanaryHealthReport
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) EvaluateCanaryHealthReturnsOnCall(i int, result1 v7action.CanaryHealthReport, result2 v7action.Warnings, result3 error) {
+	fake.evaluateCanaryHealthMutex.Lock()
+	defer fake.evaluateCanaryHealthMutex.Unlock()
+	fake.EvaluateCanaryHealthStub = nil
+	if fake.evaluateCanaryHealthReturnsOnCall == nil {
+		fake.evaluateCanaryHealthReturnsOnCall = make(map[int]struct {
+			result1 v7action.CanaryHealthReport
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.evaluateCanaryHealthReturnsOnCall[i] = struct {
+		result1 v7action.CanaryHealthReport
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV7Actor) GetApplicationByNameAndSpace(arg1 string, arg2 string) (resources.Application, v7action.Warnings, error) {
 	fake.getApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
@@ -1256,6 +1544,73 @@ func (fake *FakeV7Actor) GetDefaultDomainReturnsOnCall(i int, result1 resources.
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV7Actor) GetDeployment(arg1 string) (resources.Deployment, v7action.Warnings, error) {
+	fake.getDeploymentMutex.Lock()
+	ret, specificReturn := fake.getDeploymentReturnsOnCall[len(fake.getDeploymentArgsForCall)]
+	fake.getDeploymentArgsForCall = append(fake.getDeploymentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetDeploymentStub
+	fakeReturns := fake.getDeploymentReturns
+	fake.recordInvocation("GetDeployment", []interface{}{arg1})
+	fake.getDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetDeploymentCallCount() int {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	return len(fake.getDeploymentArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetDeploymentCalls(stub func(string) (resources.Deployment, v7action.Warnings, error)) {
+	fake.getDeploymentMutex.Lock()
+	defer fake.getDeploymentMutex.Unlock()
+	fake.GetDeploymentStub = stub
+}
+
+func (fake *FakeV7Actor) GetDeploymentArgsForCall(i int) string {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	argsForCall := fake.getDeploymentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV7Actor) GetDeploymentReturns(result1 resources.Deployment, result2 v7action.Warnings, result3 error) {
+	fake.getDeploymentMutex.Lock()
+	defer fake.getDeploymentMutex.Unlock()
+	fake.GetDeploymentStub = nil
+	fake.getDeploymentReturns = struct {
+		result1 resources.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetDeploymentReturnsOnCall(i int, result1 resources.Deployment, result2 v7action.Warnings, result3 error) {
+	fake.getDeploymentMutex.Lock()
+	defer fake.getDeploymentMutex.Unlock()
+	fake.GetDeploymentStub = nil
+	if fake.getDeploymentReturnsOnCall == nil {
+		fake.getDeploymentReturnsOnCall = make(map[int]struct {
+			result1 resources.Deployment
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDeploymentReturnsOnCall[i] = struct {
+		result1 resources.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV7Actor) GetDomain(arg1 string) (resources.Domain, v7action.Warnings, error) {
 	fake.getDomainMutex.Lock()
 	ret, specificReturn := fake.getDomainReturnsOnCall[len(fake.getDomainArgsForCall)]
@@ -1323,6 +1678,146 @@ func (fake *FakeV7Actor) GetDomainReturnsOnCall(i int, result1 resources.Domain,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV7Actor) GetDomainByName(arg1 string) (resources.Domain, v7action.Warnings, error) {
+	fake.getDomainByNameMutex.Lock()
+	ret, specificReturn := fake.getDomainByNameReturnsOnCall[len(fake.getDomainByNameArgsForCall)]
+	fake.getDomainByNameArgsForCall = append(fake.getDomainByNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetDomainByNameStub
+	fakeReturns := fake.getDomainByNameReturns
+	fake.recordInvocation("GetDomainByName", []interface{}{arg1})
+	fake.getDomainByNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetDomainByNameCallCount() int {
+	fake.getDomainByNameMutex.RLock()
+	defer fake.getDomainByNameMutex.RUnlock()
+	return len(fake.getDomainByNameArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetDomainByNameCalls(stub func(string) (resources.Domain, v7action.Warnings, error)) {
+	fake.getDomainByNameMutex.Lock()
+	defer fake.getDomainByNameMutex.Unlock()
+	fake.GetDomainByNameStub = stub
+}
+
+func (fake *FakeV7Actor) GetDomainByNameArgsForCall(i int) string {
+	fake.getDomainByNameMutex.RLock()
+	defer fake.getDomainByNameMutex.RUnlock()
+	argsForCall := fake.getDomainByNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV7Actor) GetDomainByNameReturns(result1 resources.Domain, result2 v7action.Warnings, result3 error) {
+	fake.getDomainByNameMutex.Lock()
+	defer fake.getDomainByNameMutex.Unlock()
+	fake.GetDomainByNameStub = nil
+	fake.getDomainByNameReturns = struct {
+		result1 resources.Domain
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetDomainByNameReturnsOnCall(i int, result1 resources.Domain, result2 v7action.Warnings, result3 error) {
+	fake.getDomainByNameMutex.Lock()
+	defer fake.getDomainByNameMutex.Unlock()
+	fake.GetDomainByNameStub = nil
+	if fake.getDomainByNameReturnsOnCall == nil {
+		fake.getDomainByNameReturnsOnCall = make(map[int]struct {
+			result1 resources.Domain
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDomainByNameReturnsOnCall[i] = struct {
+		result1 resources.Domain
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetExistingApplicationsByNamesAndSpace(arg1 []string, arg2 string) ([]resources.Application, v7action.Warnings, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getExistingApplicationsByNamesAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getExistingApplicationsByNamesAndSpaceReturnsOnCall[len(fake.getExistingApplicationsByNamesAndSpaceArgsForCall)]
+	fake.getExistingApplicationsByNamesAndSpaceArgsForCall = append(fake.getExistingApplicationsByNamesAndSpaceArgsForCall, struct {
+		arg1 []string
+		arg2 string
+	}{arg1Copy, arg2})
+	stub := fake.GetExistingApplicationsByNamesAndSpaceStub
+	fakeReturns := fake.getExistingApplicationsByNamesAndSpaceReturns
+	fake.recordInvocation("GetExistingApplicationsByNamesAndSpace", []interface{}{arg1Copy, arg2})
+	fake.getExistingApplicationsByNamesAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetExistingApplicationsByNamesAndSpaceCallCount() int {
+	fake.getExistingApplicationsByNamesAndSpaceMutex.RLock()
+	defer fake.getExistingApplicationsByNamesAndSpaceMutex.RUnlock()
+	return len(fake.getExistingApplicationsByNamesAndSpaceArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetExistingApplicationsByNamesAndSpaceCalls(stub func([]string, string) ([]resources.Application, v7action.Warnings, error)) {
+	fake.getExistingApplicationsByNamesAndSpaceMutex.Lock()
+	defer fake.getExistingApplicationsByNamesAndSpaceMutex.Unlock()
+	fake.GetExistingApplicationsByNamesAndSpaceStub = stub
+}
+
+func (fake *FakeV7Actor) GetExistingApplicationsByNamesAndSpaceArgsForCall(i int) ([]string, string) {
+	fake.getExistingApplicationsByNamesAndSpaceMutex.RLock()
+	defer fake.getExistingApplicationsByNamesAndSpaceMutex.RUnlock()
+	argsForCall := fake.getExistingApplicationsByNamesAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeV7Actor) GetExistingApplicationsByNamesAndSpaceReturns(result1 []resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.getExistingApplicationsByNamesAndSpaceMutex.Lock()
+	defer fake.getExistingApplicationsByNamesAndSpaceMutex.Unlock()
+	fake.GetExistingApplicationsByNamesAndSpaceStub = nil
+	fake.getExistingApplicationsByNamesAndSpaceReturns = struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetExistingApplicationsByNamesAndSpaceReturnsOnCall(i int, result1 []resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.getExistingApplicationsByNamesAndSpaceMutex.Lock()
+	defer fake.getExistingApplicationsByNamesAndSpaceMutex.Unlock()
+	fake.GetExistingApplicationsByNamesAndSpaceStub = nil
+	if fake.getExistingApplicationsByNamesAndSpaceReturnsOnCall == nil {
+		fake.getExistingApplicationsByNamesAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []resources.Application
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getExistingApplicationsByNamesAndSpaceReturnsOnCall[i] = struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV7Actor) GetRouteByAttributes(arg1 resources.Domain, arg2 string, arg3 string, arg4 int) (resources.Route, v7action.Warnings, error) {
 	fake.getRouteByAttributesMutex.Lock()
 	ret, specificReturn := fake.getRouteByAttributesReturnsOnCall[len(fake.getRouteByAttributesArgsForCall)]
@@ -2673,6 +3168,10 @@ func (fake *FakeV7Actor) UploadDropletReturnsOnCall(i int, result1 v7action.Warn
 func (fake *FakeV7Actor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
 	fake.createApplicationDropletMutex.RLock()
 	defer fake.createApplicationDropletMutex.RUnlock()
 	fake.createApplicationInSpaceMutex.RLock()
@@ -2685,6 +3184,8 @@ func (fake *FakeV7Actor) Invocations() map[string][][]interface{} {
 	defer fake.createDockerPackageByApplicationMutex.RUnlock()
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
+	fake.evaluateCanaryHealthMutex.RLock()
+	defer fake.evaluateCanaryHealthMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationDropletsMutex.RLock()
@@ -2695,8 +3196,14 @@ func (fake *FakeV7Actor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationsByNamesAndSpaceMutex.RUnlock()
 	fake.getDefaultDomainMutex.RLock()
 	defer fake.getDefaultDomainMutex.RUnlock()
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
 	fake.getDomainMutex.RLock()
 	defer fake.getDomainMutex.RUnlock()
+	fake.getDomainByNameMutex.RLock()
+	defer fake.getDomainByNameMutex.RUnlock()
+	fake.getExistingApplicationsByNamesAndSpaceMutex.RLock()
+	defer fake.getExistingApplicationsByNamesAndSpaceMutex.RUnlock()
 	fake.getRouteByAttributesMutex.RLock()
 	defer fake.getRouteByAttributesMutex.RUnlock()
 	fake.getRouteDestinationByAppGUIDMutex.RLock()
@@ -238,6 +238,50 @@ var _ = Describe("Deployment", func() {
 		})
 	})
 
+	Describe("Create a canary deployment with instance steps", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/deployments"),
+					VerifyJSON(`{
+						"droplet": {"guid": "some-droplet-guid"},
+						"strategy": "canary",
+						"options": {
+							"max_in_flight": 2,
+							"canary": {"steps": [{"instance_weight": 10}, {"instance_weight": 50}, {"instance_weight": 100}]}
+						},
+						"relationships": {"app": {"data": {"guid": "some-app-guid"}}}
+					}`),
+					RespondWith(http.StatusAccepted, `{"guid": "some-deployment-guid"}`, http.Header{"X-Cf-Warnings": {"warning"}}),
+				),
+			)
+		})
+
+		JustBeforeEach(func() {
+			_, warnings, executeErr = client.CreateApplicationDeployment(resources.Deployment{
+				Strategy:    constant.DeploymentStrategyCanary,
+				DropletGUID: "some-droplet-guid",
+				Options: resources.DeploymentOpts{
+					MaxInFlight: 2,
+					CanaryDeploymentOptions: &resources.CanaryDeploymentOptions{
+						Steps: []resources.CanaryStep{{InstanceWeight: 10}, {InstanceWeight: 50}, {InstanceWeight: 100}},
+					},
+				},
+				Relationships: resources.Relationships{constant.RelationshipTypeApplication: resources.Relationship{GUID: "some-app-guid"}},
+			})
+		})
+
+		It("sends the canary steps in the deployment options", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning"))
+		})
+	})
+
 	Describe("Create a deployment with app and revision guids", func() {
 		var (
 			deploymentGUID string
@@ -347,6 +391,10 @@ var _ = Describe("Deployment", func() {
  					"previous_droplet": {
  					  "guid": "some-other-droplet-guid"
  					},
+					"revision": {
+					  "guid": "some-revision-guid",
+					  "version": 3
+					},
  					"created_at": "some-time",
  					"updated_at": "some-later-time",
  					"relationships": {
@@ -374,6 +422,48 @@ var _ = Describe("Deployment", func() {
 				Expect(deployment.StatusValue).To(Equal(constant.DeploymentStatusValueFinalized))
 				Expect(deployment.StatusReason).To(Equal(constant.DeploymentStatusReasonSuperseded))
 				Expect(deployment.Strategy).To(Equal(constant.DeploymentStrategyCanary))
+				Expect(deployment.DropletGUID).To(Equal("some-droplet-guid"))
+				Expect(deployment.PreviousDropletGUID).To(Equal("some-other-droplet-guid"))
+				Expect(deployment.RevisionGUID).To(Equal("some-revision-guid"))
+				Expect(deployment.RevisionVersion).To(Equal(3))
+				Expect(deployment.CreatedAt).To(Equal("some-time"))
+				Expect(deployment.UpdatedAt).To(Equal("some-later-time"))
+			})
+		})
+
+		Context("when the deployment is a paused multi-step canary", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-deployment-guid",
+					"state": "PAUSED",
+					"strategy": "canary",
+					"status": {
+						"value": "ACTIVE",
+						"reason": "PAUSED",
+						"canary": {"steps": {"current": 2, "total": 4}}
+					},
+					"options": {
+						"max_in_flight": 1,
+						"canary": {"steps": [{"instance_weight": 10}, {"instance_weight": 25}, {"instance_weight": 50}, {"instance_weight": 100}]}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments/some-deployment-guid"),
+						RespondWith(http.StatusOK, response, nil),
+					),
+				)
+			})
+
+			It("returns the canary steps and the current step", func() {
+				deployment, _, err := client.GetDeployment("some-deployment-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deployment.CanaryStatus).To(Equal(resources.CanaryStatus{
+					Steps: resources.CanaryStepStatus{CurrentStep: 2, TotalSteps: 4},
+				}))
+				Expect(deployment.Options.CanaryDeploymentOptions).To(Equal(&resources.CanaryDeploymentOptions{
+					Steps: []resources.CanaryStep{{InstanceWeight: 10}, {InstanceWeight: 25}, {InstanceWeight: 50}, {InstanceWeight: 100}},
+				}))
 			})
 		})
 
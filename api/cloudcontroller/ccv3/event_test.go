@@ -100,10 +100,13 @@ var _ = Describe("Event", func() {
 				Expect(warnings).To(ConsistOf("warning"))
 				Expect(events).To(ConsistOf(
 					Event{
-						GUID:      "some-event-guid",
-						CreatedAt: timestamp,
-						Type:      "audit.app.update",
-						ActorName: "admin",
+						GUID:       "some-event-guid",
+						CreatedAt:  timestamp,
+						Type:       "audit.app.update",
+						ActorName:  "admin",
+						TargetGUID: "2e3151ba-9a63-4345-9c5b-6d8c238f4e55",
+						TargetType: "app",
+						TargetName: "my-app",
 						Data: map[string]interface{}{
 							"request": map[string]interface{}{
 								"recursive": true,
@@ -24,6 +24,7 @@ const (
 	DeleteSecurityGroupRequest                                  = "DeleteSecurityGroup"
 	DeleteSecurityGroupStagingSpaceRequest                      = "DeleteSecurityGroupStagingSpace"
 	DeleteSecurityGroupRunningSpaceRequest                      = "DeleteSecurityGroupRunningSpace"
+	DeleteSidecarRequest                                        = "DeleteSidecar"
 	DeleteServiceCredentialBindingRequest                       = "DeleteServiceCredentialBinding"
 	DeleteServiceBrokerRequest                                  = "DeleteServiceBrokerRequest"
 	DeleteServiceInstanceRelationshipsSharedSpaceRequest        = "DeleteServiceInstanceRelationshipsSharedSpace"
@@ -44,6 +45,7 @@ const (
 	GetApplicationRevisionsRequest                              = "GetApplicationRevisions"
 	GetApplicationRevisionsDeployedRequest                      = "GetApplicationRevisionsDeployed"
 	GetApplicationRoutesRequest                                 = "GetApplicationRoutes"
+	GetApplicationSidecarsRequest                               = "GetApplicationSidecars"
 	GetApplicationTasksRequest                                  = "GetApplicationTasks"
 	GetApplicationsRequest                                      = "GetApplications"
 	GetBuildRequest                                             = "GetBuild"
@@ -85,6 +87,7 @@ const (
 	GetServiceBrokersRequest                                    = "GetServiceBrokers"
 	GetServiceCredentialBindingsRequest                         = "GetServiceCredentialBindings"
 	GetServiceCredentialBindingDetailsRequest                   = "GetServiceCredentialBindingDetails"
+	GetServiceInstanceCredentialsRequest                        = "GetServiceInstanceCredentials"
 	GetServiceInstanceParametersRequest                         = "GetServiceInstanceParameters"
 	GetServiceInstancesRequest                                  = "GetServiceInstances"
 	GetServiceInstanceRelationshipsSharedSpacesRequest          = "GetServiceInstanceRelationshipSharedSpacesRequest"
@@ -126,6 +129,7 @@ const (
 	PatchServiceInstanceRequest                                 = "PatchServiceInstance"
 	PatchServiceOfferingRequest                                 = "PatchServiceOfferingRequest"
 	PatchServicePlanRequest                                     = "PatchServicePlanRequest"
+	PatchSidecarRequest                                         = "PatchSidecar"
 	PatchSpaceRelationshipIsolationSegmentRequest               = "PatchSpaceRelationshipIsolationSegment"
 	PatchSpaceRequest                                           = "PatchSpace"
 	PatchSpaceFeaturesRequest                                   = "PatchSpaceFeatures"
@@ -141,6 +145,7 @@ const (
 	PostApplicationDeploymentRequest                            = "PostApplicationDeployment"
 	PostApplicationProcessActionScaleRequest                    = "PostApplicationProcessActionScale"
 	PostApplicationRequest                                      = "PostApplication"
+	PostApplicationSidecarRequest                               = "PostApplicationSidecar"
 	PostApplicationTasksRequest                                 = "PostApplicationTasks"
 	PostBuildRequest                                            = "PostBuild"
 	PostBuildpackBitsRequest                                    = "PostBuildpackBits"
@@ -205,6 +210,8 @@ var APIRoutes = map[string]Route{
 	GetApplicationRevisionsRequest:                              {Path: "/v3/apps/:app_guid/revisions", Method: http.MethodGet},
 	GetApplicationRevisionsDeployedRequest:                      {Path: "/v3/apps/:app_guid/revisions/deployed", Method: http.MethodGet},
 	GetApplicationRoutesRequest:                                 {Path: "/v3/apps/:app_guid/routes", Method: http.MethodGet},
+	GetApplicationSidecarsRequest:                               {Path: "/v3/apps/:app_guid/sidecars", Method: http.MethodGet},
+	PostApplicationSidecarRequest:                               {Path: "/v3/apps/:app_guid/sidecars", Method: http.MethodPost},
 	GetSSHEnabled:                                               {Path: "/v3/apps/:app_guid/ssh_enabled", Method: http.MethodGet},
 	GetApplicationTasksRequest:                                  {Path: "/v3/apps/:app_guid/tasks", Method: http.MethodGet},
 	PostApplicationTasksRequest:                                 {Path: "/v3/apps/:app_guid/tasks", Method: http.MethodPost},
@@ -294,6 +301,8 @@ var APIRoutes = map[string]Route{
 	DeleteSecurityGroupStagingSpaceRequest:                      {Path: "/v3/security_groups/:security_group_guid/relationships/staging_spaces/:space_guid", Method: http.MethodDelete},
 	DeleteSecurityGroupRunningSpaceRequest:                      {Path: "/v3/security_groups/:security_group_guid/relationships/running_spaces/:space_guid", Method: http.MethodDelete},
 	PatchSecurityGroupRequest:                                   {Path: "/v3/security_groups/:security_group_guid", Method: http.MethodPatch},
+	DeleteSidecarRequest:                                        {Path: "/v3/sidecars/:sidecar_guid", Method: http.MethodDelete},
+	PatchSidecarRequest:                                         {Path: "/v3/sidecars/:sidecar_guid", Method: http.MethodPatch},
 	GetServiceBrokersRequest:                                    {Path: "/v3/service_brokers", Method: http.MethodGet},
 	PostServiceBrokerRequest:                                    {Path: "/v3/service_brokers", Method: http.MethodPost},
 	DeleteServiceBrokerRequest:                                  {Path: "/v3/service_brokers/:service_broker_guid", Method: http.MethodDelete},
@@ -304,6 +313,7 @@ var APIRoutes = map[string]Route{
 	GetServiceCredentialBindingDetailsRequest:                   {Path: "/v3/service_credential_bindings/:service_credential_binding_guid/details", Method: http.MethodGet},
 	GetServiceInstancesRequest:                                  {Path: "/v3/service_instances", Method: http.MethodGet},
 	PostServiceInstanceRequest:                                  {Path: "/v3/service_instances", Method: http.MethodPost},
+	GetServiceInstanceCredentialsRequest:                        {Path: "/v3/service_instances/:service_instance_guid/credentials", Method: http.MethodGet},
 	GetServiceInstanceParametersRequest:                         {Path: "/v3/service_instances/:service_instance_guid/parameters", Method: http.MethodGet},
 	PatchServiceInstanceRequest:                                 {Path: "/v3/service_instances/:service_instance_guid", Method: http.MethodPatch},
 	DeleteServiceInstanceRequest:                                {Path: "/v3/service_instances/:service_instance_guid", Method: http.MethodDelete},
@@ -35,6 +35,12 @@ const (
 	StatusValueFilter QueryKey = "status_values"
 	// DomainGUIDFilter is a query param for listing events by target_guid
 	TargetGUIDFilter QueryKey = "target_guids"
+	// EventTypesFilter is a query param for listing events by type
+	EventTypesFilter QueryKey = "types"
+	// CreatedAtsAfterOrEqualFilter is a query param for listing objects created at or after a timestamp
+	CreatedAtsAfterOrEqualFilter QueryKey = "created_ats[gte]"
+	// CreatedAtsBeforeOrEqualFilter is a query param for listing objects created at or before a timestamp
+	CreatedAtsBeforeOrEqualFilter QueryKey = "created_ats[lte]"
 	// DomainGUIDFilter is a query param for listing objects by domain_guid
 	DomainGUIDFilter QueryKey = "domain_guids"
 	// HostsFilter is a query param for listing objects by hostname
@@ -69,6 +69,18 @@ func (client *Client) GetServiceInstanceParameters(serviceInstanceGUID string) (
 	return
 }
 
+// GetServiceInstanceCredentials returns the credentials of a user-provided
+// service instance.
+func (client *Client) GetServiceInstanceCredentials(serviceInstanceGUID string) (credentials types.JSONObject, warnings Warnings, err error) {
+	_, warnings, err = client.MakeRequest(RequestParams{
+		RequestName:  internal.GetServiceInstanceCredentialsRequest,
+		URIParams:    internal.Params{"service_instance_guid": serviceInstanceGUID},
+		ResponseBody: &credentials,
+	})
+
+	return
+}
+
 func (client *Client) CreateServiceInstance(serviceInstance resources.ServiceInstance) (JobURL, Warnings, error) {
 	return client.MakeRequest(RequestParams{
 		RequestName: internal.PostServiceInstanceRequest,
@@ -84,14 +84,20 @@ var _ = Describe("Sidecar", func() {
 				Expect(warnings).To(ConsistOf("this is a warning"))
 				Expect(len(processSidecars)).To(Equal(2))
 				Expect(processSidecars[0]).To(MatchAllFields(Fields{
-					"GUID":    Equal("process-1-guid"),
-					"Name":    Equal("auth-sidecar"),
-					"Command": Equal(types.FilteredString{IsSet: true, Value: "bundle exec rackup"}),
+					"GUID":         Equal("process-1-guid"),
+					"Name":         Equal("auth-sidecar"),
+					"Command":      Equal(types.FilteredString{IsSet: true, Value: "bundle exec rackup"}),
+					"ProcessTypes": Equal([]string{"web", "worker"}),
+					"MemoryInMB":   Equal(types.NullUint64{IsSet: true, Value: 300}),
+					"Origin":       BeEmpty(),
 				}))
 				Expect(processSidecars[1]).To(MatchAllFields(Fields{
-					"GUID":    Equal("process-2-guid"),
-					"Name":    Equal("echo-sidecar"),
-					"Command": Equal(types.FilteredString{IsSet: true, Value: "start-echo-server"}),
+					"GUID":         Equal("process-2-guid"),
+					"Name":         Equal("echo-sidecar"),
+					"Command":      Equal(types.FilteredString{IsSet: true, Value: "start-echo-server"}),
+					"ProcessTypes": Equal([]string{"web"}),
+					"MemoryInMB":   Equal(types.NullUint64{IsSet: true, Value: 300}),
+					"Origin":       BeEmpty(),
 				}))
 			})
 		})
@@ -185,4 +191,213 @@ var _ = Describe("Sidecar", func() {
 			})
 		})
 	})
+
+	Describe("GetApplicationSidecars", func() {
+		var (
+			sidecars []resources.Sidecar
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			sidecars, warnings, err = client.GetApplicationSidecars("some-app-guid")
+		})
+
+		When("the app has sidecars", func() {
+			BeforeEach(func() {
+				response := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"guid": "sidecar-guid",
+							"name": "envoy",
+							"command": "start-envoy",
+							"process_types": ["web"],
+							"memory_in_mb": 128,
+							"origin": "user"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/sidecars"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the sidecars and all warnings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(sidecars).To(ConsistOf(resources.Sidecar{
+					GUID:         "sidecar-guid",
+					Name:         "envoy",
+					Command:      types.FilteredString{IsSet: true, Value: "start-envoy"},
+					ProcessTypes: []string{"web"},
+					MemoryInMB:   types.NullUint64{IsSet: true, Value: 128},
+					Origin:       "user",
+				}))
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"detail": "App not found",
+							"title": "CF-ResourceNotFound",
+							"code": 10010
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/sidecars"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns an error and warnings", func() {
+				Expect(err).To(MatchError(ccerror.ApplicationNotFoundError{}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("CreateApplicationSidecar", func() {
+		var (
+			sidecar  resources.Sidecar
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			sidecar, warnings, err = client.CreateApplicationSidecar("some-app-guid", resources.Sidecar{
+				Name:         "envoy",
+				Command:      types.FilteredString{IsSet: true, Value: "start-envoy"},
+				ProcessTypes: []string{"web", "worker"},
+				MemoryInMB:   types.NullUint64{IsSet: true, Value: 128},
+			})
+		})
+
+		When("the sidecar is created", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "sidecar-guid",
+					"name": "envoy",
+					"command": "start-envoy",
+					"process_types": ["web", "worker"],
+					"memory_in_mb": 128,
+					"origin": "user"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/sidecars"),
+						VerifyJSON(`{"name": "envoy", "command": "start-envoy", "process_types": ["web", "worker"], "memory_in_mb": 128}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created sidecar and all warnings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(sidecar.GUID).To(Equal("sidecar-guid"))
+				Expect(sidecar.Origin).To(Equal("user"))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "Sidecar with name 'envoy' already exists for given app",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/sidecars"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "Sidecar with name 'envoy' already exists for given app"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UpdateSidecar", func() {
+		var (
+			sidecar  resources.Sidecar
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			sidecar, warnings, err = client.UpdateSidecar(resources.Sidecar{
+				GUID:       "sidecar-guid",
+				MemoryInMB: types.NullUint64{IsSet: true, Value: 256},
+			})
+		})
+
+		BeforeEach(func() {
+			response := `{
+				"guid": "sidecar-guid",
+				"name": "envoy",
+				"command": "start-envoy",
+				"process_types": ["web"],
+				"memory_in_mb": 256,
+				"origin": "user"
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, "/v3/sidecars/sidecar-guid"),
+					VerifyJSON(`{"memory_in_mb": 256}`),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("only sends the set fields and returns the updated sidecar", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(sidecar.MemoryInMB).To(Equal(types.NullUint64{IsSet: true, Value: 256}))
+		})
+	})
+
+	Describe("DeleteSidecar", func() {
+		var (
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			warnings, err = client.DeleteSidecar("sidecar-guid")
+		})
+
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/v3/sidecars/sidecar-guid"),
+					RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("deletes the sidecar and returns all warnings", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
 })
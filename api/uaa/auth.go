@@ -60,7 +60,11 @@ func (client Client) Authenticate(creds map[string]string, origin string, grantT
 		return "", "", err
 	}
 
-	if grantType == constant.GrantTypePassword {
+	switch grantType {
+	case constant.GrantTypePassword,
+		constant.GrantTypeAuthorizationCode,
+		constant.GrantTypeDeviceCode,
+		constant.GrantTypeJWTBearer:
 		request.SetBasicAuth(client.config.UAAOAuthClient(), client.config.UAAOAuthClientSecret())
 	}
 
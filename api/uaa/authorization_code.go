@@ -0,0 +1,39 @@
+package uaa
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+// AuthorizationCodeURL returns the URL of the login server's authorize page
+// that starts an authorization code grant for the configured client. The grant
+// is protected with PKCE (RFC 7636) using the S256 code challenge method, and
+// the code is sent to redirectURI along with state once the user has logged
+// in.
+func (client Client) AuthorizationCodeURL(redirectURI string, state string, codeChallenge string, origin string) (string, error) {
+	authorizeURL, err := url.Parse(strings.TrimSuffix(client.LoginLink(), "/") + "/oauth/authorize")
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {client.config.UAAOAuthClient()},
+		"redirect_uri":          {redirectURI},
+		"state":                 {state},
+		"code_challenge":        {codeChallenge},
+		"code_challenge_method": {"S256"},
+	}
+
+	if origin != "" {
+		loginHint, err := json.Marshal(map[string]string{"origin": origin})
+		if err != nil {
+			return "", err
+		}
+		query.Set("login_hint", string(loginHint))
+	}
+
+	authorizeURL.RawQuery = query.Encode()
+	return authorizeURL.String(), nil
+}
@@ -0,0 +1,57 @@
+package uaa_test
+
+import (
+	"net/url"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuthorizationCodeURL", func() {
+	var (
+		client *Client
+
+		origin       string
+		authorizeURL string
+		executeErr   error
+	)
+
+	BeforeEach(func() {
+		client = NewTestUAAClientAndStore(NewTestConfig())
+		origin = ""
+	})
+
+	JustBeforeEach(func() {
+		authorizeURL, executeErr = client.AuthorizationCodeURL("http://127.0.0.1:1234/callback", "some-state", "some-challenge", origin)
+	})
+
+	It("returns the login server's authorize URL with a PKCE challenge", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		parsedURL, err := url.Parse(authorizeURL)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parsedURL.Scheme + "://" + parsedURL.Host).To(Equal(client.LoginLink()))
+		Expect(parsedURL.Path).To(Equal("/oauth/authorize"))
+		Expect(parsedURL.Query()).To(Equal(url.Values{
+			"response_type":         {"code"},
+			"client_id":             {"client-id"},
+			"redirect_uri":          {"http://127.0.0.1:1234/callback"},
+			"state":                 {"some-state"},
+			"code_challenge":        {"some-challenge"},
+			"code_challenge_method": {"S256"},
+		}))
+	})
+
+	When("an origin is given", func() {
+		BeforeEach(func() {
+			origin = "some-origin"
+		})
+
+		It("passes the origin as a login hint", func() {
+			parsedURL, err := url.Parse(authorizeURL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsedURL.Query().Get("login_hint")).To(Equal(`{"origin":"some-origin"}`))
+		})
+	})
+})
@@ -4,9 +4,18 @@ package constant
 type GrantType string
 
 const (
+	// GrantTypeAuthorizationCode is used for exchanging the code received
+	// after a user logs in through a browser.
+	GrantTypeAuthorizationCode GrantType = "authorization_code"
 	// GrantTypeClientCredentials is used for a preconfigured client ID/secret
 	// authentication.
 	GrantTypeClientCredentials GrantType = "client_credentials"
+	// GrantTypeDeviceCode is used for exchanging a device code once the user
+	// has approved it on another device (RFC 8628).
+	GrantTypeDeviceCode GrantType = "urn:ietf:params:oauth:grant-type:device_code"
+	// GrantTypeJWTBearer is used for exchanging a JWT issued by a trusted
+	// identity provider, such as a CI system's OIDC ID token (RFC 7523).
+	GrantTypeJWTBearer GrantType = "urn:ietf:params:oauth:grant-type:jwt-bearer"
 	// GrantTypePassword is used for user's username/password authentication.
 	GrantTypePassword     GrantType = "password"
 	GrantTypeRefreshToken GrantType = "refresh_token"
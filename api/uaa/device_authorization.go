@@ -0,0 +1,53 @@
+package uaa
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// DeviceAuthorization is the response to a device authorization request
+// (RFC 8628). The user approves the device by visiting VerificationURI and
+// entering UserCode, while the client polls for tokens with DeviceCode every
+// Interval seconds until ExpiresIn seconds have passed.
+type DeviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// RequestDeviceAuthorization starts a device authorization grant for the
+// configured client. The tokens are then obtained by authenticating with the
+// device code and constant.GrantTypeDeviceCode, which returns
+// AuthorizationPendingError or SlowDownError until the user approves the
+// device.
+func (client Client) RequestDeviceAuthorization() (DeviceAuthorization, error) {
+	requestBody := url.Values{
+		"client_id": {client.config.UAAOAuthClient()},
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PostDeviceAuthorizationRequest,
+		Header: http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"},
+		},
+		Body: strings.NewReader(requestBody.Encode()),
+	})
+	if err != nil {
+		return DeviceAuthorization{}, err
+	}
+	request.SetBasicAuth(client.config.UAAOAuthClient(), client.config.UAAOAuthClientSecret())
+
+	var authorization DeviceAuthorization
+	response := Response{
+		Result: &authorization,
+	}
+
+	err = client.connection.Make(request, &response)
+	return authorization, err
+}
@@ -48,6 +48,12 @@ func convert(rawHTTPStatusErr RawHTTPStatusError) error {
 		if uaaErrorResponse.Type == "invalid_scim_resource" {
 			return InvalidSCIMResourceError{Message: uaaErrorResponse.Description}
 		}
+		if uaaErrorResponse.Type == "authorization_pending" {
+			return AuthorizationPendingError{Message: uaaErrorResponse.Description}
+		}
+		if uaaErrorResponse.Type == "slow_down" {
+			return SlowDownError{Message: uaaErrorResponse.Description}
+		}
 		return rawHTTPStatusErr
 	case http.StatusUnauthorized: // 401
 		if uaaErrorResponse.Type == "invalid_token" {
@@ -83,6 +83,28 @@ var _ = Describe("Error Wrapper", func() {
 						Expect(makeErr).To(MatchError(InvalidSCIMResourceError{Message: "A username must be provided"}))
 					})
 				})
+
+				Context("authorization pending", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{"error":"authorization_pending","error_description":"The user has not approved the device yet"}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns an AuthorizationPendingError", func() {
+						Expect(makeErr).To(MatchError(AuthorizationPendingError{Message: "The user has not approved the device yet"}))
+					})
+				})
+
+				Context("slow down", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{"error":"slow_down"}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns a SlowDownError", func() {
+						Expect(makeErr).To(MatchError(SlowDownError{}))
+					})
+				})
 			})
 
 			Context("(401) Unauthorized", func() {
@@ -104,3 +104,23 @@ type InvalidPasswordError struct {
 func (e InvalidPasswordError) Error() string {
 	return e.Message
 }
+
+// AuthorizationPendingError is returned when polling for a device code's
+// tokens before the user has approved the device.
+type AuthorizationPendingError struct {
+	Message string
+}
+
+func (e AuthorizationPendingError) Error() string {
+	return e.Message
+}
+
+// SlowDownError is returned when polling for a device code's tokens too
+// often. The polling interval must be increased by 5 seconds.
+type SlowDownError struct {
+	Message string
+}
+
+func (e SlowDownError) Error() string {
+	return e.Message
+}
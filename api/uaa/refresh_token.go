@@ -31,6 +31,13 @@ func (client *Client) RefreshAccessToken(refreshToken string) (RefreshedTokens,
 		values = client.clientCredentialRefreshBody()
 	case "", string(constant.GrantTypePassword): // CLI used to write empty string for grant type in the case of password; preserve compatibility with old config.json files
 		values = client.refreshTokenBody(refreshToken)
+	case string(constant.GrantTypeJWTBearer):
+		// The assertion is short-lived and is not stored, so the token can only
+		// be refreshed if UAA issued a refresh token with it.
+		if refreshToken == "" {
+			return RefreshedTokens{}, InvalidAuthTokenError{Message: "The access token obtained with an assertion has expired and cannot be refreshed."}
+		}
+		values = client.refreshTokenBody(refreshToken)
 	}
 
 	body := strings.NewReader(values.Encode())
@@ -205,7 +205,7 @@ func (uaa UAARepository) RefreshToken(t string) (string, error) {
 		data.Add("client_id", uaa.config.UAAOAuthClient())
 		data.Add("client_secret", uaa.config.UAAOAuthClientSecret())
 		data.Add("grant_type", "client_credentials")
-	case "", "password": // CLI used to leave field blank for password; preserve compatibility with old files
+	case "", "password", "urn:ietf:params:oauth:grant-type:jwt-bearer": // CLI used to leave field blank for password; preserve compatibility with old files
 		data.Add("grant_type", "refresh_token")
 		data.Add("refresh_token", uaa.config.RefreshToken())
 		data.Add("scope", "")
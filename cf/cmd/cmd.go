@@ -22,6 +22,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/cf/trace"
 	"code.cloudfoundry.org/cli/cf/util/spellcheck"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/plugin/rpc"
 
 	netrpc "net/rpc"
@@ -48,6 +49,9 @@ func Main(traceEnv string, args []string) {
 	newArgs, isVerbose := handleVerbose(args)
 	args = newArgs
 
+	newArgs, contextName := handleContext(args)
+	args = newArgs
+
 	errFunc := func(err error) {
 		if err != nil {
 			ui := terminal.NewUI(
@@ -77,6 +81,18 @@ func Main(traceEnv string, args []string) {
 	deps := commandregistry.NewDependency(Writer, traceLogger, os.Getenv("CF_DIAL_TIMEOUT"))
 	defer deps.Config.Close()
 
+	if contextName != "" {
+		err = deps.Config.OverrideContext(contextName)
+		if err != nil {
+			if translatableErr, ok := err.(translatableerror.TranslatableError); ok {
+				deps.UI.Failed(translatableErr.Translate(T))
+			} else {
+				deps.UI.Failed(err.Error())
+			}
+			os.Exit(1)
+		}
+	}
+
 	warningProducers := []net.WarningProducer{}
 	for _, warningProducer := range deps.Gateways {
 		warningProducers = append(warningProducers, warningProducer)
@@ -120,6 +136,7 @@ func Main(traceEnv string, args []string) {
 		}
 
 		err = cmd.Execute(flagContext)
+		warnCredentialStoreError(deps)
 		if err != nil {
 			deps.UI.Failed(err.Error())
 			if _, ok := err.(*errors.CurlHTTPError); ok {
@@ -156,6 +173,7 @@ func Main(traceEnv string, args []string) {
 	pluginList := pluginConfig.Plugins()
 
 	ran := rpc.RunMethodIfExists(rpcService, args[1:], pluginList)
+	warnCredentialStoreError(deps)
 	if !ran {
 		deps.UI.Say("'" + args[1] + T("' is not a registered command. See 'cf help -a'"))
 		suggestCommands(cmdName, deps.UI, append(cmdRegistry.ListCommands(), pluginConfig.ListCommands()...))
@@ -163,6 +181,16 @@ func Main(traceEnv string, args []string) {
 	}
 }
 
+// warnCredentialStoreError explains that the user was treated as logged out
+// because their credentials could not be read from the credential store.
+func warnCredentialStoreError(deps commandregistry.Dependency) {
+	if err := deps.Config.CredentialStoreError(); err != nil {
+		deps.UI.Warn(T("Unable to read credentials from the credential store, so you are not logged in: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		}))
+	}
+}
+
 func suggestCommands(cmdName string, ui terminal.UI, cmdsList []string) {
 	cmdSuggester := spellcheck.NewCommandSuggester(cmdsList)
 	recommendedCmds := cmdSuggester.Recommend(cmdName)
@@ -208,6 +236,21 @@ func handleHelp(args []string) []string {
 	}
 }
 
+// handleContext removes the --context flag given before the command name and
+// returns the name of the context. Flags after the command name are left to
+// the command, which may be a plugin with a --context flag of its own.
+func handleContext(args []string) ([]string, string) {
+	for i := 1; i < len(args) && strings.HasPrefix(args[i], "-"); i++ {
+		switch {
+		case args[i] == "--context" && i+1 < len(args):
+			return append(args[:i:i], args[i+2:]...), args[i+1]
+		case strings.HasPrefix(args[i], "--context="):
+			return append(args[:i:i], args[i+1:]...), strings.TrimPrefix(args[i], "--context=")
+		}
+	}
+	return args, ""
+}
+
 func handleVerbose(args []string) ([]string, bool) {
 	var verbose bool
 	idx := -1
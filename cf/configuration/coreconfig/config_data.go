@@ -28,6 +28,9 @@ type Data struct {
 	AuthorizationEndpoint    string
 	ColorEnabled             string
 	ConfigVersion            int
+	Contexts                 map[string]configv3.TargetContext `json:",omitempty"`
+	CredentialStore          string                            `json:",omitempty"`
+	CurrentContext           string                            `json:",omitempty"`
 	DopplerEndPoint          string
 	Locale                   string
 	LogCacheEndPoint         string
@@ -46,6 +49,13 @@ type Data struct {
 	UAAGrantType             string
 	UAAOAuthClient           string
 	UAAOAuthClientSecret     string
+
+	contextOverride string
+
+	credentialStore    configv3.CredentialStore
+	storedCredentials  map[string]configv3.Credentials
+	credentialsRead    map[string]bool
+	credentialStoreErr error
 }
 
 func NewData() *Data {
@@ -59,7 +69,8 @@ func NewData() *Data {
 
 func (d *Data) JSONMarshalV3() ([]byte, error) {
 	d.ConfigVersion = configv3.CurrentConfigVersion
-	return json.MarshalIndent(d, "", "  ")
+	persisted := d.persisted().withoutCredentials()
+	return json.MarshalIndent(&persisted, "", "  ")
 }
 
 func (d *Data) JSONUnmarshalV3(input []byte) error {
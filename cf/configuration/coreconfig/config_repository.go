@@ -1,6 +1,7 @@
 package coreconfig
 
 import (
+	"os"
 	"strings"
 	"sync"
 
@@ -137,6 +138,8 @@ type ReadWriter interface {
 type Repository interface {
 	ReadWriter
 	Close()
+	CredentialStoreError() error
+	OverrideContext(string) error
 }
 
 // ACCESS CONTROL
@@ -147,6 +150,7 @@ func (c *ConfigRepository) init() {
 		if err != nil {
 			c.onError(err)
 		}
+		c.data.selectCredentialStore(os.Getenv("CF_CREDENTIAL_STORE"), os.Getenv("CF_CREDENTIAL_STORE_PASSPHRASE"))
 	})
 }
 
@@ -165,7 +169,10 @@ func (c *ConfigRepository) write(cb func()) {
 
 	cb()
 
-	err := c.persistor.Save(c.data)
+	err := c.data.saveCredentials()
+	if err == nil {
+		err = c.persistor.Save(c.data)
+	}
 	if err != nil {
 		c.onError(err)
 	}
@@ -243,7 +250,7 @@ func (c *ConfigRepository) HasAPIEndpoint() (hasEndpoint bool) {
 }
 
 func (c *ConfigRepository) AccessToken() (accessToken string) {
-	c.read(func() {
+	c.readCredentials(func() {
 		accessToken = c.data.AccessToken
 	})
 	return
@@ -257,7 +264,7 @@ func (c *ConfigRepository) UAAOAuthClient() (clientID string) {
 }
 
 func (c *ConfigRepository) UAAOAuthClientSecret() (clientID string) {
-	c.read(func() {
+	c.readCredentials(func() {
 		clientID = c.data.UAAOAuthClientSecret
 	})
 	return
@@ -271,7 +278,7 @@ func (c *ConfigRepository) SSHOAuthClient() (clientID string) {
 }
 
 func (c *ConfigRepository) RefreshToken() (refreshToken string) {
-	c.read(func() {
+	c.readCredentials(func() {
 		refreshToken = c.data.RefreshToken
 	})
 	return
@@ -292,21 +299,21 @@ func (c *ConfigRepository) SpaceFields() (space models.SpaceFields) {
 }
 
 func (c *ConfigRepository) UserEmail() (email string) {
-	c.read(func() {
+	c.readCredentials(func() {
 		email = NewTokenInfo(c.data.AccessToken).Email
 	})
 	return
 }
 
 func (c *ConfigRepository) UserGUID() (guid string) {
-	c.read(func() {
+	c.readCredentials(func() {
 		guid = NewTokenInfo(c.data.AccessToken).UserGUID
 	})
 	return
 }
 
 func (c *ConfigRepository) Username() (name string) {
-	c.read(func() {
+	c.readCredentials(func() {
 		t := NewTokenInfo(c.data.AccessToken)
 		if t.Username != "" {
 			name = t.Username
@@ -318,7 +325,7 @@ func (c *ConfigRepository) Username() (name string) {
 }
 
 func (c *ConfigRepository) IsLoggedIn() (loggedIn bool) {
-	c.read(func() {
+	c.readCredentials(func() {
 		loggedIn = c.data.AccessToken != ""
 	})
 	return
@@ -446,7 +453,7 @@ func (c *ConfigRepository) PluginRepos() (repos []models.PluginRepo) {
 // SETTERS
 
 func (c *ConfigRepository) ClearSession() {
-	c.write(func() {
+	c.writeCredentials(func() {
 		c.data.AccessToken = ""
 		c.data.RefreshToken = ""
 		c.data.OrganizationFields = models.OrganizationFields{}
@@ -509,7 +516,7 @@ func (c *ConfigRepository) SetRoutingAPIEndpoint(routingAPIEndpoint string) {
 }
 
 func (c *ConfigRepository) SetAccessToken(token string) {
-	c.write(func() {
+	c.writeCredentials(func() {
 		c.data.AccessToken = token
 	})
 }
@@ -521,7 +528,7 @@ func (c *ConfigRepository) SetUAAOAuthClient(clientID string) {
 }
 
 func (c *ConfigRepository) SetUAAOAuthClientSecret(clientID string) {
-	c.write(func() {
+	c.writeCredentials(func() {
 		c.data.UAAOAuthClientSecret = clientID
 	})
 }
@@ -533,7 +540,7 @@ func (c *ConfigRepository) SetSSHOAuthClient(clientID string) {
 }
 
 func (c *ConfigRepository) SetRefreshToken(token string) {
-	c.write(func() {
+	c.writeCredentials(func() {
 		c.data.RefreshToken = token
 	})
 }
@@ -1,6 +1,7 @@
 package coreconfig_test
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -9,6 +10,8 @@ import (
 	"code.cloudfoundry.org/cli/cf/configuration/configurationfakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/version"
 	"github.com/blang/semver/v4"
 
@@ -351,6 +354,88 @@ var _ = Describe("Configuration Repository", func() {
 		})
 	})
 
+	Describe("contexts", func() {
+		var (
+			tmpDir     string
+			configPath string
+		)
+
+		readConfigFile := func() configv3.JSONConfig {
+			raw, err := ioutil.ReadFile(configPath)
+			Expect(err).NotTo(HaveOccurred())
+
+			var configFile configv3.JSONConfig
+			Expect(json.Unmarshal(raw, &configFile)).To(Succeed())
+			return configFile
+		}
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "test-config")
+			Expect(err).NotTo(HaveOccurred())
+			configPath = filepath.Join(tmpDir, "config.json")
+
+			raw, err := json.Marshal(configv3.JSONConfig{
+				ConfigVersion:  configv3.CurrentConfigVersion,
+				Target:         "https://api.dev.example.com",
+				AccessToken:    "dev-access-token",
+				CurrentContext: "dev",
+				Contexts: map[string]configv3.TargetContext{
+					"dev": {Target: "https://api.dev.example.com", AccessToken: "dev-access-token"},
+					"prod": {
+						Target:                  "https://api.prod.example.com",
+						AccessToken:             "prod-access-token",
+						NetworkPolicyV1Endpoint: "https://api.prod.example.com/networking",
+						TargetedSpace:           configv3.Space{GUID: "prod-space-guid", Name: "prod-space"},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ioutil.WriteFile(configPath, raw, 0600)).To(Succeed())
+
+			config = coreconfig.NewRepositoryFromFilepath(configPath, func(err error) {
+				panic(err)
+			})
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		It("keeps the contexts when the config is written", func() {
+			config.SetAccessToken("refreshed-access-token")
+
+			configFile := readConfigFile()
+			Expect(configFile.CurrentContext).To(Equal("dev"))
+			Expect(configFile.AccessToken).To(Equal("refreshed-access-token"))
+			Expect(configFile.Contexts).To(HaveLen(2))
+			Expect(configFile.Contexts["dev"].AccessToken).To(Equal("refreshed-access-token"))
+			Expect(configFile.Contexts["prod"].AccessToken).To(Equal("prod-access-token"))
+			Expect(configFile.Contexts["prod"].NetworkPolicyV1Endpoint).To(Equal("https://api.prod.example.com/networking"))
+		})
+
+		Describe("OverrideContext", func() {
+			It("uses the named context without making it current", func() {
+				Expect(config.OverrideContext("prod")).To(Succeed())
+				Expect(config.APIEndpoint()).To(Equal("https://api.prod.example.com"))
+				Expect(config.SpaceFields().Name).To(Equal("prod-space"))
+
+				config.SetAccessToken("refreshed-prod-access-token")
+
+				configFile := readConfigFile()
+				Expect(configFile.CurrentContext).To(Equal("dev"))
+				Expect(configFile.Target).To(Equal("https://api.dev.example.com"))
+				Expect(configFile.AccessToken).To(Equal("dev-access-token"))
+				Expect(configFile.Contexts["prod"].AccessToken).To(Equal("refreshed-prod-access-token"))
+				Expect(configFile.Contexts["prod"].NetworkPolicyV1Endpoint).To(Equal("https://api.prod.example.com/networking"))
+			})
+
+			It("returns an error when the context does not exist", func() {
+				Expect(config.OverrideContext("staging")).To(MatchError(translatableerror.ContextNotFoundError{Name: "staging"}))
+			})
+		})
+	})
+
 	Describe("IsMinCLIVersion", func() {
 		It("returns true when the actual version is the default version string", func() {
 			Expect(config.IsMinCLIVersion(version.DefaultVersion)).To(BeTrue())
@@ -382,4 +467,84 @@ var _ = Describe("Configuration Repository", func() {
 			Expect(config.IsMinCLIVersion(actualVersion)).To(BeTrue())
 		})
 	})
+
+	Describe("credential store", func() {
+		var (
+			tmpDir     string
+			configPath string
+			store      configv3.CredentialStore
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "test-config")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.Setenv("CF_HOME", tmpDir)).To(Succeed())
+			Expect(os.Setenv("CF_CREDENTIAL_STORE_PASSPHRASE", "some-passphrase")).To(Succeed())
+			Expect(os.MkdirAll(filepath.Join(tmpDir, ".cf"), 0700)).To(Succeed())
+			configPath = filepath.Join(tmpDir, ".cf", "config.json")
+
+			raw, err := json.Marshal(configv3.JSONConfig{
+				ConfigVersion:   configv3.CurrentConfigVersion,
+				CredentialStore: configv3.EncryptedFileCredentialStore,
+				Target:          "https://api.dev.example.com",
+				CurrentContext:  "dev",
+				Contexts: map[string]configv3.TargetContext{
+					"dev": {Target: "https://api.dev.example.com"},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ioutil.WriteFile(configPath, raw, 0600)).To(Succeed())
+
+			store = configv3.NewEncryptedFileStore(filepath.Join(tmpDir, ".cf", "credentials.enc"), "some-passphrase")
+			Expect(store.Store("dev", configv3.Credentials{
+				AccessToken:  AccessTokenForHumanUsers,
+				RefreshToken: "dev-refresh-token",
+			})).To(Succeed())
+
+			config = coreconfig.NewRepositoryFromFilepath(configPath, func(err error) {
+				panic(err)
+			})
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv("CF_HOME")).To(Succeed())
+			Expect(os.Unsetenv("CF_CREDENTIAL_STORE_PASSPHRASE")).To(Succeed())
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		It("reads the tokens from the credential store", func() {
+			Expect(config.IsLoggedIn()).To(BeTrue())
+			Expect(config.Username()).To(Equal("admin"))
+			Expect(config.RefreshToken()).To(Equal("dev-refresh-token"))
+			Expect(config.CredentialStoreError()).NotTo(HaveOccurred())
+		})
+
+		It("writes refreshed tokens to the credential store instead of the config file", func() {
+			config.SetAccessToken("bearer refreshed-access-token")
+
+			raw, err := ioutil.ReadFile(configPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(raw)).NotTo(ContainSubstring("refreshed-access-token"))
+			Expect(string(raw)).NotTo(ContainSubstring("dev-refresh-token"))
+			Expect(string(raw)).To(ContainSubstring(`"CredentialStore": "encrypted-file"`))
+
+			store = configv3.NewEncryptedFileStore(filepath.Join(tmpDir, ".cf", "credentials.enc"), "some-passphrase")
+			Expect(store.Get("dev")).To(Equal(configv3.Credentials{
+				AccessToken:  "bearer refreshed-access-token",
+				RefreshToken: "dev-refresh-token",
+			}))
+		})
+
+		When("the credentials cannot be read", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_CREDENTIAL_STORE_PASSPHRASE", "wrong-passphrase")).To(Succeed())
+			})
+
+			It("treats the user as logged out", func() {
+				Expect(config.IsLoggedIn()).To(BeFalse())
+				Expect(config.CredentialStoreError()).To(MatchError(ContainSubstring("unable to decrypt credential file")))
+			})
+		})
+	})
 })
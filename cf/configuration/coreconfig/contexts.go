@@ -0,0 +1,113 @@
+package coreconfig
+
+import (
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// OverrideContext uses the named context instead of the current one until
+// the CLI exits, as the --context flag does for the other commands. Changes
+// to it, such as refreshed tokens, are saved to the context.
+func (c *ConfigRepository) OverrideContext(name string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.init()
+
+	return c.data.overrideContext(name)
+}
+
+func (d *Data) overrideContext(name string) error {
+	if d.Contexts == nil {
+		d.Contexts = map[string]configv3.TargetContext{}
+	}
+	d.syncContext()
+
+	context, exists := d.Contexts[name]
+	if !exists {
+		return translatableerror.ContextNotFoundError{Name: name}
+	}
+
+	d.applyContext(context)
+	if name != d.currentContext() {
+		d.contextOverride = name
+	}
+	return nil
+}
+
+// persisted returns the data to write, which has the current context at its
+// top level even while a context override is in use.
+func (d *Data) persisted() Data {
+	d.syncContext()
+	persisted := *d
+	if d.contextOverride != "" {
+		persisted.applyContext(d.Contexts[d.currentContext()])
+	}
+	return persisted
+}
+
+func (d *Data) currentContext() string {
+	if d.CurrentContext == "" {
+		return configv3.DefaultContextName
+	}
+	return d.CurrentContext
+}
+
+func (d *Data) activeContext() string {
+	if d.contextOverride != "" {
+		return d.contextOverride
+	}
+	return d.currentContext()
+}
+
+// syncContext saves the top level fields to the context in use. The fields of
+// the context that Data does not have are kept. A config without contexts is
+// left for the CLI to migrate.
+func (d *Data) syncContext() {
+	if d.Contexts == nil {
+		return
+	}
+
+	name := d.activeContext()
+	context := d.Contexts[name]
+	context.AccessToken = d.AccessToken
+	context.APIVersion = d.APIVersion
+	context.AuthorizationEndpoint = d.AuthorizationEndpoint
+	context.DopplerEndpoint = d.DopplerEndPoint
+	context.LogCacheEndpoint = d.LogCacheEndPoint
+	context.MinCLIVersion = d.MinCLIVersion
+	context.MinRecommendedCLIVersion = d.MinRecommendedCLIVersion
+	context.TargetedOrganization = configv3.Organization{GUID: d.OrganizationFields.GUID, Name: d.OrganizationFields.Name}
+	context.RefreshToken = d.RefreshToken
+	context.RoutingEndpoint = d.RoutingAPIEndpoint
+	context.TargetedSpace = configv3.Space{GUID: d.SpaceFields.GUID, Name: d.SpaceFields.Name, AllowSSH: d.SpaceFields.AllowSSH}
+	context.SSHOAuthClient = d.SSHOAuthClient
+	context.SkipSSLValidation = d.SSLDisabled
+	context.Target = d.Target
+	context.UAAEndpoint = d.UaaEndpoint
+	context.UAAGrantType = d.UAAGrantType
+	context.UAAOAuthClient = d.UAAOAuthClient
+	context.UAAOAuthClientSecret = d.UAAOAuthClientSecret
+	d.Contexts[name] = context
+}
+
+func (d *Data) applyContext(context configv3.TargetContext) {
+	d.AccessToken = context.AccessToken
+	d.APIVersion = context.APIVersion
+	d.AuthorizationEndpoint = context.AuthorizationEndpoint
+	d.DopplerEndPoint = context.DopplerEndpoint
+	d.LogCacheEndPoint = context.LogCacheEndpoint
+	d.MinCLIVersion = context.MinCLIVersion
+	d.MinRecommendedCLIVersion = context.MinRecommendedCLIVersion
+	d.OrganizationFields = models.OrganizationFields{GUID: context.TargetedOrganization.GUID, Name: context.TargetedOrganization.Name}
+	d.RefreshToken = context.RefreshToken
+	d.RoutingAPIEndpoint = context.RoutingEndpoint
+	d.SpaceFields = models.SpaceFields{GUID: context.TargetedSpace.GUID, Name: context.TargetedSpace.Name, AllowSSH: context.TargetedSpace.AllowSSH}
+	d.SSHOAuthClient = context.SSHOAuthClient
+	d.SSLDisabled = context.SkipSSLValidation
+	d.Target = context.Target
+	d.UaaEndpoint = context.UAAEndpoint
+	d.UAAGrantType = context.UAAGrantType
+	d.UAAOAuthClient = context.UAAOAuthClient
+	d.UAAOAuthClientSecret = context.UAAOAuthClientSecret
+}
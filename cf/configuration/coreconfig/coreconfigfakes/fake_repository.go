@@ -88,6 +88,16 @@ type FakeRepository struct {
 	colorEnabledReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CredentialStoreErrorStub        func() error
+	credentialStoreErrorMutex       sync.RWMutex
+	credentialStoreErrorArgsForCall []struct {
+	}
+	credentialStoreErrorReturns struct {
+		result1 error
+	}
+	credentialStoreErrorReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DopplerEndpointStub        func() string
 	dopplerEndpointMutex       sync.RWMutex
 	dopplerEndpointArgsForCall []struct {
@@ -210,6 +220,17 @@ type FakeRepository struct {
 	minRecommendedCLIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	OverrideContextStub        func(string) error
+	overrideContextMutex       sync.RWMutex
+	overrideContextArgsForCall []struct {
+		arg1 string
+	}
+	overrideContextReturns struct {
+		result1 error
+	}
+	overrideContextReturnsOnCall map[int]struct {
+		result1 error
+	}
 	OrganizationFieldsStub        func() models.OrganizationFields
 	organizationFieldsMutex       sync.RWMutex
 	organizationFieldsArgsForCall []struct {
@@ -889,6 +910,58 @@ func (fake *FakeRepository) ColorEnabledReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeRepository) CredentialStoreError() error {
+	fake.credentialStoreErrorMutex.Lock()
+	ret, specificReturn := fake.credentialStoreErrorReturnsOnCall[len(fake.credentialStoreErrorArgsForCall)]
+	fake.credentialStoreErrorArgsForCall = append(fake.credentialStoreErrorArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CredentialStoreError", []interface{}{})
+	fake.credentialStoreErrorMutex.Unlock()
+	if fake.CredentialStoreErrorStub != nil {
+		return fake.CredentialStoreErrorStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.credentialStoreErrorReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeRepository) CredentialStoreErrorCallCount() int {
+	fake.credentialStoreErrorMutex.RLock()
+	defer fake.credentialStoreErrorMutex.RUnlock()
+	return len(fake.credentialStoreErrorArgsForCall)
+}
+
+func (fake *FakeRepository) CredentialStoreErrorCalls(stub func() error) {
+	fake.credentialStoreErrorMutex.Lock()
+	defer fake.credentialStoreErrorMutex.Unlock()
+	fake.CredentialStoreErrorStub = stub
+}
+
+func (fake *FakeRepository) CredentialStoreErrorReturns(result1 error) {
+	fake.credentialStoreErrorMutex.Lock()
+	defer fake.credentialStoreErrorMutex.Unlock()
+	fake.CredentialStoreErrorStub = nil
+	fake.credentialStoreErrorReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) CredentialStoreErrorReturnsOnCall(i int, result1 error) {
+	fake.credentialStoreErrorMutex.Lock()
+	defer fake.credentialStoreErrorMutex.Unlock()
+	fake.CredentialStoreErrorStub = nil
+	if fake.credentialStoreErrorReturnsOnCall == nil {
+		fake.credentialStoreErrorReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.credentialStoreErrorReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRepository) DopplerEndpoint() string {
 	fake.dopplerEndpointMutex.Lock()
 	ret, specificReturn := fake.dopplerEndpointReturnsOnCall[len(fake.dopplerEndpointArgsForCall)]
@@ -1529,6 +1602,66 @@ func (fake *FakeRepository) MinRecommendedCLIVersionReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeRepository) OverrideContext(arg1 string) error {
+	fake.overrideContextMutex.Lock()
+	ret, specificReturn := fake.overrideContextReturnsOnCall[len(fake.overrideContextArgsForCall)]
+	fake.overrideContextArgsForCall = append(fake.overrideContextArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("OverrideContext", []interface{}{arg1})
+	fake.overrideContextMutex.Unlock()
+	if fake.OverrideContextStub != nil {
+		return fake.OverrideContextStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.overrideContextReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeRepository) OverrideContextCallCount() int {
+	fake.overrideContextMutex.RLock()
+	defer fake.overrideContextMutex.RUnlock()
+	return len(fake.overrideContextArgsForCall)
+}
+
+func (fake *FakeRepository) OverrideContextCalls(stub func(string) error) {
+	fake.overrideContextMutex.Lock()
+	defer fake.overrideContextMutex.Unlock()
+	fake.OverrideContextStub = stub
+}
+
+func (fake *FakeRepository) OverrideContextArgsForCall(i int) string {
+	fake.overrideContextMutex.RLock()
+	defer fake.overrideContextMutex.RUnlock()
+	argsForCall := fake.overrideContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRepository) OverrideContextReturns(result1 error) {
+	fake.overrideContextMutex.Lock()
+	defer fake.overrideContextMutex.Unlock()
+	fake.OverrideContextStub = nil
+	fake.overrideContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) OverrideContextReturnsOnCall(i int, result1 error) {
+	fake.overrideContextMutex.Lock()
+	defer fake.overrideContextMutex.Unlock()
+	fake.OverrideContextStub = nil
+	if fake.overrideContextReturnsOnCall == nil {
+		fake.overrideContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.overrideContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRepository) OrganizationFields() models.OrganizationFields {
 	fake.organizationFieldsMutex.Lock()
 	ret, specificReturn := fake.organizationFieldsReturnsOnCall[len(fake.organizationFieldsArgsForCall)]
@@ -3053,6 +3186,8 @@ func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	defer fake.closeMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
+	fake.credentialStoreErrorMutex.RLock()
+	defer fake.credentialStoreErrorMutex.RUnlock()
 	fake.dopplerEndpointMutex.RLock()
 	defer fake.dopplerEndpointMutex.RUnlock()
 	fake.hasAPIEndpointMutex.RLock()
@@ -3077,6 +3212,8 @@ func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	defer fake.minCLIVersionMutex.RUnlock()
 	fake.minRecommendedCLIVersionMutex.RLock()
 	defer fake.minRecommendedCLIVersionMutex.RUnlock()
+	fake.overrideContextMutex.RLock()
+	defer fake.overrideContextMutex.RUnlock()
 	fake.organizationFieldsMutex.RLock()
 	defer fake.organizationFieldsMutex.RUnlock()
 	fake.pluginReposMutex.RLock()
@@ -0,0 +1,148 @@
+package coreconfig
+
+import (
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// CredentialStoreError returns the error from reading the credentials from
+// the credential store, if any. The user is treated as logged out when they
+// could not be read.
+func (c *ConfigRepository) CredentialStoreError() (err error) {
+	c.read(func() {
+		err = c.data.credentialStoreErr
+	})
+	return
+}
+
+// readCredentials is read for the tokens and client secret, which are read
+// from the credential store the first time they are needed.
+func (c *ConfigRepository) readCredentials(cb func()) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.init()
+
+	c.data.loadCredentials()
+	cb()
+}
+
+// writeCredentials is write for the tokens and client secret.
+func (c *ConfigRepository) writeCredentials(cb func()) {
+	c.write(func() {
+		c.data.loadCredentials()
+		cb()
+	})
+}
+
+// selectCredentialStore selects the credential store the same way the CLI
+// does, from $CF_CREDENTIAL_STORE or the config.json.
+func (d *Data) selectCredentialStore(name string, passphrase string) {
+	if name == "" {
+		name = d.CredentialStore
+	}
+
+	store := configv3.NewCredentialStore(name, passphrase)
+	if store == nil {
+		d.CredentialStore = ""
+		return
+	}
+
+	d.CredentialStore = name
+	d.credentialStore = store
+	d.storedCredentials = map[string]configv3.Credentials{}
+	d.credentialsRead = map[string]bool{}
+}
+
+// loadCredentials reads the credentials of the context in use from the
+// credential store, once. Credentials still in the config.json are kept.
+func (d *Data) loadCredentials() {
+	name := d.activeContext()
+	if d.credentialStore == nil || d.credentialsRead[name] {
+		return
+	}
+	d.credentialsRead[name] = true
+
+	credentials, err := d.credentialStore.Get(name)
+	if err != nil {
+		if d.credentialStoreErr == nil {
+			d.credentialStoreErr = err
+		}
+		return
+	}
+	d.storedCredentials[name] = credentials
+
+	if d.AccessToken == "" {
+		d.AccessToken = credentials.AccessToken
+	}
+	if d.RefreshToken == "" {
+		d.RefreshToken = credentials.RefreshToken
+	}
+	if d.UAAOAuthClientSecret == "" {
+		d.UAAOAuthClientSecret = credentials.UAAOAuthClientSecret
+	}
+}
+
+// saveCredentials writes the credentials that changed since they were read
+// to the credential store. Credentials of the other contexts that are still
+// in the config.json are moved into the store, as the CLI does.
+func (d *Data) saveCredentials() error {
+	if d.credentialStore == nil {
+		return nil
+	}
+
+	d.syncContext()
+	current := map[string]configv3.Credentials{}
+	for name, context := range d.Contexts {
+		current[name] = configv3.Credentials{
+			AccessToken:          context.AccessToken,
+			RefreshToken:         context.RefreshToken,
+			UAAOAuthClientSecret: context.UAAOAuthClientSecret,
+		}
+	}
+	current[d.activeContext()] = configv3.Credentials{
+		AccessToken:          d.AccessToken,
+		RefreshToken:         d.RefreshToken,
+		UAAOAuthClientSecret: d.UAAOAuthClientSecret,
+	}
+
+	for name, credentials := range current {
+		if credentials == d.storedCredentials[name] {
+			continue
+		}
+
+		var err error
+		if credentials == (configv3.Credentials{}) {
+			err = d.credentialStore.Erase(name)
+		} else {
+			err = d.credentialStore.Store(name, credentials)
+		}
+		if err != nil {
+			return err
+		}
+		d.storedCredentials[name] = credentials
+	}
+	return nil
+}
+
+// withoutCredentials returns a copy of the data that has no credentials in it
+// when they are kept in a credential store.
+func (d Data) withoutCredentials() Data {
+	if d.credentialStore == nil {
+		return d
+	}
+
+	d.AccessToken = ""
+	d.RefreshToken = ""
+	d.UAAOAuthClientSecret = ""
+
+	if d.Contexts != nil {
+		contexts := make(map[string]configv3.TargetContext, len(d.Contexts))
+		for name, context := range d.Contexts {
+			context.AccessToken = ""
+			context.RefreshToken = ""
+			context.UAAOAuthClientSecret = ""
+			contexts[name] = context
+		}
+		d.Contexts = contexts
+	}
+	return d
+}
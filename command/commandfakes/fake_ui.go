@@ -17,6 +17,13 @@ type FakeUI struct {
 		arg1 string
 		arg2 []map[string]interface{}
 	}
+	DisplayAppLogMessageStub        func(string, ui.LogMessage, bool)
+	displayAppLogMessageMutex       sync.RWMutex
+	displayAppLogMessageArgsForCall []struct {
+		arg1 string
+		arg2 ui.LogMessage
+		arg3 bool
+	}
 	DisplayBoolPromptStub        func(bool, string, ...map[string]interface{}) (bool, error)
 	displayBoolPromptMutex       sync.RWMutex
 	displayBoolPromptArgsForCall []struct {
@@ -161,6 +168,22 @@ type FakeUI struct {
 		result1 string
 		result2 error
 	}
+	DisplayStructuredDataStub        func(interface{})
+	displayStructuredDataMutex       sync.RWMutex
+	displayStructuredDataArgsForCall []struct {
+		arg1 interface{}
+	}
+	DisplayStructuredLineStub        func(interface{}) error
+	displayStructuredLineMutex       sync.RWMutex
+	displayStructuredLineArgsForCall []struct {
+		arg1 interface{}
+	}
+	displayStructuredLineReturns struct {
+		result1 error
+	}
+	displayStructuredLineReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DisplayTableWithHeaderStub        func(string, [][]string, int)
 	displayTableWithHeaderMutex       sync.RWMutex
 	displayTableWithHeaderArgsForCall []struct {
@@ -352,6 +375,40 @@ func (fake *FakeUI) DeferTextArgsForCall(i int) (string, []map[string]interface{
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeUI) DisplayAppLogMessage(arg1 string, arg2 ui.LogMessage, arg3 bool) {
+	fake.displayAppLogMessageMutex.Lock()
+	fake.displayAppLogMessageArgsForCall = append(fake.displayAppLogMessageArgsForCall, struct {
+		arg1 string
+		arg2 ui.LogMessage
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.DisplayAppLogMessageStub
+	fake.recordInvocation("DisplayAppLogMessage", []interface{}{arg1, arg2, arg3})
+	fake.displayAppLogMessageMutex.Unlock()
+	if stub != nil {
+		fake.DisplayAppLogMessageStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeUI) DisplayAppLogMessageCallCount() int {
+	fake.displayAppLogMessageMutex.RLock()
+	defer fake.displayAppLogMessageMutex.RUnlock()
+	return len(fake.displayAppLogMessageArgsForCall)
+}
+
+func (fake *FakeUI) DisplayAppLogMessageCalls(stub func(string, ui.LogMessage, bool)) {
+	fake.displayAppLogMessageMutex.Lock()
+	defer fake.displayAppLogMessageMutex.Unlock()
+	fake.DisplayAppLogMessageStub = stub
+}
+
+func (fake *FakeUI) DisplayAppLogMessageArgsForCall(i int) (string, ui.LogMessage, bool) {
+	fake.displayAppLogMessageMutex.RLock()
+	defer fake.displayAppLogMessageMutex.RUnlock()
+	argsForCall := fake.displayAppLogMessageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
 func (fake *FakeUI) DisplayBoolPrompt(arg1 bool, arg2 string, arg3 ...map[string]interface{}) (bool, error) {
 	fake.displayBoolPromptMutex.Lock()
 	ret, specificReturn := fake.displayBoolPromptReturnsOnCall[len(fake.displayBoolPromptArgsForCall)]
@@ -742,15 +799,16 @@ func (fake *FakeUI) DisplayJSON(arg1 string, arg2 interface{}) error {
 		arg1 string
 		arg2 interface{}
 	}{arg1, arg2})
+	stub := fake.DisplayJSONStub
+	fakeReturns := fake.displayJSONReturns
 	fake.recordInvocation("DisplayJSON", []interface{}{arg1, arg2})
 	fake.displayJSONMutex.Unlock()
-	if fake.DisplayJSONStub != nil {
-		return fake.DisplayJSONStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.displayJSONReturns
 	return fakeReturns.result1
 }
 
@@ -1123,6 +1181,99 @@ func (fake *FakeUI) DisplayPasswordPromptReturnsOnCall(i int, result1 string, re
 	}{result1, result2}
 }
 
+func (fake *FakeUI) DisplayStructuredData(arg1 interface{}) {
+	fake.displayStructuredDataMutex.Lock()
+	fake.displayStructuredDataArgsForCall = append(fake.displayStructuredDataArgsForCall, struct {
+		arg1 interface{}
+	}{arg1})
+	stub := fake.DisplayStructuredDataStub
+	fake.recordInvocation("DisplayStructuredData", []interface{}{arg1})
+	fake.displayStructuredDataMutex.Unlock()
+	if stub != nil {
+		fake.DisplayStructuredDataStub(arg1)
+	}
+}
+
+func (fake *FakeUI) DisplayStructuredDataCallCount() int {
+	fake.displayStructuredDataMutex.RLock()
+	defer fake.displayStructuredDataMutex.RUnlock()
+	return len(fake.displayStructuredDataArgsForCall)
+}
+
+func (fake *FakeUI) DisplayStructuredDataCalls(stub func(interface{})) {
+	fake.displayStructuredDataMutex.Lock()
+	defer fake.displayStructuredDataMutex.Unlock()
+	fake.DisplayStructuredDataStub = stub
+}
+
+func (fake *FakeUI) DisplayStructuredDataArgsForCall(i int) interface{} {
+	fake.displayStructuredDataMutex.RLock()
+	defer fake.displayStructuredDataMutex.RUnlock()
+	argsForCall := fake.displayStructuredDataArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUI) DisplayStructuredLine(arg1 interface{}) error {
+	fake.displayStructuredLineMutex.Lock()
+	ret, specificReturn := fake.displayStructuredLineReturnsOnCall[len(fake.displayStructuredLineArgsForCall)]
+	fake.displayStructuredLineArgsForCall = append(fake.displayStructuredLineArgsForCall, struct {
+		arg1 interface{}
+	}{arg1})
+	stub := fake.DisplayStructuredLineStub
+	fakeReturns := fake.displayStructuredLineReturns
+	fake.recordInvocation("DisplayStructuredLine", []interface{}{arg1})
+	fake.displayStructuredLineMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUI) DisplayStructuredLineCallCount() int {
+	fake.displayStructuredLineMutex.RLock()
+	defer fake.displayStructuredLineMutex.RUnlock()
+	return len(fake.displayStructuredLineArgsForCall)
+}
+
+func (fake *FakeUI) DisplayStructuredLineCalls(stub func(interface{}) error) {
+	fake.displayStructuredLineMutex.Lock()
+	defer fake.displayStructuredLineMutex.Unlock()
+	fake.DisplayStructuredLineStub = stub
+}
+
+func (fake *FakeUI) DisplayStructuredLineArgsForCall(i int) interface{} {
+	fake.displayStructuredLineMutex.RLock()
+	defer fake.displayStructuredLineMutex.RUnlock()
+	argsForCall := fake.displayStructuredLineArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUI) DisplayStructuredLineReturns(result1 error) {
+	fake.displayStructuredLineMutex.Lock()
+	defer fake.displayStructuredLineMutex.Unlock()
+	fake.DisplayStructuredLineStub = nil
+	fake.displayStructuredLineReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUI) DisplayStructuredLineReturnsOnCall(i int, result1 error) {
+	fake.displayStructuredLineMutex.Lock()
+	defer fake.displayStructuredLineMutex.Unlock()
+	fake.DisplayStructuredLineStub = nil
+	if fake.displayStructuredLineReturnsOnCall == nil {
+		fake.displayStructuredLineReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.displayStructuredLineReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeUI) DisplayTableWithHeader(arg1 string, arg2 [][]string, arg3 int) {
 	var arg2Copy [][]string
 	if arg2 != nil {
@@ -1958,6 +2109,8 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.deferTextMutex.RLock()
 	defer fake.deferTextMutex.RUnlock()
+	fake.displayAppLogMessageMutex.RLock()
+	defer fake.displayAppLogMessageMutex.RUnlock()
 	fake.displayBoolPromptMutex.RLock()
 	defer fake.displayBoolPromptMutex.RUnlock()
 	fake.displayChangesForPushMutex.RLock()
@@ -1996,6 +2149,10 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.displayOptionalTextPromptMutex.RUnlock()
 	fake.displayPasswordPromptMutex.RLock()
 	defer fake.displayPasswordPromptMutex.RUnlock()
+	fake.displayStructuredDataMutex.RLock()
+	defer fake.displayStructuredDataMutex.RUnlock()
+	fake.displayStructuredLineMutex.RLock()
+	defer fake.displayStructuredLineMutex.RUnlock()
 	fake.displayTableWithHeaderMutex.RLock()
 	defer fake.displayTableWithHeaderMutex.RUnlock()
 	fake.displayTextMutex.RLock()
@@ -11,7 +11,9 @@ var Commands commandList
 var ShouldFallbackToLegacy = false
 
 type commandList struct {
-	VerboseOrVersion bool `short:"v" long:"version" description:"verbose and version flag"`
+	VerboseOrVersion bool   `short:"v" long:"version" description:"verbose and version flag"`
+	OutputFormat     string `long:"output" choice:"json" choice:"yaml" description:"Display the results of list and detail commands as json or yaml"`
+	Context          string `long:"context" description:"Run the command against the named context instead of the current one"`
 
 	V3Push v7.PushCommand `command:"v3-push" description:"Push a new app or sync changes to an existing app" hidden:"true"`
 
@@ -21,6 +23,7 @@ type commandList struct {
 	AllowSpaceSSH                      v7.AllowSpaceSSHCommand                      `command:"allow-space-ssh" description:"Allow SSH access for the space"`
 	App                                v7.AppCommand                                `command:"app" description:"Display health and status for an app"`
 	ApplyManifest                      v7.ApplyManifestCommand                      `command:"apply-manifest" description:"Apply manifest properties to a space"`
+	ApplySpace                         v7.ApplySpaceCommand                         `command:"apply-space" description:"Create the services, routes, apps and network policies of a space bundle in the targeted space"`
 	Apps                               v7.AppsCommand                               `command:"apps" alias:"a" description:"List all apps in the target space"`
 	Auth                               v7.AuthCommand                               `command:"auth" description:"Authenticate non-interactively"`
 	BindRouteService                   v7.BindRouteServiceCommand                   `command:"bind-route-service" alias:"brs" description:"Bind a service instance to an HTTP route"`
@@ -32,11 +35,13 @@ type commandList struct {
 	CancelDeployment                   v7.CancelDeploymentCommand                   `command:"cancel-deployment" description:"Cancel the most recent deployment for an app. Resets the current droplet to the previous deployment's droplet."`
 	CheckRoute                         v7.CheckRouteCommand                         `command:"check-route" description:"Perform a check to determine whether a route currently exists or not"`
 	Config                             v7.ConfigCommand                             `command:"config" description:"Write default values to the config"`
+	Contexts                           v7.ContextsCommand                           `command:"contexts" description:"List the named contexts"`
 	ContinueDeployment                 v7.ContinueDeploymentCommand                 `command:"continue-deployment" description:"Continue the most recent deployment for an app."`
 	CopySource                         v7.CopySourceCommand                         `command:"copy-source" description:"Copies the source code of an application to another existing application and restages that application"`
 	CreateApp                          v7.CreateAppCommand                          `command:"create-app" description:"Create an Application in the target space"`
 	CreateAppManifest                  v7.CreateAppManifestCommand                  `command:"create-app-manifest" description:"Create an app manifest for an app that has been pushed successfully"`
 	CreateBuildpack                    v7.CreateBuildpackCommand                    `command:"create-buildpack" description:"Create a buildpack"`
+	CreateContext                      v7.CreateContextCommand                      `command:"create-context" description:"Create a named context for an API endpoint, its tokens and targeted org and space"`
 	CreatePackage                      v7.CreatePackageCommand                      `command:"create-package" description:"Uploads a Package"`
 	CreateIsolationSegment             v7.CreateIsolationSegmentCommand             `command:"create-isolation-segment" description:"Create an isolation segment"`
 	CreateOrg                          v7.CreateOrgCommand                          `command:"create-org" alias:"co" description:"Create an org"`
@@ -48,6 +53,7 @@ type commandList struct {
 	CreateServiceBroker                v7.CreateServiceBrokerCommand                `command:"create-service-broker" alias:"csb" description:"Create a service broker"`
 	CreateServiceKey                   v7.CreateServiceKeyCommand                   `command:"create-service-key" alias:"csk" description:"Create key for a service instance"`
 	CreateSharedDomain                 v7.CreateSharedDomainCommand                 `command:"create-shared-domain" description:"Create a domain that can be used by all orgs (admin-only)"`
+	CreateSidecar                      v7.CreateSidecarCommand                      `command:"create-sidecar" description:"Create a sidecar for an app"`
 	CreateSpace                        v7.CreateSpaceCommand                        `command:"create-space" alias:"csp" description:"Create a space"`
 	CreateSpaceQuota                   v7.CreateSpaceQuotaCommand                   `command:"create-space-quota" description:"Define a new quota for a space"`
 	CreateUser                         v7.CreateUserCommand                         `command:"create-user" description:"Create a new user"`
@@ -55,6 +61,7 @@ type commandList struct {
 	Curl                               v7.CurlCommand                               `command:"curl" description:"Executes a request to the targeted API endpoint"`
 	Delete                             v7.DeleteCommand                             `command:"delete" alias:"d" description:"Delete an app"`
 	DeleteBuildpack                    v7.DeleteBuildpackCommand                    `command:"delete-buildpack" description:"Delete a buildpack"`
+	DeleteContext                      v7.DeleteContextCommand                      `command:"delete-context" description:"Delete a named context"`
 	DeleteIsolationSegment             v7.DeleteIsolationSegmentCommand             `command:"delete-isolation-segment" description:"Delete an isolation segment"`
 	DeleteOrg                          v7.DeleteOrgCommand                          `command:"delete-org" description:"Delete an org"`
 	DeleteOrgQuota                     v7.DeleteOrgQuotaCommand                     `command:"delete-org-quota" alias:"delete-quota" description:"Delete an organization quota"`
@@ -66,9 +73,13 @@ type commandList struct {
 	DeleteServiceBroker                v7.DeleteServiceBrokerCommand                `command:"delete-service-broker" description:"Delete a service broker"`
 	DeleteServiceKey                   v7.DeleteServiceKeyCommand                   `command:"delete-service-key" alias:"dsk" description:"Delete a service key"`
 	DeleteSharedDomain                 v7.DeleteSharedDomainCommand                 `command:"delete-shared-domain" description:"Delete a shared domain"`
+	DeleteSidecar                      v7.DeleteSidecarCommand                      `command:"delete-sidecar" description:"Delete a sidecar of an app"`
 	DeleteSpace                        v7.DeleteSpaceCommand                        `command:"delete-space" description:"Delete a space"`
 	DeleteSpaceQuota                   v7.DeleteSpaceQuotaCommand                   `command:"delete-space-quota" description:"Delete a space quota"`
 	DeleteUser                         v7.DeleteUserCommand                         `command:"delete-user" description:"Delete a user"`
+	Deployment                         v7.DeploymentCommand                         `command:"deployment" description:"Show details of a deployment of an app"`
+	Deployments                        v7.DeploymentsCommand                        `command:"deployments" description:"List deployments of an app"`
+	DiffManifest                       v7.DiffManifestCommand                       `command:"diff-manifest" description:"Show how the apps in one or more spaces differ from a manifest"`
 	DisableFeatureFlag                 v7.DisableFeatureFlagCommand                 `command:"disable-feature-flag" description:"Prevent use of a feature"`
 	DisableOrgIsolation                v7.DisableOrgIsolationCommand                `command:"disable-org-isolation" description:"Revoke an organization's entitlement to an isolation segment"`
 	DisableSSH                         v7.DisableSSHCommand                         `command:"disable-ssh" description:"Disable ssh for the application"`
@@ -83,6 +94,7 @@ type commandList struct {
 	EnableServiceAccess                v7.EnableServiceAccessCommand                `command:"enable-service-access" description:"Enable access to a service offering or service plan for one or all orgs"`
 	Env                                v7.EnvCommand                                `command:"env" alias:"e" description:"Show all env variables for an app"`
 	Events                             v7.EventsCommand                             `command:"events" description:"Show recent app events"`
+	ExportSpace                        v7.ExportSpaceCommand                        `command:"export-space" description:"Export the apps, routes, services and network policies of the targeted space to a directory"`
 	FeatureFlag                        v7.FeatureFlagCommand                        `command:"feature-flag" description:"Retrieve an individual feature flag with status"`
 	FeatureFlags                       v7.FeatureFlagsCommand                       `command:"feature-flags" description:"Retrieve list of feature flags with status"`
 	GetHealthCheck                     v7.GetHealthCheckCommand                     `command:"get-health-check" description:"Show the type of health check performed on an app"`
@@ -93,7 +105,7 @@ type commandList struct {
 	ListPluginRepos                    plugin.ListPluginReposCommand                `command:"list-plugin-repos" description:"List all the added plugin repositories"`
 	Login                              v7.LoginCommand                              `command:"login" alias:"l" description:"Log user in"`
 	Logout                             v7.LogoutCommand                             `command:"logout" alias:"lo" description:"Log user out"`
-	Logs                               v7.LogsCommand                               `command:"logs" description:"Tail or show recent logs for an app"`
+	Logs                               v7.LogsCommand                               `command:"logs" description:"Tail or show recent logs for one or more apps"`
 	MapRoute                           v7.MapRouteCommand                           `command:"map-route" description:"Map a route to an app"`
 	Marketplace                        v7.MarketplaceCommand                        `command:"marketplace" alias:"m" description:"List available offerings in the marketplace"`
 	NetworkPolicies                    v7.NetworkPoliciesCommand                    `command:"network-policies" description:"List direct network traffic policies"`
@@ -112,6 +124,7 @@ type commandList struct {
 	RemoveNetworkPolicy                v7.RemoveNetworkPolicyCommand                `command:"remove-network-policy" description:"Remove network traffic policy of an app"`
 	RemovePluginRepo                   plugin.RemovePluginRepoCommand               `command:"remove-plugin-repo" description:"Remove a plugin repository"`
 	Rename                             v7.RenameCommand                             `command:"rename" description:"Rename an app"`
+	RenameContext                      v7.RenameContextCommand                      `command:"rename-context" description:"Rename a named context"`
 	RenameOrg                          v7.RenameOrgCommand                          `command:"rename-org" description:"Rename an org"`
 	RenameService                      v7.RenameServiceCommand                      `command:"rename-service" description:"Rename a service instance"`
 	RenameServiceBroker                v7.RenameServiceBrokerCommand                `command:"rename-service-broker" description:"Rename a service broker"`
@@ -132,6 +145,7 @@ type commandList struct {
 	RunTask                            v7.RunTaskCommand                            `command:"run-task" alias:"rt" description:"Run a one-off task on an app"`
 	RunningEnvironmentVariableGroup    v7.RunningEnvironmentVariableGroupCommand    `command:"running-environment-variable-group" alias:"revg" description:"Retrieve the contents of the running environment variable group"`
 	RunningSecurityGroups              v7.RunningSecurityGroupsCommand              `command:"running-security-groups" description:"List security groups globally configured for running applications"`
+	SCP                                v7.SCPCommand                                `command:"scp" description:"Copy files to or from an application container instance over SSH"`
 	SSH                                v7.SSHCommand                                `command:"ssh" description:"SSH to an application container instance"`
 	SSHCode                            v7.SSHCodeCommand                            `command:"ssh-code" description:"Get a one time password for ssh clients"`
 	SSHEnabled                         v7.SSHEnabledCommand                         `command:"ssh-enabled" description:"Reports whether SSH is enabled on an application container instance"`
@@ -159,6 +173,7 @@ type commandList struct {
 	SharePrivateDomain                 v7.SharePrivateDomainCommand                 `command:"share-private-domain" description:"Share a private domain with a specific org"`
 	ShareService                       v7.ShareServiceCommand                       `command:"share-service" description:"Share a service instance with another space"`
 	ShareRoute                         v7.ShareRouteCommand                         `command:"share-route" description:"Share a route in between spaces"`
+	Sidecars                           v7.SidecarsCommand                           `command:"sidecars" description:"List sidecars of an app"`
 	Space                              v7.SpaceCommand                              `command:"space" description:"Show space info"`
 	SpaceQuota                         v7.SpaceQuotaCommand                         `command:"space-quota" description:"Show space quota info"`
 	SpaceQuotas                        v7.SpaceQuotasCommand                        `command:"space-quotas" description:"List available space quotas"`
@@ -195,10 +210,13 @@ type commandList struct {
 	UpdateOrgQuota                     v7.UpdateOrgQuotaCommand                     `command:"update-org-quota" alias:"update-quota" description:"Update an existing organization quota"`
 	UpdateSecurityGroup                v7.UpdateSecurityGroupCommand                `command:"update-security-group" description:"Update a security group"`
 	UpdateService                      v7.UpdateServiceCommand                      `command:"update-service" description:"Update a service instance"`
+	UpdateSidecar                      v7.UpdateSidecarCommand                      `command:"update-sidecar" description:"Update a sidecar of an app"`
 	UpgradeService                     v7.UpgradeServiceCommand                     `command:"upgrade-service" description:"Upgrade a service instance to the latest available version of its current service plan"`
 	UpdateServiceBroker                v7.UpdateServiceBrokerCommand                `command:"update-service-broker" description:"Update a service broker"`
 	UpdateSpaceQuota                   v7.UpdateSpaceQuotaCommand                   `command:"update-space-quota" description:"Update an existing space quota"`
 	UpdateUserProvidedService          v7.UpdateUserProvidedServiceCommand          `command:"update-user-provided-service" alias:"uups" description:"Update user-provided service instance"`
+	UseContext                         v7.UseContextCommand                         `command:"use-context" description:"Switch to a named context"`
+	ValidateManifest                   v7.ValidateManifestCommand                   `command:"validate-manifest" description:"Check an app manifest for invalid and misspelled fields"`
 	Version                            VersionCommand                               `command:"version" description:"Print the version"`
 }
 
@@ -318,6 +318,8 @@ func (cmd HelpCommand) displayCommand() error {
 func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 	return [][]string{
 		{"CF_COLOR=false", cmd.UI.TranslateText("Do not colorize output")},
+		{"CF_CREDENTIAL_STORE=encrypted-file", cmd.UI.TranslateText("Keep tokens out of config.json: plaintext, encrypted-file or helper:NAME")},
+		{"CF_CREDENTIAL_STORE_PASSPHRASE=...", cmd.UI.TranslateText("Passphrase of the encrypted-file credential store")},
 		{"CF_DIAL_TIMEOUT=6", cmd.UI.TranslateText("Max wait time to establish a connection, including name resolution, in seconds")},
 		{"CF_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default config directory")},
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
@@ -332,6 +334,8 @@ func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
+		{"--output", cmd.UI.TranslateText("Display results as json or yaml, other output goes to stderr")},
+		{"--context", cmd.UI.TranslateText("Run the command against the named context instead of the current one")},
 	}
 }
 
@@ -6,6 +6,7 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"help", "version", "login", "logout", "passwd", "target"},
 			{"api", "auth"},
+			{"contexts", "use-context", "create-context", "rename-context", "delete-context"},
 		},
 	},
 	{
@@ -13,7 +14,7 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"apps", "app", "create-app"},
 			{"push", "scale", "delete", "rename"},
-			{"cancel-deployment", "continue-deployment"},
+			{"deployments", "deployment", "cancel-deployment", "continue-deployment"},
 			{"start", "stop", "restart", "stage-package", "restage", "restart-app-instance"},
 			{"run-task", "tasks", "terminate-task"},
 			{"packages", "create-package"},
@@ -21,9 +22,10 @@ var HelpCategoryList = []HelpCategory{
 			{"droplets", "set-droplet", "download-droplet"},
 			{"events", "logs"},
 			{"env", "set-env", "unset-env"},
+			{"sidecars", "create-sidecar", "update-sidecar", "delete-sidecar"},
 			{"stacks", "stack"},
 			{"copy-source", "create-app-manifest"},
-			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
+			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh", "scp"},
 		},
 	},
 	{
@@ -49,7 +51,8 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "SPACES:",
 		CommandList: [][]string{
 			{"spaces", "space"},
-			{"create-space", "delete-space", "rename-space", "apply-manifest"},
+			{"create-space", "delete-space", "rename-space", "apply-manifest", "diff-manifest", "validate-manifest"},
+			{"export-space", "apply-space"},
 			{"allow-space-ssh", "disallow-space-ssh", "space-ssh-allowed"},
 		},
 	},
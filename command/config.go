@@ -20,8 +20,12 @@ type Config interface {
 	CFPassword() string
 	CFUsername() string
 	ColorEnabled() configv3.ColorSetting
+	Contexts() map[string]configv3.TargetContext
+	CreateContext(name string, fromCurrent bool) error
+	CurrentContext() string
 	CurrentUser() (configv3.User, error)
 	CurrentUserName() (string, error)
+	DeleteContext(name string) error
 	DialTimeout() time.Duration
 	DockerPassword() string
 	Experimental() bool
@@ -32,9 +36,11 @@ type Config interface {
 	IsTTY() bool
 	Locale() string
 	LogCacheEndpoint() string
+	ManifestVarsHelper() string
 	MinCLIVersion() string
 	NOAARequestRetryCount() int
 	NetworkPolicyV1Endpoint() string
+	OutputFormat() configv3.OutputFormat
 	OverallPollingTimeout() time.Duration
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
@@ -42,6 +48,7 @@ type Config interface {
 	PollingInterval() time.Duration
 	RefreshToken() string
 	RemovePlugin(string)
+	RenameContext(oldName string, newName string) error
 	RequestRetryCount() int
 	RoutingEndpoint() string
 	SetAsyncTimeout(timeout int)
@@ -77,6 +84,7 @@ type Config interface {
 	UnsetOrganizationAndSpaceInformation()
 	UnsetSpaceInformation()
 	UnsetUserInformation()
+	UseContext(name string) error
 	Verbose() (bool, []string)
 	WritePluginConfig() error
 	WriteConfig() error
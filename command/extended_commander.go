@@ -9,3 +9,10 @@ type ExtendedCommander interface {
 	flags.Commander
 	Setup(Config, UI) error
 }
+
+// StructuredCommander is implemented by commands that display their results
+// as json or yaml when the global --output flag is set. Other commands cannot
+// be run with --output.
+type StructuredCommander interface {
+	StructuredOutput()
+}
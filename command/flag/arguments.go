@@ -8,6 +8,10 @@ type OptionalAppName struct {
 	AppName string `positional-arg-name:"APP_NAME" description:"The application name"`
 }
 
+type OptionalAppNames struct {
+	AppNames []string `positional-arg-name:"APP_NAME" description:"The application names"`
+}
+
 type AppDroplet struct {
 	AppName     string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	DropletGUID string `positional-arg-name:"DROPLET_GUID" required:"true" description:"The droplet guid"`
@@ -73,6 +77,15 @@ type Space struct {
 	Space string `positional-arg-name:"SPACE" required:"true" description:"The space"`
 }
 
+type ContextName struct {
+	Name string `positional-arg-name:"CONTEXT_NAME" required:"true" description:"The context name"`
+}
+
+type RenameContextArgs struct {
+	OldName string `positional-arg-name:"CONTEXT_NAME" required:"true" description:"The current context name"`
+	NewName string `positional-arg-name:"NEW_CONTEXT_NAME" required:"true" description:"The new context name"`
+}
+
 type Rename struct {
 	OldAppName string `positional-arg-name:"APP_NAME" required:"true" description:"The current app name"`
 	NewAppName string `positional-arg-name:"NEW_APP_NAME" required:"true" description:"The new app name"`
@@ -114,6 +127,24 @@ type AppInstance struct {
 	Index   int    `positional-arg-name:"INDEX" required:"true" description:"The index of the application instance"`
 }
 
+type AppSidecar struct {
+	AppName     string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	SidecarName string `positional-arg-name:"SIDECAR_NAME" required:"true" description:"The sidecar name"`
+}
+
+type SCPArgs struct {
+	Source      string `positional-arg-name:"SOURCE" required:"true" description:"The local path or APP_NAME:REMOTE_PATH to copy from"`
+	Destination string `positional-arg-name:"DESTINATION" required:"true" description:"The local path or APP_NAME:REMOTE_PATH to copy to"`
+}
+
+type SpaceBundleDirectory struct {
+	Directory Path `positional-arg-name:"DIRECTORY" required:"true" description:"The directory of the space bundle"`
+}
+
+type SpaceBundleDirectoryWithExistenceCheck struct {
+	Directory PathWithExistenceCheck `positional-arg-name:"DIRECTORY" required:"true" description:"The directory of the space bundle"`
+}
+
 type OrgSpace struct {
 	Organization string `positional-arg-name:"ORG" required:"true" description:"The organization"`
 	Space        string `positional-arg-name:"SPACE" required:"true" description:"The space"`
@@ -0,0 +1,25 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Duration is a positive length of time such as 30s, 5m or 1h.
+type Duration struct {
+	time.Duration
+}
+
+func (d *Duration) UnmarshalFlag(rawValue string) error {
+	duration, err := time.ParseDuration(rawValue)
+	if err != nil || duration <= 0 {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: "Duration must be a positive length of time (e.g. 30s, 5m, 1h)",
+		}
+	}
+
+	d.Duration = duration
+	return nil
+}
@@ -0,0 +1,42 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Duration", func() {
+	var duration Duration
+
+	BeforeEach(func() {
+		duration = Duration{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		When("passed a positive duration", func() {
+			It("sets the duration", func() {
+				err := duration.UnmarshalFlag("5m30s")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(duration.Duration).To(Equal(5*time.Minute + 30*time.Second))
+			})
+		})
+
+		DescribeTable("returns an error for invalid durations",
+			func(rawValue string) {
+				err := duration.UnmarshalFlag(rawValue)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: "Duration must be a positive length of time (e.g. 30s, 5m, 1h)",
+				}))
+			},
+			Entry("not a duration", "soon"),
+			Entry("a bare number", "5"),
+			Entry("zero", "0s"),
+			Entry("negative", "-5m"),
+		)
+	})
+})
@@ -0,0 +1,46 @@
+package flag
+
+import (
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/resources"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// InstanceSteps is a comma-separated list of increasing canary instance
+// weights, each a percentage between 1 and 100.
+type InstanceSteps struct {
+	Weights []int64
+}
+
+func (s *InstanceSteps) UnmarshalFlag(val string) error {
+	s.Weights = nil
+
+	var weights []int64
+	for _, step := range strings.Split(val, ",") {
+		weight, err := strconv.ParseInt(strings.TrimSpace(step), 10, 64)
+		if err != nil {
+			return instanceStepsError()
+		}
+		weights = append(weights, weight)
+	}
+
+	if !resources.ValidInstanceSteps(weights) {
+		return instanceStepsError()
+	}
+
+	s.Weights = weights
+	return nil
+}
+
+func instanceStepsError() error {
+	return &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: "invalid argument for flag '--instance-steps' (expected comma-separated increasing percentages between 1 and 100)",
+	}
+}
+
+func (s InstanceSteps) IsSet() bool {
+	return len(s.Weights) > 0
+}
@@ -0,0 +1,45 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InstanceSteps", func() {
+	var instanceSteps InstanceSteps
+
+	BeforeEach(func() {
+		instanceSteps = InstanceSteps{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		When("increasing percentages are provided", func() {
+			It("stores the weights", func() {
+				err := instanceSteps.UnmarshalFlag("10, 25,50,100")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(instanceSteps.Weights).To(Equal([]int64{10, 25, 50, 100}))
+				Expect(instanceSteps.IsSet()).To(BeTrue())
+			})
+		})
+
+		DescribeTable("invalid values",
+			func(value string) {
+				err := instanceSteps.UnmarshalFlag(value)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for flag '--instance-steps' (expected comma-separated increasing percentages between 1 and 100)",
+				}))
+				Expect(instanceSteps.IsSet()).To(BeFalse())
+			},
+			Entry("empty", ""),
+			Entry("not a number", "10,abc"),
+			Entry("zero", "0,50"),
+			Entry("over 100", "50,150"),
+			Entry("decreasing", "50,25"),
+			Entry("repeated", "25,25"),
+			Entry("trailing comma", "10,"),
+		)
+	})
+})
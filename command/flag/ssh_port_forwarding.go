@@ -10,36 +10,86 @@ import (
 
 const DefaultLocalAddress = "localhost"
 
+var portNumberRegexp = regexp.MustCompile(`^\d+$`)
+
 type SSHPortForwarding struct {
 	LocalAddress  string
 	RemoteAddress string
 }
 
 func (s *SSHPortForwarding) UnmarshalFlag(val string) error {
-	splitHosts := strings.Split(val, ":")
-	for _, piece := range splitHosts {
-		if len(piece) == 0 {
-			return &flags.Error{
-				Type:    flags.ErrRequired,
-				Message: fmt.Sprintf("Bad local forwarding specification '%s'", val),
-			}
-		}
+	listenAddress, connectAddress, err := parseForwardingSpecification(val, "local")
+	if err != nil {
+		return err
 	}
 
-	re := regexp.MustCompile(`^\d+$`)
+	s.LocalAddress = listenAddress
+	s.RemoteAddress = connectAddress
+	return nil
+}
+
+// SSHRemotePortForwarding is a [BIND_ADDRESS:]REMOTE_PORT:LOCAL_HOST:LOCAL_PORT
+// specification. The instance listens on RemoteAddress and connections are
+// forwarded to LocalAddress.
+type SSHRemotePortForwarding struct {
+	RemoteAddress string
+	LocalAddress  string
+}
+
+func (s *SSHRemotePortForwarding) UnmarshalFlag(val string) error {
+	listenAddress, connectAddress, err := parseForwardingSpecification(val, "remote")
+	if err != nil {
+		return err
+	}
+
+	s.RemoteAddress = listenAddress
+	s.LocalAddress = connectAddress
+	return nil
+}
+
+// SSHDynamicPortForwarding is a [BIND_ADDRESS:]LOCAL_PORT specification for a
+// local SOCKS proxy.
+type SSHDynamicPortForwarding struct {
+	LocalAddress string
+}
+
+func (s *SSHDynamicPortForwarding) UnmarshalFlag(val string) error {
+	splitHosts := strings.Split(val, ":")
+
 	switch {
-	case len(splitHosts) == 3 && re.MatchString(splitHosts[0]) && re.MatchString(splitHosts[2]):
+	case len(splitHosts) == 1 && portNumberRegexp.MatchString(splitHosts[0]):
 		s.LocalAddress = fmt.Sprintf("%s:%s", DefaultLocalAddress, splitHosts[0])
-		s.RemoteAddress = fmt.Sprintf("%s:%s", splitHosts[1], splitHosts[2])
-	case len(splitHosts) == 4 && re.MatchString(splitHosts[1]) && re.MatchString(splitHosts[3]):
+	case len(splitHosts) == 2 && len(splitHosts[0]) > 0 && portNumberRegexp.MatchString(splitHosts[1]):
 		s.LocalAddress = fmt.Sprintf("%s:%s", splitHosts[0], splitHosts[1])
-		s.RemoteAddress = fmt.Sprintf("%s:%s", splitHosts[2], splitHosts[3])
 	default:
 		return &flags.Error{
 			Type:    flags.ErrRequired,
-			Message: fmt.Sprintf("Bad local forwarding specification '%s'", val),
+			Message: fmt.Sprintf("Bad dynamic forwarding specification '%s'", val),
 		}
 	}
 
 	return nil
 }
+
+func parseForwardingSpecification(val string, kind string) (string, string, error) {
+	badSpecificationErr := &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: fmt.Sprintf("Bad %s forwarding specification '%s'", kind, val),
+	}
+
+	splitHosts := strings.Split(val, ":")
+	for _, piece := range splitHosts {
+		if len(piece) == 0 {
+			return "", "", badSpecificationErr
+		}
+	}
+
+	switch {
+	case len(splitHosts) == 3 && portNumberRegexp.MatchString(splitHosts[0]) && portNumberRegexp.MatchString(splitHosts[2]):
+		return fmt.Sprintf("%s:%s", DefaultLocalAddress, splitHosts[0]), fmt.Sprintf("%s:%s", splitHosts[1], splitHosts[2]), nil
+	case len(splitHosts) == 4 && portNumberRegexp.MatchString(splitHosts[1]) && portNumberRegexp.MatchString(splitHosts[3]):
+		return fmt.Sprintf("%s:%s", splitHosts[0], splitHosts[1]), fmt.Sprintf("%s:%s", splitHosts[2], splitHosts[3]), nil
+	default:
+		return "", "", badSpecificationErr
+	}
+}
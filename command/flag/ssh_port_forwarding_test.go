@@ -60,3 +60,89 @@ var _ = Describe("SpaceRole", func() {
 		)
 	})
 })
+
+var _ = Describe("SSHRemotePortForwarding", func() {
+	var forward SSHRemotePortForwarding
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			forward = SSHRemotePortForwarding{}
+		})
+
+		When("passed remote_port:local:local_port", func() {
+			It("extracts the remote and local addresses", func() {
+				err := forward.UnmarshalFlag("9229:localhost:9229")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHRemotePortForwarding{
+					RemoteAddress: "localhost:9229",
+					LocalAddress:  "localhost:9229",
+				}))
+			})
+		})
+
+		When("passed remote:remote_port:local:local_port", func() {
+			It("extracts the remote and local addresses", func() {
+				err := forward.UnmarshalFlag("0.0.0.0:9000:debugger:5005")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHRemotePortForwarding{
+					RemoteAddress: "0.0.0.0:9000",
+					LocalAddress:  "debugger:5005",
+				}))
+			})
+		})
+
+		DescribeTable("error cases",
+			func(input string) {
+				err := forward.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: fmt.Sprintf("Bad remote forwarding specification '%s'", input),
+				}))
+			},
+
+			Entry("1 colon", "9000:5005"),
+			Entry("empty values in between colons", "9000::5005"),
+			Entry("incorrect port numbers", "remote:AM:local:5005"),
+		)
+	})
+})
+
+var _ = Describe("SSHDynamicPortForwarding", func() {
+	var forward SSHDynamicPortForwarding
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			forward = SSHDynamicPortForwarding{}
+		})
+
+		When("passed local_port", func() {
+			It("binds to localhost", func() {
+				err := forward.UnmarshalFlag("1080")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHDynamicPortForwarding{LocalAddress: "localhost:1080"}))
+			})
+		})
+
+		When("passed local:local_port", func() {
+			It("binds to the given address", func() {
+				err := forward.UnmarshalFlag("0.0.0.0:1080")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHDynamicPortForwarding{LocalAddress: "0.0.0.0:1080"}))
+			})
+		})
+
+		DescribeTable("error cases",
+			func(input string) {
+				err := forward.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: fmt.Sprintf("Bad dynamic forwarding specification '%s'", input),
+				}))
+			},
+
+			Entry("not a port", "socks"),
+			Entry("empty address", ":1080"),
+			Entry("too many colons", "local:1080:remote"),
+		)
+	})
+})
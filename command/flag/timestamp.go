@@ -0,0 +1,47 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Timestamp is a point in time given as an RFC3339 timestamp, as a date in the
+// local time zone, or as a duration that is counted back from now. A date is
+// the start of that day.
+type Timestamp struct {
+	time.Time
+	// IsDate is set when the timestamp was given as a date.
+	IsDate bool
+}
+
+// EndOfDay returns the last moment of the day when the timestamp was given as
+// a date, and the time itself otherwise.
+func (t Timestamp) EndOfDay() time.Time {
+	if !t.IsDate {
+		return t.Time
+	}
+	return t.Time.AddDate(0, 0, 1).Add(-time.Nanosecond)
+}
+
+func (t *Timestamp) UnmarshalFlag(rawValue string) error {
+	if parsed, err := time.Parse(time.RFC3339, rawValue); err == nil {
+		*t = Timestamp{Time: parsed}
+		return nil
+	}
+
+	if parsed, err := time.ParseInLocation(time.DateOnly, rawValue, time.Local); err == nil {
+		*t = Timestamp{Time: parsed, IsDate: true}
+		return nil
+	}
+
+	if duration, err := time.ParseDuration(rawValue); err == nil && duration > 0 {
+		*t = Timestamp{Time: time.Now().Add(-duration)}
+		return nil
+	}
+
+	return &flags.Error{
+		Type:    flags.ErrMarshal,
+		Message: `Time must be a timestamp (e.g. 2006-01-02T15:04:05Z), a date (e.g. 2006-01-02) or a duration ago (e.g. 24h, 30m)`,
+	}
+}
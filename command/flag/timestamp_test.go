@@ -0,0 +1,72 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Timestamp", func() {
+	var timestamp Timestamp
+
+	BeforeEach(func() {
+		timestamp = Timestamp{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		When("passed an RFC3339 timestamp", func() {
+			It("sets the time", func() {
+				err := timestamp.UnmarshalFlag("2024-01-31T15:04:05Z")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(timestamp.Time).To(BeTemporally("==", time.Date(2024, 1, 31, 15, 4, 5, 0, time.UTC)))
+				Expect(timestamp.IsDate).To(BeFalse())
+			})
+		})
+
+		When("passed a date", func() {
+			It("sets the start of the day in the local time zone", func() {
+				err := timestamp.UnmarshalFlag("2024-01-31")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(timestamp.Time).To(BeTemporally("==", time.Date(2024, 1, 31, 0, 0, 0, 0, time.Local)))
+				Expect(timestamp.IsDate).To(BeTrue())
+			})
+		})
+
+		When("passed a duration", func() {
+			It("sets the time that long ago", func() {
+				err := timestamp.UnmarshalFlag("24h")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(timestamp.Time).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Minute))
+			})
+		})
+
+		When("passed anything else", func() {
+			It("returns an error", func() {
+				err := timestamp.UnmarshalFlag("yesterday")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: `Time must be a timestamp (e.g. 2006-01-02T15:04:05Z), a date (e.g. 2006-01-02) or a duration ago (e.g. 24h, 30m)`,
+				}))
+			})
+		})
+	})
+
+	Describe("EndOfDay", func() {
+		When("the timestamp is a date", func() {
+			It("returns the end of that day", func() {
+				Expect(timestamp.UnmarshalFlag("2024-01-31")).To(Succeed())
+				Expect(timestamp.EndOfDay()).To(BeTemporally("==", time.Date(2024, 2, 1, 0, 0, 0, 0, time.Local).Add(-time.Nanosecond)))
+			})
+		})
+
+		When("the timestamp is a point in time", func() {
+			It("returns the time", func() {
+				Expect(timestamp.UnmarshalFlag("2024-01-31T15:04:05Z")).To(Succeed())
+				Expect(timestamp.EndOfDay()).To(BeTemporally("==", time.Date(2024, 1, 31, 15, 4, 5, 0, time.UTC)))
+			})
+		})
+	})
+})
@@ -0,0 +1,19 @@
+package translatableerror
+
+type CanaryDeploymentCanceledError struct {
+	AppName    string
+	Reason     string
+	BinaryName string
+}
+
+func (CanaryDeploymentCanceledError) Error() string {
+	return "Canary deployment for app {{.AppName}} was canceled: {{.Reason}}\n\nThe previous version of the app is still running.\nTIP: use '{{.BinaryName}} logs {{.AppName}} --recent' for more information"
+}
+
+func (e CanaryDeploymentCanceledError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":    e.AppName,
+		"Reason":     e.Reason,
+		"BinaryName": e.BinaryName,
+	})
+}
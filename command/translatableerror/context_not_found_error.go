@@ -0,0 +1,15 @@
+package translatableerror
+
+type ContextNotFoundError struct {
+	Name string
+}
+
+func (ContextNotFoundError) Error() string {
+	return "Context '{{.Name}}' not found."
+}
+
+func (e ContextNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
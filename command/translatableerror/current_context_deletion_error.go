@@ -0,0 +1,15 @@
+package translatableerror
+
+type CurrentContextDeletionError struct {
+	Name string
+}
+
+func (CurrentContextDeletionError) Error() string {
+	return "Context '{{.Name}}' is in use and cannot be deleted. Switch to another context first."
+}
+
+func (e CurrentContextDeletionError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// EmptyAssertionError is returned when the assertion given to auth with
+// --assertion-file or --assertion-env is empty.
+type EmptyAssertionError struct {
+	Source string
+}
+
+func (EmptyAssertionError) Error() string {
+	return "No assertion found in {{.Source}}."
+}
+
+func (e EmptyAssertionError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Source": e.Source,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// ManifestDriftError is returned when the apps in one or more spaces differ
+// from the manifest they were compared with.
+type ManifestDriftError struct {
+	Spaces []string
+}
+
+func (ManifestDriftError) Error() string {
+	return "The apps in {{.Spaces}} differ from the manifest."
+}
+
+func (e ManifestDriftError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Spaces": strings.Join(e.Spaces, ", "),
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type ManifestValidationFailedError struct {
+	PathToManifest string
+}
+
+func (ManifestValidationFailedError) Error() string {
+	return "Manifest {{.PathToManifest}} is not valid."
+}
+
+func (e ManifestValidationFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PathToManifest": e.PathToManifest,
+	})
+}
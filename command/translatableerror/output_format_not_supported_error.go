@@ -0,0 +1,13 @@
+package translatableerror
+
+// OutputFormatNotSupportedError is returned when the global --output flag is
+// used with a command that has no results to display as json or yaml.
+type OutputFormatNotSupportedError struct{}
+
+func (OutputFormatNotSupportedError) Error() string {
+	return "Incorrect Usage: --output is not supported by this command because it has no results to display as json or yaml"
+}
+
+func (e OutputFormatNotSupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
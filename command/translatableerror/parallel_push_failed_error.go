@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// ParallelPushFailedError is returned by push --parallel when one or more of
+// the apps could not be pushed.
+type ParallelPushFailedError struct {
+	AppNames []string
+}
+
+func (ParallelPushFailedError) Error() string {
+	return "Failed to push apps: {{.AppNames}}"
+}
+
+func (e ParallelPushFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppNames": strings.Join(e.AppNames, ", "),
+	})
+}
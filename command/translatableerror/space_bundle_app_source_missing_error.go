@@ -0,0 +1,17 @@
+package translatableerror
+
+// SpaceBundleAppSourceMissingError is returned when apply-space --push is
+// given a bundle with an app that has neither a path nor a docker image.
+type SpaceBundleAppSourceMissingError struct {
+	AppName string
+}
+
+func (SpaceBundleAppSourceMissingError) Error() string {
+	return "App {{.AppName}} in the space bundle has no path or docker image. Add the path to its source to manifest.yml, or apply the bundle without --push."
+}
+
+func (e SpaceBundleAppSourceMissingError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
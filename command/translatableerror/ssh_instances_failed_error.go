@@ -0,0 +1,21 @@
+package translatableerror
+
+// SSHInstancesFailedError is returned when a command run on multiple app
+// instances did not succeed on all of them. ExitStatus is the exit status the
+// CLI should exit with.
+type SSHInstancesFailedError struct {
+	FailedCount   int
+	InstanceCount int
+	ExitStatus    int
+}
+
+func (SSHInstancesFailedError) Error() string {
+	return "Command failed on {{.FailedCount}} of {{.InstanceCount}} instances."
+}
+
+func (e SSHInstancesFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"FailedCount":   e.FailedCount,
+		"InstanceCount": e.InstanceCount,
+	})
+}
@@ -12,6 +12,7 @@ import (
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . UI
 type UI interface {
 	DeferText(template string, data ...map[string]interface{})
+	DisplayAppLogMessage(appName string, message ui.LogMessage, displayHeader bool)
 	DisplayBoolPrompt(defaultResponse bool, template string, templateValues ...map[string]interface{}) (bool, error)
 	DisplayChangesForPush(changeSet []ui.Change) error
 	DisplayDeprecationWarning()
@@ -31,6 +32,8 @@ type UI interface {
 	DisplayOK()
 	DisplayOptionalTextPrompt(defaultValue string, template string, templateValues ...map[string]interface{}) (string, error)
 	DisplayPasswordPrompt(template string, templateValues ...map[string]interface{}) (string, error)
+	DisplayStructuredData(data interface{})
+	DisplayStructuredLine(data interface{}) error
 	DisplayTableWithHeader(prefix string, table [][]string, padding int)
 	DisplayText(template string, data ...map[string]interface{})
 	DisplayTextLiteral(text string)
@@ -22,7 +22,10 @@ import (
 
 type Actor interface {
 	ApplyOrganizationQuotaByName(quotaName string, orgGUID string) (v7action.Warnings, error)
+	ApplyRoute(spaceGUID string, route v7action.ExportedRoute) (bool, v7action.Warnings, error)
+	ApplyServiceInstance(spaceGUID string, instance v7action.ExportedServiceInstance) (bool, v7action.Warnings, error)
 	ApplySpaceQuotaByName(quotaName string, spaceGUID string, orgGUID string) (v7action.Warnings, error)
+	ApplyUserProvidedService(spaceGUID string, instance v7action.ExportedUserProvidedService) (bool, v7action.Warnings, error)
 	AssignIsolationSegmentToSpaceByNameAndSpace(isolationSegmentName string, spaceGUID string) (v7action.Warnings, error)
 	Authenticate(credentials map[string]string, origin string, grantType uaa.GrantType) error
 	BindSecurityGroupToSpaces(securityGroupGUID string, spaces []resources.Space, lifecycle constant.SecurityGroupLifecycle) (v7action.Warnings, error)
@@ -34,6 +37,7 @@ type Actor interface {
 	CreateAndUploadBitsPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string) (resources.Package, v7action.Warnings, error)
 	CreateApplicationDroplet(appGUID string) (resources.Droplet, v7action.Warnings, error)
 	CreateApplicationInSpace(app resources.Application, spaceGUID string) (resources.Application, v7action.Warnings, error)
+	CreateApplicationSidecarByNameAndSpace(appName string, spaceGUID string, sidecar resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)
 	CreateBitsPackageByApplication(appGUID string) (resources.Package, v7action.Warnings, error)
 	CreateBuildpack(buildpack resources.Buildpack) (resources.Buildpack, v7action.Warnings, error)
 	CreateDeployment(dep resources.Deployment) (string, v7action.Warnings, error)
@@ -58,6 +62,7 @@ type Actor interface {
 	CreateUser(username string, password string, origin string) (resources.User, v7action.Warnings, error)
 	CreateUserProvidedServiceInstance(instance resources.ServiceInstance) (v7action.Warnings, error)
 	DeleteApplicationByNameAndSpace(name, spaceGUID string, deleteRoutes bool) (v7action.Warnings, error)
+	DeleteApplicationSidecarByNameAndSpace(appName string, spaceGUID string, sidecarName string) (v7action.Warnings, error)
 	DeleteBuildpackByNameAndStack(buildpackName string, buildpackStack string) (v7action.Warnings, error)
 	DeleteDomain(domain resources.Domain) (v7action.Warnings, error)
 	DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, instanceIndex int) (v7action.Warnings, error)
@@ -86,6 +91,10 @@ type Actor interface {
 	EnableFeatureFlag(flagName string) (v7action.Warnings, error)
 	EnableServiceAccess(offeringName, brokerName, orgName, planName string) (v7action.SkippedPlans, v7action.Warnings, error)
 	EntitleIsolationSegmentToOrganizationByName(isolationSegmentName string, orgName string) (v7action.Warnings, error)
+	EvaluateCanaryHealth(app resources.Application, deployment resources.Deployment, criteria v7action.CanaryHealthCriteria, client sharedaction.LogCacheClient) (v7action.CanaryHealthReport, v7action.Warnings, error)
+	ExportSpace(spaceGUID string) (v7action.SpaceExport, v7action.Warnings, error)
+	FinishAuthorizationCodeLogin(login *v7action.AuthorizationCodeLogin, timeout time.Duration) error
+	FinishDeviceLogin(authorization v7action.DeviceAuthorization) error
 	GetAppFeature(appGUID string, featureName string) (resources.ApplicationFeature, v7action.Warnings, error)
 	GetAppSummariesForSpace(spaceGUID string, labels string, omitStats bool) ([]v7action.ApplicationSummary, v7action.Warnings, error)
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (resources.Application, v7action.Warnings, error)
@@ -96,19 +105,28 @@ type Actor interface {
 	GetApplicationProcessHealthChecksByNameAndSpace(appName string, spaceGUID string) ([]v7action.ProcessHealthCheck, v7action.Warnings, error)
 	GetApplicationRevisionsDeployed(appGUID string) ([]resources.Revision, v7action.Warnings, error)
 	GetApplicationRoutes(appGUID string) ([]resources.Route, v7action.Warnings, error)
+	GetApplicationSidecarsByNameAndSpace(appName string, spaceGUID string) ([]resources.Sidecar, v7action.Warnings, error)
 	GetApplicationTasks(appName string, sortOrder v7action.SortOrder) ([]resources.Task, v7action.Warnings, error)
 	GetApplicationsByNamesAndSpace(appNames []string, spaceGUID string) ([]resources.Application, v7action.Warnings, error)
+	GetApplicationsBySpace(spaceGUID string) ([]resources.Application, v7action.Warnings, error)
 	GetBuildpackLabels(buildpackName string, buildpackStack string) (map[string]types.NullString, v7action.Warnings, error)
 	GetBuildpacks(labelSelector string) ([]resources.Buildpack, v7action.Warnings, error)
 	GetCurrentUser() (configv3.User, error)
 	GetDefaultDomain(orgGUID string) (resources.Domain, v7action.Warnings, error)
+	GetDeployment(deploymentGUID string) (resources.Deployment, v7action.Warnings, error)
+	GetDeploymentSummariesForApp(appGUID string) ([]v7action.DeploymentSummary, v7action.Warnings, error)
+	GetDetailedDeploymentSummary(appGUID string, deploymentGUID string) (v7action.DetailedDeploymentSummary, v7action.Warnings, error)
 	GetDetailedAppSummary(appName string, spaceGUID string, withObfuscatedValues bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)
 	GetDomain(domainGUID string) (resources.Domain, v7action.Warnings, error)
 	GetDomainByName(domainName string) (resources.Domain, v7action.Warnings, error)
 	GetDomainLabels(domainName string) (map[string]types.NullString, v7action.Warnings, error)
+	GetEventsByApplicationNameAndSpace(appName string, spaceGUID string, filters v7action.EventFilters) ([]v7action.Event, v7action.Warnings, error)
+	GetEventsByOrganization(orgGUID string, filters v7action.EventFilters) ([]v7action.Event, v7action.Warnings, error)
+	GetEventsBySpace(spaceGUID string, filters v7action.EventFilters) ([]v7action.Event, v7action.Warnings, error)
 	GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefaultIsolationSegmentGUID string) (resources.IsolationSegment, v7action.Warnings, error)
 	GetEnvironmentVariableGroup(group constant.EnvironmentVariableGroupName) (v7action.EnvironmentVariableGroup, v7action.Warnings, error)
 	GetEnvironmentVariablesByApplicationNameAndSpace(appName string, spaceGUID string) (v7action.EnvironmentVariableGroups, v7action.Warnings, error)
+	GetExistingApplicationsByNamesAndSpace(appNames []string, spaceGUID string) ([]resources.Application, v7action.Warnings, error)
 	GetFeatureFlagByName(featureFlagName string) (resources.FeatureFlag, v7action.Warnings, error)
 	GetFeatureFlags() ([]resources.FeatureFlag, v7action.Warnings, error)
 	GetGlobalRunningSecurityGroups() ([]resources.SecurityGroup, v7action.Warnings, error)
@@ -131,8 +149,7 @@ type Actor interface {
 	GetOrganizations(labelSelector string) ([]resources.Organization, v7action.Warnings, error)
 	GetProcessByTypeAndApplication(processType string, appGUID string) (resources.Process, v7action.Warnings, error)
 	GetRawApplicationManifestByNameAndSpace(appName string, spaceGUID string) ([]byte, v7action.Warnings, error)
-	GetRecentEventsByApplicationNameAndSpace(appName string, spaceGUID string) ([]v7action.Event, v7action.Warnings, error)
-	GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) ([]sharedaction.LogMessage, v7action.Warnings, error)
+	GetRecentLogsForApplications(apps []resources.Application, client sharedaction.LogCacheClient, options v7action.LogOptions) ([]v7action.AppLogMessage, error)
 	GetRootResponse() (v7action.Info, v7action.Warnings, error)
 	GetRevisionByApplicationAndVersion(appGUID string, revisionVersion int) (resources.Revision, v7action.Warnings, error)
 	GetRevisionsByApplicationNameAndSpace(appName string, spaceGUID string) ([]resources.Revision, v7action.Warnings, error)
@@ -147,6 +164,7 @@ type Actor interface {
 	GetSSHEnabledByAppName(appName string, spaceGUID string) (ccv3.SSHEnabled, v7action.Warnings, error)
 	GetSSHPasscode() (string, error)
 	GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint) (v7action.SSHAuthentication, v7action.Warnings, error)
+	GetSecureShellConfigurationsForAllInstances(appName string, spaceGUID string, processType string) ([]v7action.InstanceSSHAuthentication, v7action.Warnings, error)
 	GetSecurityGroup(securityGroupName string) (resources.SecurityGroup, v7action.Warnings, error)
 	GetSecurityGroupSummary(securityGroupName string) (v7action.SecurityGroupSummary, v7action.Warnings, error)
 	GetSecurityGroups() ([]v7action.SecurityGroupSummary, v7action.Warnings, error)
@@ -176,6 +194,7 @@ type Actor interface {
 	GetStackLabels(stackName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetStacks(string) ([]resources.Stack, v7action.Warnings, error)
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
+	GetStreamingLogsForApplications(apps []resources.Application, client sharedaction.LogCacheClient, options v7action.LogOptions) (<-chan v7action.AppLogMessage, <-chan error, context.CancelFunc)
 	GetTaskBySequenceIDAndApplication(sequenceID int, appGUID string) (resources.Task, v7action.Warnings, error)
 	GetUAAAPIVersion() (string, error)
 	GetUnstagedNewestPackageGUID(appGuid string) (string, v7action.Warnings, error)
@@ -222,6 +241,8 @@ type Actor interface {
 	StageApplicationPackage(pkgGUID string) (resources.Build, v7action.Warnings, error)
 	StagePackage(packageGUID, appName, spaceGUID string) (<-chan resources.Droplet, <-chan v7action.Warnings, <-chan error)
 	StartApplication(appGUID string) (v7action.Warnings, error)
+	StartAuthorizationCodeLogin(origin string) (*v7action.AuthorizationCodeLogin, error)
+	StartDeviceLogin() (v7action.DeviceAuthorization, error)
 	StopApplication(appGUID string) (v7action.Warnings, error)
 	TerminateTask(taskGUID string) (resources.Task, v7action.Warnings, error)
 	UnbindSecurityGroup(securityGroupName string, orgGUID string, spaceGUID string, lifecycle constant.SecurityGroupLifecycle) (v7action.Warnings, error)
@@ -234,6 +255,7 @@ type Actor interface {
 	UpdateAppFeature(app resources.Application, enabled bool, featureName string) (v7action.Warnings, error)
 	UpdateApplication(app resources.Application) (resources.Application, v7action.Warnings, error)
 	UpdateApplicationLabelsByApplicationName(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateApplicationSidecarByNameAndSpace(appName string, spaceGUID string, sidecar resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)
 	UpdateBuildpackByNameAndStack(buildpackName string, buildpackStack string, buildpack resources.Buildpack) (resources.Buildpack, v7action.Warnings, error)
 	UpdateBuildpackLabelsByBuildpackNameAndStack(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateDestination(string, string, string) (v7action.Warnings, error)
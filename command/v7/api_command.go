@@ -31,6 +31,9 @@ func (cmd *APICommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+// StructuredOutput marks api as displaying its results with --output.
+func (cmd *APICommand) StructuredOutput() {}
+
 func (cmd *APICommand) Execute(args []string) error {
 	if cmd.Unset {
 		return cmd.clearTarget()
@@ -93,6 +96,10 @@ func (cmd *APICommand) viewTarget() error {
 }
 
 func (cmd *APICommand) displayTarget() error {
+	cmd.UI.DisplayStructuredData(targetSummary{
+		APIEndpoint: cmd.Config.Target(),
+		APIVersion:  cmd.Config.APIVersion(),
+	})
 	cmd.UI.DisplayKeyValueTable("", [][]string{
 		{cmd.UI.TranslateText("API endpoint:"), cmd.Config.Target()},
 		{cmd.UI.TranslateText("API version:"), cmd.Config.APIVersion()},
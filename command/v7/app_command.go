@@ -14,6 +14,9 @@ type AppCommand struct {
 	relatedCommands interface{}  `related_commands:"apps, events, logs, map-route, unmap-route, push"`
 }
 
+// StructuredOutput marks app as displaying its results with --output.
+func (cmd AppCommand) StructuredOutput() {}
+
 func (cmd AppCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -20,6 +20,7 @@ type ApplyManifestCommand struct {
 	PathToManifest   flag.ManifestPathWithExistenceCheck `short:"f" description:"Path to app manifest"`
 	Vars             []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
 	PathsToVarsFiles []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	PathsToOpsFiles  []flag.PathWithExistenceCheck       `long:"ops-file" description:"Path to a BOSH-style ops file applied to the manifest after variable substitution; can specify multiple times"`
 	RedactEnv        bool                                `long:"redact-env" description:"Do not print values for environment vars set in the application manifest"`
 	usage            interface{}                         `usage:"CF_NAME apply-manifest -f APP_MANIFEST_PATH"`
 	relatedCommands  interface{}                         `related_commands:"create-app, create-app-manifest, push"`
@@ -33,10 +34,12 @@ type ApplyManifestCommand struct {
 
 func (cmd *ApplyManifestCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.ManifestLocator = manifestparser.NewLocator()
-	cmd.ManifestParser = manifestparser.ManifestParser{}
+	manifestParser := &manifestparser.ManifestParser{CredentialHelper: config.ManifestVarsHelper()}
+	cmd.ManifestParser = manifestParser
 	cmd.DiffDisplayer = &shared.ManifestDiffDisplayer{
 		UI:        ui,
 		RedactEnv: cmd.RedactEnv,
+		Secrets:   manifestParser,
 	}
 
 	currentDir, err := os.Getwd()
@@ -87,7 +90,12 @@ func (cmd ApplyManifestCommand) Execute(args []string) error {
 		pathsToVarsFiles = append(pathsToVarsFiles, string(varFilePath))
 	}
 
-	interpolatedManifestBytes, err := cmd.ManifestParser.InterpolateManifest(pathToManifest, pathsToVarsFiles, cmd.Vars)
+	var pathsToOpsFiles []string
+	for _, opsFilePath := range cmd.PathsToOpsFiles {
+		pathsToOpsFiles = append(pathsToOpsFiles, string(opsFilePath))
+	}
+
+	interpolatedManifestBytes, err := cmd.ManifestParser.InterpolateManifest(pathToManifest, pathsToVarsFiles, cmd.Vars, pathsToOpsFiles)
 	if err != nil {
 		return err
 	}
@@ -155,6 +155,7 @@ var _ = Describe("apply-manifest Command", func() {
 				BeforeEach(func() {
 					cmd.PathsToVarsFiles = []flag.PathWithExistenceCheck{"vars.yml"}
 					cmd.Vars = []template.VarKV{{Name: "o", Value: "nice"}}
+					cmd.PathsToOpsFiles = []flag.PathWithExistenceCheck{"ops.yml"}
 					fakeLocator.PathReturns(resolvedPath, true, nil)
 				})
 
@@ -184,10 +185,11 @@ var _ = Describe("apply-manifest Command", func() {
 						Expect(testUI.Out).To(Say("OK"))
 
 						Expect(fakeParser.InterpolateManifestCallCount()).To(Equal(1))
-						path, varsFiles, vars := fakeParser.InterpolateManifestArgsForCall(0)
+						path, varsFiles, vars, opsFiles := fakeParser.InterpolateManifestArgsForCall(0)
 						Expect(path).To(Equal(resolvedPath))
 						Expect(varsFiles).To(Equal([]string{"vars.yml"}))
 						Expect(vars).To(Equal([]template.VarKV{{Name: "o", Value: "nice"}}))
+						Expect(opsFiles).To(Equal([]string{"ops.yml"}))
 
 						Expect(fakeParser.ParseManifestCallCount()).To(Equal(1))
 						path, rawManifest := fakeParser.ParseManifestArgsForCall(0)
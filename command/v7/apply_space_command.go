@@ -0,0 +1,307 @@
+package v7
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/types"
+	"gopkg.in/yaml.v2"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ManifestPusher
+
+type ManifestPusher interface {
+	PushManifest(pathToManifest string) error
+}
+
+type ApplySpaceCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.SpaceBundleDirectoryWithExistenceCheck `positional-args:"yes"`
+	Push            bool                                        `long:"push" description:"Push the apps in the manifest; every app must have a path to its source or a docker image"`
+	usage           interface{}                                 `usage:"CF_NAME apply-space DIRECTORY [--push]\n\n   Creates the service instances, user-provided services and routes in the bundle that are\n   missing from the targeted space, applies its app manifest and adds its network policies.\n   The labels in the bundle are added to the space, routes and service instances. Existing\n   resources are otherwise left as they are, so an interrupted apply can be re-run.\n\n   The exported manifest has no app source, so apps are only pushed with --push, after a\n   path to the source of each app has been added to the manifest.\n\nEXAMPLES:\n   CF_NAME apply-space ./my-space\n   CF_NAME apply-space ./my-space --push"`
+	relatedCommands interface{}                                 `related_commands:"apply-manifest, export-space, push"`
+
+	NetworkingActor NetworkingActor
+	Pusher          ManifestPusher
+}
+
+func (cmd *ApplySpaceCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	ccClient, uaaClient := cmd.BaseCommand.GetClients()
+
+	networkingClient, err := shared.NewNetworkingClient(config.NetworkPolicyV1Endpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NetworkingActor = cfnetworkingaction.NewActor(networkingClient, ccClient)
+	cmd.Pusher = pushCommandPusher{config: config, ui: ui}
+
+	return nil
+}
+
+func (cmd ApplySpaceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	org := cmd.Config.TargetedOrganization()
+	space := cmd.Config.TargetedSpace()
+	directory := string(cmd.RequiredArgs.Directory)
+
+	bundle, err := shared.ReadSpaceBundle(directory)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying space bundle {{.Directory}} to org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Directory": directory,
+		"OrgName":   org.Name,
+		"SpaceName": space.Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	// Apps are bound to services and mapped to routes by the manifest, and
+	// network policies need the apps, so the apps come after the services
+	// and routes and before the policies.
+	steps := []func(shared.SpaceSpec) error{
+		cmd.applyLabels,
+		cmd.applyServiceInstances,
+		cmd.applyUserProvidedServices,
+		cmd.applyRoutes,
+	}
+	for _, step := range steps {
+		err = step(bundle.Spec)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cmd.applyManifest(directory, bundle.Manifest)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.applyNetworkPolicies(bundle.Spec)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd ApplySpaceCommand) applyLabels(spec shared.SpaceSpec) error {
+	if len(spec.Labels) == 0 {
+		return nil
+	}
+
+	cmd.UI.DisplayText("Setting labels of space {{.SpaceName}}...", map[string]interface{}{
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+	})
+
+	labels := map[string]types.NullString{}
+	for key, value := range spec.Labels {
+		labels[key] = types.NewNullString(value)
+	}
+
+	warnings, err := cmd.Actor.UpdateSpaceLabelsBySpaceName(cmd.Config.TargetedSpace().Name, cmd.Config.TargetedOrganization().GUID, labels)
+	cmd.UI.DisplayWarnings(warnings)
+	return err
+}
+
+func (cmd ApplySpaceCommand) applyServiceInstances(spec shared.SpaceSpec) error {
+	for _, instance := range spec.ServiceInstances {
+		cmd.UI.DisplayText("Creating service instance {{.ServiceInstanceName}}...", map[string]interface{}{
+			"ServiceInstanceName": instance.Name,
+		})
+
+		created, warnings, err := cmd.Actor.ApplyServiceInstance(cmd.Config.TargetedSpace().GUID, instance)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		cmd.displayApplied(created, "Service instance {{.Name}} already exists.", instance.Name)
+	}
+	return nil
+}
+
+func (cmd ApplySpaceCommand) applyUserProvidedServices(spec shared.SpaceSpec) error {
+	for _, instance := range spec.UserProvidedServices {
+		cmd.UI.DisplayText("Creating user-provided service instance {{.ServiceInstanceName}}...", map[string]interface{}{
+			"ServiceInstanceName": instance.Name,
+		})
+
+		created, warnings, err := cmd.Actor.ApplyUserProvidedService(cmd.Config.TargetedSpace().GUID, instance)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		cmd.displayApplied(created, "Service instance {{.Name}} already exists.", instance.Name)
+	}
+	return nil
+}
+
+func (cmd ApplySpaceCommand) applyRoutes(spec shared.SpaceSpec) error {
+	for _, route := range spec.Routes {
+		url := exportedRouteURL(route)
+		cmd.UI.DisplayText("Creating route {{.URL}}...", map[string]interface{}{
+			"URL": url,
+		})
+
+		created, warnings, err := cmd.Actor.ApplyRoute(cmd.Config.TargetedSpace().GUID, route)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		cmd.displayApplied(created, "Route {{.Name}} already exists.", url)
+	}
+	return nil
+}
+
+func (cmd ApplySpaceCommand) applyManifest(directory string, manifest []byte) error {
+	var apps struct {
+		Applications []struct {
+			Name   string      `yaml:"name"`
+			Path   string      `yaml:"path"`
+			Docker interface{} `yaml:"docker"`
+		} `yaml:"applications"`
+	}
+	err := yaml.Unmarshal(manifest, &apps)
+	if err != nil {
+		return err
+	}
+	if len(apps.Applications) == 0 {
+		return nil
+	}
+
+	if !cmd.Push {
+		cmd.UI.DisplayText("Applying app manifest...")
+		warnings, err := cmd.Actor.SetSpaceManifest(cmd.Config.TargetedSpace().GUID, manifest)
+		cmd.UI.DisplayWarnings(warnings)
+		return err
+	}
+
+	// Without a path push would upload the current directory, which may be
+	// the bundle itself with the credentials in space.yml.
+	for _, app := range apps.Applications {
+		if app.Path == "" && app.Docker == nil {
+			return translatableerror.SpaceBundleAppSourceMissingError{AppName: app.Name}
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	return cmd.Pusher.PushManifest(filepath.Join(directory, shared.SpaceBundleManifestFile))
+}
+
+func (cmd ApplySpaceCommand) applyNetworkPolicies(spec shared.SpaceSpec) error {
+	if len(spec.NetworkPolicies) == 0 {
+		return nil
+	}
+	cmd.UI.DisplayNewline()
+
+	for _, policy := range spec.NetworkPolicies {
+		cmd.UI.DisplayText("Adding network policy from app {{.SrcAppName}} to app {{.DstAppName}}...", map[string]interface{}{
+			"SrcAppName": policy.Source,
+			"DstAppName": policy.Destination,
+		})
+
+		destSpaceGUID, err := cmd.destinationSpaceGUID(policy)
+		if err != nil {
+			return err
+		}
+
+		warnings, err := cmd.NetworkingActor.AddNetworkPolicy(
+			cmd.Config.TargetedSpace().GUID,
+			policy.Source,
+			destSpaceGUID,
+			policy.Destination,
+			policy.Protocol,
+			policy.StartPort,
+			policy.EndPort,
+		)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cmd ApplySpaceCommand) destinationSpaceGUID(policy shared.SpaceNetworkPolicy) (string, error) {
+	if policy.DestinationSpace == "" {
+		return cmd.Config.TargetedSpace().GUID, nil
+	}
+
+	orgGUID := cmd.Config.TargetedOrganization().GUID
+	if policy.DestinationOrg != "" {
+		org, warnings, err := cmd.Actor.GetOrganizationByName(policy.DestinationOrg)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return "", err
+		}
+		orgGUID = org.GUID
+	}
+
+	space, warnings, err := cmd.Actor.GetSpaceByNameAndOrganization(policy.DestinationSpace, orgGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return "", err
+	}
+	return space.GUID, nil
+}
+
+func (cmd ApplySpaceCommand) displayApplied(created bool, existsMessage string, name string) {
+	if created {
+		cmd.UI.DisplayOK()
+		return
+	}
+	cmd.UI.DisplayText(existsMessage, map[string]interface{}{
+		"Name": name,
+	})
+}
+
+func exportedRouteURL(route v7action.ExportedRoute) string {
+	if route.Port != 0 {
+		return fmt.Sprintf("%s:%d", route.Domain, route.Port)
+	}
+
+	url := route.Domain
+	if route.Host != "" {
+		url = route.Host + "." + url
+	}
+	return url + route.Path
+}
+
+type pushCommandPusher struct {
+	config command.Config
+	ui     command.UI
+}
+
+func (pusher pushCommandPusher) PushManifest(pathToManifest string) error {
+	push := PushCommand{PathToManifest: flag.ManifestPathWithExistenceCheck(pathToManifest)}
+	err := push.Setup(pusher.config, pusher.ui)
+	if err != nil {
+		return err
+	}
+	return push.Execute(nil)
+}
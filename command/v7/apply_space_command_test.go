@@ -0,0 +1,266 @@
+package v7_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-space Command", func() {
+	var (
+		cmd                 ApplySpaceCommand
+		testUI              *ui.UI
+		fakeConfig          *commandfakes.FakeConfig
+		fakeSharedActor     *commandfakes.FakeSharedActor
+		fakeActor           *v7fakes.FakeActor
+		fakeNetworkingActor *v7fakes.FakeNetworkingActor
+		fakePusher          *v7fakes.FakeManifestPusher
+		binaryName          string
+		executeErr          error
+
+		bundleDir string
+		bundle    shared.SpaceBundle
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeNetworkingActor = new(v7fakes.FakeNetworkingActor)
+		fakePusher = new(v7fakes.FakeManifestPusher)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		var err error
+		bundleDir, err = os.MkdirTemp("", "apply-space")
+		Expect(err).NotTo(HaveOccurred())
+
+		cmd = ApplySpaceCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs:    flag.SpaceBundleDirectoryWithExistenceCheck{Directory: flag.PathWithExistenceCheck(bundleDir)},
+			NetworkingActor: fakeNetworkingActor,
+			Pusher:          fakePusher,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		bundle = shared.SpaceBundle{
+			Manifest: []byte("applications:\n- name: frontend\n- name: backend\n"),
+			Spec: shared.SpaceSpec{
+				Labels: map[string]string{"env": "staging"},
+				Routes: []v7action.ExportedRoute{
+					{Host: "www", Domain: "example.com", Path: "/api"},
+					{Domain: "tcp.example.com", Port: 1024},
+				},
+				ServiceInstances: []v7action.ExportedServiceInstance{
+					{Name: "db", Offering: "postgres", Plan: "small"},
+				},
+				UserProvidedServices: []v7action.ExportedUserProvidedService{
+					{Name: "ups", Credentials: map[string]interface{}{"password": "secret"}},
+				},
+				NetworkPolicies: []shared.SpaceNetworkPolicy{
+					{Source: "frontend", Destination: "backend", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+					{Source: "backend", Destination: "db-proxy", Protocol: "tcp", StartPort: 5432, EndPort: 5432, DestinationSpace: "shared", DestinationOrg: "platform"},
+				},
+			},
+		}
+
+		fakeActor.ApplyServiceInstanceReturns(true, v7action.Warnings{"instance-warning"}, nil)
+		fakeActor.ApplyUserProvidedServiceReturns(false, v7action.Warnings{"ups-warning"}, nil)
+		fakeActor.ApplyRouteReturns(true, v7action.Warnings{"route-warning"}, nil)
+		fakeActor.GetOrganizationByNameReturns(resources.Organization{GUID: "platform-guid"}, nil, nil)
+		fakeActor.GetSpaceByNameAndOrganizationReturns(resources.Space{GUID: "shared-guid"}, nil, nil)
+		fakeNetworkingActor.AddNetworkPolicyReturns(cfnetworkingaction.Warnings{"policy-warning"}, nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(bundleDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		Expect(shared.WriteSpaceBundle(bundleDir, bundle)).To(Succeed())
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("applies the bundle to the targeted space in dependency order", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Applying space bundle %s to org some-org / space some-space as steve...", bundleDir))
+		Expect(testUI.Out).To(Say("Setting labels of space some-space..."))
+		Expect(testUI.Out).To(Say("Creating service instance db..."))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say("Creating user-provided service instance ups..."))
+		Expect(testUI.Out).To(Say("Service instance ups already exists."))
+		Expect(testUI.Out).To(Say("Creating route www.example.com/api..."))
+		Expect(testUI.Out).To(Say("Creating route tcp.example.com:1024..."))
+		Expect(testUI.Out).To(Say("Applying app manifest..."))
+		Expect(testUI.Out).To(Say("Adding network policy from app frontend to app backend..."))
+		Expect(testUI.Out).To(Say("Adding network policy from app backend to app db-proxy..."))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Err).To(Say("instance-warning"))
+		Expect(testUI.Err).To(Say("ups-warning"))
+		Expect(testUI.Err).To(Say("route-warning"))
+		Expect(testUI.Err).To(Say("policy-warning"))
+
+		spaceName, orgGUID, labels := fakeActor.UpdateSpaceLabelsBySpaceNameArgsForCall(0)
+		Expect(spaceName).To(Equal("some-space"))
+		Expect(orgGUID).To(Equal("some-org-guid"))
+		Expect(labels).To(Equal(map[string]types.NullString{"env": types.NewNullString("staging")}))
+
+		spaceGUID, instance := fakeActor.ApplyServiceInstanceArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(instance).To(Equal(bundle.Spec.ServiceInstances[0]))
+
+		spaceGUID, ups := fakeActor.ApplyUserProvidedServiceArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(ups).To(Equal(bundle.Spec.UserProvidedServices[0]))
+
+		Expect(fakeActor.ApplyRouteCallCount()).To(Equal(2))
+		_, route := fakeActor.ApplyRouteArgsForCall(1)
+		Expect(route).To(Equal(bundle.Spec.Routes[1]))
+
+		spaceGUID, manifest := fakeActor.SetSpaceManifestArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(manifest).To(Equal(bundle.Manifest))
+		Expect(fakePusher.PushManifestCallCount()).To(Equal(0))
+
+		Expect(fakeNetworkingActor.AddNetworkPolicyCallCount()).To(Equal(2))
+		srcSpaceGUID, srcApp, destSpaceGUID, destApp, protocol, startPort, endPort := fakeNetworkingActor.AddNetworkPolicyArgsForCall(0)
+		Expect([]interface{}{srcSpaceGUID, srcApp, destSpaceGUID, destApp, protocol, startPort, endPort}).To(Equal(
+			[]interface{}{"some-space-guid", "frontend", "some-space-guid", "backend", "tcp", 8080, 8080},
+		))
+		_, _, destSpaceGUID, _, _, _, _ = fakeNetworkingActor.AddNetworkPolicyArgsForCall(1)
+		Expect(destSpaceGUID).To(Equal("shared-guid"))
+		Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("platform"))
+		spaceName, orgGUID = fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+		Expect(spaceName).To(Equal("shared"))
+		Expect(orgGUID).To(Equal("platform-guid"))
+	})
+
+	When("--push is given", func() {
+		BeforeEach(func() {
+			cmd.Push = true
+			bundle.Manifest = []byte("applications:\n- name: frontend\n  path: ../frontend\n- name: backend\n  docker:\n    image: some/image\n")
+		})
+
+		It("pushes the manifest instead of only applying it", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakePusher.PushManifestCallCount()).To(Equal(1))
+			Expect(fakePusher.PushManifestArgsForCall(0)).To(Equal(filepath.Join(bundleDir, "manifest.yml")))
+			Expect(fakeActor.SetSpaceManifestCallCount()).To(Equal(0))
+		})
+
+		When("an app has no path or docker image", func() {
+			BeforeEach(func() {
+				bundle.Manifest = []byte("applications:\n- name: frontend\n  path: ../frontend\n- name: backend\n")
+			})
+
+			It("returns an error without pushing the current directory", func() {
+				Expect(executeErr).To(MatchError(translatableerror.SpaceBundleAppSourceMissingError{AppName: "backend"}))
+				Expect(fakePusher.PushManifestCallCount()).To(Equal(0))
+				Expect(fakeActor.SetSpaceManifestCallCount()).To(Equal(0))
+				Expect(fakeNetworkingActor.AddNetworkPolicyCallCount()).To(Equal(0))
+			})
+		})
+
+		When("pushing fails", func() {
+			BeforeEach(func() {
+				fakePusher.PushManifestReturns(errors.New("push-error"))
+			})
+
+			It("does not add the network policies", func() {
+				Expect(executeErr).To(MatchError("push-error"))
+				Expect(fakeNetworkingActor.AddNetworkPolicyCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("the bundle has no apps", func() {
+		BeforeEach(func() {
+			bundle.Manifest = []byte("applications: []\n")
+		})
+
+		It("does not apply the manifest", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.SetSpaceManifestCallCount()).To(Equal(0))
+			Expect(fakePusher.PushManifestCallCount()).To(Equal(0))
+		})
+	})
+
+	When("creating a service instance fails", func() {
+		BeforeEach(func() {
+			fakeActor.ApplyServiceInstanceReturns(false, v7action.Warnings{"instance-warning"}, errors.New("instance-error"))
+		})
+
+		It("stops before creating anything that depends on it", func() {
+			Expect(executeErr).To(MatchError("instance-error"))
+			Expect(testUI.Err).To(Say("instance-warning"))
+			Expect(fakeActor.ApplyRouteCallCount()).To(Equal(0))
+			Expect(fakePusher.PushManifestCallCount()).To(Equal(0))
+			Expect(fakeNetworkingActor.AddNetworkPolicyCallCount()).To(Equal(0))
+		})
+	})
+
+	When("applying the manifest fails", func() {
+		BeforeEach(func() {
+			fakeActor.SetSpaceManifestReturns(v7action.Warnings{"manifest-warning"}, errors.New("manifest-error"))
+		})
+
+		It("does not add the network policies", func() {
+			Expect(executeErr).To(MatchError("manifest-error"))
+			Expect(testUI.Err).To(Say("manifest-warning"))
+			Expect(fakeNetworkingActor.AddNetworkPolicyCallCount()).To(Equal(0))
+		})
+	})
+
+	When("adding a network policy fails", func() {
+		BeforeEach(func() {
+			fakeNetworkingActor.AddNetworkPolicyReturns(cfnetworkingaction.Warnings{"policy-warning"}, errors.New("policy-error"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("policy-error"))
+			Expect(testUI.Err).To(Say("policy-warning"))
+		})
+	})
+})
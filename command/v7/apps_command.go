@@ -17,6 +17,9 @@ type AppsCommand struct {
 	OmitStats bool   `long:"no-stats" description:"Do not retrieve process stats"`
 }
 
+// StructuredOutput marks apps as displaying its results with --output.
+func (cmd AppsCommand) StructuredOutput() {}
+
 func (cmd AppsCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -40,6 +43,7 @@ func (cmd AppsCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(summaries)
 
 	if len(summaries) == 0 {
 		cmd.UI.DisplayText("No apps found")
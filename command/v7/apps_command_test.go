@@ -1,6 +1,7 @@
 package v7_test
 
 import (
+	"encoding/json"
 	"errors"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -248,6 +249,30 @@ var _ = Describe("apps Command", func() {
 				Expect(labels).To(Equal(""))
 				Expect(omitStats).To(Equal(false))
 			})
+
+			When("the output format is json", func() {
+				BeforeEach(func() {
+					testUI.SetOutputFormat(configv3.OutputFormatJSON)
+				})
+
+				It("displays the application summaries as json and the text on stderr", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					testUI.FlushDeferred()
+
+					Expect(testUI.Err).To(Say(`Getting apps in org some-org / space some-space as steve\.\.\.`))
+					Expect(testUI.Err).To(Say(`name\s+requested state\s+processes\s+routes`))
+
+					var apps []map[string]interface{}
+					Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &apps)).To(Succeed())
+					Expect(apps).To(HaveLen(2))
+					Expect(apps[0]).To(HaveKeyWithValue("guid", "app-guid-1"))
+					Expect(apps[0]).To(HaveKeyWithValue("name", "some-app-1"))
+					Expect(apps[0]).To(HaveKeyWithValue("state", "STARTED"))
+					Expect(apps[0]["process_summaries"]).To(HaveLen(3))
+					Expect(apps[0]["routes"]).To(ContainElement(HaveKeyWithValue("url", "some-app-1.some-domain")))
+					Expect(apps[1]).To(HaveKeyWithValue("name", "some-app-2"))
+				})
+			})
 		})
 
 		When("app does not have processes", func() {
@@ -294,6 +319,20 @@ var _ = Describe("apps Command", func() {
 				Expect(testUI.Out).To(Say("No apps found"))
 			})
 
+			When("the output format is json", func() {
+				BeforeEach(func() {
+					testUI.SetOutputFormat(configv3.OutputFormatJSON)
+				})
+
+				It("displays an empty list", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					testUI.FlushDeferred()
+
+					Expect(testUI.Err).To(Say("No apps found"))
+					Expect(testUI.Out).To(Say(`^\[\]\n$`))
+				})
+			})
+
 		})
 	})
 	Context("when a labels flag is set", func() {
@@ -3,6 +3,8 @@ package v7
 import (
 	"errors"
 	"fmt"
+	"os"
+	"strings"
 
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/api/uaa/uaaversion"
@@ -16,9 +18,11 @@ type AuthCommand struct {
 	BaseCommand
 
 	RequiredArgs      flag.Authentication `positional-args:"yes"`
+	AssertionEnv      string              `long:"assertion-env" description:"Authenticate with the JWT in this environment variable, such as an OIDC ID token issued to a CI job"`
+	AssertionFile     flag.Path           `long:"assertion-file" description:"Authenticate with the JWT in this file, such as an OIDC ID token issued to a CI job"`
 	ClientCredentials bool                `long:"client-credentials" description:"Use (non-user) service account (also called client credentials)"`
 	Origin            string              `long:"origin" description:"Indicates the identity provider to be used for authentication"`
-	usage             interface{}         `usage:"CF_NAME auth USERNAME PASSWORD\n   CF_NAME auth USERNAME PASSWORD --origin ORIGIN\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --client-credentials\n\nENVIRONMENT VARIABLES:\n   CF_USERNAME=user          Authenticating user. Overridden if USERNAME argument is provided.\n   CF_PASSWORD=password      Password associated with user. Overridden if PASSWORD argument is provided.\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n   Consider using the CF_PASSWORD environment variable instead\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)"`
+	usage             interface{}         `usage:"CF_NAME auth USERNAME PASSWORD\n   CF_NAME auth USERNAME PASSWORD --origin ORIGIN\n   CF_NAME auth --assertion-file PATH [--origin ORIGIN]\n   CF_NAME auth --assertion-env VARIABLE [--origin ORIGIN]\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --client-credentials\n\n   With --assertion-file or --assertion-env the JWT is exchanged using the JWT bearer grant\n   as the 'cf' UAA client, which must be allowed to use that grant type.\n\nENVIRONMENT VARIABLES:\n   CF_USERNAME=user          Authenticating user. Overridden if USERNAME argument is provided.\n   CF_PASSWORD=password      Password associated with user. Overridden if PASSWORD argument is provided.\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n   Consider using the CF_PASSWORD environment variable instead\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME auth --assertion-env CI_ID_TOKEN (exchange the OIDC ID token of a CI job using the JWT bearer grant)"`
 	relatedCommands   interface{}         `related_commands:"api, login, target"`
 }
 
@@ -41,7 +45,17 @@ func (cmd AuthCommand) Execute(args []string) error {
 		}
 	}
 
-	username, password, err := cmd.getUsernamePassword()
+	useAssertion := cmd.AssertionFile != "" || cmd.AssertionEnv != ""
+
+	var (
+		username, password, assertion string
+		err                           error
+	)
+	if useAssertion {
+		assertion, err = cmd.getAssertion()
+	} else {
+		username, password, err = cmd.getUsernamePassword()
+	}
 	if err != nil {
 		return err
 	}
@@ -60,6 +74,7 @@ func (cmd AuthCommand) Execute(args []string) error {
 		cmd.UI.DisplayWarning(versionWarning)
 	}
 
+	// The password and JWT bearer grants are both requested as the cf client.
 	if !cmd.ClientCredentials {
 		if cmd.Config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
 			return translatableerror.PasswordGrantTypeLogoutRequiredError{}
@@ -74,7 +89,10 @@ func (cmd AuthCommand) Execute(args []string) error {
 
 	credentials := make(map[string]string)
 	grantType := constant.GrantTypePassword
-	if cmd.ClientCredentials {
+	if useAssertion {
+		grantType = constant.GrantTypeJWTBearer
+		credentials["assertion"] = assertion
+	} else if cmd.ClientCredentials {
 		grantType = constant.GrantTypeClientCredentials
 		credentials["client_id"] = username
 		credentials["client_secret"] = password
@@ -156,3 +174,53 @@ func (cmd AuthCommand) getUsernamePassword() (string, string, error) {
 
 	return username, password, nil
 }
+
+// getAssertion reads the JWT given with --assertion-file or --assertion-env.
+func (cmd AuthCommand) getAssertion() (string, error) {
+	source := "--assertion-file"
+	if cmd.AssertionEnv != "" {
+		source = "--assertion-env"
+	}
+
+	if cmd.AssertionFile != "" && cmd.AssertionEnv != "" {
+		return "", translatableerror.ArgumentCombinationError{
+			Args: []string{"--assertion-file", "--assertion-env"},
+		}
+	}
+	if cmd.ClientCredentials {
+		return "", translatableerror.ArgumentCombinationError{
+			Args: []string{"--client-credentials", source},
+		}
+	}
+	if cmd.RequiredArgs.Username != "" || cmd.RequiredArgs.Password != "" {
+		return "", translatableerror.ArgumentCombinationError{
+			Args: []string{"USERNAME", "PASSWORD", source},
+		}
+	}
+	if cmd.Config.IsCFOnK8s() {
+		return "", translatableerror.NotSupportedOnKubernetesArgumentError{Arg: source}
+	}
+
+	var assertion string
+	if cmd.AssertionFile != "" {
+		raw, err := os.ReadFile(string(cmd.AssertionFile))
+		if err != nil {
+			if os.IsNotExist(err) {
+				return "", translatableerror.FileNotFoundError{Path: string(cmd.AssertionFile)}
+			}
+			return "", err
+		}
+		assertion = string(raw)
+	} else {
+		assertion = os.Getenv(cmd.AssertionEnv)
+	}
+
+	assertion = strings.TrimSpace(assertion)
+	if assertion == "" {
+		if cmd.AssertionFile != "" {
+			return "", translatableerror.EmptyAssertionError{Source: string(cmd.AssertionFile)}
+		}
+		return "", translatableerror.EmptyAssertionError{Source: "$" + cmd.AssertionEnv}
+	}
+	return assertion, nil
+}
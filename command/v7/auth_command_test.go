@@ -2,6 +2,7 @@ package v7_test
 
 import (
 	"errors"
+	"os"
 
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 
@@ -9,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/api/uaa/uaaversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
@@ -373,6 +375,171 @@ var _ = Describe("auth Command", func() {
 		})
 	})
 
+	When("authenticating with an assertion", func() {
+		var assertionFile string
+
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("some-api-target")
+			fakeActor.GetUAAAPIVersionReturns(uaaversion.MinUAAClientVersion, nil)
+
+			tempFile, tempErr := os.CreateTemp("", "assertion")
+			Expect(tempErr).ToNot(HaveOccurred())
+			_, tempErr = tempFile.WriteString("some-assertion\n")
+			Expect(tempErr).ToNot(HaveOccurred())
+			Expect(tempFile.Close()).To(Succeed())
+			assertionFile = tempFile.Name()
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(assertionFile)).To(Succeed())
+		})
+
+		When("--assertion-file is set", func() {
+			BeforeEach(func() {
+				cmd.AssertionFile = flag.Path(assertionFile)
+				cmd.Origin = "some-origin"
+			})
+
+			It("authenticates with the JWT bearer grant using the contents of the file", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Authenticating\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+				credentials, origin, grantType := fakeActor.AuthenticateArgsForCall(0)
+				Expect(credentials).To(Equal(map[string]string{"assertion": "some-assertion"}))
+				Expect(origin).To(Equal("some-origin"))
+				Expect(grantType).To(Equal(constant.GrantTypeJWTBearer))
+			})
+
+			When("the file does not exist", func() {
+				BeforeEach(func() {
+					cmd.AssertionFile = "/some/missing/assertion"
+				})
+
+				It("returns a FileNotFoundError", func() {
+					Expect(err).To(MatchError(translatableerror.FileNotFoundError{Path: "/some/missing/assertion"}))
+					Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+				})
+			})
+
+			When("the file is empty", func() {
+				BeforeEach(func() {
+					Expect(os.WriteFile(assertionFile, []byte("\n"), 0600)).To(Succeed())
+				})
+
+				It("returns an EmptyAssertionError", func() {
+					Expect(err).To(MatchError(translatableerror.EmptyAssertionError{Source: assertionFile}))
+					Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+				})
+			})
+
+			When("--client-credentials is also set", func() {
+				BeforeEach(func() {
+					cmd.ClientCredentials = true
+					cmd.Origin = ""
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+						Args: []string{"--client-credentials", "--assertion-file"},
+					}))
+				})
+			})
+
+			When("a username and password are also provided", func() {
+				BeforeEach(func() {
+					cmd.RequiredArgs.Username = "some-username"
+					cmd.RequiredArgs.Password = "some-password"
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+						Args: []string{"USERNAME", "PASSWORD", "--assertion-file"},
+					}))
+				})
+			})
+
+			When("already logged in via client credentials", func() {
+				BeforeEach(func() {
+					fakeConfig.UAAGrantTypeReturns("client_credentials")
+				})
+
+				It("returns a PasswordGrantTypeLogoutRequiredError", func() {
+					Expect(err).To(MatchError(translatableerror.PasswordGrantTypeLogoutRequiredError{}))
+					Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+				})
+			})
+
+			When("a user has manually added their client credentials to the config file", func() {
+				BeforeEach(func() {
+					fakeConfig.UAAOAuthClientReturns("AClientsId")
+					fakeConfig.UAAOAuthClientSecretReturns("some-secret")
+				})
+
+				It("returns a ManualClientCredentialsError", func() {
+					Expect(err).To(MatchError(translatableerror.ManualClientCredentialsError{}))
+					Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+				})
+			})
+
+			When("authenticating against Korifi", func() {
+				BeforeEach(func() {
+					fakeConfig.IsCFOnK8sReturns(true)
+				})
+
+				It("returns an error", func() {
+					Expect(err).To(MatchError(translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--assertion-file"}))
+				})
+			})
+		})
+
+		When("--assertion-env is set", func() {
+			BeforeEach(func() {
+				cmd.AssertionEnv = "SOME_ID_TOKEN"
+				Expect(os.Setenv("SOME_ID_TOKEN", " some-env-assertion ")).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("SOME_ID_TOKEN")).To(Succeed())
+			})
+
+			It("authenticates with the JWT bearer grant using the environment variable", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+				credentials, origin, grantType := fakeActor.AuthenticateArgsForCall(0)
+				Expect(credentials).To(Equal(map[string]string{"assertion": "some-env-assertion"}))
+				Expect(origin).To(BeEmpty())
+				Expect(grantType).To(Equal(constant.GrantTypeJWTBearer))
+			})
+
+			When("the environment variable is not set", func() {
+				BeforeEach(func() {
+					Expect(os.Unsetenv("SOME_ID_TOKEN")).To(Succeed())
+				})
+
+				It("returns an EmptyAssertionError", func() {
+					Expect(err).To(MatchError(translatableerror.EmptyAssertionError{Source: "$SOME_ID_TOKEN"}))
+					Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+				})
+			})
+
+			When("--assertion-file is also set", func() {
+				BeforeEach(func() {
+					cmd.AssertionFile = flag.Path(assertionFile)
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+						Args: []string{"--assertion-file", "--assertion-env"},
+					}))
+				})
+			})
+		})
+	})
+
 	When("already logged in via client credentials", func() {
 		BeforeEach(func() {
 			fakeConfig.UAAGrantTypeReturns("client_credentials")
@@ -15,6 +15,9 @@ type BuildpacksCommand struct {
 	Labels          string      `long:"labels" description:"Selector to filter buildpacks by labels"`
 }
 
+// StructuredOutput marks buildpacks as displaying its results with --output.
+func (cmd BuildpacksCommand) StructuredOutput() {}
+
 func (cmd BuildpacksCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -36,6 +39,7 @@ func (cmd BuildpacksCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(buildpacks)
 
 	if len(buildpacks) == 0 {
 		cmd.UI.DisplayTextWithFlavor("No buildpacks found")
@@ -0,0 +1,78 @@
+package v7
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type ContextsCommand struct {
+	UI     command.UI
+	Config command.Config
+
+	usage           interface{} `usage:"CF_NAME contexts"`
+	relatedCommands interface{} `related_commands:"create-context, delete-context, rename-context, use-context"`
+}
+
+// contextSummary is what is displayed about a context as structured data,
+// which leaves out its tokens.
+type contextSummary struct {
+	Name         string `json:"name"`
+	Current      bool   `json:"current"`
+	APIEndpoint  string `json:"api_endpoint"`
+	Organization string `json:"org"`
+	Space        string `json:"space"`
+}
+
+func (cmd *ContextsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+// StructuredOutput marks contexts as displaying its results with --output.
+func (cmd ContextsCommand) StructuredOutput() {}
+
+func (cmd ContextsCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Getting contexts...")
+	cmd.UI.DisplayNewline()
+
+	contexts := cmd.Config.Contexts()
+	currentContext := cmd.Config.CurrentContext()
+
+	var names []string
+	for name := range contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	summaries := []contextSummary{}
+	table := [][]string{{"current", "name", "api endpoint", "org", "space"}}
+	for _, name := range names {
+		var current string
+		if name == currentContext {
+			current = "*"
+		}
+		context := contexts[name]
+		table = append(table, []string{
+			current,
+			name,
+			context.Target,
+			context.TargetedOrganization.Name,
+			context.TargetedSpace.Name,
+		})
+		summaries = append(summaries, contextSummary{
+			Name:         name,
+			Current:      name == currentContext,
+			APIEndpoint:  context.Target,
+			Organization: context.TargetedOrganization.Name,
+			Space:        context.TargetedSpace.Name,
+		})
+	}
+	cmd.UI.DisplayStructuredData(summaries)
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
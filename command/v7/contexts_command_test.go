@@ -0,0 +1,55 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("contexts Command", func() {
+	var (
+		cmd        ContextsCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = ContextsCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+
+		fakeConfig.ContextsReturns(map[string]configv3.TargetContext{
+			"staging": {
+				Target:               "https://api.staging.example.com",
+				TargetedOrganization: configv3.Organization{Name: "some-org"},
+				TargetedSpace:        configv3.Space{Name: "some-space"},
+			},
+			"default": {Target: "https://api.example.com"},
+			"new":     {},
+		})
+		fakeConfig.CurrentContextReturns("staging")
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("lists the contexts by name and marks the current one", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Getting contexts..."))
+		Expect(testUI.Out).To(Say(`current\s+name\s+api endpoint\s+org\s+space`))
+		Expect(testUI.Out).To(Say(`\s+default\s+https://api.example.com\s*\n`))
+		Expect(testUI.Out).To(Say(`\s+new\s*\n`))
+		Expect(testUI.Out).To(Say(`\*\s+staging\s+https://api.staging.example.com\s+some-org\s+some-space`))
+	})
+})
@@ -14,6 +14,10 @@ type ContinueDeploymentCommand struct {
 	relatedCommands interface{}  `related_commands:"app, push"`
 }
 
+// StructuredOutput marks continue-deployment as displaying its results with
+// --output.
+func (cmd *ContinueDeploymentCommand) StructuredOutput() {}
+
 func (cmd *ContinueDeploymentCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -53,6 +57,14 @@ func (cmd *ContinueDeploymentCommand) Execute(args []string) error {
 		return err
 	}
 
+	canarySteps := deployment.CanaryStatus.Steps
+	if canarySteps.CurrentStep < canarySteps.TotalSteps {
+		cmd.UI.DisplayText("Advancing canary deployment to step {{.NextStep}} of {{.TotalSteps}}...", map[string]interface{}{
+			"NextStep":   canarySteps.CurrentStep + 1,
+			"TotalSteps": canarySteps.TotalSteps,
+		})
+	}
+
 	cmd.UI.DisplayText("Waiting for app to deploy...\n")
 
 	handleInstanceDetails := func(instanceDetails string) {
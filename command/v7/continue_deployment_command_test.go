@@ -205,6 +205,26 @@ var _ = Describe("Continue deployment command", func() {
 
 					It("returns success", func() {
 						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).ToNot(Say("Advancing canary deployment"))
+					})
+
+					When("the deployment is a canary paused before its last step", func() {
+						BeforeEach(func() {
+							fakeActor.GetLatestActiveDeploymentForAppReturns(
+								resources.Deployment{
+									GUID:         deploymentGUID,
+									CanaryStatus: resources.CanaryStatus{Steps: resources.CanaryStepStatus{CurrentStep: 1, TotalSteps: 3}},
+								},
+								nil,
+								nil,
+							)
+						})
+
+						It("displays the step the deployment advances to", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).To(Say(`Advancing canary deployment to step 2 of 3\.\.\.`))
+							Expect(testUI.Out).To(Say("Waiting for app to deploy..."))
+						})
 					})
 
 					When("the --no-wait flag is not provided", func() {
@@ -76,6 +76,9 @@ func (cmd *CopySourceCommand) Setup(config command.Config, ui command.UI) error
 	return nil
 }
 
+// StructuredOutput marks copy-source as displaying its results with --output.
+func (cmd CopySourceCommand) StructuredOutput() {}
+
 func (cmd CopySourceCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -0,0 +1,48 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type CreateContextCommand struct {
+	UI     command.UI
+	Config command.Config
+
+	RequiredArgs    flag.ContextName `positional-args:"yes"`
+	FromCurrent     bool             `long:"from-current" description:"Copy the API endpoint, tokens and targeted org and space of the current context"`
+	usage           interface{}      `usage:"CF_NAME create-context CONTEXT_NAME [--from-current]"`
+	relatedCommands interface{}      `related_commands:"contexts, login, use-context"`
+}
+
+func (cmd *CreateContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+func (cmd CreateContextCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Creating context {{.Name}}...", map[string]interface{}{
+		"Name": cmd.RequiredArgs.Name,
+	})
+
+	err := cmd.Config.CreateContext(cmd.RequiredArgs.Name, cmd.FromCurrent)
+	if err != nil {
+		if _, ok := err.(translatableerror.ContextAlreadyExistsError); ok {
+			cmd.UI.DisplayWarning("Context {{.Name}} already exists.", map[string]interface{}{
+				"Name": cmd.RequiredArgs.Name,
+			})
+			cmd.UI.DisplayOK()
+			return nil
+		}
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Use '{{.Command}}' to switch to the new context.", map[string]interface{}{
+		"Command": cmd.Config.BinaryName() + " use-context " + cmd.RequiredArgs.Name,
+	})
+	return nil
+}
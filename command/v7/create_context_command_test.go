@@ -0,0 +1,83 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-context Command", func() {
+	var (
+		cmd        CreateContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+
+		cmd = CreateContextCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.Name = "staging"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("creates an empty context", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(fakeConfig.CreateContextCallCount()).To(Equal(1))
+		name, fromCurrent := fakeConfig.CreateContextArgsForCall(0)
+		Expect(name).To(Equal("staging"))
+		Expect(fromCurrent).To(BeFalse())
+
+		Expect(testUI.Out).To(Say("Creating context staging..."))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say("TIP: Use 'faceman use-context staging' to switch to the new context."))
+	})
+
+	When("--from-current is given", func() {
+		BeforeEach(func() {
+			cmd.FromCurrent = true
+		})
+
+		It("copies the current context", func() {
+			_, fromCurrent := fakeConfig.CreateContextArgsForCall(0)
+			Expect(fromCurrent).To(BeTrue())
+		})
+	})
+
+	When("the context already exists", func() {
+		BeforeEach(func() {
+			fakeConfig.CreateContextReturns(translatableerror.ContextAlreadyExistsError{Name: "staging"})
+		})
+
+		It("warns and succeeds", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("Context staging already exists."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("creating the context fails", func() {
+		BeforeEach(func() {
+			fakeConfig.CreateContextReturns(errors.New("some-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+})
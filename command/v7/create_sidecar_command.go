@@ -0,0 +1,66 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+type CreateSidecarCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppSidecar `positional-args:"yes"`
+	Command         string          `long:"command" short:"c" required:"true" description:"The command the sidecar runs"`
+	MemoryLimit     flag.Megabytes  `short:"m" description:"Memory reserved for the sidecar out of the process memory limit (e.g. 256M, 1024M, 1G)"`
+	ProcessTypes    []string        `long:"process" description:"App process type the sidecar runs with (Default: web). Can be specified multiple times"`
+	usage           interface{}     `usage:"CF_NAME create-sidecar APP_NAME SIDECAR_NAME -c COMMAND [--process PROCESS]... [-m MEMORY]\n\n   The app must be restarted for the sidecar to run.\n\nEXAMPLES:\n   CF_NAME create-sidecar my-app envoy -c './envoy -c envoy.yaml' --process web --process worker -m 64M"`
+	relatedCommands interface{}     `related_commands:"delete-sidecar, restart, sidecars, update-sidecar"`
+}
+
+func (cmd CreateSidecarCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating sidecar {{.SidecarName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"SidecarName": cmd.RequiredArgs.SidecarName,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	})
+
+	processTypes := cmd.ProcessTypes
+	if len(processTypes) == 0 {
+		processTypes = []string{constant.ProcessTypeWeb}
+	}
+
+	_, warnings, err := cmd.Actor.CreateApplicationSidecarByNameAndSpace(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		resources.Sidecar{
+			Name:         cmd.RequiredArgs.SidecarName,
+			Command:      types.FilteredString{IsSet: true, Value: cmd.Command},
+			ProcessTypes: processTypes,
+			MemoryInMB:   cmd.MemoryLimit.NullUint64,
+		},
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Use 'cf restart {{.AppName}}' to ensure your sidecar changes take effect.", map[string]interface{}{
+		"AppName": cmd.RequiredArgs.AppName,
+	})
+
+	return nil
+}
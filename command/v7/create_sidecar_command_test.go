@@ -0,0 +1,122 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-sidecar Command", func() {
+	var (
+		cmd             v7.CreateSidecarCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.CreateSidecarCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.AppSidecar{AppName: "some-app", SidecarName: "envoy"},
+			Command:      "start-envoy",
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.CreateApplicationSidecarByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("creating the sidecar succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.CreateApplicationSidecarByNameAndSpaceReturns(resources.Sidecar{}, v7action.Warnings{"create-warning"}, nil)
+		})
+
+		It("creates a sidecar for the web process and tells the user to restart", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Creating sidecar envoy for app some-app in org some-org / space some-space as banana\.\.\.`))
+			Expect(testUI.Err).To(Say("create-warning"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`TIP: Use 'cf restart some-app' to ensure your sidecar changes take effect\.`))
+
+			Expect(fakeActor.CreateApplicationSidecarByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID, sidecar := fakeActor.CreateApplicationSidecarByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(sidecar).To(Equal(resources.Sidecar{
+				Name:         "envoy",
+				Command:      types.FilteredString{IsSet: true, Value: "start-envoy"},
+				ProcessTypes: []string{"web"},
+			}))
+		})
+
+		When("process types and memory are provided", func() {
+			BeforeEach(func() {
+				cmd.ProcessTypes = []string{"web", "worker"}
+				cmd.MemoryLimit = flag.Megabytes{NullUint64: types.NullUint64{IsSet: true, Value: 64}}
+			})
+
+			It("creates the sidecar with them", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				_, _, sidecar := fakeActor.CreateApplicationSidecarByNameAndSpaceArgsForCall(0)
+				Expect(sidecar.ProcessTypes).To(Equal([]string{"web", "worker"}))
+				Expect(sidecar.MemoryInMB).To(Equal(types.NullUint64{IsSet: true, Value: 64}))
+			})
+		})
+	})
+
+	When("creating the sidecar fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateApplicationSidecarByNameAndSpaceReturns(resources.Sidecar{}, v7action.Warnings{"create-warning"}, errors.New("create-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("create-error"))
+			Expect(testUI.Err).To(Say("create-warning"))
+			Expect(testUI.Out).NotTo(Say("OK"))
+		})
+	})
+})
@@ -0,0 +1,59 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type DeleteContextCommand struct {
+	UI     command.UI
+	Config command.Config
+
+	RequiredArgs    flag.ContextName `positional-args:"yes"`
+	Force           bool             `short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}      `usage:"CF_NAME delete-context CONTEXT_NAME [-f]"`
+	relatedCommands interface{}      `related_commands:"contexts, use-context"`
+}
+
+func (cmd *DeleteContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+func (cmd DeleteContextCommand) Execute(args []string) error {
+	if !cmd.Force {
+		deleteContext, err := cmd.UI.DisplayBoolPrompt(false, "Really delete the context {{.Name}}, including its tokens?", map[string]interface{}{
+			"Name": cmd.RequiredArgs.Name,
+		})
+		if err != nil {
+			return err
+		}
+
+		if !deleteContext {
+			cmd.UI.DisplayText("Context '{{.Name}}' has not been deleted.", map[string]interface{}{
+				"Name": cmd.RequiredArgs.Name,
+			})
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayText("Deleting context {{.Name}}...", map[string]interface{}{
+		"Name": cmd.RequiredArgs.Name,
+	})
+
+	err := cmd.Config.DeleteContext(cmd.RequiredArgs.Name)
+	if err != nil {
+		if _, ok := err.(translatableerror.ContextNotFoundError); !ok {
+			return err
+		}
+		cmd.UI.DisplayWarning("Context {{.Name}} does not exist.", map[string]interface{}{
+			"Name": cmd.RequiredArgs.Name,
+		})
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,100 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-context Command", func() {
+	var (
+		cmd        DeleteContextCommand
+		input      *Buffer
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = DeleteContextCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.Name = "staging"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the user confirms the deletion", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("deletes the context", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Really delete the context staging, including its tokens\?`))
+			Expect(testUI.Out).To(Say("Deleting context staging..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeConfig.DeleteContextCallCount()).To(Equal(1))
+			Expect(fakeConfig.DeleteContextArgsForCall(0)).To(Equal("staging"))
+		})
+	})
+
+	When("the user declines the deletion", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not delete the context", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Context 'staging' has not been deleted."))
+			Expect(fakeConfig.DeleteContextCallCount()).To(Equal(0))
+		})
+	})
+
+	When("-f is given", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("deletes the context without asking", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Really delete"))
+			Expect(fakeConfig.DeleteContextCallCount()).To(Equal(1))
+		})
+
+		When("the context does not exist", func() {
+			BeforeEach(func() {
+				fakeConfig.DeleteContextReturns(translatableerror.ContextNotFoundError{Name: "staging"})
+			})
+
+			It("warns and succeeds", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Context staging does not exist."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		When("the context is in use", func() {
+			BeforeEach(func() {
+				fakeConfig.DeleteContextReturns(translatableerror.CurrentContextDeletionError{Name: "staging"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.CurrentContextDeletionError{Name: "staging"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,118 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-sidecar Command", func() {
+	var (
+		cmd             v7.DeleteSidecarCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		input           *Buffer
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.DeleteSidecarCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.AppSidecar{AppName: "some-app", SidecarName: "envoy"},
+		}
+
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the user does not confirm the deletion", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("does not delete the sidecar", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Really delete the sidecar envoy of app some-app\?`))
+			Expect(testUI.Out).To(Say("Delete cancelled"))
+			Expect(fakeActor.DeleteApplicationSidecarByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		When("deleting the sidecar succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteApplicationSidecarByNameAndSpaceReturns(v7action.Warnings{"delete-warning"}, nil)
+			})
+
+			It("deletes the sidecar", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Deleting sidecar envoy for app some-app in org some-org / space some-space as banana\.\.\.`))
+				Expect(testUI.Err).To(Say("delete-warning"))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeActor.DeleteApplicationSidecarByNameAndSpaceCallCount()).To(Equal(1))
+				appName, spaceGUID, sidecarName := fakeActor.DeleteApplicationSidecarByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(sidecarName).To(Equal("envoy"))
+			})
+		})
+
+		When("the sidecar does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteApplicationSidecarByNameAndSpaceReturns(v7action.Warnings{"delete-warning"}, actionerror.SidecarNotFoundError{Name: "envoy"})
+			})
+
+			It("warns and succeeds", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Err).To(Say("Sidecar envoy does not exist."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		When("deleting the sidecar fails", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteApplicationSidecarByNameAndSpaceReturns(v7action.Warnings{"delete-warning"}, errors.New("delete-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("delete-error"))
+				Expect(testUI.Err).To(Say("delete-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,107 @@
+package v7
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type DeploymentCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	DeploymentGUID  string       `long:"guid" description:"GUID of the deployment to show. Defaults to the most recent deployment of the app."`
+	usage           interface{}  `usage:"CF_NAME deployment APP_NAME [--guid DEPLOYMENT_GUID]\n\nEXAMPLES:\n   CF_NAME deployment my-app\n   CF_NAME deployment my-app --guid 2f2b0a4c-6e0b-4a8c-a3e4-7f1f5b4f7a1d"`
+	relatedCommands interface{}  `related_commands:"app, cancel-deployment, continue-deployment, deployments"`
+}
+
+// StructuredOutput marks deployment as displaying its results with --output.
+func (cmd DeploymentCommand) StructuredOutput() {}
+
+func (cmd DeploymentCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting deployment for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	deployment, warnings, err := cmd.Actor.GetDetailedDeploymentSummary(app.GUID, cmd.DeploymentGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayStructuredData(deployment)
+
+	keyValueTable := [][]string{
+		{cmd.UI.TranslateText("guid:"), deployment.GUID},
+		{cmd.UI.TranslateText("strategy:"), string(deployment.Strategy)},
+		{cmd.UI.TranslateText("state:"), string(deployment.StatusValue)},
+		{cmd.UI.TranslateText("reason:"), string(deployment.StatusReason)},
+	}
+	if deployment.Strategy == constant.DeploymentStrategyCanary && deployment.CanaryStatus.Steps.TotalSteps > 0 {
+		keyValueTable = append(keyValueTable, []string{
+			cmd.UI.TranslateText("canary-steps:"),
+			fmt.Sprintf("%d/%d", deployment.CanaryStatus.Steps.CurrentStep, deployment.CanaryStatus.Steps.TotalSteps),
+		})
+	}
+	if deployment.Options.MaxInFlight > 0 {
+		keyValueTable = append(keyValueTable, []string{cmd.UI.TranslateText("max-in-flight:"), fmt.Sprint(deployment.Options.MaxInFlight)})
+	}
+	keyValueTable = append(keyValueTable,
+		[]string{cmd.UI.TranslateText("revision:"), formatRevisionVersion(deployment.RevisionVersion)},
+		[]string{cmd.UI.TranslateText("droplet guid:"), deployment.DropletGUID},
+		[]string{cmd.UI.TranslateText("previous droplet guid:"), deployment.PreviousDropletGUID},
+		[]string{cmd.UI.TranslateText("created at:"), deployment.CreatedAt},
+		[]string{cmd.UI.TranslateText("updated at:"), deployment.UpdatedAt},
+		[]string{cmd.UI.TranslateText("last status change:"), deployment.LastStatusChange},
+		[]string{cmd.UI.TranslateText("created by:"), deployment.CreatedBy},
+	)
+	cmd.UI.DisplayKeyValueTable("", keyValueTable, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	processTable := [][]string{{
+		"",
+		cmd.UI.TranslateText("type"),
+		cmd.UI.TranslateText("guid"),
+		cmd.UI.TranslateText("instances"),
+	}}
+	for _, process := range deployment.OldProcesses {
+		processTable = append(processTable, cmd.processRow("old", process))
+	}
+	for _, process := range deployment.NewProcesses {
+		processTable = append(processTable, cmd.processRow("new", process))
+	}
+	cmd.UI.DisplayTableWithHeader("", processTable, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+func (cmd DeploymentCommand) processRow(role string, process v7action.DeploymentProcessSummary) []string {
+	instances := fmt.Sprintf("%d/%d", process.RunningInstances, process.DesiredInstances)
+	if process.Removed {
+		instances = cmd.UI.TranslateText("removed")
+	}
+
+	return []string{cmd.UI.TranslateText(role), process.Type, process.GUID, instances}
+}
@@ -0,0 +1,169 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("deployment command", func() {
+	var (
+		cmd             DeploymentCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = DeploymentCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "some-app-guid"}, v7action.Warnings{"app-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+		})
+	})
+
+	When("the deployment is found", func() {
+		BeforeEach(func() {
+			cmd.DeploymentGUID = "deployment-2"
+
+			fakeActor.GetDetailedDeploymentSummaryReturns(
+				v7action.DetailedDeploymentSummary{
+					DeploymentSummary: v7action.DeploymentSummary{
+						Deployment: resources.Deployment{
+							GUID:                "deployment-2",
+							Strategy:            constant.DeploymentStrategyCanary,
+							StatusValue:         constant.DeploymentStatusValueActive,
+							StatusReason:        constant.DeploymentStatusReasonPaused,
+							CanaryStatus:        resources.CanaryStatus{Steps: resources.CanaryStepStatus{CurrentStep: 1, TotalSteps: 3}},
+							Options:             resources.DeploymentOpts{MaxInFlight: 2},
+							RevisionVersion:     5,
+							DropletGUID:         "droplet-2",
+							PreviousDropletGUID: "droplet-1",
+							CreatedAt:           "2024-02-01T00:00:00Z",
+							UpdatedAt:           "2024-02-01T00:05:00Z",
+							LastStatusChange:    "2024-02-01T00:04:00Z",
+						},
+						CreatedBy: "alice",
+					},
+					OldProcesses: []v7action.DeploymentProcessSummary{
+						{GUID: "old-web-guid", Type: "web", DesiredInstances: 3, RunningInstances: 3},
+					},
+					NewProcesses: []v7action.DeploymentProcessSummary{
+						{GUID: "new-web-guid", Type: "web", DesiredInstances: 3, RunningInstances: 1},
+					},
+				},
+				v7action.Warnings{"deployment-warning"},
+				nil,
+			)
+		})
+
+		It("displays the deployment and its old and new process instance counts", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting deployment for app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`guid:\s+deployment-2`))
+			Expect(testUI.Out).To(Say(`strategy:\s+canary`))
+			Expect(testUI.Out).To(Say(`state:\s+ACTIVE`))
+			Expect(testUI.Out).To(Say(`reason:\s+PAUSED`))
+			Expect(testUI.Out).To(Say(`canary-steps:\s+1/3`))
+			Expect(testUI.Out).To(Say(`max-in-flight:\s+2`))
+			Expect(testUI.Out).To(Say(`revision:\s+5`))
+			Expect(testUI.Out).To(Say(`droplet guid:\s+droplet-2`))
+			Expect(testUI.Out).To(Say(`previous droplet guid:\s+droplet-1`))
+			Expect(testUI.Out).To(Say(`created at:\s+2024-02-01T00:00:00Z`))
+			Expect(testUI.Out).To(Say(`updated at:\s+2024-02-01T00:05:00Z`))
+			Expect(testUI.Out).To(Say(`last status change:\s+2024-02-01T00:04:00Z`))
+			Expect(testUI.Out).To(Say(`created by:\s+alice`))
+			Expect(testUI.Out).To(Say(`type\s+guid\s+instances`))
+			Expect(testUI.Out).To(Say(`old\s+web\s+old-web-guid\s+3/3`))
+			Expect(testUI.Out).To(Say(`new\s+web\s+new-web-guid\s+1/3`))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(testUI.Err).To(Say("deployment-warning"))
+
+			appGUID, deploymentGUID := fakeActor.GetDetailedDeploymentSummaryArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(deploymentGUID).To(Equal("deployment-2"))
+		})
+	})
+
+	When("the new processes have been removed", func() {
+		BeforeEach(func() {
+			fakeActor.GetDetailedDeploymentSummaryReturns(
+				v7action.DetailedDeploymentSummary{
+					DeploymentSummary: v7action.DeploymentSummary{
+						Deployment: resources.Deployment{GUID: "deployment-1", Strategy: constant.DeploymentStrategyRolling},
+					},
+					NewProcesses: []v7action.DeploymentProcessSummary{
+						{GUID: "new-web-guid", Type: "web", Removed: true},
+					},
+				},
+				nil,
+				nil,
+			)
+		})
+
+		It("shows them as removed", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).NotTo(Say("canary-steps:"))
+			Expect(testUI.Out).To(Say(`new\s+web\s+new-web-guid\s+removed`))
+		})
+	})
+
+	When("getting the deployment fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetDetailedDeploymentSummaryReturns(v7action.DetailedDeploymentSummary{}, v7action.Warnings{"deployment-warning"}, errors.New("deployment-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("deployment-error"))
+			Expect(testUI.Err).To(Say("deployment-warning"))
+		})
+	})
+})
@@ -0,0 +1,94 @@
+package v7
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type DeploymentsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME deployments APP_NAME\n\nEXAMPLES:\n   CF_NAME deployments my-app"`
+	relatedCommands interface{}  `related_commands:"app, deployment, push, revisions"`
+}
+
+// StructuredOutput marks deployments as displaying its results with --output.
+func (cmd DeploymentsCommand) StructuredOutput() {}
+
+func (cmd DeploymentsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting deployments for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	deployments, warnings, err := cmd.Actor.GetDeploymentSummariesForApp(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayStructuredData(deployments)
+
+	if len(deployments) == 0 {
+		cmd.UI.DisplayText("No deployments found.")
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("guid"),
+		cmd.UI.TranslateText("strategy"),
+		cmd.UI.TranslateText("state"),
+		cmd.UI.TranslateText("reason"),
+		cmd.UI.TranslateText("revision"),
+		cmd.UI.TranslateText("droplet guid"),
+		cmd.UI.TranslateText("created at"),
+		cmd.UI.TranslateText("updated at"),
+		cmd.UI.TranslateText("created by"),
+	}}
+
+	for _, deployment := range deployments {
+		table = append(table, []string{
+			deployment.GUID,
+			string(deployment.Strategy),
+			string(deployment.StatusValue),
+			string(deployment.StatusReason),
+			formatRevisionVersion(deployment.RevisionVersion),
+			deployment.DropletGUID,
+			deployment.CreatedAt,
+			deployment.UpdatedAt,
+			deployment.CreatedBy,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+func formatRevisionVersion(version int) string {
+	if version == 0 {
+		return ""
+	}
+	return strconv.Itoa(version)
+}
@@ -0,0 +1,157 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("deployments command", func() {
+	var (
+		cmd             DeploymentsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = DeploymentsCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "some-app-guid"}, v7action.Warnings{"app-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the app has deployments", func() {
+		BeforeEach(func() {
+			fakeActor.GetDeploymentSummariesForAppReturns(
+				[]v7action.DeploymentSummary{
+					{
+						Deployment: resources.Deployment{
+							GUID:            "deployment-2",
+							Strategy:        constant.DeploymentStrategyCanary,
+							StatusValue:     constant.DeploymentStatusValueActive,
+							StatusReason:    constant.DeploymentStatusReasonPaused,
+							RevisionVersion: 2,
+							DropletGUID:     "droplet-2",
+							CreatedAt:       "2024-02-01T00:00:00Z",
+							UpdatedAt:       "2024-02-01T00:05:00Z",
+						},
+						CreatedBy: "alice",
+					},
+					{
+						Deployment: resources.Deployment{
+							GUID:         "deployment-1",
+							Strategy:     constant.DeploymentStrategyRolling,
+							StatusValue:  constant.DeploymentStatusValueFinalized,
+							StatusReason: constant.DeploymentStatusReasonDeployed,
+							DropletGUID:  "droplet-1",
+							CreatedAt:    "2024-01-01T00:00:00Z",
+							UpdatedAt:    "2024-01-01T00:05:00Z",
+						},
+					},
+				},
+				v7action.Warnings{"deployments-warning"},
+				nil,
+			)
+		})
+
+		It("lists the deployments of the app", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting deployments for app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`guid\s+strategy\s+state\s+reason\s+revision\s+droplet guid\s+created at\s+updated at\s+created by`))
+			Expect(testUI.Out).To(Say(`deployment-2\s+canary\s+ACTIVE\s+PAUSED\s+2\s+droplet-2\s+2024-02-01T00:00:00Z\s+2024-02-01T00:05:00Z\s+alice`))
+			Expect(testUI.Out).To(Say(`deployment-1\s+rolling\s+FINALIZED\s+DEPLOYED\s+droplet-1\s+2024-01-01T00:00:00Z\s+2024-01-01T00:05:00Z`))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(testUI.Err).To(Say("deployments-warning"))
+
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(fakeActor.GetDeploymentSummariesForAppArgsForCall(0)).To(Equal("some-app-guid"))
+		})
+	})
+
+	When("the app has no deployments", func() {
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No deployments found\.`))
+		})
+	})
+
+	When("getting the app fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{}, v7action.Warnings{"app-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(fakeActor.GetDeploymentSummariesForAppCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the deployments fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetDeploymentSummariesForAppReturns(nil, v7action.Warnings{"deployments-warning"}, errors.New("deployments-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("deployments-error"))
+			Expect(testUI.Err).To(Say("deployments-warning"))
+		})
+	})
+})
@@ -0,0 +1,197 @@
+package v7
+
+import (
+	"fmt"
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"gopkg.in/yaml.v2"
+)
+
+type DiffManifestCommand struct {
+	BaseCommand
+
+	PathToManifest   flag.ManifestPathWithExistenceCheck `short:"f" description:"Path to app manifest"`
+	Org              string                              `short:"o" description:"Org of the spaces to compare. Defaults to the targeted org."`
+	Spaces           []string                            `short:"s" description:"Space to compare the manifest with; can specify multiple times. Defaults to the targeted space."`
+	Vars             []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	PathsToVarsFiles []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	PathsToOpsFiles  []flag.PathWithExistenceCheck       `long:"ops-file" description:"Path to a BOSH-style ops file applied to the manifest after variable substitution; can specify multiple times"`
+	RedactEnv        bool                                `long:"redact-env" description:"Do not print values for environment vars set in the application manifest"`
+	usage            interface{}                         `usage:"CF_NAME diff-manifest -f APP_MANIFEST_PATH [-o ORG] [-s SPACE]...\n\nEXAMPLES:\n   CF_NAME diff-manifest -f manifest.yml\n   CF_NAME diff-manifest -f manifest.yml -o my-org -s staging -s production"`
+	relatedCommands  interface{}                         `related_commands:"apply-manifest, create-app-manifest, push"`
+
+	ManifestLocator ManifestLocator
+	ManifestParser  ManifestParser
+
+	DiffDisplayer DiffDisplayer
+	CWD           string
+}
+
+func (cmd *DiffManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.ManifestLocator = manifestparser.NewLocator()
+	manifestParser := &manifestparser.ManifestParser{CredentialHelper: config.ManifestVarsHelper()}
+	cmd.ManifestParser = manifestParser
+	cmd.DiffDisplayer = &shared.ManifestDiffDisplayer{
+		UI:        ui,
+		RedactEnv: cmd.RedactEnv,
+		Secrets:   manifestParser,
+	}
+
+	currentDir, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	cmd.CWD = currentDir
+
+	return cmd.BaseCommand.Setup(config, ui)
+}
+
+func (cmd DiffManifestCommand) Execute(args []string) error {
+	if cmd.Org != "" && len(cmd.Spaces) == 0 {
+		return translatableerror.RequiredFlagsError{Arg1: "-o", Arg2: "-s"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Org == "", len(cmd.Spaces) == 0)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	spaces, err := cmd.spacesToCompare()
+	if err != nil {
+		return err
+	}
+
+	readPath := cmd.CWD
+	if cmd.PathToManifest != "" {
+		readPath = string(cmd.PathToManifest)
+	}
+
+	pathToManifest, exists, err := cmd.ManifestLocator.Path(readPath)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: readPath}
+	}
+
+	var pathsToVarsFiles []string
+	for _, varFilePath := range cmd.PathsToVarsFiles {
+		pathsToVarsFiles = append(pathsToVarsFiles, string(varFilePath))
+	}
+
+	var pathsToOpsFiles []string
+	for _, opsFilePath := range cmd.PathsToOpsFiles {
+		pathsToOpsFiles = append(pathsToOpsFiles, string(opsFilePath))
+	}
+
+	interpolatedManifestBytes, err := cmd.ManifestParser.InterpolateManifest(pathToManifest, pathsToVarsFiles, cmd.Vars, pathsToOpsFiles)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := cmd.ManifestParser.ParseManifest(pathToManifest, interpolatedManifestBytes)
+	if err != nil {
+		if _, ok := err.(*yaml.TypeError); ok {
+			return errors.New("Unable to compare manifest because its format is invalid.")
+		}
+		return err
+	}
+
+	manifestBytes, err := cmd.ManifestParser.MarshalManifest(manifest)
+	if err != nil {
+		return err
+	}
+
+	var driftedSpaces []string
+	for i, space := range spaces {
+		if i > 0 {
+			cmd.UI.DisplayNewline()
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Comparing manifest {{.ManifestPath}} with apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"ManifestPath": pathToManifest,
+			"OrgName":      space.OrgName,
+			"SpaceName":    space.Name,
+			"Username":     user.Name,
+		})
+
+		diff, warnings, err := cmd.Actor.DiffSpaceManifest(space.GUID, manifestBytes)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayNewline()
+		if len(diff.Diffs) == 0 {
+			cmd.UI.DisplayText("No differences found.")
+			continue
+		}
+
+		cmd.UI.DisplayText("Differences found:")
+		err = cmd.DiffDisplayer.DisplayDiff(manifestBytes, diff)
+		if err != nil {
+			return err
+		}
+		driftedSpaces = append(driftedSpaces, fmt.Sprintf("%s / %s", space.OrgName, space.Name))
+	}
+
+	if len(driftedSpaces) > 0 {
+		return translatableerror.ManifestDriftError{Spaces: driftedSpaces}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+type diffManifestSpace struct {
+	GUID    string
+	Name    string
+	OrgName string
+}
+
+func (cmd DiffManifestCommand) spacesToCompare() ([]diffManifestSpace, error) {
+	if len(cmd.Spaces) == 0 {
+		return []diffManifestSpace{{
+			GUID:    cmd.Config.TargetedSpace().GUID,
+			Name:    cmd.Config.TargetedSpace().Name,
+			OrgName: cmd.Config.TargetedOrganization().Name,
+		}}, nil
+	}
+
+	org := cmd.Config.TargetedOrganization()
+	if cmd.Org != "" {
+		foundOrg, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Org)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return nil, err
+		}
+		org = configv3.Organization{GUID: foundOrg.GUID, Name: foundOrg.Name}
+	}
+
+	var spaces []diffManifestSpace
+	for _, spaceName := range cmd.Spaces {
+		space, warnings, err := cmd.Actor.GetSpaceByNameAndOrganization(spaceName, org.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return nil, err
+		}
+		spaces = append(spaces, diffManifestSpace{GUID: space.GUID, Name: space.Name, OrgName: org.Name})
+	}
+	return spaces, nil
+}
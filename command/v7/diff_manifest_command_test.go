@@ -0,0 +1,269 @@
+package v7_test
+
+import (
+	"errors"
+	"regexp"
+
+	"gopkg.in/yaml.v2"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("diff-manifest Command", func() {
+	var (
+		cmd               DiffManifestCommand
+		testUI            *ui.UI
+		fakeConfig        *commandfakes.FakeConfig
+		fakeSharedActor   *commandfakes.FakeSharedActor
+		fakeActor         *v7fakes.FakeActor
+		fakeParser        *v7fakes.FakeManifestParser
+		fakeLocator       *v7fakes.FakeManifestLocator
+		fakeDiffDisplayer *v7fakes.FakeDiffDisplayer
+		binaryName        string
+		executeErr        error
+
+		resolvedPath string
+		drift        resources.ManifestDiff
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeParser = new(v7fakes.FakeManifestParser)
+		fakeLocator = new(v7fakes.FakeManifestLocator)
+		fakeDiffDisplayer = new(v7fakes.FakeDiffDisplayer)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = DiffManifestCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			ManifestParser:  fakeParser,
+			ManifestLocator: fakeLocator,
+			DiffDisplayer:   fakeDiffDisplayer,
+			CWD:             "fake-directory",
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		resolvedPath = "/fake/manifest.yml"
+		fakeLocator.PathReturns(resolvedPath, true, nil)
+		fakeParser.InterpolateManifestReturns([]byte("interpolated!"), nil)
+		fakeParser.MarshalManifestReturns([]byte("manifesto"), nil)
+
+		drift = resources.ManifestDiff{
+			Diffs: []resources.Diff{
+				{Op: resources.ReplaceOperation, Path: "/applications/0/instances", Was: 4, Value: 2},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the manifest matches the apps in the targeted space", func() {
+		BeforeEach(func() {
+			cmd.PathToManifest = flag.ManifestPathWithExistenceCheck("some-manifest-path")
+			cmd.PathsToVarsFiles = []flag.PathWithExistenceCheck{"vars.yml"}
+			cmd.Vars = []template.VarKV{{Name: "o", Value: "nice"}}
+			cmd.PathsToOpsFiles = []flag.PathWithExistenceCheck{"ops.yml"}
+			fakeActor.DiffSpaceManifestReturns(resources.ManifestDiff{}, v7action.Warnings{"diff-warning"}, nil)
+		})
+
+		It("reports no differences without applying the manifest", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("Comparing manifest %s with apps in org some-org / space some-space as steve...", regexp.QuoteMeta(resolvedPath)))
+			Expect(testUI.Out).To(Say("No differences found."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("diff-warning"))
+
+			Expect(fakeLocator.PathArgsForCall(0)).To(Equal("some-manifest-path"))
+			path, varsFiles, vars, opsFiles := fakeParser.InterpolateManifestArgsForCall(0)
+			Expect(path).To(Equal(resolvedPath))
+			Expect(varsFiles).To(Equal([]string{"vars.yml"}))
+			Expect(vars).To(Equal([]template.VarKV{{Name: "o", Value: "nice"}}))
+			Expect(opsFiles).To(Equal([]string{"ops.yml"}))
+
+			spaceGUID, manifestBytes := fakeActor.DiffSpaceManifestArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(manifestBytes).To(Equal([]byte("manifesto")))
+
+			Expect(fakeDiffDisplayer.DisplayDiffCallCount()).To(Equal(0))
+			Expect(fakeActor.SetSpaceManifestCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the apps have drifted from the manifest", func() {
+		BeforeEach(func() {
+			fakeActor.DiffSpaceManifestReturns(drift, nil, nil)
+		})
+
+		It("displays the differences and returns a drift error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ManifestDriftError{Spaces: []string{"some-org / some-space"}}))
+			Expect(testUI.Out).To(Say("Differences found:"))
+
+			Expect(fakeDiffDisplayer.DisplayDiffCallCount()).To(Equal(1))
+			manifestBytes, diff := fakeDiffDisplayer.DisplayDiffArgsForCall(0)
+			Expect(manifestBytes).To(Equal([]byte("manifesto")))
+			Expect(diff).To(Equal(drift))
+			Expect(fakeActor.SetSpaceManifestCallCount()).To(Equal(0))
+		})
+
+		When("displaying the diff fails", func() {
+			BeforeEach(func() {
+				fakeDiffDisplayer.DisplayDiffReturns(errors.New("diff failed"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("diff failed"))
+			})
+		})
+	})
+
+	When("several spaces are given", func() {
+		BeforeEach(func() {
+			cmd.Org = "other-org"
+			cmd.Spaces = []string{"staging", "production"}
+
+			fakeActor.GetOrganizationByNameReturns(resources.Organization{Name: "other-org", GUID: "other-org-guid"}, v7action.Warnings{"org-warning"}, nil)
+			fakeActor.GetSpaceByNameAndOrganizationStub = func(spaceName string, orgGUID string) (resources.Space, v7action.Warnings, error) {
+				return resources.Space{Name: spaceName, GUID: spaceName + "-guid"}, v7action.Warnings{"space-warning"}, nil
+			}
+			fakeActor.DiffSpaceManifestStub = func(spaceGUID string, rawManifest []byte) (resources.ManifestDiff, v7action.Warnings, error) {
+				if spaceGUID == "production-guid" {
+					return drift, nil, nil
+				}
+				return resources.ManifestDiff{}, nil, nil
+			}
+		})
+
+		It("compares the manifest with the apps in each space", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ManifestDriftError{Spaces: []string{"other-org / production"}}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("other-org"))
+			Expect(fakeActor.GetSpaceByNameAndOrganizationCallCount()).To(Equal(2))
+			spaceName, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(1)
+			Expect(spaceName).To(Equal("production"))
+			Expect(orgGUID).To(Equal("other-org-guid"))
+
+			Expect(testUI.Out).To(Say("Comparing manifest .* with apps in org other-org / space staging as steve..."))
+			Expect(testUI.Out).To(Say("No differences found."))
+			Expect(testUI.Out).To(Say("Comparing manifest .* with apps in org other-org / space production as steve..."))
+			Expect(testUI.Out).To(Say("Differences found:"))
+			Expect(testUI.Err).To(Say("org-warning"))
+
+			Expect(fakeActor.DiffSpaceManifestCallCount()).To(Equal(2))
+		})
+
+		When("a space cannot be found", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceByNameAndOrganizationStub = nil
+				fakeActor.GetSpaceByNameAndOrganizationReturns(resources.Space{}, nil, actionerror.SpaceNotFoundError{Name: "staging"})
+			})
+
+			It("returns the error before comparing anything", func() {
+				Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "staging"}))
+				Expect(fakeActor.DiffSpaceManifestCallCount()).To(Equal(0))
+			})
+		})
+
+		When("no org is given", func() {
+			BeforeEach(func() {
+				cmd.Org = ""
+			})
+
+			It("looks up the spaces in the targeted org", func() {
+				checkTargetedOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(0))
+				_, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+			})
+		})
+	})
+
+	When("an org is given without spaces", func() {
+		BeforeEach(func() {
+			cmd.Org = "other-org"
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "-o", Arg2: "-s"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the manifest file does not exist", func() {
+		BeforeEach(func() {
+			fakeLocator.PathReturns("", false, nil)
+		})
+
+		It("returns a descriptive error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: "fake-directory"}))
+		})
+	})
+
+	When("the manifest is unparseable", func() {
+		BeforeEach(func() {
+			fakeParser.ParseManifestReturns(manifestparser.Manifest{}, &yaml.TypeError{Errors: []string{"oooooh nooooos"}})
+		})
+
+		It("returns the parse error", func() {
+			Expect(executeErr).To(MatchError("Unable to compare manifest because its format is invalid."))
+			Expect(fakeActor.DiffSpaceManifestCallCount()).To(Equal(0))
+		})
+	})
+
+	When("diffing the manifest fails", func() {
+		BeforeEach(func() {
+			fakeActor.DiffSpaceManifestReturns(resources.ManifestDiff{}, v7action.Warnings{"diff-warning"}, errors.New("diff-error"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("diff-error"))
+			Expect(testUI.Err).To(Say("diff-warning"))
+		})
+	})
+})
@@ -17,6 +17,9 @@ type DomainsCommand struct {
 	Labels          string      `long:"labels" description:"Selector to filter domains by labels"`
 }
 
+// StructuredOutput marks domains as displaying its results with --output.
+func (cmd DomainsCommand) StructuredOutput() {}
+
 func (cmd DomainsCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, false)
 	if err != nil {
@@ -41,6 +44,7 @@ func (cmd DomainsCommand) Execute(args []string) error {
 	}
 
 	sort.Slice(domains, func(i, j int) bool { return sorting.LessIgnoreCase(domains[i].Name, domains[j].Name) })
+	cmd.UI.DisplayStructuredData(domains)
 
 	if len(domains) > 0 {
 		cmd.displayDomainsTable(domains)
@@ -17,6 +17,9 @@ type DropletsCommand struct {
 	relatedCommands interface{}  `related_commands:"set-droplet, create-package, packages, app, push"`
 }
 
+// StructuredOutput marks droplets as displaying its results with --output.
+func (cmd DropletsCommand) StructuredOutput() {}
+
 func (cmd DropletsCommand) Execute(args []string) error {
 
 	err := cmd.SharedActor.CheckTarget(true, true)
@@ -42,6 +45,7 @@ func (cmd DropletsCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(droplets)
 
 	if len(droplets) == 0 {
 		cmd.UI.DisplayText("No droplets found")
@@ -16,6 +16,9 @@ type EnvCommand struct {
 	relatedCommands interface{}          `related_commands:"app, apps, set-env, unset-env, running-environment-variable-group, staging-environment-variable-group"`
 }
 
+// StructuredOutput marks env as displaying its results with --output.
+func (cmd EnvCommand) StructuredOutput() {}
+
 func (cmd EnvCommand) Execute(_ []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -43,6 +46,7 @@ func (cmd EnvCommand) Execute(_ []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(envGroups)
 
 	if len(envGroups.System) > 0 || len(envGroups.Application) > 0 {
 		cmd.UI.DisplayHeader("System-Provided:")
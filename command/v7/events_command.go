@@ -1,20 +1,39 @@
 package v7
 
 import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/ui"
 )
 
 type EventsCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	usage           interface{}  `usage:"CF_NAME events APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, logs, map-route, unmap-route"`
+	RequiredArgs    flag.OptionalAppName `positional-args:"yes"`
+	Types           []string             `long:"type" description:"Only show events of this type (e.g. audit.app.update). Can be specified multiple times"`
+	ActorName       string               `long:"actor" description:"Only show events triggered by this user or client"`
+	Since           flag.Timestamp       `long:"since" description:"Only show events created at or after this time (e.g. 2006-01-02T15:04:05Z, 2006-01-02, or 24h for 24 hours ago)"`
+	Until           flag.Timestamp       `long:"until" description:"Only show events created at or before this time, in the same format as --since. A date includes the whole day"`
+	Limit           flag.PositiveInteger `long:"limit" default:"50" description:"Maximum number of events to show, most recent first"`
+	Space           bool                 `long:"space" description:"Show the events of all apps, routes and services in the targeted space"`
+	Org             bool                 `long:"org" description:"Show the events of all resources in the targeted org"`
+	usage           interface{}          `usage:"CF_NAME events APP_NAME [--type TYPE]... [--actor ACTOR] [--since TIME] [--until TIME] [--limit LIMIT]\n   CF_NAME events (--space | --org) [--type TYPE]... [--actor ACTOR] [--since TIME] [--until TIME] [--limit LIMIT]\n\nEXAMPLES:\n   CF_NAME events my-app\n   CF_NAME events --space --type audit.app.process.scale --type audit.app.delete-request --since 24h\n   CF_NAME events --org --actor admin --since 2024-01-31 --until 2024-02-01"`
+	relatedCommands interface{}          `related_commands:"app, logs, map-route, unmap-route"`
 }
 
+// StructuredOutput marks events as displaying its results with --output.
+func (cmd EventsCommand) StructuredOutput() {}
+
 func (cmd EventsCommand) Execute(_ []string) error {
-	err := cmd.SharedActor.CheckTarget(true, true)
+	err := cmd.validateArgs()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, !cmd.Org)
 	if err != nil {
 		return err
 	}
@@ -24,46 +43,100 @@ func (cmd EventsCommand) Execute(_ []string) error {
 		return err
 	}
 
-	appName := cmd.RequiredArgs.AppName
-	cmd.UI.DisplayTextWithFlavor("Getting events for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"AppName":   appName,
-		"OrgName":   cmd.Config.TargetedOrganization().Name,
-		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
-	})
-
-	events, warnings, err := cmd.Actor.GetRecentEventsByApplicationNameAndSpace(
-		appName,
-		cmd.Config.TargetedSpace().GUID,
+	filters := v7action.EventFilters{
+		Types:     cmd.Types,
+		ActorName: cmd.ActorName,
+		Since:     cmd.Since.Time,
+		Until:     cmd.Until.EndOfDay(),
+		Limit:     int(cmd.Limit.Value),
+	}
+
+	var (
+		events   []v7action.Event
+		warnings v7action.Warnings
 	)
+	switch {
+	case cmd.Org:
+		cmd.UI.DisplayTextWithFlavor("Getting events in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  cmd.Config.TargetedOrganization().Name,
+			"Username": user.Name,
+		})
+		events, warnings, err = cmd.Actor.GetEventsByOrganization(cmd.Config.TargetedOrganization().GUID, filters)
+	case cmd.Space:
+		cmd.UI.DisplayTextWithFlavor("Getting events in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		events, warnings, err = cmd.Actor.GetEventsBySpace(cmd.Config.TargetedSpace().GUID, filters)
+	default:
+		cmd.UI.DisplayTextWithFlavor("Getting events for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   cmd.RequiredArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		events, warnings, err = cmd.Actor.GetEventsByApplicationNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, filters)
+	}
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
 	}
 
+	cmd.UI.DisplayStructuredData(events)
 	if len(events) == 0 {
 		cmd.UI.DisplayText("No events found.")
 	}
 
-	table := [][]string{
-		{
-			cmd.UI.TranslateText("time"),
-			cmd.UI.TranslateText("event"),
-			cmd.UI.TranslateText("actor"),
-			cmd.UI.TranslateText("description"),
-		},
+	cmd.displayTable(events)
+
+	return nil
+}
+
+func (cmd EventsCommand) validateArgs() error {
+	switch {
+	case cmd.RequiredArgs.AppName != "" && cmd.Space:
+		return translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--space"}}
+	case cmd.RequiredArgs.AppName != "" && cmd.Org:
+		return translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--org"}}
+	case cmd.Space && cmd.Org:
+		return translatableerror.ArgumentCombinationError{Args: []string{"--space", "--org"}}
+	case cmd.RequiredArgs.AppName == "" && !cmd.Space && !cmd.Org:
+		return translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	}
+	return nil
+}
+
+func (cmd EventsCommand) displayTable(events []v7action.Event) {
+	showTarget := cmd.Space || cmd.Org
+
+	header := []string{
+		cmd.UI.TranslateText("time"),
+		cmd.UI.TranslateText("event"),
+	}
+	if showTarget {
+		header = append(header, cmd.UI.TranslateText("target"))
 	}
+	header = append(header,
+		cmd.UI.TranslateText("actor"),
+		cmd.UI.TranslateText("description"),
+	)
+	table := [][]string{header}
 
 	for _, event := range events {
-		table = append(table, []string{
+		row := []string{
 			event.Time.Local().Format("2006-01-02T15:04:05.00-0700"),
 			event.Type,
+		}
+		if showTarget {
+			row = append(row, strings.TrimSpace(event.TargetType+" "+event.TargetName))
+		}
+		row = append(row,
 			event.ActorName,
 			event.Description,
-		})
+		)
+		table = append(table, row)
 	}
 
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
-
-	return nil
 }
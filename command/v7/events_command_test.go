@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/command/commandfakes"
@@ -42,7 +43,7 @@ var _ = Describe("events Command", func() {
 		fakeConfig.BinaryNameReturns(binaryName)
 
 		cmd = EventsCommand{
-			RequiredArgs: flag.AppName{AppName: "some-app"},
+			RequiredArgs: flag.OptionalAppName{AppName: "some-app"},
 			BaseCommand: BaseCommand{
 				UI:          testUI,
 				Config:      fakeConfig,
@@ -100,7 +101,7 @@ var _ = Describe("events Command", func() {
 
 		BeforeEach(func() {
 			expectedErr = ccerror.RequestError{}
-			fakeActor.GetRecentEventsByApplicationNameAndSpaceReturns(nil, v7action.Warnings{"warning-1", "warning-2"}, expectedErr)
+			fakeActor.GetEventsByApplicationNameAndSpaceReturns(nil, v7action.Warnings{"warning-1", "warning-2"}, expectedErr)
 		})
 
 		It("returns the error and prints warnings", func() {
@@ -138,7 +139,7 @@ var _ = Describe("events Command", func() {
 				},
 			}
 
-			fakeActor.GetRecentEventsByApplicationNameAndSpaceReturns(events, v7action.Warnings{"warning-1", "warning-2"}, nil)
+			fakeActor.GetEventsByApplicationNameAndSpaceReturns(events, v7action.Warnings{"warning-1", "warning-2"}, nil)
 		})
 
 		It("prints the events and outputs warnings", func() {
@@ -157,16 +158,17 @@ var _ = Describe("events Command", func() {
 			Expect(testUI.Err).To(Say("warning-1"))
 			Expect(testUI.Err).To(Say("warning-2"))
 
-			Expect(fakeActor.GetRecentEventsByApplicationNameAndSpaceCallCount()).To(Equal(1))
-			appName, spaceGUID := fakeActor.GetRecentEventsByApplicationNameAndSpaceArgsForCall(0)
+			Expect(fakeActor.GetEventsByApplicationNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID, filters := fakeActor.GetEventsByApplicationNameAndSpaceArgsForCall(0)
 			Expect(appName).To(Equal("some-app"))
 			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(filters).To(Equal(v7action.EventFilters{}))
 		})
 	})
 
 	When("getting the application events returns no events", func() {
 		BeforeEach(func() {
-			fakeActor.GetRecentEventsByApplicationNameAndSpaceReturns([]v7action.Event{}, v7action.Warnings{"warning-1", "warning-2"}, nil)
+			fakeActor.GetEventsByApplicationNameAndSpaceReturns([]v7action.Event{}, v7action.Warnings{"warning-1", "warning-2"}, nil)
 		})
 
 		It("displays there are no events", func() {
@@ -179,4 +181,126 @@ var _ = Describe("events Command", func() {
 			Expect(testUI.Err).To(Say("warning-2"))
 		})
 	})
+
+	When("filters are provided", func() {
+		var since, until time.Time
+
+		BeforeEach(func() {
+			since = time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+			until = time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+			cmd.Types = []string{"audit.app.update"}
+			cmd.ActorName = "admin"
+			cmd.Since = flag.Timestamp{Time: since}
+			cmd.Until = flag.Timestamp{Time: until}
+			cmd.Limit = flag.PositiveInteger{Value: 10}
+		})
+
+		It("passes them to the actor", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, _, filters := fakeActor.GetEventsByApplicationNameAndSpaceArgsForCall(0)
+			Expect(filters).To(Equal(v7action.EventFilters{
+				Types:     []string{"audit.app.update"},
+				ActorName: "admin",
+				Since:     since,
+				Until:     until,
+				Limit:     10,
+			}))
+		})
+
+		When("--until is a date", func() {
+			BeforeEach(func() {
+				cmd.Until = flag.Timestamp{Time: until, IsDate: true}
+			})
+
+			It("includes the events of the whole day", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, _, filters := fakeActor.GetEventsByApplicationNameAndSpaceArgsForCall(0)
+				Expect(filters.Since).To(Equal(since))
+				Expect(filters.Until).To(Equal(time.Date(2024, 2, 1, 23, 59, 59, 999999999, time.UTC)))
+			})
+		})
+	})
+
+	When("the --space flag is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+			cmd.Space = true
+			fakeActor.GetEventsBySpaceReturns(
+				[]v7action.Event{
+					{Type: "audit.route.create", ActorName: "user1", TargetType: "route", TargetName: "www.example.com"},
+				},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("displays the events of the space with their targets", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting events in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`time\s+event\s+target\s+actor\s+description`))
+			Expect(testUI.Out).To(Say(`audit.route.create\s+route www.example.com\s+user1`))
+			Expect(testUI.Err).To(Say("warning-1"))
+
+			Expect(fakeActor.GetEventsBySpaceCallCount()).To(Equal(1))
+			spaceGUID, _ := fakeActor.GetEventsBySpaceArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+	})
+
+	When("the --org flag is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+			cmd.Org = true
+		})
+
+		It("only requires a targeted org and displays the events of the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(testUI.Out).To(Say(`Getting events in org some-org as steve\.\.\.`))
+
+			Expect(fakeActor.GetEventsByOrganizationCallCount()).To(Equal(1))
+			orgGUID, _ := fakeActor.GetEventsByOrganizationArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+		})
+	})
+
+	When("neither an app name nor a scope is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+		})
+
+		It("returns a required argument error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+		})
+	})
+
+	When("an app name and a scope are provided", func() {
+		BeforeEach(func() {
+			cmd.Space = true
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--space"}}))
+		})
+	})
+
+	When("both scopes are provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+			cmd.Space = true
+			cmd.Org = true
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--space", "--org"}}))
+		})
+	})
 })
@@ -0,0 +1,111 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+)
+
+type ExportSpaceCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.SpaceBundleDirectory `positional-args:"yes"`
+	usage           interface{}               `usage:"CF_NAME export-space DIRECTORY\n\n   The bundle contains an app manifest (manifest.yml) and the routes, service instances,\n   user-provided services, network policies and labels of the space (space.yml).\n\nEXAMPLES:\n   CF_NAME export-space ./my-space"`
+	relatedCommands interface{}               `related_commands:"apply-manifest, create-app-manifest, network-policies, services"`
+
+	NetworkingActor NetworkPoliciesActor
+}
+
+func (cmd *ExportSpaceCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	ccClient, uaaClient := cmd.BaseCommand.GetClients()
+
+	networkingClient, err := shared.NewNetworkingClient(config.NetworkPolicyV1Endpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NetworkingActor = cfnetworkingaction.NewActor(networkingClient, ccClient)
+
+	return nil
+}
+
+func (cmd ExportSpaceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	org := cmd.Config.TargetedOrganization()
+	space := cmd.Config.TargetedSpace()
+
+	cmd.UI.DisplayTextWithFlavor("Exporting space {{.SpaceName}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"SpaceName": space.Name,
+		"OrgName":   org.Name,
+		"Username":  user.Name,
+	})
+
+	export, warnings, err := cmd.Actor.ExportSpace(space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	policies, networkingWarnings, err := cmd.NetworkingActor.NetworkPoliciesBySpace(space.GUID)
+	cmd.UI.DisplayWarnings(networkingWarnings)
+	if err != nil {
+		return err
+	}
+
+	bundle := shared.SpaceBundle{
+		Manifest: export.Manifest,
+		Spec: shared.SpaceSpec{
+			Labels:               export.Labels,
+			Routes:               export.Routes,
+			ServiceInstances:     export.ServiceInstances,
+			UserProvidedServices: export.UserProvidedServices,
+		},
+	}
+	for _, policy := range policies {
+		bundlePolicy := shared.SpaceNetworkPolicy{
+			Source:      policy.SourceName,
+			Destination: policy.DestinationName,
+			Protocol:    policy.Protocol,
+			StartPort:   policy.StartPort,
+			EndPort:     policy.EndPort,
+		}
+		// Policies within the space are kept relative so that the bundle can be
+		// applied to a space with a different name.
+		if policy.DestinationSpaceName != space.Name || policy.DestinationOrgName != org.Name {
+			bundlePolicy.DestinationSpace = policy.DestinationSpaceName
+			bundlePolicy.DestinationOrg = policy.DestinationOrgName
+		}
+		bundle.Spec.NetworkPolicies = append(bundle.Spec.NetworkPolicies, bundlePolicy)
+	}
+
+	directory := string(cmd.RequiredArgs.Directory)
+	err = shared.WriteSpaceBundle(directory, bundle)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Space exported to {{.Directory}}.", map[string]interface{}{
+		"Directory": directory,
+	})
+	if len(bundle.Spec.UserProvidedServices) > 0 || len(bundle.Spec.ServiceInstances) > 0 {
+		cmd.UI.DisplayWarning("The bundle may contain service credentials and parameters. Store it securely.")
+	}
+
+	return nil
+}
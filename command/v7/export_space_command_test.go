@@ -0,0 +1,164 @@
+package v7_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-space Command", func() {
+	var (
+		cmd                 ExportSpaceCommand
+		testUI              *ui.UI
+		fakeConfig          *commandfakes.FakeConfig
+		fakeSharedActor     *commandfakes.FakeSharedActor
+		fakeActor           *v7fakes.FakeActor
+		fakeNetworkingActor *v7fakes.FakeNetworkPoliciesActor
+		binaryName          string
+		executeErr          error
+
+		tmpDir    string
+		bundleDir string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeNetworkingActor = new(v7fakes.FakeNetworkPoliciesActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "export-space")
+		Expect(err).NotTo(HaveOccurred())
+		bundleDir = filepath.Join(tmpDir, "bundle")
+
+		cmd = ExportSpaceCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs:    flag.SpaceBundleDirectory{Directory: flag.Path(bundleDir)},
+			NetworkingActor: fakeNetworkingActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.ExportSpaceReturns(
+			v7action.SpaceExport{
+				Manifest: []byte("applications:\n- name: frontend\n- name: backend\n"),
+				Labels:   map[string]string{"env": "staging"},
+				Routes:   []v7action.ExportedRoute{{Host: "www", Domain: "example.com"}},
+				UserProvidedServices: []v7action.ExportedUserProvidedService{
+					{Name: "ups", Credentials: map[string]interface{}{"password": "secret"}},
+				},
+			},
+			v7action.Warnings{"export-warning"},
+			nil,
+		)
+		fakeNetworkingActor.NetworkPoliciesBySpaceReturns(
+			[]cfnetworkingaction.Policy{
+				{SourceName: "frontend", DestinationName: "backend", Protocol: "tcp", StartPort: 8080, EndPort: 8080, DestinationSpaceName: "some-space", DestinationOrgName: "some-org"},
+				{SourceName: "backend", DestinationName: "db-proxy", Protocol: "tcp", StartPort: 5432, EndPort: 5433, DestinationSpaceName: "shared", DestinationOrgName: "platform"},
+			},
+			cfnetworkingaction.Warnings{"policies-warning"},
+			nil,
+		)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("writes the space bundle to the directory", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(testUI.Out).To(Say("Exporting space some-space in org some-org as steve..."))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say("Space exported to %s.", bundleDir))
+		Expect(testUI.Err).To(Say("export-warning"))
+		Expect(testUI.Err).To(Say("policies-warning"))
+		Expect(testUI.Err).To(Say("The bundle may contain service credentials and parameters. Store it securely."))
+
+		Expect(fakeActor.ExportSpaceArgsForCall(0)).To(Equal("some-space-guid"))
+		Expect(fakeNetworkingActor.NetworkPoliciesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+		bundle, err := shared.ReadSpaceBundle(bundleDir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(bundle.Manifest)).To(Equal("applications:\n- name: frontend\n- name: backend\n"))
+		Expect(bundle.Spec).To(Equal(shared.SpaceSpec{
+			Labels: map[string]string{"env": "staging"},
+			Routes: []v7action.ExportedRoute{{Host: "www", Domain: "example.com"}},
+			UserProvidedServices: []v7action.ExportedUserProvidedService{
+				{Name: "ups", Credentials: map[string]interface{}{"password": "secret"}},
+			},
+			NetworkPolicies: []shared.SpaceNetworkPolicy{
+				{Source: "frontend", Destination: "backend", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+				{Source: "backend", Destination: "db-proxy", Protocol: "tcp", StartPort: 5432, EndPort: 5433, DestinationSpace: "shared", DestinationOrg: "platform"},
+			},
+		}))
+	})
+
+	When("exporting the space fails", func() {
+		BeforeEach(func() {
+			fakeActor.ExportSpaceReturns(v7action.SpaceExport{}, v7action.Warnings{"export-warning"}, errors.New("export-error"))
+		})
+
+		It("returns the error and writes nothing", func() {
+			Expect(executeErr).To(MatchError("export-error"))
+			Expect(testUI.Err).To(Say("export-warning"))
+			Expect(bundleDir).NotTo(BeADirectory())
+			Expect(fakeNetworkingActor.NetworkPoliciesBySpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the network policies fails", func() {
+		BeforeEach(func() {
+			fakeNetworkingActor.NetworkPoliciesBySpaceReturns(nil, cfnetworkingaction.Warnings{"policies-warning"}, errors.New("policies-error"))
+		})
+
+		It("returns the error and writes nothing", func() {
+			Expect(executeErr).To(MatchError("policies-error"))
+			Expect(testUI.Err).To(Say("policies-warning"))
+			Expect(bundleDir).NotTo(BeADirectory())
+		})
+	})
+})
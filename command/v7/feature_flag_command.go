@@ -15,6 +15,9 @@ type FeatureFlagCommand struct {
 	relatedCommands interface{}  `related_commands:"disable-feature-flag, enable-feature-flag, feature-flags"`
 }
 
+// StructuredOutput marks feature-flag as displaying its results with --output.
+func (cmd FeatureFlagCommand) StructuredOutput() {}
+
 func (cmd FeatureFlagCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -37,6 +40,7 @@ func (cmd FeatureFlagCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(featureFlag)
 
 	cmd.displayTable(featureFlag)
 	return nil
@@ -13,6 +13,9 @@ type FeatureFlagsCommand struct {
 	relatedCommands interface{} `related_commands:"disable-feature-flag, enable-feature-flag, feature-flag"`
 }
 
+// StructuredOutput marks feature-flags as displaying its results with --output.
+func (cmd FeatureFlagsCommand) StructuredOutput() {}
+
 func (cmd FeatureFlagsCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -34,6 +37,7 @@ func (cmd FeatureFlagsCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(flags)
 
 	cmd.displayTable(flags)
 
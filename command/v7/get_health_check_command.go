@@ -15,6 +15,10 @@ type GetHealthCheckCommand struct {
 	usage        interface{}  `usage:"CF_NAME get-health-check APP_NAME"`
 }
 
+// StructuredOutput marks get-health-check as displaying its results with
+// --output.
+func (cmd GetHealthCheckCommand) StructuredOutput() {}
+
 func (cmd GetHealthCheckCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -38,6 +42,7 @@ func (cmd GetHealthCheckCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(processHealthChecks)
 
 	cmd.UI.DisplayNewline()
 
@@ -12,6 +12,10 @@ type IsolationSegmentsCommand struct {
 	relatedCommands interface{} `related_commands:"enable-org-isolation, create-isolation-segment"`
 }
 
+// StructuredOutput marks isolation-segments as displaying its results with
+// --output.
+func (cmd IsolationSegmentsCommand) StructuredOutput() {}
+
 func (cmd IsolationSegmentsCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -34,6 +38,7 @@ func (cmd IsolationSegmentsCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(summaries)
 
 	table := [][]string{
 		{
@@ -40,6 +40,9 @@ type LabelsCommand struct {
 	username string
 }
 
+// StructuredOutput marks labels as displaying its results with --output.
+func (cmd LabelsCommand) StructuredOutput() {}
+
 func (cmd LabelsCommand) Execute(args []string) error {
 	var (
 		labels   map[string]types.NullString
@@ -104,6 +107,7 @@ func (cmd LabelsCommand) Execute(args []string) error {
 		return err
 	}
 
+	cmd.UI.DisplayStructuredData(labels)
 	cmd.printLabels(labels)
 	return nil
 }
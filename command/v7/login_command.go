@@ -5,9 +5,11 @@ import (
 	"io"
 	"net/url"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/browser"
 	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/clock"
 
@@ -40,13 +42,20 @@ func (a ActualActorReloader) Reload(config command.Config, ui command.UI) (Actor
 
 const maxLoginTries = 3
 
+// browserLoginTimeout is how long to wait for the user to log in through the
+// browser before falling back to a one-time passcode.
+const browserLoginTimeout = 5 * time.Minute
+
 type LoginCommand struct {
 	UI            command.UI
 	Actor         Actor
 	Config        command.Config
 	ActorReloader ActorReloader
+	OpenBrowser   func(url string) error
 
 	APIEndpoint       string      `short:"a" description:"API endpoint (e.g. https://api.example.com)"`
+	Browser           bool        `long:"browser" description:"Log in through a browser using single sign-on, falling back to a one-time passcode"`
+	Device            bool        `long:"device" description:"Log in by approving a code in a browser on another device"`
 	Organization      string      `short:"o" description:"Org"`
 	Password          string      `short:"p" description:"Password"`
 	Space             string      `short:"s" description:"Space"`
@@ -55,7 +64,7 @@ type LoginCommand struct {
 	SSOPasscode       string      `long:"sso-passcode" description:"One-time passcode"`
 	Username          string      `short:"u" description:"Username"`
 	Origin            string      `long:"origin" description:"Indicates the identity provider to be used for login"`
-	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE] [--origin ORIGIN]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)\n   CF_NAME login --origin ldap"`
+	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE | --browser | --device] [--origin ORIGIN]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)\n   CF_NAME login --browser (CF_NAME will open a browser to log in with single sign-on)\n   CF_NAME login --device (CF_NAME will provide a url and a code to approve the login from another device)\n   CF_NAME login --origin ldap"`
 	relatedCommands   interface{} `related_commands:"api, auth, target"`
 }
 
@@ -63,6 +72,7 @@ func (cmd *LoginCommand) Setup(config command.Config, ui command.UI) error {
 	ccClient := shared.NewWrappedCloudControllerClient(config, ui)
 	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, nil, clock.NewClock())
 	cmd.ActorReloader = ActualActorReloader{}
+	cmd.OpenBrowser = browser.Open
 
 	cmd.UI = ui
 	cmd.Config = config
@@ -121,7 +131,11 @@ func (cmd *LoginCommand) Execute(args []string) error {
 	defer cmd.showStatus()
 
 	var authErr error
-	if cmd.SSO || cmd.SSOPasscode != "" {
+	if cmd.Device {
+		authErr = cmd.authenticateDevice()
+	} else if cmd.Browser {
+		authErr = cmd.authenticateBrowser()
+	} else if cmd.SSO || cmd.SSOPasscode != "" {
 		authErr = cmd.authenticateSSO()
 	} else {
 		authErr = cmd.authenticate()
@@ -326,6 +340,68 @@ func (cmd *LoginCommand) authenticate() error {
 	return err
 }
 
+// authenticateBrowser logs in with an authorization code obtained through the
+// browser. It falls back to a one-time passcode when no browser can be opened
+// or the browser login fails.
+func (cmd *LoginCommand) authenticateBrowser() error {
+	login, err := cmd.Actor.StartAuthorizationCodeLogin(cmd.Origin)
+	if err != nil {
+		cmd.UI.DisplayWarning("Unable to start the browser login: {{.Error}}", map[string]interface{}{"Error": err.Error()})
+		return cmd.authenticateSSO()
+	}
+
+	err = cmd.OpenBrowser(login.URL)
+	if err != nil {
+		_ = login.Close()
+		cmd.UI.DisplayWarning("Unable to open a browser. Log in with a one-time passcode instead.")
+		return cmd.authenticateSSO()
+	}
+
+	cmd.UI.DisplayText("Opening {{.URL}} in your browser to log in...", map[string]interface{}{"URL": login.URL})
+	cmd.UI.DisplayText("Authenticating...")
+	err = cmd.Actor.FinishAuthorizationCodeLogin(login, browserLoginTimeout)
+	if err != nil {
+		cmd.UI.DisplayWarning(translatableerror.ConvertToTranslatableError(err).Error())
+		cmd.UI.DisplayWarning("Log in with a one-time passcode instead.")
+		cmd.UI.DisplayNewline()
+		return cmd.authenticateSSO()
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+// authenticateDevice logs in with a device code that the user approves in a
+// browser on another device.
+func (cmd *LoginCommand) authenticateDevice() error {
+	authorization, err := cmd.Actor.StartDeviceLogin()
+	if err != nil {
+		cmd.UI.DisplayWarning(translatableerror.ConvertToTranslatableError(err).Error())
+		return err
+	}
+
+	cmd.UI.DisplayText("To log in, visit {{.URL}} on any device and enter the code {{.Code}}", map[string]interface{}{
+		"URL":  authorization.VerificationURI,
+		"Code": authorization.UserCode,
+	})
+	if authorization.VerificationURIComplete != "" {
+		cmd.UI.DisplayText("or visit {{.URL}}", map[string]interface{}{"URL": authorization.VerificationURIComplete})
+	}
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Waiting for the login to be approved...")
+
+	err = cmd.Actor.FinishDeviceLogin(authorization)
+	if err != nil {
+		cmd.UI.DisplayWarning(translatableerror.ConvertToTranslatableError(err).Error())
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
 func (cmd *LoginCommand) authenticateSSO() error {
 	prompts, err := cmd.Actor.GetLoginPrompts()
 	if err != nil {
@@ -577,6 +653,66 @@ func (cmd *LoginCommand) validateFlags() error {
 		}
 	}
 
+	if cmd.Browser && cmd.SSO {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--browser", "--sso"},
+		}
+	}
+
+	if cmd.Browser && cmd.SSOPasscode != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--browser", "--sso-passcode"},
+		}
+	}
+
+	if cmd.Browser && cmd.Username != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--browser", "-u"},
+		}
+	}
+
+	if cmd.Browser && cmd.Password != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--browser", "-p"},
+		}
+	}
+
+	if cmd.Device && cmd.SSO {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--device", "--sso"},
+		}
+	}
+
+	if cmd.Device && cmd.SSOPasscode != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--device", "--sso-passcode"},
+		}
+	}
+
+	if cmd.Device && cmd.Browser {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--device", "--browser"},
+		}
+	}
+
+	if cmd.Device && cmd.Username != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--device", "-u"},
+		}
+	}
+
+	if cmd.Device && cmd.Password != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--device", "-p"},
+		}
+	}
+
+	if cmd.Device && cmd.Origin != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--device", "--origin"},
+		}
+	}
+
 	return nil
 }
 
@@ -594,6 +730,12 @@ func (cmd *LoginCommand) validateTargetSpecificFlags() error {
 	if cmd.SSOPasscode != "" {
 		return translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--sso-passcode"}
 	}
+	if cmd.Browser {
+		return translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--browser"}
+	}
+	if cmd.Device {
+		return translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--device"}
+	}
 	if cmd.Username != "" {
 		return translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "-u"}
 	}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
@@ -103,6 +104,71 @@ var _ = Describe("login Command", func() {
 			})
 		})
 
+		When("the --browser and the --sso flag are used together", func() {
+			BeforeEach(func() {
+				cmd.Browser = true
+				cmd.SSO = true
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--browser", "--sso"},
+				}))
+			})
+		})
+
+		When("the --browser and the --sso-passcode flag are used together", func() {
+			BeforeEach(func() {
+				cmd.Browser = true
+				cmd.SSOPasscode = "some-passcode"
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--browser", "--sso-passcode"},
+				}))
+			})
+		})
+
+		When("the --device and the --sso flag are used together", func() {
+			BeforeEach(func() {
+				cmd.Device = true
+				cmd.SSO = true
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--device", "--sso"},
+				}))
+			})
+		})
+
+		When("the --device and the --origin flag are used together", func() {
+			BeforeEach(func() {
+				cmd.Device = true
+				cmd.Origin = "some-origin"
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--device", "--origin"},
+				}))
+			})
+		})
+
+		When("the --browser and the -u flag are used together", func() {
+			BeforeEach(func() {
+				cmd.Browser = true
+				cmd.Username = "some-user"
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--browser", "-u"},
+				}))
+			})
+		})
+
 		When("the user has manually added their client credentials", func() {
 			BeforeEach(func() {
 				fakeConfig.UAAOAuthClientReturns("some-other-client-id")
@@ -818,6 +884,161 @@ var _ = Describe("login Command", func() {
 		})
 	})
 
+	Describe("Browser login", func() {
+		var login *v7action.AuthorizationCodeLogin
+
+		BeforeEach(func() {
+			cmd.Browser = true
+			fakeConfig.TargetReturns("whatever.com")
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "potatoface"}, nil)
+
+			login = &v7action.AuthorizationCodeLogin{URL: "https://login.whatever.com/oauth/authorize?some=query"}
+			fakeActor.StartAuthorizationCodeLoginReturns(login, nil)
+			cmd.OpenBrowser = func(string) error { return nil }
+
+			fakeActor.GetLoginPromptsReturns(map[string]coreconfig.AuthPrompt{
+				"passcode": {
+					DisplayName: "some-sso-prompt",
+					Type:        coreconfig.AuthPromptTypePassword,
+				},
+			}, nil)
+			_, err := input.Write([]byte("some-passcode\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		When("the user logs in through the browser", func() {
+			var openedURL string
+
+			BeforeEach(func() {
+				cmd.Origin = "some-origin"
+				cmd.OpenBrowser = func(url string) error {
+					openedURL = url
+					return nil
+				}
+			})
+
+			It("opens the authorize URL and waits for the login to complete", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.StartAuthorizationCodeLoginCallCount()).To(Equal(1))
+				Expect(fakeActor.StartAuthorizationCodeLoginArgsForCall(0)).To(Equal("some-origin"))
+				Expect(openedURL).To(Equal(login.URL))
+
+				Expect(fakeActor.FinishAuthorizationCodeLoginCallCount()).To(Equal(1))
+				finishedLogin, timeout := fakeActor.FinishAuthorizationCodeLoginArgsForCall(0)
+				Expect(finishedLogin).To(Equal(login))
+				Expect(timeout).To(Equal(5 * time.Minute))
+
+				Expect(testUI.Out).To(Say(`Opening https://login.whatever.com/oauth/authorize\?some=query in your browser to log in\.\.\.`))
+				Expect(testUI.Out).To(Say(`Authenticating\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`user:\s+potatoface`))
+
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+				Expect(fakeActor.GetLoginPromptsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("no browser can be opened", func() {
+			BeforeEach(func() {
+				cmd.OpenBrowser = func(string) error { return errors.New("no browser is available") }
+			})
+
+			It("falls back to a one-time passcode", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Unable to open a browser. Log in with a one-time passcode instead."))
+				Expect(fakeActor.FinishAuthorizationCodeLoginCallCount()).To(Equal(0))
+
+				Expect(testUI.Out).To(Say("some-sso-prompt:"))
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+				credentials, _, grantType := fakeActor.AuthenticateArgsForCall(0)
+				Expect(credentials["passcode"]).To(Equal("some-passcode"))
+				Expect(grantType).To(Equal(constant.GrantTypePassword))
+			})
+		})
+
+		When("the browser login fails", func() {
+			BeforeEach(func() {
+				fakeActor.FinishAuthorizationCodeLoginReturns(errors.New("the login server denied the login: access_denied"))
+			})
+
+			It("falls back to a one-time passcode", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("the login server denied the login: access_denied"))
+				Expect(testUI.Err).To(Say("Log in with a one-time passcode instead."))
+				Expect(testUI.Out).To(Say("some-sso-prompt:"))
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the browser login cannot be started", func() {
+			BeforeEach(func() {
+				fakeActor.StartAuthorizationCodeLoginReturns(nil, errors.New("some-listen-error"))
+			})
+
+			It("falls back to a one-time passcode", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Unable to start the browser login: some-listen-error"))
+				Expect(testUI.Out).To(Say("some-sso-prompt:"))
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("Device login", func() {
+		BeforeEach(func() {
+			cmd.Device = true
+			fakeConfig.TargetReturns("whatever.com")
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "potatoface"}, nil)
+
+			fakeActor.StartDeviceLoginReturns(v7action.DeviceAuthorization{
+				DeviceCode:              "some-device-code",
+				UserCode:                "WDJB-MJHT",
+				VerificationURI:         "https://login.whatever.com/device",
+				VerificationURIComplete: "https://login.whatever.com/device?user_code=WDJB-MJHT",
+			}, nil)
+		})
+
+		It("displays the verification URI and user code and waits for the login to be approved", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`To log in, visit https://login.whatever.com/device on any device and enter the code WDJB-MJHT`))
+			Expect(testUI.Out).To(Say(`or visit https://login.whatever.com/device\?user_code=WDJB-MJHT`))
+			Expect(testUI.Out).To(Say(`Waiting for the login to be approved\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`user:\s+potatoface`))
+
+			Expect(fakeActor.FinishDeviceLoginCallCount()).To(Equal(1))
+			Expect(fakeActor.FinishDeviceLoginArgsForCall(0).DeviceCode).To(Equal("some-device-code"))
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+			Expect(fakeConfig.WriteConfigCallCount()).To(Equal(1))
+		})
+
+		When("the device authorization cannot be started", func() {
+			BeforeEach(func() {
+				fakeActor.StartDeviceLoginReturns(v7action.DeviceAuthorization{}, errors.New("some-error"))
+			})
+
+			It("fails to authenticate", func() {
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+				Expect(testUI.Err).To(Say("some-error"))
+				Expect(fakeActor.FinishDeviceLoginCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the login is not approved", func() {
+			BeforeEach(func() {
+				fakeActor.FinishDeviceLoginReturns(errors.New("the device code expired before the login was approved"))
+			})
+
+			It("fails to authenticate", func() {
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+				Expect(testUI.Err).To(Say("the device code expired before the login was approved"))
+				Expect(fakeConfig.WriteConfigCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("Config", func() {
 		When("a user has successfully authenticated", func() {
 			BeforeEach(func() {
@@ -3,6 +3,9 @@ package v7
 import (
 	"os"
 	"os/signal"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -11,15 +14,24 @@ import (
 	"code.cloudfoundry.org/cli/api/logcache"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type LogsCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	Recent          bool         `long:"recent" description:"Dump recent logs instead of tailing"`
-	usage           interface{}  `usage:"CF_NAME logs APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, ssh"`
+	RequiredArgs    flag.OptionalAppNames `positional-args:"yes"`
+	Recent          bool                  `long:"recent" description:"Dump recent logs instead of tailing"`
+	AllApps         bool                  `long:"all-apps" description:"Show the logs of every app in the targeted space"`
+	SourceTypes     []string              `long:"source-type" choice:"APP" choice:"RTR" choice:"STG" choice:"CELL" description:"Only show logs from the given source type (APP, RTR, STG, CELL). Can be specified multiple times"`
+	Instance        types.NullInt         `long:"instance" description:"Only show logs from the given app instance index"`
+	Grep            string                `long:"grep" description:"Only show log lines matching the given regular expression"`
+	Since           flag.Timestamp        `long:"since" description:"Show logs starting from the given time (e.g. 2006-01-02T15:04:05Z, 2006-01-02 or 30m)"`
+	usage           interface{}           `usage:"CF_NAME logs APP_NAME [APP_NAME...] [--recent] [--source-type TYPE] [--instance INDEX] [--grep REGEX] [--since TIME]\n   CF_NAME logs --all-apps [--recent] [--source-type TYPE] [--instance INDEX] [--grep REGEX] [--since TIME]\n\n   With --output json, each log line is displayed as a JSON object.\n\nEXAMPLES:\n   CF_NAME logs my-app --recent --source-type RTR\n   CF_NAME logs my-app --instance 0 --grep 'ERROR|WARN'\n   CF_NAME logs frontend backend --since 15m\n   CF_NAME --output json logs --all-apps"`
+	relatedCommands interface{}           `related_commands:"app, apps, ssh"`
 
 	LogCacheClient sharedaction.LogCacheClient
 }
@@ -34,8 +46,16 @@ func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
 	return err
 }
 
+// StructuredOutput marks logs as displaying its results with --output.
+func (cmd LogsCommand) StructuredOutput() {}
+
 func (cmd LogsCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(true, true)
+	options, err := cmd.logOptions()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
 	}
@@ -45,17 +65,20 @@ func (cmd LogsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
-		map[string]interface{}{
-			"AppName":   cmd.RequiredArgs.AppName,
-			"OrgName":   cmd.Config.TargetedOrganization().Name,
-			"SpaceName": cmd.Config.TargetedSpace().Name,
-			"Username":  user.Name,
-		})
-	cmd.UI.DisplayNewline()
+	cmd.displayFlavorText(user.Name)
+
+	apps, err := cmd.getApplications()
+	if err != nil {
+		return err
+	}
+
+	if len(apps) == 0 {
+		cmd.UI.DisplayText("No apps found.")
+		return nil
+	}
 
 	if cmd.Recent {
-		return cmd.displayRecentLogs()
+		return cmd.displayRecentLogs(apps, options)
 	}
 
 	stop := make(chan struct{})
@@ -66,7 +89,7 @@ func (cmd LogsCommand) Execute(args []string) error {
 		return err
 	}
 
-	err = cmd.streamLogs()
+	err = cmd.streamLogs(apps, options)
 
 	close(stop)
 	<-stoppedRefreshing
@@ -75,21 +98,120 @@ func (cmd LogsCommand) Execute(args []string) error {
 	return err
 }
 
-func (cmd LogsCommand) displayRecentLogs() error {
-	messages, warnings, err := cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
-		cmd.Config.TargetedSpace().GUID,
-		cmd.LogCacheClient,
-	)
+func (cmd LogsCommand) logOptions() (v7action.LogOptions, error) {
+	if cmd.AllApps && len(cmd.RequiredArgs.AppNames) > 0 {
+		return v7action.LogOptions{}, translatableerror.ArgumentCombinationError{
+			Args: []string{"--all-apps", "APP_NAME"},
+		}
+	}
 
-	for _, message := range messages {
-		cmd.UI.DisplayLogMessage(message, true)
+	if !cmd.AllApps && len(cmd.RequiredArgs.AppNames) == 0 {
+		return v7action.LogOptions{}, translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	}
+
+	if cmd.Config.OutputFormat() == configv3.OutputFormatYAML {
+		return v7action.LogOptions{}, translatableerror.IncorrectUsageError{
+			Message: "--output yaml is not supported by logs; use --output json to display each log line as a JSON object",
+		}
+	}
+
+	options := v7action.LogOptions{
+		Since: cmd.Since.Time,
+		Filter: sharedaction.LogFilter{
+			SourceTypes: cmd.SourceTypes,
+		},
+	}
+
+	if cmd.Instance.IsSet {
+		if cmd.Instance.Value < 0 {
+			return v7action.LogOptions{}, translatableerror.IncorrectUsageError{
+				Message: "--instance must be a non-negative integer",
+			}
+		}
+		options.Filter.Instance = strconv.Itoa(cmd.Instance.Value)
+	}
+
+	if cmd.Grep != "" {
+		pattern, err := regexp.Compile(cmd.Grep)
+		if err != nil {
+			return v7action.LogOptions{}, translatableerror.IncorrectUsageError{
+				Message: "--grep must be a valid regular expression: " + err.Error(),
+			}
+		}
+		options.Filter.Pattern = pattern
+	}
+
+	return options, nil
+}
+
+func (cmd LogsCommand) displayFlavorText(username string) {
+	templateValues := map[string]interface{}{
+		"AppName":   strings.Join(cmd.RequiredArgs.AppNames, ", "),
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  username,
+	}
+
+	switch {
+	case cmd.AllApps:
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for all apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+	case len(cmd.RequiredArgs.AppNames) > 1:
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for apps {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+	default:
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+	}
+	cmd.UI.DisplayNewline()
+}
+
+func (cmd LogsCommand) getApplications() ([]resources.Application, error) {
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+
+	if cmd.AllApps {
+		apps, warnings, err := cmd.Actor.GetApplicationsBySpace(spaceGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		return apps, err
+	}
+
+	if len(cmd.RequiredArgs.AppNames) == 1 {
+		app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppNames[0], spaceGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return nil, err
+		}
+		return []resources.Application{app}, nil
 	}
 
+	apps, warnings, err := cmd.Actor.GetApplicationsByNamesAndSpace(cmd.RequiredArgs.AppNames, spaceGUID)
 	cmd.UI.DisplayWarnings(warnings)
+	return apps, err
+}
+
+func (cmd LogsCommand) displayRecentLogs(apps []resources.Application, options v7action.LogOptions) error {
+	messages, err := cmd.Actor.GetRecentLogsForApplications(apps, cmd.LogCacheClient, options)
+
+	for _, message := range messages {
+		displayErr := cmd.displayLogMessage(message)
+		if displayErr != nil {
+			return displayErr
+		}
+	}
+
 	return err
 }
 
+func (cmd LogsCommand) displayLogMessage(message v7action.AppLogMessage) error {
+	if cmd.Config.OutputFormat() == configv3.OutputFormatJSON {
+		return cmd.UI.DisplayStructuredLine(message)
+	}
+
+	if cmd.AllApps || len(cmd.RequiredArgs.AppNames) > 1 {
+		cmd.UI.DisplayAppLogMessage(message.AppName, message, true)
+	} else {
+		cmd.UI.DisplayLogMessage(message, true)
+	}
+	return nil
+}
+
 func (cmd LogsCommand) refreshTokenPeriodically(
 	stop chan struct{},
 	stoppedRefreshing chan struct{},
@@ -127,17 +249,9 @@ func (cmd LogsCommand) handleLogErr(logErr error) {
 	}
 }
 
-func (cmd LogsCommand) streamLogs() error {
-	messages, logErrs, stopStreaming, warnings, err := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
-		cmd.Config.TargetedSpace().GUID,
-		cmd.LogCacheClient,
-	)
+func (cmd LogsCommand) streamLogs(apps []resources.Application, options v7action.LogOptions) error {
+	messages, logErrs, stopStreaming := cmd.Actor.GetStreamingLogsForApplications(apps, cmd.LogCacheClient, options)
 
-	cmd.UI.DisplayWarnings(warnings)
-	if err != nil {
-		return err
-	}
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt)
 
@@ -150,7 +264,10 @@ func (cmd LogsCommand) streamLogs() error {
 				messagesClosed = true
 				break
 			}
-			cmd.UI.DisplayLogMessage(message, true)
+			err := cmd.displayLogMessage(message)
+			if err != nil {
+				return err
+			}
 		case logErr, ok := <-logErrs:
 			if !ok {
 				errLogsClosed = true
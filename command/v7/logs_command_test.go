@@ -3,6 +3,7 @@ package v7_test
 import (
 	"context"
 	"errors"
+	"regexp"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -10,8 +11,11 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo/v2"
@@ -31,6 +35,13 @@ var _ = Describe("logs command", func() {
 		executeErr      error
 	)
 
+	newAppLogMessage := func(appName string, message string, timestamp time.Time, sourceType string, sourceInstance string) v7action.AppLogMessage {
+		return v7action.AppLogMessage{
+			AppName:    appName,
+			LogMessage: *sharedaction.NewLogMessage(message, "OUT", timestamp, sourceType, sourceInstance),
+		}
+	}
+
 	BeforeEach(func() {
 		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
 		fakeConfig = new(commandfakes.FakeConfig)
@@ -50,14 +61,78 @@ var _ = Describe("logs command", func() {
 
 		binaryName = "faceman"
 		fakeConfig.BinaryNameReturns(binaryName)
-		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.AppNames = []string{"some-app"}
 		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			resources.Application{Name: "some-app", GUID: "some-app-guid"},
+			v7action.Warnings{"get-app-warning"},
+			nil,
+		)
 	})
 
 	JustBeforeEach(func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	When("no app name is provided and --all-apps is not set", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppNames = nil
+		})
+
+		It("returns a required argument error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the output format is yaml", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatYAML)
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{
+				Message: "--output yaml is not supported by logs; use --output json to display each log line as a JSON object",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("app names and --all-apps are both provided", func() {
+		BeforeEach(func() {
+			cmd.AllApps = true
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--all-apps", "APP_NAME"},
+			}))
+		})
+	})
+
+	When("--grep is not a valid regular expression", func() {
+		BeforeEach(func() {
+			cmd.Grep = "(unclosed"
+		})
+
+		It("returns an incorrect usage error", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(translatableerror.IncorrectUsageError{}))
+			Expect(executeErr.(translatableerror.IncorrectUsageError).Message).To(ContainSubstring("--grep must be a valid regular expression"))
+		})
+	})
+
+	When("--instance is negative", func() {
+		BeforeEach(func() {
+			cmd.Instance = types.NullInt{IsSet: true, Value: -1}
+		})
+
+		It("returns an incorrect usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{
+				Message: "--instance must be a non-negative integer",
+			}))
+		})
+	})
+
 	When("the checkTarget fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(
@@ -84,6 +159,23 @@ var _ = Describe("logs command", func() {
 			})
 		})
 
+		When("getting the app fails", func() {
+			BeforeEach(func() {
+				cmd.Recent = true
+				fakeActor.GetApplicationByNameAndSpaceReturns(
+					resources.Application{},
+					v7action.Warnings{"get-app-warning"},
+					actionerror.ApplicationNotFoundError{Name: "some-app"},
+				)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(testUI.Err).To(Say("get-app-warning"))
+				Expect(fakeActor.GetRecentLogsForApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
 		When("the --recent flag is provided", func() {
 			BeforeEach(func() {
 				cmd.Recent = true
@@ -97,65 +189,179 @@ var _ = Describe("logs command", func() {
 				var expectedErr error
 				BeforeEach(func() {
 					expectedErr = errors.New("some-error")
-					fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
-						[]sharedaction.LogMessage{
-							*sharedaction.NewLogMessage(
-								"all your base are belong to us",
-								"1",
-								time.Unix(0, 0),
-								"app",
-								"1",
-							),
+					fakeActor.GetRecentLogsForApplicationsReturns(
+						[]v7action.AppLogMessage{
+							newAppLogMessage("some-app", "all your base are belong to us", time.Unix(0, 0), "app", "1"),
 						},
-						v7action.Warnings{"some-warning-1", "some-warning-2"},
 						expectedErr)
 				})
 
 				It("displays the errors along with the logs and warnings", func() {
 					Expect(executeErr).To(MatchError(expectedErr))
 					Expect(testUI.Out).To(Say("all your base are belong to us"))
-					Expect(testUI.Err).To(Say("some-warning-1"))
-					Expect(testUI.Err).To(Say("some-warning-2"))
+					Expect(testUI.Err).To(Say("get-app-warning"))
 				})
 			})
 
 			When("the logs actor returns logs", func() {
 				BeforeEach(func() {
-					fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
-						[]sharedaction.LogMessage{
-							*sharedaction.NewLogMessage(
-								"i am message 1",
-								"1",
-								time.Unix(0, 0),
-								"app",
-								"1",
-							),
-							*sharedaction.NewLogMessage(
-								"i am message 2",
-								"1",
-								time.Unix(1, 0),
-								"another-app",
-								"2",
-							),
+					fakeActor.GetRecentLogsForApplicationsReturns(
+						[]v7action.AppLogMessage{
+							newAppLogMessage("some-app", "i am message 1", time.Unix(0, 0), "app", "1"),
+							newAppLogMessage("some-app", "i am message 2", time.Unix(1, 0), "another-app", "2"),
 						},
-						v7action.Warnings{"some-warning-1", "some-warning-2"},
 						nil)
 				})
 
 				It("displays the recent log messages and warnings", func() {
 					Expect(executeErr).NotTo(HaveOccurred())
-					Expect(testUI.Err).To(Say("some-warning-1"))
-					Expect(testUI.Err).To(Say("some-warning-2"))
+					Expect(testUI.Err).To(Say("get-app-warning"))
 
-					Expect(testUI.Out).To(Say("i am message 1"))
-					Expect(testUI.Out).To(Say("i am message 2"))
-
-					Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
-					appName, spaceGUID, client := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+					Expect(testUI.Out).To(Say(`\[app/1\] OUT i am message 1`))
+					Expect(testUI.Out).To(Say(`\[another-app/2\] OUT i am message 2`))
+					Expect(testUI.Out).NotTo(Say(`\[some-app\]`))
 
+					Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+					appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
 					Expect(appName).To(Equal("some-app"))
 					Expect(spaceGUID).To(Equal("some-space-guid"))
+
+					Expect(fakeActor.GetRecentLogsForApplicationsCallCount()).To(Equal(1))
+					apps, client, options := fakeActor.GetRecentLogsForApplicationsArgsForCall(0)
+					Expect(apps).To(Equal([]resources.Application{{Name: "some-app", GUID: "some-app-guid"}}))
 					Expect(client).To(Equal(logCacheClient))
+					Expect(options).To(Equal(v7action.LogOptions{}))
+				})
+			})
+
+			When("filters and a start time are provided", func() {
+				var since time.Time
+
+				BeforeEach(func() {
+					since = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+					cmd.SourceTypes = []string{"APP", "RTR"}
+					cmd.Instance = types.NullInt{IsSet: true, Value: 2}
+					cmd.Grep = "ERROR|WARN"
+					cmd.Since.Time = since
+				})
+
+				It("passes them to the actor", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					_, _, options := fakeActor.GetRecentLogsForApplicationsArgsForCall(0)
+					Expect(options).To(Equal(v7action.LogOptions{
+						Since: since,
+						Filter: sharedaction.LogFilter{
+							SourceTypes: []string{"APP", "RTR"},
+							Instance:    "2",
+							Pattern:     regexp.MustCompile("ERROR|WARN"),
+						},
+					}))
+				})
+			})
+
+			When("several app names are provided", func() {
+				BeforeEach(func() {
+					cmd.RequiredArgs.AppNames = []string{"app-1", "app-2"}
+					fakeActor.GetApplicationsByNamesAndSpaceReturns(
+						[]resources.Application{{Name: "app-1", GUID: "app-1-guid"}, {Name: "app-2", GUID: "app-2-guid"}},
+						v7action.Warnings{"get-apps-warning"},
+						nil,
+					)
+					fakeActor.GetRecentLogsForApplicationsReturns(
+						[]v7action.AppLogMessage{
+							newAppLogMessage("app-1", "i am message 1", time.Unix(0, 0), "APP/PROC/WEB", "0"),
+							newAppLogMessage("app-2", "i am message 2", time.Unix(1, 0), "APP/PROC/WEB", "0"),
+						},
+						nil)
+				})
+
+				It("displays the logs of every app prefixed with the app name", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say("Retrieving logs for apps app-1, app-2 in org some-org-name / space some-space-name as some-user..."))
+					Expect(testUI.Out).To(Say(`\[app-1\] .* \[APP/PROC/WEB/0\] OUT i am message 1`))
+					Expect(testUI.Out).To(Say(`\[app-2\] .* \[APP/PROC/WEB/0\] OUT i am message 2`))
+					Expect(testUI.Err).To(Say("get-apps-warning"))
+
+					appNames, spaceGUID := fakeActor.GetApplicationsByNamesAndSpaceArgsForCall(0)
+					Expect(appNames).To(Equal([]string{"app-1", "app-2"}))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+
+					apps, _, _ := fakeActor.GetRecentLogsForApplicationsArgsForCall(0)
+					Expect(apps).To(HaveLen(2))
+				})
+			})
+
+			When("--all-apps is provided", func() {
+				BeforeEach(func() {
+					cmd.RequiredArgs.AppNames = nil
+					cmd.AllApps = true
+					fakeActor.GetApplicationsBySpaceReturns(
+						[]resources.Application{{Name: "app-1", GUID: "app-1-guid"}, {Name: "app-2", GUID: "app-2-guid"}},
+						v7action.Warnings{"get-apps-warning"},
+						nil,
+					)
+					fakeActor.GetRecentLogsForApplicationsReturns(
+						[]v7action.AppLogMessage{
+							newAppLogMessage("app-2", "i am message 1", time.Unix(0, 0), "RTR", "0"),
+						},
+						nil)
+				})
+
+				It("displays the logs of every app in the space", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say("Retrieving logs for all apps in org some-org-name / space some-space-name as some-user..."))
+					Expect(testUI.Out).To(Say(`\[app-2\] .* \[RTR/0\] OUT i am message 1`))
+					Expect(testUI.Err).To(Say("get-apps-warning"))
+
+					Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+				})
+
+				When("the space has no apps", func() {
+					BeforeEach(func() {
+						fakeActor.GetApplicationsBySpaceReturns(nil, nil, nil)
+					})
+
+					It("says so and does not retrieve logs", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).To(Say("No apps found."))
+						Expect(fakeActor.GetRecentLogsForApplicationsCallCount()).To(Equal(0))
+					})
+
+					When("the output format is json", func() {
+						BeforeEach(func() {
+							testUI.SetOutputFormat(configv3.OutputFormatJSON)
+							fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+						})
+
+						It("says so on stderr and keeps stdout empty", func() {
+							Expect(executeErr).NotTo(HaveOccurred())
+							Expect(testUI.Err).To(Say(`No apps found\.`))
+							Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+						})
+					})
+				})
+			})
+
+			When("the output format is json", func() {
+				BeforeEach(func() {
+					testUI.SetOutputFormat(configv3.OutputFormatJSON)
+					fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+					fakeActor.GetRecentLogsForApplicationsReturns(
+						[]v7action.AppLogMessage{
+							newAppLogMessage("some-app", "i am message 1", time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), "APP/PROC/WEB", "0"),
+							newAppLogMessage("some-app", "i am message 2", time.Date(2024, 1, 2, 3, 4, 6, 0, time.UTC), "RTR", "1"),
+						},
+						nil)
+				})
+
+				It("displays one JSON object per line on stdout and the flavor text on stderr", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Err).To(Say("Retrieving logs"))
+					Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(
+						`{"app_name":"some-app","timestamp":"2024-01-02T03:04:05Z","source_type":"APP/PROC/WEB","source_instance":"0","type":"OUT","message":"i am message 1"}` + "\n" +
+							`{"app_name":"some-app","timestamp":"2024-01-02T03:04:06Z","source_type":"RTR","source_instance":"1","type":"OUT","message":"i am message 2"}` + "\n",
+					))
 				})
 			})
 		})
@@ -175,26 +381,6 @@ var _ = Describe("logs command", func() {
 				}
 			})
 
-			When("the logs setup returns an error", func() {
-				var expectedErr error
-
-				BeforeEach(func() {
-					expectedErr = errors.New("some-error")
-					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceReturns(nil,
-						nil,
-						nil,
-						v7action.Warnings{"some-warning-1",
-							"some-warning-2"},
-						expectedErr)
-				})
-
-				It("displays the error and all warnings", func() {
-					Expect(executeErr).To(MatchError(expectedErr))
-					Expect(testUI.Err).To(Say("some-warning-1"))
-					Expect(testUI.Err).To(Say("some-warning-2"))
-				})
-			})
-
 			When("the logs stream returns an error", func() {
 				var (
 					expectedErr                 error
@@ -204,13 +390,12 @@ var _ = Describe("logs command", func() {
 				BeforeEach(func() {
 					expectedErr = errors.New("banana")
 
-					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub =
-						func(appName string, spaceGUID string, client sharedaction.LogCacheClient) (
-							<-chan sharedaction.LogMessage,
+					fakeActor.GetStreamingLogsForApplicationsStub =
+						func(_ []resources.Application, _ sharedaction.LogCacheClient, _ v7action.LogOptions) (
+							<-chan v7action.AppLogMessage,
 							<-chan error,
-							context.CancelFunc,
-							v7action.Warnings, error) {
-							logStream := make(chan sharedaction.LogMessage)
+							context.CancelFunc) {
+							logStream := make(chan v7action.AppLogMessage)
 							errorStream := make(chan error)
 							cancelFunctionHasBeenCalled = false
 							streamsWereClosed := false
@@ -233,7 +418,7 @@ var _ = Describe("logs command", func() {
 								streamsWereClosed = true
 							}()
 
-							return logStream, errorStream, cancelFunc, v7action.Warnings{"steve for all I care"}, nil
+							return logStream, errorStream, cancelFunc
 						}
 				})
 
@@ -244,37 +429,37 @@ var _ = Describe("logs command", func() {
 					})
 					It("displays the errors", func() {
 						Expect(executeErr).To(MatchError("firs swimming"))
-						Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+						Expect(fakeActor.GetStreamingLogsForApplicationsCallCount()).To(Equal(0))
 					})
 				})
 
 				It("displays the error and all warnings", func() {
 					Expect(executeErr).NotTo(HaveOccurred())
-					Expect(testUI.Err).To(Say("steve for all I care"))
+					Expect(testUI.Err).To(Say("get-app-warning"))
+					Expect(testUI.Err).To(Say("Failed to retrieve logs from Log Cache: banana"))
 					Expect(cancelFunctionHasBeenCalled).To(BeTrue())
 				})
 			})
 
 			When("the logs actor returns logs", func() {
 				BeforeEach(func() {
-					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub =
-						func(_ string, _ string, _ sharedaction.LogCacheClient) (
-							<-chan sharedaction.LogMessage,
-							<-chan error, context.CancelFunc,
-							v7action.Warnings,
-							error) {
-
-							logStream := make(chan sharedaction.LogMessage)
+					fakeActor.GetStreamingLogsForApplicationsStub =
+						func(_ []resources.Application, _ sharedaction.LogCacheClient, _ v7action.LogOptions) (
+							<-chan v7action.AppLogMessage,
+							<-chan error,
+							context.CancelFunc) {
+
+							logStream := make(chan v7action.AppLogMessage)
 							errorStream := make(chan error)
 
 							go func() {
-								logStream <- *sharedaction.NewLogMessage("Here are some staging logs!", "OUT", time.Now(), sharedaction.StagingLog, "sourceInstance") //TODO: is it ok to leave staging logs here?
-								logStream <- *sharedaction.NewLogMessage("Here are some other staging logs!", "OUT", time.Now(), sharedaction.StagingLog, "sourceInstance")
+								logStream <- newAppLogMessage("some-app", "Here are some staging logs!", time.Now(), sharedaction.StagingLog, "sourceInstance")
+								logStream <- newAppLogMessage("some-app", "Here are some other staging logs!", time.Now(), sharedaction.StagingLog, "sourceInstance")
 								close(logStream)
 								close(errorStream)
 							}()
 
-							return logStream, errorStream, func() {}, v7action.Warnings{"some-warning-1", "some-warning-2"}, nil
+							return logStream, errorStream, func() {}
 						}
 				})
 
@@ -284,18 +469,51 @@ var _ = Describe("logs command", func() {
 
 				It("displays all streaming log messages and warnings", func() {
 					Expect(executeErr).NotTo(HaveOccurred())
-					Expect(testUI.Err).To(Say("some-warning-1"))
-					Expect(testUI.Err).To(Say("some-warning-2"))
+					Expect(testUI.Err).To(Say("get-app-warning"))
 
 					Expect(testUI.Out).To(Say("Here are some staging logs!"))
 					Expect(testUI.Out).To(Say("Here are some other staging logs!"))
 
-					Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
-					appName, spaceGUID, client := fakeActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+					Expect(fakeActor.GetStreamingLogsForApplicationsCallCount()).To(Equal(1))
+					apps, client, options := fakeActor.GetStreamingLogsForApplicationsArgsForCall(0)
 
-					Expect(appName).To(Equal("some-app"))
-					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(apps).To(Equal([]resources.Application{{Name: "some-app", GUID: "some-app-guid"}}))
 					Expect(client).To(Equal(logCacheClient))
+					Expect(options).To(Equal(v7action.LogOptions{}))
+				})
+
+				When("several app names are provided", func() {
+					BeforeEach(func() {
+						cmd.RequiredArgs.AppNames = []string{"some-app", "other-app"}
+						fakeActor.GetApplicationsByNamesAndSpaceReturns(
+							[]resources.Application{{Name: "some-app", GUID: "some-app-guid"}, {Name: "other-app", GUID: "other-app-guid"}},
+							nil,
+							nil,
+						)
+					})
+
+					It("streams the logs prefixed with the app name", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).To(Say(`\[some-app\] .* Here are some staging logs!`))
+						Expect(testUI.Out).To(Say(`\[some-app\] .* Here are some other staging logs!`))
+
+						apps, _, _ := fakeActor.GetStreamingLogsForApplicationsArgsForCall(0)
+						Expect(apps).To(HaveLen(2))
+					})
+				})
+
+				When("the output format is json", func() {
+					BeforeEach(func() {
+						testUI.SetOutputFormat(configv3.OutputFormatJSON)
+						fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+					})
+
+					It("streams one JSON object per log line", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).NotTo(Say("Retrieving logs"))
+						Expect(testUI.Out).To(Say(`\{"app_name":"some-app",.*"message":"Here are some staging logs!"\}\n`))
+						Expect(testUI.Out).To(Say(`\{"app_name":"some-app",.*"message":"Here are some other staging logs!"\}\n`))
+					})
 				})
 
 				When("scheduling a token refresh errors immediately", func() {
@@ -305,31 +523,29 @@ var _ = Describe("logs command", func() {
 					})
 					It("displays the errors", func() {
 						Expect(executeErr).To(MatchError("fjords pining"))
-						Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+						Expect(fakeActor.GetStreamingLogsForApplicationsCallCount()).To(Equal(0))
 					})
 				})
 
 				When("there is an error refreshing a token sometime later", func() {
 					BeforeEach(func() {
 						cmd.Recent = false
-						fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub =
-							func(_ string, _ string, _ sharedaction.LogCacheClient) (
-								<-chan sharedaction.LogMessage,
-								<-chan error, context.CancelFunc,
-								v7action.Warnings,
-								error) {
-
-								logStream := make(chan sharedaction.LogMessage)
+						fakeActor.GetStreamingLogsForApplicationsStub =
+							func(_ []resources.Application, _ sharedaction.LogCacheClient, _ v7action.LogOptions) (
+								<-chan v7action.AppLogMessage,
+								<-chan error,
+								context.CancelFunc) {
+
+								logStream := make(chan v7action.AppLogMessage)
 								errorStream := make(chan error)
 
 								go func() {
 									time.Sleep(100 * time.Millisecond)
 									close(logStream)
 									close(errorStream)
-									return
 								}()
 
-								return logStream, errorStream, func() {}, v7action.Warnings{}, nil
+								return logStream, errorStream, func() {}
 							}
 						fakeActor.ScheduleTokenRefreshStub = func(
 							after func(time.Duration) <-chan time.Time,
@@ -345,7 +561,7 @@ var _ = Describe("logs command", func() {
 					})
 					It("displays the errors", func() {
 						Expect(executeErr).NotTo(HaveOccurred())
-						Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+						Expect(fakeActor.GetStreamingLogsForApplicationsCallCount()).To(Equal(1))
 						Expect(testUI.Err).To(Say("fjords pining"))
 					})
 				})
@@ -21,6 +21,9 @@ type MarketplaceCommand struct {
 	relatedCommands     interface{} `related_commands:"create-service, services"`
 }
 
+// StructuredOutput marks marketplace as displaying its results with --output.
+func (cmd MarketplaceCommand) StructuredOutput() {}
+
 func (cmd MarketplaceCommand) Execute(args []string) error {
 	var username string
 
@@ -45,6 +48,7 @@ func (cmd MarketplaceCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(offerings)
 
 	if len(offerings) == 0 {
 		cmd.UI.DisplayNewline()
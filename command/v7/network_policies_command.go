@@ -45,6 +45,10 @@ func (cmd *NetworkPoliciesCommand) Setup(config command.Config, ui command.UI) e
 	return nil
 }
 
+// StructuredOutput marks network-policies as displaying its results with
+// --output.
+func (cmd NetworkPoliciesCommand) StructuredOutput() {}
+
 func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -81,6 +85,7 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 		return err
 	}
 
+	cmd.UI.DisplayStructuredData(policies)
 	cmd.UI.DisplayNewline()
 
 	table := [][]string{
@@ -5,7 +5,9 @@ import (
 	"sort"
 	"strings"
 
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/resources"
 )
 
 type OrgCommand struct {
@@ -17,6 +19,9 @@ type OrgCommand struct {
 	relatedCommands interface{}       `related_commands:"org-users, orgs"`
 }
 
+// StructuredOutput marks org as displaying its results with --output.
+func (cmd OrgCommand) StructuredOutput() {}
+
 func (cmd OrgCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -74,6 +79,10 @@ func (cmd OrgCommand) displayOrgSummary() error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(struct {
+		v7action.OrganizationSummary
+		IsolationSegments []resources.IsolationSegment
+	}{orgSummary, isolationSegments})
 
 	isolationSegmentNames := []string{}
 	for _, iso := range isolationSegments {
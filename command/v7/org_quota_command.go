@@ -14,6 +14,9 @@ type OrgQuotaCommand struct {
 	relatedCommands interface{}            `related_commands:"org, org-quotas"`
 }
 
+// StructuredOutput marks org-quota as displaying its results with --output.
+func (cmd OrgQuotaCommand) StructuredOutput() {}
+
 func (cmd OrgQuotaCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
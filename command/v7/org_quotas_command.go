@@ -12,6 +12,9 @@ type OrgQuotasCommand struct {
 	relatedCommands interface{} `related_commands:"org-quota"`
 }
 
+// StructuredOutput marks org-quotas as displaying its results with --output.
+func (cmd OrgQuotasCommand) StructuredOutput() {}
+
 func (cmd OrgQuotasCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -13,6 +13,9 @@ type OrgsCommand struct {
 	Labels          string      `long:"labels" description:"Selector to filter orgs by labels"`
 }
 
+// StructuredOutput marks orgs as displaying its results with --output.
+func (cmd OrgsCommand) StructuredOutput() {}
+
 func (cmd OrgsCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -34,6 +37,7 @@ func (cmd OrgsCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(orgs)
 
 	if len(orgs) == 0 {
 		cmd.UI.DisplayText("No orgs found.")
@@ -16,6 +16,9 @@ type PackagesCommand struct {
 	relatedCommands interface{}  `related_commands:"droplets, create-package, app, push"`
 }
 
+// StructuredOutput marks packages as displaying its results with --output.
+func (cmd PackagesCommand) StructuredOutput() {}
+
 func (cmd PackagesCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -40,6 +43,7 @@ func (cmd PackagesCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(packages)
 
 	if len(packages) == 0 {
 		cmd.UI.DisplayText("No packages found.")
@@ -3,9 +3,14 @@ package v7
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
 	"strings"
+	"sync"
+	"time"
 
+	"code.cloudfoundry.org/bytefmt"
 	"github.com/cloudfoundry/bosh-cli/director/template"
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
@@ -23,9 +28,11 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/clissh"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/manifestparser"
 	"code.cloudfoundry.org/cli/util/progressbar"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ProgressBar
@@ -41,8 +48,17 @@ type ProgressBar interface {
 type PushActor interface {
 	HandleFlagOverrides(baseManifest manifestparser.Manifest, flagOverrides v7pushaction.FlagOverrides) (manifestparser.Manifest, error)
 	CreatePushPlans(spaceGUID string, orgGUID string, manifest manifestparser.Manifest, overrides v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error)
+	// CreateDryRunPushPlans creates the push plans without requiring the
+	// apps to exist, since a dry run does not apply the manifest.
+	CreateDryRunPushPlans(spaceGUID string, orgGUID string, manifest manifestparser.Manifest, overrides v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error)
+	// CreateDryRunPlan describes the changes Actualize would make without
+	// making them.
+	CreateDryRunPlan(plan v7pushaction.PushPlan, manifestApp manifestparser.Application) (v7pushaction.DryRunPlan, v7action.Warnings, error)
 	// Actualize applies any necessary changes.
 	Actualize(plan v7pushaction.PushPlan, progressBar v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent
+	// RepushApplicationSource packages, stages and starts the current
+	// contents of the plan's bits path.
+	RepushApplicationSource(plan v7pushaction.PushPlan, progressBar v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent
 }
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . V7ActorForPush
@@ -58,9 +74,12 @@ type V7ActorForPush interface {
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ManifestParser
 
 type ManifestParser interface {
-	InterpolateManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]byte, error)
+	InterpolateManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV, pathsToOpsFiles []string) ([]byte, error)
 	ParseManifest(pathToManifest string, rawManifest []byte) (manifestparser.Manifest, error)
+	ValidateManifest(pathToManifest string) ([]manifestparser.ValidationError, error)
+	ValidateRawManifest(rawManifest []byte) ([]manifestparser.ValidationError, error)
 	MarshalManifest(manifest manifestparser.Manifest) ([]byte, error)
+	ResolvedSecrets() []string
 }
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ManifestLocator
@@ -75,40 +94,78 @@ type DiffDisplayer interface {
 	DisplayDiff(rawManifest []byte, diff resources.ManifestDiff) error
 }
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . PushCache
+
+type PushCache interface {
+	Save() error
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . WatchActor
+
+type WatchActor interface {
+	SnapshotDirectory(sourceDir string) (sharedaction.DirectorySnapshot, error)
+	WaitForDirectoryChanges(sourceDir string, snapshot sharedaction.DirectorySnapshot, pollInterval time.Duration, debounce time.Duration, stop <-chan struct{}) (sharedaction.DirectorySnapshot, sharedaction.DirectoryChanges, error)
+	ExecuteSecureSync(sshClient sharedaction.SecureShellClient, syncOptions sharedaction.SecureSyncOptions) error
+}
+
+const (
+	// watchPollInterval is how often push --watch checks the app's files for
+	// changes.
+	watchPollInterval = 500 * time.Millisecond
+
+	// watchDebounce is how long the app's files must stay unchanged before
+	// push --watch re-pushes them, so that saving several files at once
+	// results in a single push.
+	watchDebounce = time.Second
+
+	// watchSyncRemoteDir is where the app's files are on its instances.
+	watchSyncRemoteDir = "/home/vcap/app"
+)
+
 type PushCommand struct {
 	BaseCommand
 
 	OptionalArgs            flag.OptionalAppName                `positional-args:"yes"`
+	AutoPromoteAfter        flag.Duration                       `long:"auto-promote-after" description:"Continue a canary deployment once the canary instances have stayed healthy for this long, or cancel it as soon as they are not (e.g. 5m). Only applies with the canary deployment strategy, from --strategy or the manifest."`
+	AutoPromoteMaxCrashes   *int                                `long:"auto-promote-max-crashes" description:"Number of canary instance crashes tolerated before the deployment is canceled. Only applies when --auto-promote-after is specified. Defaults to 0."`
+	AutoPromoteMaxErrorRate *float64                            `long:"auto-promote-max-error-rate" description:"Highest percentage of HTTP requests to the app that may fail with a 5xx status code before the deployment is canceled (e.g. 1.5). Only applies when --auto-promote-after is specified."`
 	HealthCheckTimeout      flag.PositiveInteger                `long:"app-start-timeout" short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
 	Buildpacks              []string                            `long:"buildpack" short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
 	Disk                    string                              `long:"disk" short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
 	DockerImage             flag.DockerImage                    `long:"docker-image" short:"o" description:"Docker image to use (e.g. user/docker-image-name)"`
 	DockerUsername          string                              `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
 	DropletPath             flag.PathWithExistenceCheck         `long:"droplet" description:"Path to a tgz file with a pre-staged app"`
+	DryRun                  bool                                `long:"dry-run" description:"Display the changes the push would make to the apps, routes and manifest without making them"`
 	HealthCheckHTTPEndpoint string                              `long:"endpoint"  description:"Valid path on the app for an HTTP health check. Only used when specifying --health-check-type=http"`
 	HealthCheckType         flag.HealthCheckType                `long:"health-check-type" short:"u" description:"Application health check type. Defaults to 'port'. 'http' requires a valid endpoint, for example, '/health'."`
 	Instances               flag.Instances                      `long:"instances" short:"i" description:"Number of instances"`
+	InstanceSteps           flag.InstanceSteps                  `long:"instance-steps" description:"An array of percentage steps to deploy when using deployment strategy canary. (e.g. 20,40,60)"`
 	LogRateLimit            string                              `long:"log-rate-limit" short:"l" description:"Log rate limit per second, in bytes (e.g. 128B, 4K, 1M). -l=-1 represents unlimited"`
 	PathToManifest          flag.ManifestPathWithExistenceCheck `long:"manifest" short:"f" description:"Path to manifest"`
-	MaxInFlight             *int                                `long:"max-in-flight" description:"Defines the maximum number of instances that will be actively being started. Only applies when --strategy flag is specified."`
+	MaxInFlight             *int                                `long:"max-in-flight" description:"Defines the maximum number of instances that will be actively being started. Only applies when a deployment strategy is specified with --strategy or in the manifest."`
 	Memory                  string                              `long:"memory" short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
 	NoManifest              bool                                `long:"no-manifest" description:"Ignore manifest file"`
 	NoRoute                 bool                                `long:"no-route" description:"Do not map a route to this app"`
 	NoStart                 bool                                `long:"no-start" description:"Do not stage and start the app after pushing"`
 	NoWait                  bool                                `long:"no-wait" description:"Exit when the first instance of the web process is healthy"`
+	PathsToOpsFiles         []flag.PathWithExistenceCheck       `long:"ops-file" description:"Path to a BOSH-style ops file applied to the manifest after variable substitution; can specify multiple times"`
+	Parallel                flag.PositiveInteger                `long:"parallel" description:"Number of apps in the manifest to push at the same time. Staging logs are shown with each line prefixed with the app name. Defaults to 1."`
 	AppPath                 flag.PathWithExistenceCheck         `long:"path" short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
 	RandomRoute             bool                                `long:"random-route" description:"Create a random route for this app (except when no-route is specified in the manifest)"`
 	RedactEnv               bool                                `long:"redact-env" description:"Do not print values for environment vars set in the application manifest"`
 	Stack                   string                              `long:"stack" short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
 	StartCommand            flag.Command                        `long:"start-command" short:"c" description:"Startup command, set to null to reset to default start command"`
-	Strategy                flag.DeploymentStrategy             `long:"strategy" description:"Deployment strategy can be canary, rolling or null."`
+	Strategy                flag.DeploymentStrategy             `long:"strategy" description:"Deployment strategy can be canary, rolling or null. When not specified, the 'strategy' of the app in the manifest is used."`
 	Task                    bool                                `long:"task" description:"Push an app that is used only to execute tasks. The app will be staged, but not started and will have no route assigned."`
 	Vars                    []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
 	PathsToVarsFiles        []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	Watch                   bool                                `long:"watch" description:"After pushing, watch the app path for changes (except files excluded by .cfignore) and push the app again when they change. Press Ctrl-C to stop."`
+	WatchSync               bool                                `long:"watch-sync" description:"Copy changed files to the running instances over SSH instead of staging the app again, falling back to a full push if that fails. Synced files are lost when an instance restarts. Only applies when --watch is specified."`
 	dockerPassword          interface{}                         `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-	usage                   interface{}                         `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n \n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route ]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]..."`
+	usage                   interface{}                         `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]... [--ops-file OPS_FILE_PATH]...\n   [--parallel NUM_APPS] [--watch [--watch-sync]]\n \n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route ]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]... [--ops-file OPS_FILE_PATH]..."`
 	envCFStagingTimeout     interface{}                         `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout     interface{}                         `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	envCFManifestVarsHelper interface{}                         `environmentName:"CF_MANIFEST_VARS_HELPER" environmentDescription:"Executable that resolves ((helper:NAME)) manifest variables (run as HELPER get NAME, it prints a JSON object with a value field)"`
 
 	LogCacheClient  sharedaction.LogCacheClient
 	PushActor       PushActor
@@ -118,6 +175,9 @@ type PushCommand struct {
 	ManifestLocator ManifestLocator
 	ManifestParser  ManifestParser
 	DiffDisplayer   DiffDisplayer
+	PushCache       PushCache
+	WatchActor      WatchActor
+	NewSSHClient    func() sharedaction.SecureShellClient
 
 	stopStreamingFunc func()
 }
@@ -130,23 +190,42 @@ func (cmd *PushCommand) Setup(config command.Config, ui command.UI) error {
 
 	cmd.ProgressBar = progressbar.NewProgressBar()
 	cmd.VersionActor = cmd.Actor
-	cmd.PushActor = v7pushaction.NewActor(cmd.Actor, sharedaction.NewActor(config))
+	pushCache := sharedaction.LoadPushCache(configv3.PushCacheFilePath())
+	sharedActor := sharedaction.NewActor(config)
+	sharedActor.PushCache = pushCache
+	pushActor := v7pushaction.NewActor(cmd.Actor, sharedActor)
+	pushActor.ResourceMatchCache = pushCache.TargetMatches(config.Target())
+	cmd.PushActor = pushActor
+	cmd.PushCache = pushCache
+	cmd.WatchActor = sharedActor
+	cmd.NewSSHClient = func() sharedaction.SecureShellClient {
+		return clissh.NewDefaultSecureShell()
+	}
 
 	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
 	if err != nil {
 		return err
 	}
+	pushActor.LogCacheClient = cmd.LogCacheClient
 
 	currentDir, err := os.Getwd()
 	cmd.CWD = currentDir
 
 	cmd.ManifestLocator = manifestparser.NewLocator()
-	cmd.ManifestParser = manifestparser.ManifestParser{}
-	cmd.DiffDisplayer = &shared.ManifestDiffDisplayer{UI: ui, RedactEnv: cmd.RedactEnv}
+	manifestParser := &manifestparser.ManifestParser{CredentialHelper: config.ManifestVarsHelper()}
+	cmd.ManifestParser = manifestParser
+	cmd.DiffDisplayer = &shared.ManifestDiffDisplayer{
+		UI:        ui,
+		RedactEnv: cmd.RedactEnv,
+		Secrets:   manifestParser,
+	}
 
 	return err
 }
 
+// StructuredOutput marks push as displaying its results with --output.
+func (cmd PushCommand) StructuredOutput() {}
+
 func (cmd PushCommand) Execute(args []string) error {
 	cmd.stopStreamingFunc = nil
 	err := cmd.SharedActor.CheckTarget(true, true)
@@ -154,6 +233,10 @@ func (cmd PushCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.PushCache != nil && !cmd.DryRun {
+		defer cmd.savePushCache()
+	}
+
 	user, err := cmd.Actor.GetCurrentUser()
 	if err != nil {
 		return err
@@ -189,6 +272,10 @@ func (cmd PushCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.DryRun {
+		return cmd.executeDryRun(transformedManifest, transformedRawManifest, flagOverrides, user)
+	}
+
 	cmd.announcePushing(transformedManifest.AppNames(), user)
 
 	hasManifest := transformedManifest.PathToManifest != ""
@@ -250,6 +337,14 @@ func (cmd PushCommand) Execute(args []string) error {
 		}
 	}()
 
+	if cmd.Watch {
+		return cmd.actualizeAndWatch(pushPlans[0])
+	}
+
+	if cmd.Parallel.Value > 1 && len(pushPlans) > 1 {
+		return cmd.actualizeInParallel(pushPlans)
+	}
+
 	for _, plan := range pushPlans {
 		log.WithField("app_name", plan.Application.Name).Info("actualizing")
 		eventStream := cmd.PushActor.Actualize(plan, cmd.ProgressBar)
@@ -269,6 +364,157 @@ func (cmd PushCommand) Execute(args []string) error {
 	return nil
 }
 
+// dryRunReport is the document displayed by push --dry-run with --output.
+type dryRunReport struct {
+	ManifestDiff *resources.ManifestDiff   `json:"manifest_diff,omitempty"`
+	Applications []v7pushaction.DryRunPlan `json:"applications"`
+}
+
+func (cmd PushCommand) executeDryRun(manifest manifestparser.Manifest, rawManifest []byte, flagOverrides v7pushaction.FlagOverrides, user configv3.User) error {
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+
+	cmd.announceDryRun(manifest.AppNames(), user)
+
+	var report dryRunReport
+	if manifest.PathToManifest != "" {
+		diff, warnings, err := cmd.Actor.DiffSpaceManifest(spaceGUID, rawManifest)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			if _, isUnexpectedError := err.(ccerror.V3UnexpectedResponseError); !isUnexpectedError {
+				return err
+			}
+			cmd.UI.DisplayWarning("Unable to generate diff.")
+		} else {
+			cmd.UI.DisplayNewline()
+			cmd.UI.DisplayText("Manifest file {{.Path}} would be applied with these attributes...", map[string]interface{}{
+				"Path": manifest.PathToManifest,
+			})
+
+			err = cmd.DiffDisplayer.DisplayDiff(rawManifest, diff)
+			if err != nil {
+				return err
+			}
+
+			if cmd.RedactEnv {
+				diff = shared.RedactManifestDiff(diff)
+			}
+			diff = shared.RedactManifestDiffSecrets(diff, cmd.ManifestParser.ResolvedSecrets())
+			report.ManifestDiff = &diff
+		}
+	}
+
+	pushPlans, warnings, err := cmd.PushActor.CreateDryRunPushPlans(
+		spaceGUID,
+		cmd.Config.TargetedOrganization().GUID,
+		manifest,
+		flagOverrides,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	report.Applications = []v7pushaction.DryRunPlan{}
+	for i, plan := range pushPlans {
+		dryRunPlan, warnings, err := cmd.PushActor.CreateDryRunPlan(plan, manifest.Applications[i])
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		report.Applications = append(report.Applications, dryRunPlan)
+		cmd.displayDryRunPlan(dryRunPlan)
+	}
+
+	cmd.UI.DisplayStructuredData(report)
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Dry run complete; no changes were made.")
+	return nil
+}
+
+func (cmd PushCommand) announceDryRun(appNames []string, user configv3.User) {
+	tokens := map[string]interface{}{
+		"AppName":   strings.Join(appNames, ", "),
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	}
+	singular := "Planning push of app {{.AppName}} to org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}..."
+	plural := "Planning push of apps {{.AppName}} to org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}..."
+
+	if len(appNames) == 1 {
+		cmd.UI.DisplayTextWithFlavor(singular, tokens)
+	} else {
+		cmd.UI.DisplayTextWithFlavor(plural, tokens)
+	}
+	cmd.UI.DisplayText("Dry run: no changes will be made.")
+}
+
+func (cmd PushCommand) displayDryRunPlan(plan v7pushaction.DryRunPlan) {
+	cmd.UI.DisplayNewline()
+	if plan.CreateApp {
+		cmd.UI.DisplayTextWithFlavor("App {{.AppName}} would be created:", map[string]interface{}{"AppName": plan.AppName})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("App {{.AppName}} would be updated:", map[string]interface{}{"AppName": plan.AppName})
+	}
+
+	var source string
+	switch plan.Source {
+	case v7pushaction.AppSourceDocker:
+		source = cmd.UI.TranslateText("docker image {{.Image}}", map[string]interface{}{"Image": plan.DockerImage})
+	case v7pushaction.AppSourceDroplet:
+		source = cmd.UI.TranslateText("droplet {{.Path}}", map[string]interface{}{"Path": plan.DropletPath})
+	default:
+		source = cmd.UI.TranslateText("{{.Total}} files: {{.Matched}} found in remote cache, {{.ToUpload}} to upload ({{.Size}})", map[string]interface{}{
+			"Total":    plan.Files.Total,
+			"Matched":  plan.Files.Matched,
+			"ToUpload": plan.Files.ToUpload,
+			"Size":     bytefmt.ByteSize(uint64(plan.Files.UploadBytes)),
+		})
+	}
+
+	table := [][]string{{cmd.UI.TranslateText("source:"), source}}
+
+	routesKey := cmd.UI.TranslateText("routes:")
+	switch {
+	case plan.NoRoute:
+		table = append(table, []string{routesKey, cmd.UI.TranslateText("none (all routes would be unmapped)")})
+	case plan.RandomRoute:
+		table = append(table, []string{routesKey, cmd.UI.TranslateText("random route (create and map)")})
+	case plan.DefaultRoute:
+		table = append(table, []string{routesKey, cmd.UI.TranslateText("default route (create and map)")})
+	case len(plan.Routes) == 0:
+		table = append(table, []string{routesKey, cmd.UI.TranslateText("none")})
+	}
+	for _, route := range plan.Routes {
+		table = append(table, []string{routesKey, fmt.Sprintf("%s (%s)", route.URL, cmd.UI.TranslateText(string(route.Change)))})
+		routesKey = ""
+	}
+
+	strategy := string(plan.Strategy)
+	if strategy == "" {
+		strategy = cmd.UI.TranslateText("none (stop and restart)")
+	}
+	if !plan.Start {
+		strategy = cmd.UI.TranslateText("none (app would not be started)")
+	}
+
+	table = append(table,
+		[]string{cmd.UI.TranslateText("deployment strategy:"), strategy},
+		[]string{cmd.UI.TranslateText("staging:"), cmd.formatDryRunBool(plan.Stage)},
+		[]string{cmd.UI.TranslateText("start:"), cmd.formatDryRunBool(plan.Start)},
+	)
+
+	cmd.UI.DisplayKeyValueTable("  ", table, ui.DefaultTableSpacePadding)
+}
+
+func (cmd PushCommand) formatDryRunBool(value bool) string {
+	if value {
+		return cmd.UI.TranslateText("yes")
+	}
+	return cmd.UI.TranslateText("no")
+}
+
 func (cmd PushCommand) GetBaseManifest(flagOverrides v7pushaction.FlagOverrides) (manifestparser.Manifest, error) {
 	defaultManifest := manifestparser.Manifest{
 		Applications: []manifestparser.Application{
@@ -298,7 +544,7 @@ func (cmd PushCommand) GetBaseManifest(flagOverrides v7pushaction.FlagOverrides)
 	}
 
 	log.WithField("manifestPath", pathToManifest).Debug("path to manifest")
-	rawManifest, err := cmd.ManifestParser.InterpolateManifest(pathToManifest, flagOverrides.PathsToVarsFiles, flagOverrides.Vars)
+	rawManifest, err := cmd.ManifestParser.InterpolateManifest(pathToManifest, flagOverrides.PathsToVarsFiles, flagOverrides.Vars, flagOverrides.PathsToOpsFiles)
 	if err != nil {
 		log.Errorln("reading manifest:", err)
 		if _, ok := err.(*yaml.TypeError); ok {
@@ -307,6 +553,11 @@ func (cmd PushCommand) GetBaseManifest(flagOverrides v7pushaction.FlagOverrides)
 		return manifestparser.Manifest{}, err
 	}
 
+	err = cmd.validateManifest(pathToManifest, rawManifest)
+	if err != nil {
+		return manifestparser.Manifest{}, err
+	}
+
 	manifest, err := cmd.ManifestParser.ParseManifest(pathToManifest, rawManifest)
 	if err != nil {
 		log.Errorln("parsing manifest:", err)
@@ -316,6 +567,25 @@ func (cmd PushCommand) GetBaseManifest(flagOverrides v7pushaction.FlagOverrides)
 	return manifest, nil
 }
 
+// validateManifest displays the problems found in the interpolated manifest,
+// after the vars and ops files are applied. Unknown fields are ignored by the
+// API, so they only fail validate-manifest; push warns about them and
+// continues.
+func (cmd PushCommand) validateManifest(pathToManifest string, rawManifest []byte) error {
+	validationErrors, err := cmd.ManifestParser.ValidateRawManifest(rawManifest)
+	if err != nil {
+		return err
+	}
+
+	shared.DisplayInterpolatedManifestValidationErrors(cmd.UI, pathToManifest, validationErrors)
+	for _, validationError := range validationErrors {
+		if !validationError.UnknownField {
+			return translatableerror.ManifestValidationFailedError{PathToManifest: pathToManifest}
+		}
+	}
+	return nil
+}
+
 func (cmd PushCommand) GetDockerPassword(dockerUsername string, containsPrivateDockerImages bool) (string, error) {
 	if dockerUsername == "" && !containsPrivateDockerImages { // no need for a password without a username
 		return "", nil
@@ -336,33 +606,49 @@ func (cmd PushCommand) GetFlagOverrides() (v7pushaction.FlagOverrides, error) {
 		pathsToVarsFiles = append(pathsToVarsFiles, string(varFilePath))
 	}
 
+	var pathsToOpsFiles []string
+	for _, opsFilePath := range cmd.PathsToOpsFiles {
+		pathsToOpsFiles = append(pathsToOpsFiles, string(opsFilePath))
+	}
+
+	var autoPromoteMaxCrashes int
+	if cmd.AutoPromoteMaxCrashes != nil {
+		autoPromoteMaxCrashes = *cmd.AutoPromoteMaxCrashes
+	}
+
 	return v7pushaction.FlagOverrides{
-		AppName:             cmd.OptionalArgs.AppName,
-		Buildpacks:          cmd.Buildpacks,
-		Stack:               cmd.Stack,
-		Disk:                cmd.Disk,
-		DropletPath:         string(cmd.DropletPath),
-		DockerImage:         cmd.DockerImage.Path,
-		DockerUsername:      cmd.DockerUsername,
-		HealthCheckEndpoint: cmd.HealthCheckHTTPEndpoint,
-		HealthCheckType:     cmd.HealthCheckType.Type,
-		HealthCheckTimeout:  cmd.HealthCheckTimeout.Value,
-		Instances:           cmd.Instances.NullInt,
-		MaxInFlight:         cmd.MaxInFlight,
-		Memory:              cmd.Memory,
-		NoStart:             cmd.NoStart,
-		NoWait:              cmd.NoWait,
-		ProvidedAppPath:     string(cmd.AppPath),
-		NoRoute:             cmd.NoRoute,
-		RandomRoute:         cmd.RandomRoute,
-		StartCommand:        cmd.StartCommand.FilteredString,
-		Strategy:            cmd.Strategy.Name,
-		ManifestPath:        string(cmd.PathToManifest),
-		PathsToVarsFiles:    pathsToVarsFiles,
-		Vars:                cmd.Vars,
-		NoManifest:          cmd.NoManifest,
-		Task:                cmd.Task,
-		LogRateLimit:        cmd.LogRateLimit,
+		AppName:                 cmd.OptionalArgs.AppName,
+		Buildpacks:              cmd.Buildpacks,
+		Stack:                   cmd.Stack,
+		Disk:                    cmd.Disk,
+		DropletPath:             string(cmd.DropletPath),
+		DockerImage:             cmd.DockerImage.Path,
+		DockerUsername:          cmd.DockerUsername,
+		HealthCheckEndpoint:     cmd.HealthCheckHTTPEndpoint,
+		HealthCheckType:         cmd.HealthCheckType.Type,
+		HealthCheckTimeout:      cmd.HealthCheckTimeout.Value,
+		Instances:               cmd.Instances.NullInt,
+		MaxInFlight:             cmd.MaxInFlight,
+		InstanceSteps:           cmd.InstanceSteps.Weights,
+		AutoPromoteAfter:        cmd.AutoPromoteAfter.Duration,
+		AutoPromoteMaxCrashes:   autoPromoteMaxCrashes,
+		AutoPromoteMaxErrorRate: cmd.AutoPromoteMaxErrorRate,
+		Memory:                  cmd.Memory,
+		NoStart:                 cmd.NoStart,
+		NoWait:                  cmd.NoWait,
+		ProvidedAppPath:         string(cmd.AppPath),
+		NoRoute:                 cmd.NoRoute,
+		RandomRoute:             cmd.RandomRoute,
+		StartCommand:            cmd.StartCommand.FilteredString,
+		Strategy:                cmd.Strategy.Name,
+		ManifestPath:            string(cmd.PathToManifest),
+		PathsToVarsFiles:        pathsToVarsFiles,
+		PathsToOpsFiles:         pathsToOpsFiles,
+		Vars:                    cmd.Vars,
+		NoManifest:              cmd.NoManifest,
+		Task:                    cmd.Task,
+		LogRateLimit:            cmd.LogRateLimit,
+		Watch:                   cmd.Watch,
 	}, nil
 }
 
@@ -422,6 +708,14 @@ func (cmd PushCommand) ValidateFlags() error {
 			},
 		}
 
+	case cmd.NoManifest && len(cmd.PathsToOpsFiles) > 0:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--no-manifest",
+				"--ops-file",
+			},
+		}
+
 	case cmd.HealthCheckType.Type == constant.HTTP && cmd.HealthCheckHTTPEndpoint == "":
 		return translatableerror.RequiredFlagsError{
 			Arg1: "--endpoint",
@@ -488,10 +782,60 @@ func (cmd PushCommand) ValidateFlags() error {
 	case !cmd.validBuildpacks():
 		return translatableerror.InvalidBuildpacksError{}
 
-	case cmd.Strategy.Name == constant.DeploymentStrategyDefault && cmd.MaxInFlight != nil:
-		return translatableerror.RequiredFlagsError{Arg1: "--max-in-flight", Arg2: "--strategy"}
-	case cmd.Strategy.Name != constant.DeploymentStrategyDefault && cmd.MaxInFlight != nil && *cmd.MaxInFlight < 1:
+	case cmd.MaxInFlight != nil && *cmd.MaxInFlight < 1:
 		return translatableerror.IncorrectUsageError{Message: "--max-in-flight must be greater than or equal to 1"}
+	case cmd.AutoPromoteAfter.Duration != 0 && cmd.NoWait:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--auto-promote-after",
+				"--no-wait",
+			},
+		}
+	case cmd.AutoPromoteAfter.Duration == 0 && cmd.AutoPromoteMaxCrashes != nil:
+		return translatableerror.RequiredFlagsError{Arg1: "--auto-promote-max-crashes", Arg2: "--auto-promote-after"}
+	case cmd.AutoPromoteAfter.Duration == 0 && cmd.AutoPromoteMaxErrorRate != nil:
+		return translatableerror.RequiredFlagsError{Arg1: "--auto-promote-max-error-rate", Arg2: "--auto-promote-after"}
+	case cmd.AutoPromoteMaxCrashes != nil && *cmd.AutoPromoteMaxCrashes < 0:
+		return translatableerror.IncorrectUsageError{Message: "--auto-promote-max-crashes must be greater than or equal to 0"}
+	case cmd.AutoPromoteMaxErrorRate != nil && (*cmd.AutoPromoteMaxErrorRate < 0 || *cmd.AutoPromoteMaxErrorRate > 100):
+		return translatableerror.IncorrectUsageError{Message: "--auto-promote-max-error-rate must be between 0 and 100"}
+	case cmd.WatchSync && !cmd.Watch:
+		return translatableerror.RequiredFlagsError{Arg1: "--watch-sync", Arg2: "--watch"}
+	case cmd.Watch && cmd.DockerImage.Path != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--watch",
+				"--docker-image, -o",
+			},
+		}
+	case cmd.Watch && cmd.DropletPath != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--watch",
+				"--droplet",
+			},
+		}
+	case cmd.Watch && cmd.DryRun:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--watch",
+				"--dry-run",
+			},
+		}
+	case cmd.Watch && cmd.NoStart:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--watch",
+				"--no-start",
+			},
+		}
+	case cmd.Watch && cmd.Parallel.Value > 1:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--watch",
+				"--parallel",
+			},
+		}
 	}
 
 	return nil
@@ -526,6 +870,12 @@ func (cmd PushCommand) mapErr(appName string, err error) error {
 			AppName:    appName,
 			BinaryName: cmd.Config.BinaryName(),
 		}
+	case actionerror.CanaryUnhealthyError:
+		return translatableerror.CanaryDeploymentCanceledError{
+			AppName:    appName,
+			Reason:     err.(actionerror.CanaryUnhealthyError).Reason,
+			BinaryName: cmd.Config.BinaryName(),
+		}
 	}
 	return err
 }
@@ -622,7 +972,9 @@ func (cmd *PushCommand) processEvent(event v7pushaction.Event, appName string) e
 			cmd.stopStreamingFunc()
 		}
 		cmd.stopStreamingFunc = cancelFunc
-		go cmd.getLogs(logStream, errStream)
+		go cmd.getLogs(logStream, errStream, func(logMessage sharedaction.LogMessage) {
+			cmd.UI.DisplayLogMessage(logMessage, false)
+		})
 	case v7pushaction.StagingComplete:
 		if cmd.stopStreamingFunc != nil {
 			cmd.stopStreamingFunc()
@@ -648,6 +1000,14 @@ func (cmd *PushCommand) processEvent(event v7pushaction.Event, appName string) e
 	case v7pushaction.WaitingForDeployment:
 		cmd.UI.DisplayText("Waiting for app to deploy...")
 		cmd.UI.DisplayNewline()
+	case v7pushaction.EvaluatingCanary:
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Evaluating canary health before continuing the deployment...")
+	case v7pushaction.PromotingCanary:
+		cmd.UI.DisplayText("Canary is healthy; continuing deployment...")
+		cmd.UI.DisplayNewline()
+	case v7pushaction.CancelingCanary:
+		cmd.UI.DisplayText("Canary is unhealthy; canceling deployment...")
 	default:
 		log.WithField("event", event).Debug("ignoring event")
 	}
@@ -655,7 +1015,166 @@ func (cmd *PushCommand) processEvent(event v7pushaction.Event, appName string) e
 	return nil
 }
 
-func (cmd PushCommand) getLogs(logStream <-chan sharedaction.LogMessage, errStream <-chan error) {
+// actualizeAndWatch pushes the app and then pushes it again every time the
+// files in its bits path change, until Ctrl-C is pressed while waiting for
+// changes or watching the files fails. Push failures are displayed so that
+// the next change can fix them.
+func (cmd *PushCommand) actualizeAndWatch(plan v7pushaction.PushPlan) error {
+	if plan.Archive {
+		return translatableerror.IncorrectUsageError{Message: "--watch requires the app path to be a directory"}
+	}
+
+	snapshot, err := cmd.WatchActor.SnapshotDirectory(plan.BitsPath)
+	if err != nil {
+		return err
+	}
+
+	log.WithField("app_name", plan.Application.Name).Info("actualizing")
+	cmd.displayWatchedPushResult(plan, cmd.eventStreamHandler(cmd.PushActor.Actualize(plan, cmd.ProgressBar)))
+
+	for {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Watching {{.Path}} for changes... Press Ctrl-C to stop.", map[string]interface{}{
+			"Path": plan.BitsPath,
+		})
+
+		var changes sharedaction.DirectoryChanges
+		snapshot, changes, err = cmd.waitForDirectoryChanges(plan.BitsPath, snapshot)
+		if err != nil {
+			return err
+		}
+		if changes.Empty() {
+			return nil
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Detected {{.Changed}} changed and {{.Removed}} removed files.", map[string]interface{}{
+			"Changed": len(changes.Changed),
+			"Removed": len(changes.Removed),
+		})
+
+		if cmd.WatchSync {
+			syncErr := cmd.syncWatchedApp(plan, changes)
+			if syncErr == nil {
+				cmd.UI.DisplayOK()
+				continue
+			}
+			cmd.UI.DisplayWarning("Unable to sync files over SSH: {{.Error}}", map[string]interface{}{
+				"Error": cmd.errorMessage(syncErr),
+			})
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Pushing app {{.AppName}} again...", map[string]interface{}{
+			"AppName": plan.Application.Name,
+		})
+		log.WithField("app_name", plan.Application.Name).Info("repushing")
+		cmd.displayWatchedPushResult(plan, cmd.eventStreamHandler(cmd.PushActor.RepushApplicationSource(plan, cmd.ProgressBar)))
+	}
+}
+
+// waitForDirectoryChanges waits for the files in sourceDir to change. It
+// returns no changes when Ctrl-C is pressed first, so that push can save its
+// cache before exiting.
+func (cmd *PushCommand) waitForDirectoryChanges(sourceDir string, snapshot sharedaction.DirectorySnapshot) (sharedaction.DirectorySnapshot, sharedaction.DirectoryChanges, error) {
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-interrupt:
+			close(stop)
+		case <-done:
+		}
+	}()
+
+	return cmd.WatchActor.WaitForDirectoryChanges(sourceDir, snapshot, watchPollInterval, watchDebounce, stop)
+}
+
+func (cmd *PushCommand) displayWatchedPushResult(plan v7pushaction.PushPlan, err error) {
+	if cmd.stopStreamingFunc != nil {
+		cmd.stopStreamingFunc()
+		cmd.stopStreamingFunc = nil
+	}
+
+	if cmd.shouldDisplaySummary(err) {
+		summaryErr := cmd.displayAppSummary(plan)
+		if summaryErr != nil && err == nil {
+			err = summaryErr
+		}
+	}
+	if err != nil {
+		cmd.UI.DisplayWarning("Push failed: {{.Error}}", map[string]interface{}{
+			"Error": cmd.errorMessage(cmd.mapErr(plan.Application.Name, err)),
+		})
+	}
+}
+
+// syncWatchedApp copies the changed files to every running instance of the
+// app's web process and removes the removed files from them.
+func (cmd PushCommand) syncWatchedApp(plan v7pushaction.PushPlan, changes sharedaction.DirectoryChanges) error {
+	instanceAuths, warnings, err := cmd.Actor.GetSecureShellConfigurationsForAllInstances(
+		plan.Application.Name,
+		cmd.Config.TargetedSpace().GUID,
+		constant.ProcessTypeWeb,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	synced := 0
+	for _, instanceAuth := range instanceAuths {
+		if instanceAuth.Username == "" {
+			continue
+		}
+
+		cmd.UI.DisplayText("Syncing files to instance {{.Index}}...", map[string]interface{}{
+			"Index": instanceAuth.Index,
+		})
+		passcode, err := cmd.Actor.GetSSHPasscode()
+		if err != nil {
+			return err
+		}
+		err = cmd.WatchActor.ExecuteSecureSync(cmd.NewSSHClient(), sharedaction.SecureSyncOptions{
+			Username:           instanceAuth.Username,
+			Passcode:           passcode,
+			Endpoint:           instanceAuth.Endpoint,
+			HostKeyFingerprint: instanceAuth.HostKeyFingerprint,
+			LocalDir:           plan.BitsPath,
+			RemoteDir:          watchSyncRemoteDir,
+			Changed:            changes.Changed,
+			Removed:            changes.Removed,
+		})
+		if err != nil {
+			return err
+		}
+		synced++
+	}
+
+	if synced == 0 {
+		return errors.New("no instances are running")
+	}
+	return nil
+}
+
+// savePushCache writes the checksums and resource matches of this push to
+// disk. Failing to do so only makes the next push slower.
+func (cmd PushCommand) savePushCache() {
+	err := cmd.PushCache.Save()
+	if err != nil {
+		cmd.UI.DisplayWarning("Unable to save push cache: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		})
+	}
+}
+
+// getLogs displays the staging logs in logStream with displayLogMessage and
+// the errors in errStream as warnings, until either stream is closed.
+func (cmd PushCommand) getLogs(logStream <-chan sharedaction.LogMessage, errStream <-chan error, displayLogMessage func(sharedaction.LogMessage)) {
 	for {
 		select {
 		case logMessage, open := <-logStream:
@@ -663,7 +1182,7 @@ func (cmd PushCommand) getLogs(logStream <-chan sharedaction.LogMessage, errStre
 				return
 			}
 			if logMessage.Staging() {
-				cmd.UI.DisplayLogMessage(logMessage, false)
+				displayLogMessage(logMessage)
 			}
 		case err, open := <-errStream:
 			if !open {
@@ -677,3 +1196,205 @@ func (cmd PushCommand) getLogs(logStream <-chan sharedaction.LogMessage, errStre
 		}
 	}
 }
+
+// parallelEventMessages are the lines displayed for push events when apps are
+// pushed in parallel. Each line is prefixed with the name of its app so that
+// the interleaved output of the apps can be told apart.
+var parallelEventMessages = map[v7pushaction.Event]string{
+	v7pushaction.CreatingArchive:                 "Packaging files to upload...",
+	v7pushaction.UploadingApplicationWithArchive: "Uploading files...",
+	v7pushaction.UploadingApplication:            "All files found in remote cache; nothing to upload.",
+	v7pushaction.RetryUpload:                     "Retrying upload due to an error...",
+	v7pushaction.UploadWithArchiveComplete:       "Waiting for API to complete processing files...",
+	v7pushaction.UploadingDroplet:                "Uploading droplet bits...",
+	v7pushaction.UploadDropletComplete:           "Waiting for API to complete processing files...",
+	v7pushaction.StoppingApplication:             "Stopping Application...",
+	v7pushaction.StoppingApplicationComplete:     "Application Stopped",
+	v7pushaction.ApplyManifest:                   "Applying manifest...",
+	v7pushaction.ApplyManifestComplete:           "Manifest applied",
+	v7pushaction.StartingStaging:                 "Staging app...",
+	v7pushaction.StagingComplete:                 "Staging complete",
+	v7pushaction.RestartingApplication:           "Waiting for app to start...",
+	v7pushaction.StartingDeployment:              "Starting deployment...",
+	v7pushaction.WaitingForDeployment:            "Waiting for app to deploy...",
+	v7pushaction.EvaluatingCanary:                "Evaluating canary health before continuing the deployment...",
+	v7pushaction.PromotingCanary:                 "Canary is healthy; continuing deployment...",
+	v7pushaction.CancelingCanary:                 "Canary is unhealthy; canceling deployment...",
+}
+
+func (cmd PushCommand) actualizeInParallel(pushPlans []v7pushaction.PushPlan) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Pushing {{.Count}} apps, up to {{.Parallel}} at a time...", map[string]interface{}{
+		"Count":    len(pushPlans),
+		"Parallel": cmd.Parallel.Value,
+	})
+
+	errs := make([]error, len(pushPlans))
+	slots := make(chan struct{}, cmd.Parallel.Value)
+	var wg sync.WaitGroup
+	for i, plan := range pushPlans {
+		wg.Add(1)
+		go func(i int, plan v7pushaction.PushPlan) {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			log.WithField("app_name", plan.Application.Name).Info("actualizing")
+			progressBar := parallelProgressBar{UI: cmd.UI, AppName: plan.Application.Name}
+			errs[i] = cmd.parallelEventStreamHandler(plan.Application.Name, cmd.PushActor.Actualize(plan, progressBar))
+		}(i, plan)
+	}
+	wg.Wait()
+
+	var failedApps []string
+	table := [][]string{{
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("status"),
+		cmd.UI.TranslateText("details"),
+	}}
+	for i, plan := range pushPlans {
+		appName := plan.Application.Name
+		err := errs[i]
+
+		if cmd.shouldDisplaySummary(err) {
+			summaryErr := cmd.displayAppSummary(plan)
+			if summaryErr != nil && err == nil {
+				err = summaryErr
+			}
+		}
+
+		if err == nil {
+			table = append(table, []string{appName, cmd.UI.TranslateText("pushed"), ""})
+			continue
+		}
+		failedApps = append(failedApps, appName)
+		table = append(table, []string{appName, cmd.UI.TranslateText("failed"), cmd.errorMessage(cmd.mapErr(appName, err))})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	if len(failedApps) > 0 {
+		return translatableerror.ParallelPushFailedError{AppNames: failedApps}
+	}
+	return nil
+}
+
+// parallelEventStreamHandler displays the events of one app pushed in
+// parallel with others. Its staging logs are streamed with every line
+// prefixed with the app name, so that the logs of several apps can be told
+// apart.
+func (cmd PushCommand) parallelEventStreamHandler(appName string, eventStream <-chan *v7pushaction.PushEvent) error {
+	var stopStreaming func()
+	defer func() {
+		if stopStreaming != nil {
+			stopStreaming()
+		}
+	}()
+
+	for event := range eventStream {
+		cmd.displayParallelWarnings(appName, event.Warnings)
+		if event.Err != nil {
+			return event.Err
+		}
+
+		switch event.Event {
+		case v7pushaction.StartingStaging:
+			logStream, errStream, cancelFunc, warnings, err := cmd.VersionActor.GetStreamingLogsForApplicationByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID, cmd.LogCacheClient)
+			cmd.displayParallelWarnings(appName, warnings)
+			if err != nil {
+				return err
+			}
+			if stopStreaming != nil {
+				stopStreaming()
+			}
+			stopStreaming = cancelFunc
+			go cmd.getLogs(logStream, errStream, func(logMessage sharedaction.LogMessage) {
+				cmd.UI.DisplayAppLogMessage(appName, logMessage, false)
+			})
+		case v7pushaction.StagingComplete:
+			if stopStreaming != nil {
+				stopStreaming()
+				stopStreaming = nil
+			}
+		}
+
+		message, ok := parallelEventMessages[event.Event]
+		if !ok {
+			log.WithField("event", event.Event).Debug("ignoring event")
+			continue
+		}
+		cmd.UI.DisplayText("{{.AppName}}: {{.Message}}", map[string]interface{}{
+			"AppName": appName,
+			"Message": cmd.UI.TranslateText(message),
+		})
+	}
+	return nil
+}
+
+func (cmd PushCommand) displayParallelWarnings(appName string, warnings []string) {
+	for _, warning := range warnings {
+		cmd.UI.DisplayWarning("{{.AppName}}: {{.Warning}}", map[string]interface{}{
+			"AppName": appName,
+			"Warning": warning,
+		})
+	}
+}
+
+func (cmd PushCommand) errorMessage(err error) string {
+	translatable, ok := translatableerror.ConvertToTranslatableError(err).(translatableerror.TranslatableError)
+	if !ok {
+		return err.Error()
+	}
+	return translatable.Translate(func(template string, values ...interface{}) string {
+		var templateValues []map[string]interface{}
+		for _, value := range values {
+			if valueMap, isMap := value.(map[string]interface{}); isMap {
+				templateValues = append(templateValues, valueMap)
+			}
+		}
+		return cmd.UI.TranslateText(template, templateValues...)
+	})
+}
+
+// parallelProgressBar reports the upload progress of an app pushed in
+// parallel with others as percentage lines instead of a progress bar, which
+// cannot be shared between concurrent uploads.
+type parallelProgressBar struct {
+	UI      command.UI
+	AppName string
+}
+
+func (bar parallelProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader {
+	return &parallelProgressReader{reader: reader, size: sizeOfFile, bar: bar}
+}
+
+type parallelProgressReader struct {
+	reader   io.Reader
+	size     int64
+	read     int64
+	reported int64
+	bar      parallelProgressBar
+}
+
+func (r *parallelProgressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+
+	if r.size > 0 {
+		// Report every full quarter of the upload once.
+		quarter := r.read * 4 / r.size
+		if quarter > 4 {
+			quarter = 4
+		}
+		for r.reported < quarter {
+			r.reported++
+			r.bar.UI.DisplayText("{{.AppName}}: Uploaded {{.Percent}}%", map[string]interface{}{
+				"AppName": r.bar.AppName,
+				"Percent": r.reported * 25,
+			})
+		}
+	}
+
+	return n, err
+}
@@ -2,7 +2,11 @@ package v7_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"io"
+	"strings"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -210,6 +214,31 @@ var _ = Describe("push Command", func() {
 				})
 			})
 
+			When("a push cache is set", func() {
+				var fakePushCache *v7fakes.FakePushCache
+
+				BeforeEach(func() {
+					fakePushCache = new(v7fakes.FakePushCache)
+					cmd.PushCache = fakePushCache
+					fakeManifestLocator.PathReturns("", false, errors.New("locate-error"))
+				})
+
+				It("saves it even when the push fails", func() {
+					Expect(executeErr).To(MatchError("locate-error"))
+					Expect(fakePushCache.SaveCallCount()).To(Equal(1))
+				})
+
+				When("saving it fails", func() {
+					BeforeEach(func() {
+						fakePushCache.SaveReturns(errors.New("disk full"))
+					})
+
+					It("warns about it", func() {
+						Expect(testUI.Err).To(Say("Unable to save push cache: disk full"))
+					})
+				})
+			})
+
 			When("the flags are all valid", func() {
 				It("delegating to the GetBaseManifest", func() {
 					// This tells us GetBaseManifest is being called because we dont have a fake
@@ -255,6 +284,31 @@ var _ = Describe("push Command", func() {
 						Expect(actualFlagOverrides).To(Equal(v7pushaction.FlagOverrides{}))
 					})
 
+					When("the manifest sets the canary strategy and --instance-steps is given", func() {
+						BeforeEach(func() {
+							cmd.InstanceSteps = flag.InstanceSteps{Weights: []int64{10, 50, 100}}
+							fakeManifestParser.ParseManifestReturns(
+								manifestparser.Manifest{
+									Applications: []manifestparser.Application{
+										{
+											Name:     "some-app-name",
+											Strategy: constant.DeploymentStrategyCanary,
+										},
+									},
+								},
+								nil,
+							)
+						})
+
+						It("passes the instance steps on to the push actor", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(fakeActor.HandleFlagOverridesCallCount()).To(Equal(1))
+							_, actualFlagOverrides := fakeActor.HandleFlagOverridesArgsForCall(0)
+							Expect(actualFlagOverrides.InstanceSteps).To(Equal([]int64{10, 50, 100}))
+							Expect(actualFlagOverrides.Strategy).To(Equal(constant.DeploymentStrategyDefault))
+						})
+					})
+
 					When("handling the flag overrides fails", func() {
 						BeforeEach(func() {
 							fakeActor.HandleFlagOverridesReturns(manifestparser.Manifest{}, errors.New("override-handler-error"))
@@ -331,6 +385,207 @@ var _ = Describe("push Command", func() {
 								Expect(actualManifestBytes).To(Equal([]byte("our-manifest")))
 							})
 
+							When("--dry-run is passed", func() {
+								var expectedDiff resources.ManifestDiff
+
+								BeforeEach(func() {
+									cmd.DryRun = true
+
+									fakeActor.HandleFlagOverridesReturns(
+										manifestparser.Manifest{
+											PathToManifest: "path/to/manifest",
+											Applications: []manifestparser.Application{
+												{Name: "some-app-name"},
+											},
+										},
+										nil,
+									)
+									expectedDiff = resources.ManifestDiff{
+										Diffs: []resources.Diff{
+											{Op: resources.ReplaceOperation, Path: "/applications/0/env/SECRET", Was: "old", Value: "new"},
+										},
+									}
+									fakeDiffActor.DiffSpaceManifestReturns(expectedDiff, v7action.Warnings{"diff-warning"}, nil)
+
+									fakeActor.CreateDryRunPushPlansReturns(
+										[]v7pushaction.PushPlan{{Application: resources.Application{Name: "some-app-name", GUID: "some-app-guid"}}},
+										v7action.Warnings{"plan-warning"},
+										nil,
+									)
+									fakeActor.CreateDryRunPlanReturns(
+										v7pushaction.DryRunPlan{
+											AppName:  "some-app-name",
+											Source:   v7pushaction.AppSourceBits,
+											Files:    &v7pushaction.DryRunFiles{Total: 3, Matched: 1, ToUpload: 2, UploadBytes: 2048},
+											Routes:   []v7pushaction.DryRunRoute{{URL: "some-app.example.com", Change: v7pushaction.RouteCreateAndMap}},
+											Strategy: constant.DeploymentStrategyRolling,
+											Stage:    true,
+											Start:    true,
+										},
+										v7action.Warnings{"dry-run-warning"},
+										nil,
+									)
+								})
+
+								It("displays the plan without making any changes", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+
+									Expect(testUI.Out).To(Say(`Planning push of app some-app-name to org some-org / space some-space as some-user\.\.\.`))
+									Expect(testUI.Out).To(Say(`Dry run: no changes will be made\.`))
+									Expect(testUI.Out).To(Say(`Manifest file path/to/manifest would be applied with these attributes\.\.\.`))
+									Expect(testUI.Out).To(Say(`App some-app-name would be updated:`))
+									Expect(testUI.Out).To(Say(`source:\s+3 files: 1 found in remote cache, 2 to upload \(2K\)`))
+									Expect(testUI.Out).To(Say(`routes:\s+some-app\.example\.com \(create and map\)`))
+									Expect(testUI.Out).To(Say(`deployment strategy:\s+rolling`))
+									Expect(testUI.Out).To(Say(`staging:\s+yes`))
+									Expect(testUI.Out).To(Say(`start:\s+yes`))
+									Expect(testUI.Out).To(Say(`Dry run complete; no changes were made\.`))
+									Expect(testUI.Err).To(Say("diff-warning"))
+									Expect(testUI.Err).To(Say("plan-warning"))
+									Expect(testUI.Err).To(Say("dry-run-warning"))
+
+									Expect(fakeDiffDisplayer.DisplayDiffCallCount()).To(Equal(1))
+									_, diff := fakeDiffDisplayer.DisplayDiffArgsForCall(0)
+									Expect(diff).To(Equal(expectedDiff))
+
+									Expect(fakeActor.CreateDryRunPlanCallCount()).To(Equal(1))
+									plan, manifestApp := fakeActor.CreateDryRunPlanArgsForCall(0)
+									Expect(plan.Application.GUID).To(Equal("some-app-guid"))
+									Expect(manifestApp.Name).To(Equal("some-app-name"))
+
+									Expect(fakeActor.CreateDryRunPushPlansCallCount()).To(Equal(1))
+									Expect(fakeActor.CreatePushPlansCallCount()).To(Equal(0))
+									Expect(fakeVersionActor.SetSpaceManifestCallCount()).To(Equal(0))
+									Expect(fakeActor.ActualizeCallCount()).To(Equal(0))
+								})
+
+								When("the app does not exist yet", func() {
+									BeforeEach(func() {
+										fakeActor.CreatePushPlansReturns(nil, nil, actionerror.ApplicationsNotFoundError{})
+										fakeActor.CreateDryRunPushPlansReturns(
+											[]v7pushaction.PushPlan{{Application: resources.Application{Name: "some-app-name"}}},
+											v7action.Warnings{"plan-warning"},
+											nil,
+										)
+										fakeActor.CreateDryRunPlanReturns(
+											v7pushaction.DryRunPlan{
+												AppName:   "some-app-name",
+												CreateApp: true,
+												Source:    v7pushaction.AppSourceBits,
+												Files:     &v7pushaction.DryRunFiles{},
+											},
+											nil,
+											nil,
+										)
+									})
+
+									It("displays that the app would be created", func() {
+										Expect(executeErr).ToNot(HaveOccurred())
+										Expect(testUI.Out).To(Say(`App some-app-name would be created:`))
+										Expect(testUI.Out).To(Say(`Dry run complete; no changes were made\.`))
+
+										Expect(fakeActor.CreateDryRunPlanCallCount()).To(Equal(1))
+										plan, _ := fakeActor.CreateDryRunPlanArgsForCall(0)
+										Expect(plan.Application.GUID).To(BeEmpty())
+										Expect(fakeVersionActor.SetSpaceManifestCallCount()).To(Equal(0))
+									})
+								})
+
+								When("creating the push plans fails", func() {
+									BeforeEach(func() {
+										fakeActor.CreateDryRunPushPlansReturns(nil, v7action.Warnings{"plan-warning"}, errors.New("plan-error"))
+									})
+
+									It("returns the error", func() {
+										Expect(executeErr).To(MatchError("plan-error"))
+										Expect(testUI.Err).To(Say("plan-warning"))
+										Expect(fakeActor.CreateDryRunPlanCallCount()).To(Equal(0))
+									})
+								})
+
+								When("there is a push cache", func() {
+									var fakePushCache *v7fakes.FakePushCache
+
+									BeforeEach(func() {
+										fakePushCache = new(v7fakes.FakePushCache)
+										cmd.PushCache = fakePushCache
+									})
+
+									It("does not save it", func() {
+										Expect(executeErr).ToNot(HaveOccurred())
+										Expect(fakePushCache.SaveCallCount()).To(Equal(0))
+									})
+								})
+
+								When("the output format is json", func() {
+									BeforeEach(func() {
+										testUI.SetOutputFormat(configv3.OutputFormatJSON)
+									})
+
+									It("displays the plan as text on stderr and the report as json", func() {
+										Expect(executeErr).ToNot(HaveOccurred())
+										testUI.FlushDeferred()
+
+										Expect(testUI.Err).To(Say(`App some-app-name would be updated:`))
+										Expect(testUI.Err).To(Say(`Dry run complete; no changes were made\.`))
+
+										var report map[string]interface{}
+										Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &report)).To(Succeed())
+										Expect(report).To(HaveKey("manifest_diff"))
+										Expect(report["applications"]).To(HaveLen(1))
+									})
+
+									When("--redact-env is passed", func() {
+										BeforeEach(func() {
+											cmd.RedactEnv = true
+										})
+
+										It("displays the redacted diff and the plan as JSON", func() {
+											Expect(executeErr).ToNot(HaveOccurred())
+											testUI.FlushDeferred()
+
+											output := string(testUI.Out.(*Buffer).Contents())
+											Expect(output).NotTo(ContainSubstring("Planning push"))
+											Expect(output).To(ContainSubstring(`"path": "/applications/0/env/SECRET"`))
+											Expect(output).To(ContainSubstring(`"was": "<redacted>"`))
+											Expect(output).To(ContainSubstring(`"name": "some-app-name"`))
+											Expect(output).To(ContainSubstring(`"to_upload": 2`))
+											Expect(output).To(ContainSubstring(`"change": "create and map"`))
+											Expect(output).To(ContainSubstring(`"strategy": "rolling"`))
+
+											Expect(fakeVersionActor.SetSpaceManifestCallCount()).To(Equal(0))
+											Expect(fakeActor.ActualizeCallCount()).To(Equal(0))
+										})
+									})
+
+									When("values were resolved from variable sources", func() {
+										BeforeEach(func() {
+											fakeManifestParser.ResolvedSecretsReturns([]string{"new"})
+										})
+
+										It("redacts them from the diff", func() {
+											Expect(executeErr).ToNot(HaveOccurred())
+											testUI.FlushDeferred()
+
+											output := string(testUI.Out.(*Buffer).Contents())
+											Expect(output).To(ContainSubstring(`"was": "old"`))
+											Expect(output).To(ContainSubstring(`"value": "<redacted>"`))
+										})
+									})
+								})
+
+								When("creating the dry run plan fails", func() {
+									BeforeEach(func() {
+										fakeActor.CreateDryRunPlanReturns(v7pushaction.DryRunPlan{}, v7action.Warnings{"dry-run-warning"}, errors.New("dry-run-error"))
+									})
+
+									It("returns the error", func() {
+										Expect(executeErr).To(MatchError("dry-run-error"))
+										Expect(testUI.Err).To(Say("dry-run-warning"))
+									})
+								})
+							})
+
 							When("the manifest is successfully parsed", func() {
 								var expectedDiff resources.ManifestDiff
 
@@ -512,6 +767,14 @@ var _ = Describe("push Command", func() {
 																Plan:  v7pushaction.PushPlan{Application: resources.Application{GUID: pushPlan.Application.GUID, Name: pushPlan.Application.Name}},
 																Event: v7pushaction.WaitingForDeployment,
 															},
+															{
+																Plan:  v7pushaction.PushPlan{Application: resources.Application{GUID: pushPlan.Application.GUID, Name: pushPlan.Application.Name}},
+																Event: v7pushaction.EvaluatingCanary,
+															},
+															{
+																Plan:  v7pushaction.PushPlan{Application: resources.Application{GUID: pushPlan.Application.GUID, Name: pushPlan.Application.Name}},
+																Event: v7pushaction.PromotingCanary,
+															},
 														})
 													}
 												})
@@ -549,6 +812,9 @@ var _ = Describe("push Command", func() {
 													Expect(testUI.Out).To(Say("Starting deployment for app second-app..."))
 
 													Expect(testUI.Out).To(Say("Waiting for app to deploy..."))
+
+													Expect(testUI.Out).To(Say("Evaluating canary health before continuing the deployment..."))
+													Expect(testUI.Out).To(Say("Canary is healthy; continuing deployment..."))
 												})
 											})
 
@@ -696,6 +962,28 @@ var _ = Describe("push Command", func() {
 												})
 											})
 
+											When("the error is a canary unhealthy error", func() {
+												BeforeEach(func() {
+													fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+														return FillInEvents([]Step{
+															{Plan: pushPlan, Event: v7pushaction.EvaluatingCanary},
+															{Plan: pushPlan, Event: v7pushaction.CancelingCanary},
+															{Error: actionerror.CanaryUnhealthyError{Reason: "too many crashes"}},
+														})
+													}
+												})
+
+												It("displays the evaluation and returns the CanaryDeploymentCanceledError", func() {
+													Expect(testUI.Out).To(Say("Evaluating canary health before continuing the deployment..."))
+													Expect(testUI.Out).To(Say("Canary is unhealthy; canceling deployment..."))
+													Expect(executeErr).To(MatchError(translatableerror.CanaryDeploymentCanceledError{
+														AppName:    "first-app",
+														Reason:     "too many crashes",
+														BinaryName: binaryName,
+													}))
+												})
+											})
+
 											When("the error is a process crashed error", func() {
 												BeforeEach(func() {
 													fakeActor.ActualizeStub = func(v7pushaction.PushPlan, v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
@@ -719,6 +1007,309 @@ var _ = Describe("push Command", func() {
 											})
 										})
 									})
+
+									When("--parallel is given", func() {
+										BeforeEach(func() {
+											cmd.Parallel = flag.PositiveInteger{Value: 2}
+											fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, progressBar v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+												_, err := io.ReadAll(progressBar.NewProgressBarWrapper(strings.NewReader("some-bits"), 9))
+												Expect(err).NotTo(HaveOccurred())
+												return FillInEvents([]Step{
+													{Plan: pushPlan, Event: v7pushaction.UploadingApplicationWithArchive, Warnings: v7pushaction.Warnings{"upload warning"}},
+													{Plan: pushPlan, Event: v7pushaction.StartingStaging},
+													{Plan: pushPlan, Event: v7pushaction.RestartingApplication},
+												})
+											}
+											fakeVersionActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
+												return ReturnLogs(
+													[]LogEvent{
+														{Log: sharedaction.NewLogMessage("staging log of "+appName, "OUT", time.Now(), sharedaction.StagingLog, "source-instance")},
+													},
+													v7action.Warnings{"log warning"},
+													nil,
+												)(appName, spaceGUID, client)
+											}
+										})
+
+										It("pushes the apps at the same time and displays their events prefixed with the app name", func() {
+											Expect(executeErr).NotTo(HaveOccurred())
+											Expect(fakeActor.ActualizeCallCount()).To(Equal(2))
+
+											Expect(testUI.Out).To(Say("Pushing 2 apps, up to 2 at a time..."))
+											out := string(testUI.Out.(*Buffer).Contents())
+											for _, appName := range []string{"first-app", "second-app"} {
+												Expect(out).To(ContainSubstring(appName + ": Uploading files..."))
+												Expect(out).To(ContainSubstring(appName + ": Uploaded 100%"))
+												Expect(out).To(ContainSubstring(appName + ": Staging app..."))
+												Expect(out).To(ContainSubstring(appName + ": Waiting for app to start..."))
+											}
+											Expect(testUI.Err).To(Say("upload warning"))
+											Expect(string(testUI.Err.(*Buffer).Contents())).To(ContainSubstring("second-app: upload warning"))
+
+											Expect(fakeProgressBar.ReadyCallCount()).To(Equal(0))
+										})
+
+										It("streams the staging logs of each app prefixed with the app name", func() {
+											Expect(fakeVersionActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(2))
+											_, spaceGUID, _ := fakeVersionActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+											Expect(spaceGUID).To(Equal("some-space-guid"))
+
+											Eventually(func() string { return string(testUI.Out.(*Buffer).Contents()) }).Should(And(
+												ContainSubstring("[first-app] staging log of first-app"),
+												ContainSubstring("[second-app] staging log of second-app"),
+											))
+											Expect(string(testUI.Err.(*Buffer).Contents())).To(ContainSubstring("first-app: log warning"))
+										})
+
+										It("displays the app summaries followed by a summary of the push", func() {
+											Expect(fakeVersionActor.GetDetailedAppSummaryCallCount()).To(Equal(2))
+											Expect(testUI.Out).To(Say(`name\s+status\s+details`))
+											Expect(testUI.Out).To(Say(`first-app\s+pushed`))
+											Expect(testUI.Out).To(Say(`second-app\s+pushed`))
+										})
+
+										When("one of the apps fails to push", func() {
+											BeforeEach(func() {
+												fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+													if pushPlan.Application.Name == "first-app" {
+														return FillInEvents([]Step{{Error: actionerror.StartupTimeoutError{}}})
+													}
+													return FillInEvents([]Step{{Plan: pushPlan, Event: v7pushaction.RestartingApplication}})
+												}
+											})
+
+											It("pushes the other apps and reports the failure", func() {
+												Expect(executeErr).To(MatchError(translatableerror.ParallelPushFailedError{AppNames: []string{"first-app"}}))
+												Expect(fakeVersionActor.GetDetailedAppSummaryCallCount()).To(Equal(1))
+												Expect(testUI.Out).To(Say(`first-app\s+failed\s+Start app timeout`))
+												Expect(testUI.Out).To(Say(`second-app\s+pushed`))
+											})
+										})
+
+										When("there are more apps than --parallel allows", func() {
+											var (
+												lock       sync.Mutex
+												running    int
+												maxRunning int
+											)
+
+											BeforeEach(func() {
+												running, maxRunning = 0, 0
+												fakeActor.CreatePushPlansReturns(
+													[]v7pushaction.PushPlan{
+														{Application: resources.Application{Name: "first-app"}},
+														{Application: resources.Application{Name: "second-app"}},
+														{Application: resources.Application{Name: "third-app"}},
+														{Application: resources.Application{Name: "fourth-app"}},
+													},
+													nil,
+													nil,
+												)
+												fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+													lock.Lock()
+													running++
+													if running > maxRunning {
+														maxRunning = running
+													}
+													lock.Unlock()
+
+													eventStream := make(chan *v7pushaction.PushEvent)
+													go func() {
+														time.Sleep(10 * time.Millisecond)
+														lock.Lock()
+														running--
+														lock.Unlock()
+														close(eventStream)
+													}()
+													return eventStream
+												}
+											})
+
+											It("pushes no more apps at a time than it allows", func() {
+												Expect(executeErr).NotTo(HaveOccurred())
+												Expect(fakeActor.ActualizeCallCount()).To(Equal(4))
+												Expect(maxRunning).To(Equal(2))
+											})
+										})
+									})
+
+									When("--watch is given", func() {
+										var (
+											fakeWatchActor *v7fakes.FakeWatchActor
+											fakeSSHClient  *sharedactionfakes.FakeSecureShellClient
+											watchErr       error
+										)
+
+										BeforeEach(func() {
+											cmd.Watch = true
+											fakeWatchActor = new(v7fakes.FakeWatchActor)
+											fakeSSHClient = new(sharedactionfakes.FakeSecureShellClient)
+											cmd.WatchActor = fakeWatchActor
+											cmd.NewSSHClient = func() sharedaction.SecureShellClient {
+												return fakeSSHClient
+											}
+
+											fakeActor.CreatePushPlansReturns(
+												[]v7pushaction.PushPlan{
+													{Application: resources.Application{Name: "first-app", GUID: "potato"}, BitsPath: "/some/app/dir"},
+												},
+												nil,
+												nil,
+											)
+											fakeActor.ActualizeReturns(FillInEvents([]Step{{Event: v7pushaction.RestartingApplication}}))
+											fakeActor.RepushApplicationSourceStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+												return FillInEvents([]Step{{Plan: pushPlan, Event: v7pushaction.StartingStaging}})
+											}
+											fakeVersionActor.GetStreamingLogsForApplicationByNameAndSpaceStub = ReturnLogs(nil, nil, nil)
+
+											fakeWatchActor.SnapshotDirectoryReturns(sharedaction.DirectorySnapshot{"index.js": {Size: 1}}, nil)
+											watchErr = errors.New("stop watching")
+											fakeWatchActor.WaitForDirectoryChangesReturnsOnCall(0,
+												sharedaction.DirectorySnapshot{"index.js": {Size: 2}},
+												sharedaction.DirectoryChanges{Changed: []string{"index.js"}, Removed: []string{"old.js"}},
+												nil,
+											)
+											fakeWatchActor.WaitForDirectoryChangesReturnsOnCall(1, nil, sharedaction.DirectoryChanges{}, watchErr)
+										})
+
+										It("pushes the app and pushes it again when its files change until watching fails", func() {
+											Expect(executeErr).To(MatchError(watchErr))
+
+											Expect(fakeWatchActor.SnapshotDirectoryCallCount()).To(Equal(1))
+											Expect(fakeWatchActor.SnapshotDirectoryArgsForCall(0)).To(Equal("/some/app/dir"))
+											Expect(fakeActor.ActualizeCallCount()).To(Equal(1))
+
+											Expect(fakeWatchActor.WaitForDirectoryChangesCallCount()).To(Equal(2))
+											sourceDir, snapshot, pollInterval, debounce, _ := fakeWatchActor.WaitForDirectoryChangesArgsForCall(0)
+											Expect(sourceDir).To(Equal("/some/app/dir"))
+											Expect(snapshot).To(Equal(sharedaction.DirectorySnapshot{"index.js": {Size: 1}}))
+											Expect(pollInterval).To(BeNumerically(">", 0))
+											Expect(debounce).To(BeNumerically(">=", pollInterval))
+											_, snapshot, _, _, _ = fakeWatchActor.WaitForDirectoryChangesArgsForCall(1)
+											Expect(snapshot).To(Equal(sharedaction.DirectorySnapshot{"index.js": {Size: 2}}))
+
+											Expect(fakeActor.RepushApplicationSourceCallCount()).To(Equal(1))
+											plan, _ := fakeActor.RepushApplicationSourceArgsForCall(0)
+											Expect(plan.Application.Name).To(Equal("first-app"))
+
+											Expect(testUI.Out).To(Say("Watching /some/app/dir for changes... Press Ctrl-C to stop."))
+											Expect(testUI.Out).To(Say("Detected 1 changed and 1 removed files."))
+											Expect(testUI.Out).To(Say("Pushing app first-app again..."))
+											Expect(testUI.Out).To(Say("Staging app and tracing logs..."))
+											Expect(testUI.Out).To(Say("Watching /some/app/dir for changes..."))
+											Expect(fakeVersionActor.GetDetailedAppSummaryCallCount()).To(Equal(2))
+											Expect(fakeWatchActor.ExecuteSecureSyncCallCount()).To(Equal(0))
+										})
+
+										When("a push fails", func() {
+											BeforeEach(func() {
+												fakeActor.ActualizeReturns(FillInEvents([]Step{{Error: errors.New("staging failed")}}))
+											})
+
+											It("displays the error and keeps watching", func() {
+												Expect(executeErr).To(MatchError(watchErr))
+												Expect(testUI.Err).To(Say("Push failed: staging failed"))
+												Expect(fakeActor.RepushApplicationSourceCallCount()).To(Equal(1))
+											})
+										})
+
+										When("Ctrl-C is pressed while waiting for changes", func() {
+											var fakePushCache *v7fakes.FakePushCache
+
+											BeforeEach(func() {
+												fakePushCache = new(v7fakes.FakePushCache)
+												cmd.PushCache = fakePushCache
+												fakeWatchActor.WaitForDirectoryChangesReturnsOnCall(1, sharedaction.DirectorySnapshot{"index.js": {Size: 2}}, sharedaction.DirectoryChanges{}, nil)
+											})
+
+											It("stops watching and saves the push cache", func() {
+												Expect(executeErr).ToNot(HaveOccurred())
+												Expect(fakeWatchActor.WaitForDirectoryChangesCallCount()).To(Equal(2))
+												Expect(fakeActor.RepushApplicationSourceCallCount()).To(Equal(1))
+												Expect(fakePushCache.SaveCallCount()).To(Equal(1))
+											})
+										})
+
+										When("the app path is an archive", func() {
+											BeforeEach(func() {
+												fakeActor.CreatePushPlansReturns(
+													[]v7pushaction.PushPlan{
+														{Application: resources.Application{Name: "first-app"}, BitsPath: "/some/app.zip", Archive: true},
+													},
+													nil,
+													nil,
+												)
+											})
+
+											It("returns an error without pushing", func() {
+												Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "--watch requires the app path to be a directory"}))
+												Expect(fakeActor.ActualizeCallCount()).To(Equal(0))
+											})
+										})
+
+										When("--watch-sync is given", func() {
+											BeforeEach(func() {
+												cmd.WatchSync = true
+												fakeDiffActor.GetSecureShellConfigurationsForAllInstancesReturns(
+													[]v7action.InstanceSSHAuthentication{
+														{
+															Index: 0,
+															SSHAuthentication: v7action.SSHAuthentication{
+																Username:           "cf:some-process-guid/0",
+																Endpoint:           "some-endpoint",
+																HostKeyFingerprint: "some-fingerprint",
+															},
+														},
+														{Index: 1, State: constant.ProcessInstanceDown},
+													},
+													v7action.Warnings{"ssh-warning"},
+													nil,
+												)
+												fakeDiffActor.GetSSHPasscodeReturns("some-passcode", nil)
+											})
+
+											It("syncs the changed files to the running instances without pushing again", func() {
+												Expect(executeErr).To(MatchError(watchErr))
+
+												appName, spaceGUID, processType := fakeDiffActor.GetSecureShellConfigurationsForAllInstancesArgsForCall(0)
+												Expect(appName).To(Equal("first-app"))
+												Expect(spaceGUID).To(Equal("some-space-guid"))
+												Expect(processType).To(Equal("web"))
+												Expect(testUI.Err).To(Say("ssh-warning"))
+
+												Expect(fakeDiffActor.GetSSHPasscodeCallCount()).To(Equal(1))
+												Expect(fakeWatchActor.ExecuteSecureSyncCallCount()).To(Equal(1))
+												sshClient, syncOptions := fakeWatchActor.ExecuteSecureSyncArgsForCall(0)
+												Expect(sshClient).To(Equal(fakeSSHClient))
+												Expect(syncOptions).To(Equal(sharedaction.SecureSyncOptions{
+													Username:           "cf:some-process-guid/0",
+													Passcode:           "some-passcode",
+													Endpoint:           "some-endpoint",
+													HostKeyFingerprint: "some-fingerprint",
+													LocalDir:           "/some/app/dir",
+													RemoteDir:          "/home/vcap/app",
+													Changed:            []string{"index.js"},
+													Removed:            []string{"old.js"},
+												}))
+
+												Expect(testUI.Out).To(Say("Syncing files to instance 0..."))
+												Expect(testUI.Out).To(Say("OK"))
+												Expect(fakeActor.RepushApplicationSourceCallCount()).To(Equal(0))
+											})
+
+											When("syncing the files fails", func() {
+												BeforeEach(func() {
+													fakeWatchActor.ExecuteSecureSyncReturns(errors.New("connection refused"))
+												})
+
+												It("warns and pushes the app again instead", func() {
+													Expect(executeErr).To(MatchError(watchErr))
+													Expect(testUI.Err).To(Say("Unable to sync files over SSH: connection refused"))
+													Expect(fakeActor.RepushApplicationSourceCallCount()).To(Equal(1))
+												})
+											})
+										})
+									})
 								})
 							})
 						})
@@ -906,7 +1497,7 @@ var _ = Describe("push Command", func() {
 					Expect(fakeManifestLocator.PathArgsForCall(0)).To(Equal(cmd.CWD))
 
 					Expect(fakeManifestParser.InterpolateManifestCallCount()).To(Equal(1))
-					actualManifestPath, _, _ := fakeManifestParser.InterpolateManifestArgsForCall(0)
+					actualManifestPath, _, _, _ := fakeManifestParser.InterpolateManifestArgsForCall(0)
 					Expect(actualManifestPath).To(Equal("/manifest/path"))
 
 					Expect(fakeManifestParser.ParseManifestCallCount()).To(Equal(1))
@@ -977,6 +1568,64 @@ var _ = Describe("push Command", func() {
 					Expect(fakeManifestParser.ParseManifestCallCount()).To(Equal(1))
 				})
 			})
+
+			When("the manifest has unknown fields", func() {
+				BeforeEach(func() {
+					fakeManifestLocator.PathReturns("/manifest/path", true, nil)
+					fakeManifestParser.ValidateRawManifestReturns([]manifestparser.ValidationError{
+						{Line: 3, Column: 3, Field: "applications[0].healthcheck-type", Message: "unknown field; did you mean 'health-check-type'?", UnknownField: true},
+					}, nil)
+				})
+
+				It("warns about them and parses the manifest", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Err).To(Say(`/manifest/path: applications\[0\].healthcheck-type: unknown field; did you mean 'health-check-type'\?`))
+					Expect(fakeManifestParser.ParseManifestCallCount()).To(Equal(1))
+				})
+			})
+
+			When("the manifest has invalid fields", func() {
+				BeforeEach(func() {
+					fakeManifestLocator.PathReturns("/manifest/path", true, nil)
+					fakeManifestParser.ValidateRawManifestReturns([]manifestparser.ValidationError{
+						{Line: 4, Column: 14, Field: "applications[0].instances", Message: "must be a whole number"},
+					}, nil)
+				})
+
+				It("displays them and returns an error without parsing the manifest", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ManifestValidationFailedError{PathToManifest: "/manifest/path"}))
+					Expect(testUI.Err).To(Say(`/manifest/path: applications\[0\].instances: must be a whole number`))
+					Expect(fakeManifestParser.ParseManifestCallCount()).To(Equal(0))
+				})
+			})
+
+			When("ops files are given", func() {
+				BeforeEach(func() {
+					flagOverrides.PathsToOpsFiles = []string{"/some/ops.yml"}
+					fakeManifestLocator.PathReturns("/manifest/path", true, nil)
+					fakeManifestParser.InterpolateManifestReturns([]byte("applications:\n- name: name-from-ops-file\n"), nil)
+				})
+
+				It("validates the manifest with the ops files applied", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					_, _, _, pathsToOpsFiles := fakeManifestParser.InterpolateManifestArgsForCall(0)
+					Expect(pathsToOpsFiles).To(Equal([]string{"/some/ops.yml"}))
+					Expect(fakeManifestParser.ValidateRawManifestCallCount()).To(Equal(1))
+					Expect(string(fakeManifestParser.ValidateRawManifestArgsForCall(0))).To(Equal("applications:\n- name: name-from-ops-file\n"))
+					Expect(fakeManifestParser.ValidateManifestCallCount()).To(Equal(0))
+				})
+			})
+
+			When("validating the manifest fails", func() {
+				BeforeEach(func() {
+					fakeManifestLocator.PathReturns("/manifest/path", true, nil)
+					fakeManifestParser.ValidateRawManifestReturns(nil, errors.New("read-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("read-error"))
+				})
+			})
 		})
 
 		When("The -f flag is specified", func() {
@@ -1001,7 +1650,7 @@ var _ = Describe("push Command", func() {
 				Expect(fakeManifestLocator.PathArgsForCall(0)).To(Equal(somePath))
 
 				Expect(fakeManifestParser.InterpolateManifestCallCount()).To(Equal(1))
-				actualManifestPath, _, _ := fakeManifestParser.InterpolateManifestArgsForCall(0)
+				actualManifestPath, _, _, _ := fakeManifestParser.InterpolateManifestArgsForCall(0)
 				Expect(actualManifestPath).To(Equal("/manifest/path"))
 				Expect(manifest).To(Equal(
 					manifestparser.Manifest{
@@ -1026,7 +1675,7 @@ var _ = Describe("push Command", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 
 				Expect(fakeManifestParser.InterpolateManifestCallCount()).To(Equal(1))
-				_, actualVarsFiles, _ := fakeManifestParser.InterpolateManifestArgsForCall(0)
+				_, actualVarsFiles, _, _ := fakeManifestParser.InterpolateManifestArgsForCall(0)
 				Expect(actualVarsFiles).To(Equal(varsFiles))
 			})
 		})
@@ -1046,10 +1695,25 @@ var _ = Describe("push Command", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 
 				Expect(fakeManifestParser.InterpolateManifestCallCount()).To(Equal(1))
-				_, _, actualVars := fakeManifestParser.InterpolateManifestArgsForCall(0)
+				_, _, actualVars, _ := fakeManifestParser.InterpolateManifestArgsForCall(0)
 				Expect(actualVars).To(Equal(vars))
 			})
 		})
+
+		When("--ops-file flags are provided", func() {
+			BeforeEach(func() {
+				fakeManifestLocator.PathReturns("/manifest/path", true, nil)
+				flagOverrides.PathsToOpsFiles = []string{"ops1", "ops2"}
+			})
+
+			It("passes the ops files to the manifest parser", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeManifestParser.InterpolateManifestCallCount()).To(Equal(1))
+				_, _, _, actualOpsFiles := fakeManifestParser.InterpolateManifestArgsForCall(0)
+				Expect(actualOpsFiles).To(Equal([]string{"ops1", "ops2"}))
+			})
+		})
 	})
 
 	Describe("GetFlagOverrides", func() {
@@ -1078,6 +1742,7 @@ var _ = Describe("push Command", func() {
 			cmd.Instances = flag.Instances{NullInt: types.NullInt{Value: 10, IsSet: true}}
 			cmd.PathToManifest = "/manifest/path"
 			cmd.PathsToVarsFiles = []flag.PathWithExistenceCheck{"/vars1", "/vars2"}
+			cmd.PathsToOpsFiles = []flag.PathWithExistenceCheck{"/ops1", "/ops2"}
 			cmd.Vars = []template.VarKV{{Name: "key", Value: "val"}}
 			cmd.Task = true
 			cmd.LogRateLimit = "512M"
@@ -1107,12 +1772,41 @@ var _ = Describe("push Command", func() {
 			Expect(overrides.Instances).To(Equal(types.NullInt{Value: 10, IsSet: true}))
 			Expect(overrides.ManifestPath).To(Equal("/manifest/path"))
 			Expect(overrides.PathsToVarsFiles).To(Equal([]string{"/vars1", "/vars2"}))
+			Expect(overrides.PathsToOpsFiles).To(Equal([]string{"/ops1", "/ops2"}))
 			Expect(overrides.Vars).To(Equal([]template.VarKV{{Name: "key", Value: "val"}}))
 			Expect(overrides.Task).To(BeTrue())
 			Expect(overrides.LogRateLimit).To(Equal("512M"))
 			Expect(*overrides.MaxInFlight).To(Equal(1))
 		})
 
+		When("instance steps are provided", func() {
+			BeforeEach(func() {
+				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
+				cmd.InstanceSteps = flag.InstanceSteps{Weights: []int64{10, 25, 100}}
+			})
+
+			It("sets them on the flag overrides", func() {
+				Expect(overrides.InstanceSteps).To(Equal([]int64{10, 25, 100}))
+			})
+		})
+
+		When("auto promotion is requested", func() {
+			BeforeEach(func() {
+				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
+				cmd.AutoPromoteAfter = flag.Duration{Duration: 10 * time.Minute}
+				maxCrashes := 2
+				cmd.AutoPromoteMaxCrashes = &maxCrashes
+				maxErrorRate := 1.5
+				cmd.AutoPromoteMaxErrorRate = &maxErrorRate
+			})
+
+			It("sets the thresholds on the flag overrides", func() {
+				Expect(overrides.AutoPromoteAfter).To(Equal(10 * time.Minute))
+				Expect(overrides.AutoPromoteMaxCrashes).To(Equal(2))
+				Expect(*overrides.AutoPromoteMaxErrorRate).To(Equal(1.5))
+			})
+		})
+
 		When("a docker image is provided", func() {
 			BeforeEach(func() {
 				cmd.DockerImage = flag.DockerImage{Path: "some-docker-image"}
@@ -1163,6 +1857,13 @@ var _ = Describe("push Command", func() {
 			},
 			translatableerror.ArgumentCombinationError{Args: []string{"--docker-image, -o", "--path, -p"}}),
 
+		Entry("when --no-manifest and --ops-file flags are passed",
+			func() {
+				cmd.NoManifest = true
+				cmd.PathsToOpsFiles = []flag.PathWithExistenceCheck{"ops.yml"}
+			},
+			translatableerror.ArgumentCombinationError{Args: []string{"--no-manifest", "--ops-file"}}),
+
 		Entry("when -u http does not have a matching --endpoint",
 			func() {
 				cmd.HealthCheckType.Type = constant.HTTP
@@ -1299,10 +2000,7 @@ var _ = Describe("push Command", func() {
 				maxInFlight := 10
 				cmd.MaxInFlight = &maxInFlight
 			},
-			translatableerror.RequiredFlagsError{
-				Arg1: "--max-in-flight",
-				Arg2: "--strategy",
-			}),
+			nil),
 
 		Entry("max-in-flight is smaller than 1",
 			func() {
@@ -1313,5 +2011,155 @@ var _ = Describe("push Command", func() {
 			translatableerror.IncorrectUsageError{
 				Message: "--max-in-flight must be greater than or equal to 1",
 			}),
+
+		Entry("instance-steps is passed without strategy",
+			func() {
+				cmd.InstanceSteps = flag.InstanceSteps{Weights: []int64{10, 50}}
+			},
+			nil),
+
+		Entry("auto-promote-after is passed without strategy",
+			func() {
+				cmd.AutoPromoteAfter = flag.Duration{Duration: 5 * time.Minute}
+			},
+			nil),
+
+		Entry("auto-promote-after is passed with no-wait",
+			func() {
+				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
+				cmd.AutoPromoteAfter = flag.Duration{Duration: 5 * time.Minute}
+				cmd.NoWait = true
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--auto-promote-after", "--no-wait",
+				},
+			}),
+
+		Entry("auto-promote-max-crashes is passed without auto-promote-after",
+			func() {
+				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
+				maxCrashes := 1
+				cmd.AutoPromoteMaxCrashes = &maxCrashes
+			},
+			translatableerror.RequiredFlagsError{
+				Arg1: "--auto-promote-max-crashes",
+				Arg2: "--auto-promote-after",
+			}),
+
+		Entry("auto-promote-max-error-rate is passed without auto-promote-after",
+			func() {
+				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
+				maxErrorRate := 1.5
+				cmd.AutoPromoteMaxErrorRate = &maxErrorRate
+			},
+			translatableerror.RequiredFlagsError{
+				Arg1: "--auto-promote-max-error-rate",
+				Arg2: "--auto-promote-after",
+			}),
+
+		Entry("auto-promote-max-crashes is negative",
+			func() {
+				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
+				cmd.AutoPromoteAfter = flag.Duration{Duration: 5 * time.Minute}
+				maxCrashes := -1
+				cmd.AutoPromoteMaxCrashes = &maxCrashes
+			},
+			translatableerror.IncorrectUsageError{
+				Message: "--auto-promote-max-crashes must be greater than or equal to 0",
+			}),
+
+		Entry("auto-promote-max-error-rate is more than 100",
+			func() {
+				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
+				cmd.AutoPromoteAfter = flag.Duration{Duration: 5 * time.Minute}
+				maxErrorRate := 101.0
+				cmd.AutoPromoteMaxErrorRate = &maxErrorRate
+			},
+			translatableerror.IncorrectUsageError{
+				Message: "--auto-promote-max-error-rate must be between 0 and 100",
+			}),
+
+		Entry("auto-promote flags are passed with the canary strategy",
+			func() {
+				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
+				cmd.AutoPromoteAfter = flag.Duration{Duration: 5 * time.Minute}
+				maxCrashes := 0
+				cmd.AutoPromoteMaxCrashes = &maxCrashes
+				maxErrorRate := 2.5
+				cmd.AutoPromoteMaxErrorRate = &maxErrorRate
+			},
+			nil),
+
+		Entry("watch-sync is passed without watch",
+			func() {
+				cmd.WatchSync = true
+			},
+			translatableerror.RequiredFlagsError{
+				Arg1: "--watch-sync",
+				Arg2: "--watch",
+			}),
+
+		Entry("watch and docker image flags are passed",
+			func() {
+				cmd.Watch = true
+				cmd.DockerImage.Path = "some-docker-image"
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--watch", "--docker-image, -o",
+				},
+			}),
+
+		Entry("watch and droplet flags are passed",
+			func() {
+				cmd.Watch = true
+				cmd.DropletPath = "some-droplet.tgz"
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--watch", "--droplet",
+				},
+			}),
+
+		Entry("watch and dry-run flags are passed",
+			func() {
+				cmd.Watch = true
+				cmd.DryRun = true
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--watch", "--dry-run",
+				},
+			}),
+
+		Entry("watch and no-start flags are passed",
+			func() {
+				cmd.Watch = true
+				cmd.NoStart = true
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--watch", "--no-start",
+				},
+			}),
+
+		Entry("watch and parallel flags are passed",
+			func() {
+				cmd.Watch = true
+				cmd.Parallel = flag.PositiveInteger{Value: 2}
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--watch", "--parallel",
+				},
+			}),
+
+		Entry("watch and watch-sync flags are passed",
+			func() {
+				cmd.Watch = true
+				cmd.WatchSync = true
+			},
+			nil),
 	)
 })
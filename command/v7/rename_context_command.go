@@ -0,0 +1,37 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type RenameContextCommand struct {
+	UI     command.UI
+	Config command.Config
+
+	RequiredArgs    flag.RenameContextArgs `positional-args:"yes"`
+	usage           interface{}            `usage:"CF_NAME rename-context CONTEXT_NAME NEW_CONTEXT_NAME"`
+	relatedCommands interface{}            `related_commands:"contexts, use-context"`
+}
+
+func (cmd *RenameContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+func (cmd RenameContextCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Renaming context {{.OldName}} to {{.NewName}}...", map[string]interface{}{
+		"OldName": cmd.RequiredArgs.OldName,
+		"NewName": cmd.RequiredArgs.NewName,
+	})
+
+	err := cmd.Config.RenameContext(cmd.RequiredArgs.OldName, cmd.RequiredArgs.NewName)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
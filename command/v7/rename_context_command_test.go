@@ -0,0 +1,58 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rename-context Command", func() {
+	var (
+		cmd        RenameContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = RenameContextCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.OldName = "default"
+		cmd.RequiredArgs.NewName = "production"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("renames the context", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(fakeConfig.RenameContextCallCount()).To(Equal(1))
+		oldName, newName := fakeConfig.RenameContextArgsForCall(0)
+		Expect(oldName).To(Equal("default"))
+		Expect(newName).To(Equal("production"))
+
+		Expect(testUI.Out).To(Say("Renaming context default to production..."))
+		Expect(testUI.Out).To(Say("OK"))
+	})
+
+	When("renaming the context fails", func() {
+		BeforeEach(func() {
+			fakeConfig.RenameContextReturns(translatableerror.ContextAlreadyExistsError{Name: "production"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ContextAlreadyExistsError{Name: "production"}))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
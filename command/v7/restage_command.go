@@ -42,6 +42,9 @@ func (cmd *RestageCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+// StructuredOutput marks restage as displaying its results with --output.
+func (cmd RestageCommand) StructuredOutput() {}
+
 func (cmd RestageCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -41,6 +41,9 @@ func (cmd *RestartCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+// StructuredOutput marks restart as displaying its results with --output.
+func (cmd RestartCommand) StructuredOutput() {}
+
 func (cmd RestartCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
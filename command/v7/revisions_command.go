@@ -24,6 +24,9 @@ type RevisionsCommand struct {
 	relatedCommands interface{} `related_commands:"rollback"`
 }
 
+// StructuredOutput marks revisions as displaying its results with --output.
+func (cmd RevisionsCommand) StructuredOutput() {}
+
 func (cmd RevisionsCommand) Execute(_ []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -78,6 +81,7 @@ func (cmd RevisionsCommand) Execute(_ []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(revisions)
 
 	if len(revisions) == 0 {
 		cmd.UI.DisplayText("No revisions found")
@@ -37,6 +37,9 @@ func (cmd *RollbackCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+// StructuredOutput marks rollback as displaying its results with --output.
+func (cmd RollbackCommand) StructuredOutput() {}
+
 func (cmd RollbackCommand) Execute(args []string) error {
 	targetRevision := int(cmd.Version.Value)
 	err := cmd.SharedActor.CheckTarget(true, true)
@@ -34,6 +34,9 @@ CF_NAME route example.com --path foo           # example.com/foo
 CF_NAME route example.com --port 5000          # example.com:5000`
 }
 
+// StructuredOutput marks route as displaying its results with --output.
+func (cmd RouteCommand) StructuredOutput() {}
+
 func (cmd RouteCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, false)
 	if err != nil {
@@ -79,6 +82,7 @@ func (cmd RouteCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(route)
 
 	port := ""
 	if route.Port != 0 {
@@ -12,6 +12,9 @@ type RouterGroupsCommand struct {
 	relatedCommands interface{} `related_commands:"create-domain, domains"`
 }
 
+// StructuredOutput marks router-groups as displaying its results with --output.
+func (cmd RouterGroupsCommand) StructuredOutput() {}
+
 func (cmd RouterGroupsCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -33,6 +36,7 @@ func (cmd RouterGroupsCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(routerGroups)
 
 	if len(routerGroups) == 0 {
 		cmd.UI.DisplayText("No router groups found.")
@@ -18,6 +18,9 @@ type RoutesCommand struct {
 	Labels          string      `long:"labels" description:"Selector to filter routes by labels"`
 }
 
+// StructuredOutput marks routes as displaying its results with --output.
+func (cmd RoutesCommand) StructuredOutput() {}
+
 func (cmd RoutesCommand) Execute(args []string) error {
 	var (
 		routes   []resources.Route
@@ -63,6 +66,7 @@ func (cmd RoutesCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(routeSummaries)
 
 	if len(routes) > 0 {
 		cmd.displayRoutesTable(routeSummaries)
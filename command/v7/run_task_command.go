@@ -22,6 +22,9 @@ type RunTaskCommand struct {
 	relatedCommands interface{}             `related_commands:"logs, tasks, terminate-task"`
 }
 
+// StructuredOutput marks run-task as displaying its results with --output.
+func (cmd RunTaskCommand) StructuredOutput() {}
+
 func (cmd RunTaskCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -86,6 +89,7 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(task)
 
 	cmd.UI.DisplayText("Task has been submitted successfully for execution.")
 	cmd.UI.DisplayOK()
@@ -12,6 +12,10 @@ type RunningEnvironmentVariableGroupCommand struct {
 	relatedCommands interface{} `related_commands:"env, staging-environment-variable-group"`
 }
 
+// StructuredOutput marks running-environment-variable-group as displaying its results with
+// --output.
+func (cmd RunningEnvironmentVariableGroupCommand) StructuredOutput() {}
+
 func (cmd RunningEnvironmentVariableGroupCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -33,6 +37,7 @@ func (cmd RunningEnvironmentVariableGroupCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(envVars)
 
 	if len(envVars) == 0 {
 		cmd.UI.DisplayTextWithFlavor("No running environment variable group has been set.")
@@ -11,6 +11,10 @@ type RunningSecurityGroupsCommand struct {
 	relatedCommands interface{} `related_commands:"bind-running-security-group, security-group, unbind-running-security-group"`
 }
 
+// StructuredOutput marks running-security-groups as displaying its results with
+// --output.
+func (cmd RunningSecurityGroupsCommand) StructuredOutput() {}
+
 func (cmd RunningSecurityGroupsCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -32,6 +36,7 @@ func (cmd RunningSecurityGroupsCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(runningSecurityGroups)
 
 	if len(runningSecurityGroups) == 0 {
 		cmd.UI.DisplayText("No global running security groups found.")
@@ -25,6 +25,9 @@ type ScaleCommand struct {
 	envCFStartupTimeout interface{}             `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 }
 
+// StructuredOutput marks scale as displaying its results with --output.
+func (cmd ScaleCommand) StructuredOutput() {}
+
 func (cmd ScaleCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -0,0 +1,160 @@
+package v7
+
+import (
+	"runtime"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/clissh"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SharedSCPActor
+
+type SharedSCPActor interface {
+	ExecuteSecureCopy(sshClient sharedaction.SecureShellClient, copyOptions sharedaction.SecureCopyOptions) error
+}
+
+type SCPCommand struct {
+	BaseCommand
+
+	RequiredArgs       flag.SCPArgs `positional-args:"yes"`
+	ProcessIndex       uint         `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
+	ProcessType        string       `long:"process" default:"web" description:"App process name"`
+	Recursive          bool         `long:"recursive" short:"r" description:"Recursively copy entire directories"`
+	SkipHostValidation bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+
+	usage           interface{} `usage:"CF_NAME scp [-r] [--process PROCESS] [-i INDEX] LOCAL_PATH APP_NAME:REMOTE_PATH\n   CF_NAME scp [-r] [--process PROCESS] [-i INDEX] APP_NAME:REMOTE_PATH LOCAL_PATH\n\nEXAMPLES:\n   CF_NAME scp my-app:/home/vcap/app/heap.hprof .\n   CF_NAME scp -r ./debug-config my-app:/home/vcap/app/config\n   CF_NAME scp --process worker -i 2 my-app:/tmp/dump.hprof ./dump.hprof"`
+	relatedCommands interface{} `related_commands:"enable-ssh, ssh, ssh-enabled"`
+	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
+
+	SSHActor  SharedSCPActor
+	SSHClient *clissh.SecureShell
+}
+
+func (cmd *SCPCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+	cmd.SSHActor = sharedActor
+	cmd.SSHClient = clissh.NewDefaultSecureShell()
+
+	return nil
+}
+
+func (cmd SCPCommand) Execute(args []string) error {
+	sourceApp, sourcePath, sourceIsRemote := parseSCPLocation(cmd.RequiredArgs.Source)
+	destinationApp, destinationPath, destinationIsRemote := parseSCPLocation(cmd.RequiredArgs.Destination)
+	if sourceIsRemote == destinationIsRemote {
+		return translatableerror.IncorrectUsageError{
+			Message: "exactly one of SOURCE and DESTINATION must be of the form APP_NAME:REMOTE_PATH",
+		}
+	}
+
+	copyOptions := sharedaction.SecureCopyOptions{
+		SkipHostValidation: cmd.SkipHostValidation,
+		Recursive:          cmd.Recursive,
+	}
+
+	var appName string
+	if sourceIsRemote {
+		appName = sourceApp
+		copyOptions.Direction = sharedaction.SecureCopyDownload
+		copyOptions.RemotePath = sourcePath
+		copyOptions.LocalPath = destinationPath
+	} else {
+		appName = destinationApp
+		copyOptions.Direction = sharedaction.SecureCopyUpload
+		copyOptions.LocalPath = sourcePath
+		copyOptions.RemotePath = destinationPath
+	}
+	if copyOptions.RemotePath == "" {
+		copyOptions.RemotePath = "."
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	templateValues := map[string]interface{}{
+		"LocalPath":   copyOptions.LocalPath,
+		"RemotePath":  copyOptions.RemotePath,
+		"Index":       cmd.ProcessIndex,
+		"ProcessType": cmd.ProcessType,
+		"AppName":     appName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	}
+	if copyOptions.Direction == sharedaction.SecureCopyDownload {
+		cmd.UI.DisplayTextWithFlavor("Downloading {{.RemotePath}} from instance {{.Index}} of process {{.ProcessType}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Uploading {{.LocalPath}} to instance {{.Index}} of process {{.ProcessType}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+	}
+
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+		appName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		cmd.ProcessIndex,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	copyOptions.Username = sshAuth.Username
+	copyOptions.Passcode = sshAuth.Passcode
+	copyOptions.Endpoint = sshAuth.Endpoint
+	copyOptions.HostKeyFingerprint = sshAuth.HostKeyFingerprint
+
+	err = cmd.SSHActor.ExecuteSecureCopy(cmd.SSHClient, copyOptions)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// parseSCPLocation splits an APP_NAME:PATH argument into its parts. Arguments
+// without an app name prefix, Windows drive letters and paths containing a
+// separator before the first colon are local paths.
+func parseSCPLocation(location string) (string, string, bool) {
+	index := strings.Index(location, ":")
+	if index <= 0 || strings.ContainsAny(location[:index], `/\`) || isDriveLetter(location) {
+		return "", location, false
+	}
+
+	return location[:index], location[index+1:], true
+}
+
+// isDriveLetter reports whether the location starts with a Windows drive
+// letter, as in C:\dumps. C:/dumps is only a drive letter on Windows, since
+// elsewhere it is the /dumps directory of an app called C.
+func isDriveLetter(location string) bool {
+	if len(location) < 2 || location[1] != ':' || !isASCIILetter(location[0]) {
+		return false
+	}
+	if len(location) == 2 {
+		return true
+	}
+
+	return location[2] == '\\' || (location[2] == '/' && runtime.GOOS == "windows")
+}
+
+func isASCIILetter(char byte) bool {
+	return ('a' <= char && char <= 'z') || ('A' <= char && char <= 'Z')
+}
@@ -0,0 +1,207 @@
+package v7_test
+
+import (
+	"errors"
+	"runtime"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("scp Command", func() {
+	var (
+		cmd             SCPCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		fakeSCPActor    *v7fakes.FakeSharedSCPActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeSCPActor = new(v7fakes.FakeSharedSCPActor)
+
+		cmd = SCPCommand{
+			RequiredArgs: flag.SCPArgs{Source: "./debug.yml", Destination: "some-app:/home/vcap/app/debug.yml"},
+
+			ProcessType:        "some-process-type",
+			ProcessIndex:       1,
+			Recursive:          true,
+			SkipHostValidation: true,
+
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			SSHActor: fakeSCPActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+			v7action.SSHAuthentication{
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				Passcode:           "some-passcode",
+				Username:           "some-username",
+			},
+			v7action.Warnings{"some-warnings"},
+			nil,
+		)
+	})
+
+	DescribeTable("argument validation",
+		func(source string, destination string) {
+			cmd.RequiredArgs = flag.SCPArgs{Source: source, Destination: destination}
+			Expect(cmd.Execute(nil)).To(MatchError(translatableerror.IncorrectUsageError{
+				Message: "exactly one of SOURCE and DESTINATION must be of the form APP_NAME:REMOTE_PATH",
+			}))
+		},
+		Entry("both local", "./a", "./b"),
+		Entry("both remote", "app-1:/a", "app-2:/b"),
+		Entry("windows drive letters are local", `C:\a`, `D:\b`),
+		Entry("colons after a path separator are local", "./a:b", "dir/c:d"),
+	)
+
+	Describe("Execute", func() {
+		JustBeforeEach(func() {
+			executeErr = cmd.Execute(nil)
+		})
+
+		When("checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "steve"})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "steve"}))
+
+				checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeTrue())
+				Expect(fakeSCPActor.ExecuteSecureCopyCallCount()).To(Equal(0))
+			})
+		})
+
+		When("uploading", func() {
+			It("uploads the local path to the app instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Uploading \./debug\.yml to instance 1 of process some-process-type of app some-app in org some-org / space some-space as steve\.\.\.`))
+				Expect(testUI.Err).To(Say("some-warnings"))
+				Expect(testUI.Out).To(Say("OK"))
+
+				appNameArg, spaceGUIDArg, processTypeArg, processIndexArg := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+				Expect(appNameArg).To(Equal("some-app"))
+				Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+				Expect(processTypeArg).To(Equal("some-process-type"))
+				Expect(processIndexArg).To(Equal(uint(1)))
+
+				Expect(fakeSCPActor.ExecuteSecureCopyCallCount()).To(Equal(1))
+				_, copyOptions := fakeSCPActor.ExecuteSecureCopyArgsForCall(0)
+				Expect(copyOptions).To(Equal(sharedaction.SecureCopyOptions{
+					Username:           "some-username",
+					Passcode:           "some-passcode",
+					Endpoint:           "some-endpoint",
+					HostKeyFingerprint: "some-fingerprint",
+					SkipHostValidation: true,
+					Direction:          sharedaction.SecureCopyUpload,
+					LocalPath:          "./debug.yml",
+					RemotePath:         "/home/vcap/app/debug.yml",
+					Recursive:          true,
+				}))
+			})
+
+			When("the remote path is empty", func() {
+				BeforeEach(func() {
+					cmd.RequiredArgs.Destination = "some-app:"
+				})
+
+				It("copies into the home directory", func() {
+					_, copyOptions := fakeSCPActor.ExecuteSecureCopyArgsForCall(0)
+					Expect(copyOptions.RemotePath).To(Equal("."))
+				})
+			})
+		})
+
+		When("downloading", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs = flag.SCPArgs{Source: "some-app:/home/vcap/app/heap.hprof", Destination: `C:\dumps`}
+			})
+
+			It("downloads the remote path from the app instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Downloading /home/vcap/app/heap\.hprof from instance 1 of process some-process-type of app some-app in org some-org / space some-space as steve\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+
+				_, copyOptions := fakeSCPActor.ExecuteSecureCopyArgsForCall(0)
+				Expect(copyOptions.Direction).To(Equal(sharedaction.SecureCopyDownload))
+				Expect(copyOptions.RemotePath).To(Equal("/home/vcap/app/heap.hprof"))
+				Expect(copyOptions.LocalPath).To(Equal(`C:\dumps`))
+			})
+
+			When("the app name is a single letter", func() {
+				BeforeEach(func() {
+					if runtime.GOOS == "windows" {
+						Skip("a:/tmp/x is a drive letter on Windows")
+					}
+					cmd.RequiredArgs = flag.SCPArgs{Source: "a:/tmp/x", Destination: "./x"}
+				})
+
+				It("downloads from the app", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					appNameArg, _, _, _ := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+					Expect(appNameArg).To(Equal("a"))
+
+					_, copyOptions := fakeSCPActor.ExecuteSecureCopyArgsForCall(0)
+					Expect(copyOptions.Direction).To(Equal(sharedaction.SecureCopyDownload))
+					Expect(copyOptions.RemotePath).To(Equal("/tmp/x"))
+					Expect(copyOptions.LocalPath).To(Equal("./x"))
+				})
+			})
+		})
+
+		When("getting the secure shell authentication fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(v7action.SSHAuthentication{}, v7action.Warnings{"some-warnings"}, errors.New("some-error"))
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("some-warnings"))
+				Expect(fakeSCPActor.ExecuteSecureCopyCallCount()).To(Equal(0))
+			})
+		})
+
+		When("copying fails", func() {
+			BeforeEach(func() {
+				fakeSCPActor.ExecuteSecureCopyReturns(errors.New("scp: permission denied"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("scp: permission denied"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+})
@@ -13,6 +13,10 @@ type SecurityGroupCommand struct {
 	relatedCommands interface{}        `related_commands:"bind-running-security-group, bind-security-group, bind-staging-security-group"`
 }
 
+// StructuredOutput marks security-group as displaying its results with
+// --output.
+func (cmd SecurityGroupCommand) StructuredOutput() {}
+
 func (cmd SecurityGroupCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -35,6 +39,7 @@ func (cmd SecurityGroupCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(securityGroupSummary)
 
 	cmd.UI.DisplayKeyValueTable("", [][]string{
 		{cmd.UI.TranslateText("name:"), securityGroupSummary.Name},
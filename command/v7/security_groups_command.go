@@ -11,6 +11,10 @@ type SecurityGroupsCommand struct {
 	relatedCommands interface{} `related_commands:"bind-running-security-group, bind-security-group, bind-staging-security-group, security-group"`
 }
 
+// StructuredOutput marks security-groups as displaying its results with
+// --output.
+func (cmd SecurityGroupsCommand) StructuredOutput() {}
+
 func (cmd SecurityGroupsCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -32,6 +36,7 @@ func (cmd SecurityGroupsCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(securityGroupSummaries)
 
 	if len(securityGroupSummaries) == 0 {
 		cmd.UI.DisplayText("No security groups found.")
@@ -19,6 +19,10 @@ type ServiceAccessCommand struct {
 	relatedCommands interface{} `related_commands:"marketplace, disable-service-access, enable-service-access, service-brokers"`
 }
 
+// StructuredOutput marks service-access as displaying its results with
+// --output.
+func (cmd ServiceAccessCommand) StructuredOutput() {}
+
 func (cmd ServiceAccessCommand) Execute(args []string) error {
 	if err := cmd.SharedActor.CheckTarget(false, false); err != nil {
 		return err
@@ -33,6 +37,7 @@ func (cmd ServiceAccessCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(servicePlanAccess)
 
 	if len(servicePlanAccess) == 0 {
 		cmd.UI.DisplayText("No service plans found.")
@@ -12,6 +12,10 @@ type ServiceBrokersCommand struct {
 	relatedCommands interface{} `related_commands:"delete-service-broker, disable-service-access, enable-service-access"`
 }
 
+// StructuredOutput marks service-brokers as displaying its results with
+// --output.
+func (cmd *ServiceBrokersCommand) StructuredOutput() {}
+
 func (cmd *ServiceBrokersCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -30,6 +34,7 @@ func (cmd *ServiceBrokersCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(serviceBrokers)
 
 	cmd.displayServiceBrokers(serviceBrokers)
 
@@ -22,6 +22,9 @@ type ServiceCommand struct {
 	relatedCommands interface{}          `related_commands:"bind-service, rename-service, update-service"`
 }
 
+// StructuredOutput marks service as displaying its results with --output.
+func (cmd ServiceCommand) StructuredOutput() {}
+
 func (cmd ServiceCommand) Execute(args []string) error {
 	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
 		return err
@@ -58,6 +61,7 @@ func (cmd ServiceCommand) fetchAndDisplayParams() error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(params)
 
 	err = cmd.UI.DisplayJSON("", params)
 	if err != nil {
@@ -80,6 +84,7 @@ func (cmd ServiceCommand) fetchAndDisplayDetails() error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(serviceInstanceWithDetails)
 
 	switch {
 	case serviceInstanceWithDetails.Type == resources.UserProvidedServiceInstance:
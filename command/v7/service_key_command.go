@@ -11,6 +11,9 @@ type ServiceKeyCommand struct {
 	GUID         bool                    `long:"guid" description:"Retrieve and display the given service-key's guid. All other output is suppressed."`
 }
 
+// StructuredOutput marks service-key as displaying its results with --output.
+func (cmd ServiceKeyCommand) StructuredOutput() {}
+
 func (cmd ServiceKeyCommand) Execute(args []string) error {
 	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
 		return err
@@ -69,6 +72,7 @@ func (cmd ServiceKeyCommand) details() error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(details)
 
 	cmd.UI.DisplayNewline()
 
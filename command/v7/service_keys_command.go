@@ -15,6 +15,9 @@ type ServiceKeysCommand struct {
 	relatedCommands interface{}          `related_commands:"delete-service-key"`
 }
 
+// StructuredOutput marks service-keys as displaying its results with --output.
+func (cmd ServiceKeysCommand) StructuredOutput() {}
+
 func (cmd ServiceKeysCommand) Execute(args []string) error {
 	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
 		return err
@@ -32,6 +35,7 @@ func (cmd ServiceKeysCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(keys)
 
 	switch len(keys) {
 	case 0:
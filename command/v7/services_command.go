@@ -17,6 +17,9 @@ type ServicesCommand struct {
 	relatedCommands interface{} `related_commands:"create-service, marketplace"`
 }
 
+// StructuredOutput marks services as displaying its results with --output.
+func (cmd ServicesCommand) StructuredOutput() {}
+
 func (cmd ServicesCommand) Execute(args []string) error {
 	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
 		return err
@@ -31,6 +34,7 @@ func (cmd ServicesCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(instances)
 
 	cmd.displayTable(instances)
 	return nil
@@ -26,6 +26,7 @@ func NewAppSummaryDisplayer(ui command.UI) *AppSummaryDisplayer {
 }
 
 func (display AppSummaryDisplayer) AppDisplay(summary v7action.DetailedApplicationSummary, displayStartCommand bool) {
+	display.UI.DisplayStructuredData(summary)
 	var isoRow []string
 	var keyValueTable [][]string
 	if name, exists := summary.GetIsolationSegmentName(); exists {
@@ -168,16 +169,27 @@ func (display AppSummaryDisplayer) displayProcessTable(summary v7action.Detailed
 			maxInFlightRow = append(maxInFlightRow, display.UI.TranslateText("max-in-flight:"), strconv.Itoa(maxInFlight))
 		}
 
+		var canaryStepsRow []string
+		var canarySteps = summary.Deployment.CanaryStatus.Steps
+		if summary.Deployment.Strategy == constant.DeploymentStrategyCanary && canarySteps.TotalSteps > 0 {
+			canaryStepsRow = append(canaryStepsRow, display.UI.TranslateText("canary-steps:"), fmt.Sprintf("%d/%d", canarySteps.CurrentStep, canarySteps.TotalSteps))
+		}
+
 		keyValueTable := [][]string{
 			{display.UI.TranslateText("strategy:"), strings.ToLower(string(summary.Deployment.Strategy))},
 			maxInFlightRow,
+			canaryStepsRow,
 		}
 
 		display.UI.DisplayKeyValueTable("", keyValueTable, ui.DefaultTableSpacePadding)
 
 		if summary.Deployment.Strategy == constant.DeploymentStrategyCanary && summary.Deployment.StatusReason == constant.DeploymentStatusReasonPaused {
 			display.UI.DisplayNewline()
-			display.UI.DisplayText(fmt.Sprintf("Please run `cf continue-deployment %s` to promote the canary deployment, or `cf cancel-deployment %s` to rollback to the previous version.", summary.Application.Name, summary.Application.Name))
+			if canarySteps.CurrentStep < canarySteps.TotalSteps {
+				display.UI.DisplayText(fmt.Sprintf("Please run `cf continue-deployment %s` to advance the canary deployment to step %d of %d, or `cf cancel-deployment %s` to rollback to the previous version.", summary.Application.Name, canarySteps.CurrentStep+1, canarySteps.TotalSteps, summary.Application.Name))
+			} else {
+				display.UI.DisplayText(fmt.Sprintf("Please run `cf continue-deployment %s` to promote the canary deployment, or `cf cancel-deployment %s` to rollback to the previous version.", summary.Application.Name, summary.Application.Name))
+			}
 		}
 	}
 }
@@ -978,6 +978,63 @@ var _ = Describe("app summary displayer", func() {
 					})
 				})
 
+				When("the deployment is paused at an intermediate step", func() {
+					BeforeEach(func() {
+						summary = v7action.DetailedApplicationSummary{
+							ApplicationSummary: v7action.ApplicationSummary{
+								Application: resources.Application{
+									Name: "foobar",
+								},
+							},
+							Deployment: resources.Deployment{
+								Strategy:         constant.DeploymentStrategyCanary,
+								StatusValue:      constant.DeploymentStatusValueActive,
+								StatusReason:     constant.DeploymentStatusReasonPaused,
+								LastStatusChange: LastStatusChangeTimeString,
+								Options: resources.DeploymentOpts{
+									MaxInFlight: maxInFlightDefaultValue,
+								},
+								CanaryStatus: resources.CanaryStatus{
+									Steps: resources.CanaryStepStatus{CurrentStep: 2, TotalSteps: 4},
+								},
+							},
+						}
+					})
+
+					It("displays the current step and how to advance to the next one", func() {
+						Expect(testUI.Out).To(Say(`strategy:        canary`))
+						Expect(testUI.Out).To(Say(`max-in-flight:   1`))
+						Expect(testUI.Out).To(Say(`canary-steps:    2/4`))
+						Expect(testUI.Out).To(Say("Please run `cf continue-deployment foobar` to advance the canary deployment to step 3 of 4, or `cf cancel-deployment foobar` to rollback to the previous version."))
+					})
+				})
+
+				When("the deployment is paused at the last step", func() {
+					BeforeEach(func() {
+						summary = v7action.DetailedApplicationSummary{
+							ApplicationSummary: v7action.ApplicationSummary{
+								Application: resources.Application{
+									Name: "foobar",
+								},
+							},
+							Deployment: resources.Deployment{
+								Strategy:         constant.DeploymentStrategyCanary,
+								StatusValue:      constant.DeploymentStatusValueActive,
+								StatusReason:     constant.DeploymentStatusReasonPaused,
+								LastStatusChange: LastStatusChangeTimeString,
+								CanaryStatus: resources.CanaryStatus{
+									Steps: resources.CanaryStepStatus{CurrentStep: 4, TotalSteps: 4},
+								},
+							},
+						}
+					})
+
+					It("displays the current step and how to promote the deployment", func() {
+						Expect(testUI.Out).To(Say(`canary-steps:\s+4/4`))
+						Expect(testUI.Out).To(Say("Please run `cf continue-deployment foobar` to promote the canary deployment, or `cf cancel-deployment foobar` to rollback to the previous version."))
+					})
+				})
+
 				When("the deployment is canceling", func() {
 					When("max-in-flight value is non-default", func() {
 						BeforeEach(func() {
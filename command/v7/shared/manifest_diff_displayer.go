@@ -14,9 +14,17 @@ import (
 
 var redacted = "<redacted>"
 
+// SecretSource provides the values of the manifest fields that variable
+// sources such as ((env:NAME)) were interpolated into. They are always
+// redacted from the diff.
+type SecretSource interface {
+	ResolvedSecrets() []string
+}
+
 type ManifestDiffDisplayer struct {
 	UI        command.UI
 	RedactEnv bool
+	Secrets   SecretSource
 }
 
 func redactEnvVarsInRawManifest(rawManifest []byte) ([]byte, error) {
@@ -55,6 +63,15 @@ func (display *ManifestDiffDisplayer) DisplayDiff(rawManifest []byte, diff resou
 		}
 	}
 
+	secrets := display.resolvedSecrets()
+	if len(secrets) > 0 {
+		var err error
+		rawManifest, err = redactSecretsInRawManifest(rawManifest, secrets)
+		if err != nil {
+			return errors.New("unable to process manifest diff because its format is invalid")
+		}
+	}
+
 	// Always display the yaml header line
 	display.UI.DisplayDiffUnchanged("---", 0, false)
 
@@ -152,6 +169,17 @@ func (display *ManifestDiffDisplayer) processDiffsRecursively(
 	// Otherwise, print the unchanged field and value
 	display.UI.DisplayDiffUnchanged(formatKeyValue(field, value), depth, addHyphen)
 }
+
+// RedactManifestDiff replaces the values of all environment variables in the
+// diff with a placeholder.
+func RedactManifestDiff(diff resources.ManifestDiff) resources.ManifestDiff {
+	redactedDiff := resources.ManifestDiff{}
+	for _, d := range diff.Diffs {
+		redactedDiff.Diffs = append(redactedDiff.Diffs, redactDiff(d))
+	}
+	return redactedDiff
+}
+
 func redactDiff(diff resources.Diff) resources.Diff {
 	// there are 3 possible ways that a diff can contain env data
 	// - the diff applies to a path /applications/0/env/key that identifies a changed KV pair in the env object
@@ -183,10 +211,88 @@ func redactDiff(diff resources.Diff) resources.Diff {
 	return diff
 }
 
+// RedactManifestDiffSecrets replaces the values of the diff that are one of
+// the given secrets with a placeholder.
+func RedactManifestDiffSecrets(diff resources.ManifestDiff, secrets []string) resources.ManifestDiff {
+	if len(secrets) == 0 {
+		return diff
+	}
+
+	redactedDiff := resources.ManifestDiff{}
+	for _, d := range diff.Diffs {
+		redactedDiff.Diffs = append(redactedDiff.Diffs, redactSecretsInDiff(d, secrets))
+	}
+	return redactedDiff
+}
+
+func redactSecretsInDiff(diff resources.Diff, secrets []string) resources.Diff {
+	diff.Value = redactSecrets(diff.Value, secrets)
+	diff.Was = redactSecrets(diff.Was, secrets)
+	return diff
+}
+
+func redactSecretsInRawManifest(rawManifest []byte, secrets []string) ([]byte, error) {
+	var manifest yaml.MapSlice
+	if err := yaml.Unmarshal(rawManifest, &manifest); err != nil {
+		return []byte{}, err
+	}
+	return yaml.Marshal(redactSecrets(manifest, secrets))
+}
+
+// redactSecrets returns a copy of value in which the strings that are one of
+// the secrets are replaced. Only whole strings are replaced, so that a short
+// secret does not hide parts of unrelated values.
+func redactSecrets(value interface{}, secrets []string) interface{} {
+	switch typedValue := value.(type) {
+	case string:
+		for _, secret := range secrets {
+			if typedValue == secret {
+				return redacted
+			}
+		}
+		return typedValue
+	case yaml.MapSlice:
+		redactedMapSlice := make(yaml.MapSlice, len(typedValue))
+		for i, item := range typedValue {
+			redactedMapSlice[i] = yaml.MapItem{Key: item.Key, Value: redactSecrets(item.Value, secrets)}
+		}
+		return redactedMapSlice
+	case map[string]interface{}:
+		redactedMap := make(map[string]interface{}, len(typedValue))
+		for key, nestedValue := range typedValue {
+			redactedMap[key] = redactSecrets(nestedValue, secrets)
+		}
+		return redactedMap
+	case map[interface{}]interface{}:
+		redactedMap := make(map[interface{}]interface{}, len(typedValue))
+		for key, nestedValue := range typedValue {
+			redactedMap[key] = redactSecrets(nestedValue, secrets)
+		}
+		return redactedMap
+	case []interface{}:
+		redactedSlice := make([]interface{}, len(typedValue))
+		for i, nestedValue := range typedValue {
+			redactedSlice[i] = redactSecrets(nestedValue, secrets)
+		}
+		return redactedSlice
+	}
+	return value
+}
+
+func (display *ManifestDiffDisplayer) resolvedSecrets() []string {
+	if display.Secrets == nil {
+		return nil
+	}
+	return display.Secrets.ResolvedSecrets()
+}
+
 func (display *ManifestDiffDisplayer) formatDiff(field string, diff resources.Diff, depth int, addHyphen bool) {
 	if display.RedactEnv {
 		diff = redactDiff(diff)
 	}
+	if secrets := display.resolvedSecrets(); len(secrets) > 0 {
+		diff = redactSecretsInDiff(diff, secrets)
+	}
 	addHyphen = isInt(field) || addHyphen
 	switch diff.Op {
 	case resources.AddOperation:
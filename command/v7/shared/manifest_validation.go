@@ -0,0 +1,38 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+// DisplayManifestValidationErrors displays each manifest validation error as
+// a warning prefixed with the path, line and column of the invalid field.
+func DisplayManifestValidationErrors(ui command.UI, pathToManifest string, validationErrors []manifestparser.ValidationError) {
+	for _, validationError := range validationErrors {
+		ui.DisplayWarning("{{.PathToManifest}}:{{.ValidationError}}", map[string]interface{}{
+			"PathToManifest":  pathToManifest,
+			"ValidationError": validationError.Error(),
+		})
+	}
+}
+
+// DisplayInterpolatedManifestValidationErrors displays each validation error
+// of an interpolated manifest as a warning prefixed with the path of the
+// manifest and the invalid field. Lines and columns are left out because they
+// refer to the interpolated manifest rather than the file.
+func DisplayInterpolatedManifestValidationErrors(ui command.UI, pathToManifest string, validationErrors []manifestparser.ValidationError) {
+	for _, validationError := range validationErrors {
+		if validationError.Field == "" {
+			ui.DisplayWarning("{{.PathToManifest}}: {{.Message}}", map[string]interface{}{
+				"PathToManifest": pathToManifest,
+				"Message":        validationError.Message,
+			})
+			continue
+		}
+		ui.DisplayWarning("{{.PathToManifest}}: {{.Field}}: {{.Message}}", map[string]interface{}{
+			"PathToManifest": pathToManifest,
+			"Field":          validationError.Field,
+			"Message":        validationError.Message,
+		})
+	}
+}
@@ -27,6 +27,7 @@ func NewQuotaDisplayer(ui command.UI) QuotaDisplayer {
 }
 
 func (displayer QuotaDisplayer) DisplayQuotasTable(quotas []resources.Quota, emptyMessage string) {
+	displayer.ui.DisplayStructuredData(quotas)
 	if len(quotas) == 0 {
 		displayer.ui.DisplayText(emptyMessage)
 		return
@@ -54,6 +55,7 @@ func (displayer QuotaDisplayer) DisplayQuotasTable(quotas []resources.Quota, emp
 }
 
 func (displayer QuotaDisplayer) DisplaySingleQuota(quota resources.Quota) {
+	displayer.ui.DisplayStructuredData(quota)
 	quotaTable := [][]string{
 		{displayer.ui.TranslateText("total memory:"), displayer.presentQuotaMemoryValue(*quota.Apps.TotalMemory)},
 		{displayer.ui.TranslateText("instance memory:"), displayer.presentQuotaMemoryValue(*quota.Apps.InstanceMemory)},
@@ -0,0 +1,110 @@
+package shared
+
+import (
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	SpaceBundleManifestFile = "manifest.yml"
+	SpaceBundleSpecFile     = "space.yml"
+)
+
+// SpaceBundle is the on-disk form of an exported space: an app manifest and
+// a spec for the routes, service instances and network policies of the space.
+type SpaceBundle struct {
+	Manifest []byte
+	Spec     SpaceSpec
+}
+
+type SpaceSpec struct {
+	Labels               map[string]string                      `yaml:"labels,omitempty"`
+	Routes               []v7action.ExportedRoute               `yaml:"routes,omitempty"`
+	ServiceInstances     []v7action.ExportedServiceInstance     `yaml:"service_instances,omitempty"`
+	UserProvidedServices []v7action.ExportedUserProvidedService `yaml:"user_provided_services,omitempty"`
+	NetworkPolicies      []SpaceNetworkPolicy                   `yaml:"network_policies,omitempty"`
+}
+
+// SpaceNetworkPolicy is a policy allowing traffic from an app in the space.
+// The destination space and org are only set when the destination app is in
+// another space.
+type SpaceNetworkPolicy struct {
+	Source           string `yaml:"source"`
+	Destination      string `yaml:"destination"`
+	Protocol         string `yaml:"protocol"`
+	StartPort        int    `yaml:"start_port"`
+	EndPort          int    `yaml:"end_port"`
+	DestinationSpace string `yaml:"destination_space,omitempty"`
+	DestinationOrg   string `yaml:"destination_org,omitempty"`
+}
+
+// WriteSpaceBundle writes the bundle into dir, creating dir if it does not
+// exist.
+func WriteSpaceBundle(dir string, bundle SpaceBundle) error {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+
+	// The manifest can hold environment variables with secrets and the spec
+	// can hold service credentials, so only the owner may read them.
+	err = writeOwnerOnlyFile(filepath.Join(dir, SpaceBundleManifestFile), bundle.Manifest)
+	if err != nil {
+		return err
+	}
+
+	spec, err := yaml.Marshal(bundle.Spec)
+	if err != nil {
+		return err
+	}
+
+	return writeOwnerOnlyFile(filepath.Join(dir, SpaceBundleSpecFile), spec)
+}
+
+// writeOwnerOnlyFile writes data to a temporary file readable only by its
+// owner and moves it to path, so that a file exported earlier with a wider
+// mode is replaced rather than keeping its mode.
+func writeOwnerOnlyFile(path string, data []byte) error {
+	tempFile, err := os.CreateTemp(filepath.Dir(path), "temp-bundle")
+	if err != nil {
+		return err
+	}
+	tempFile.Close()
+
+	err = os.WriteFile(tempFile.Name(), data, 0600)
+	if err != nil {
+		_ = os.Remove(tempFile.Name())
+		return err
+	}
+	return os.Rename(tempFile.Name(), path)
+}
+
+// ReadSpaceBundle reads a bundle written by WriteSpaceBundle. Either file may
+// be missing, in which case that part of the bundle is empty.
+func ReadSpaceBundle(dir string) (SpaceBundle, error) {
+	var bundle SpaceBundle
+
+	manifest, err := os.ReadFile(filepath.Join(dir, SpaceBundleManifestFile))
+	if err != nil && !os.IsNotExist(err) {
+		return SpaceBundle{}, err
+	}
+	bundle.Manifest = manifest
+
+	spec, err := os.ReadFile(filepath.Join(dir, SpaceBundleSpecFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return bundle, nil
+		}
+		return SpaceBundle{}, err
+	}
+
+	err = yaml.UnmarshalStrict(spec, &bundle.Spec)
+	if err != nil {
+		return SpaceBundle{}, err
+	}
+
+	return bundle, nil
+}
@@ -0,0 +1,120 @@
+package shared_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	. "code.cloudfoundry.org/cli/command/v7/shared"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space bundles", func() {
+	var (
+		dir    string
+		bundle SpaceBundle
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "space-bundle")
+		Expect(err).NotTo(HaveOccurred())
+
+		bundle = SpaceBundle{
+			Manifest: []byte("applications:\n- name: some-app\n"),
+			Spec: SpaceSpec{
+				Labels: map[string]string{"env": "staging"},
+				Routes: []v7action.ExportedRoute{{Host: "www", Domain: "example.com"}},
+				ServiceInstances: []v7action.ExportedServiceInstance{{
+					Name:       "db",
+					Offering:   "postgres",
+					Plan:       "small",
+					Parameters: map[string]interface{}{"size": "10G"},
+				}},
+				UserProvidedServices: []v7action.ExportedUserProvidedService{{
+					Name:        "ups",
+					Credentials: map[string]interface{}{"password": "secret"},
+				}},
+				NetworkPolicies: []SpaceNetworkPolicy{{
+					Source:      "frontend",
+					Destination: "backend",
+					Protocol:    "tcp",
+					StartPort:   8080,
+					EndPort:     8080,
+				}},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("writes a bundle that can be read back", func() {
+		bundleDir := filepath.Join(dir, "export")
+		Expect(WriteSpaceBundle(bundleDir, bundle)).To(Succeed())
+
+		manifest, err := os.ReadFile(filepath.Join(bundleDir, "manifest.yml"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(manifest).To(Equal(bundle.Manifest))
+
+		for _, file := range []string{"manifest.yml", "space.yml"} {
+			info, err := os.Stat(filepath.Join(bundleDir, file))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)), file)
+		}
+
+		readBundle, err := ReadSpaceBundle(bundleDir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(readBundle).To(Equal(bundle))
+	})
+
+	When("the bundle directory already has files that others can read", func() {
+		BeforeEach(func() {
+			Expect(os.WriteFile(filepath.Join(dir, "manifest.yml"), []byte("applications: []\n"), 0644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "space.yml"), []byte("routes: []\n"), 0644)).To(Succeed())
+		})
+
+		It("overwrites them with files that only the owner can read", func() {
+			Expect(WriteSpaceBundle(dir, bundle)).To(Succeed())
+
+			for _, file := range []string{"manifest.yml", "space.yml"} {
+				info, err := os.Stat(filepath.Join(dir, file))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)), file)
+			}
+
+			entries, err := os.ReadDir(dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(2))
+
+			readBundle, err := ReadSpaceBundle(dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(readBundle).To(Equal(bundle))
+		})
+	})
+
+	When("the bundle has no spec", func() {
+		BeforeEach(func() {
+			Expect(os.WriteFile(filepath.Join(dir, "manifest.yml"), bundle.Manifest, 0644)).To(Succeed())
+		})
+
+		It("reads only the manifest", func() {
+			readBundle, err := ReadSpaceBundle(dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(readBundle).To(Equal(SpaceBundle{Manifest: bundle.Manifest}))
+		})
+	})
+
+	When("the spec has unknown keys", func() {
+		BeforeEach(func() {
+			Expect(os.WriteFile(filepath.Join(dir, "space.yml"), []byte("rouets: []\n"), 0600)).To(Succeed())
+		})
+
+		It("returns an error", func() {
+			_, err := ReadSpaceBundle(dir)
+			Expect(err).To(MatchError(ContainSubstring("rouets")))
+		})
+	})
+})
@@ -0,0 +1,86 @@
+package v7
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type SidecarsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME sidecars APP_NAME"`
+	relatedCommands interface{}  `related_commands:"app, create-sidecar, delete-sidecar, update-sidecar"`
+}
+
+// StructuredOutput marks sidecars as displaying its results with --output.
+func (cmd SidecarsCommand) StructuredOutput() {}
+
+func (cmd SidecarsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting sidecars for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	sidecars, warnings, err := cmd.Actor.GetApplicationSidecarsByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayStructuredData(sidecars)
+
+	if len(sidecars) == 0 {
+		cmd.UI.DisplayText("No sidecars found.")
+		return nil
+	}
+
+	cmd.displayTable(sidecars)
+
+	return nil
+}
+
+func (cmd SidecarsCommand) displayTable(sidecars []resources.Sidecar) {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("process types"),
+			cmd.UI.TranslateText("memory"),
+			cmd.UI.TranslateText("origin"),
+			cmd.UI.TranslateText("command"),
+		},
+	}
+
+	for _, sidecar := range sidecars {
+		var memory string
+		if sidecar.MemoryInMB.IsSet {
+			memory = fmt.Sprintf("%dM", sidecar.MemoryInMB.Value)
+		}
+
+		table = append(table, []string{
+			sidecar.Name,
+			strings.Join(sidecar.ProcessTypes, ", "),
+			memory,
+			sidecar.Origin,
+			sidecar.Command.Value,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
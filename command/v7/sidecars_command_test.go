@@ -0,0 +1,135 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("sidecars Command", func() {
+	var (
+		cmd             v7.SidecarsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.SidecarsCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		cmd.RequiredArgs.AppName = "some-app"
+
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the app has sidecars", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSidecarsByNameAndSpaceReturns(
+				[]resources.Sidecar{
+					{
+						Name:         "envoy",
+						Command:      types.FilteredString{IsSet: true, Value: "start-envoy"},
+						ProcessTypes: []string{"web", "worker"},
+						MemoryInMB:   types.NullUint64{IsSet: true, Value: 64},
+						Origin:       "user",
+					},
+					{
+						Name:         "agent",
+						Command:      types.FilteredString{IsSet: true, Value: "start-agent"},
+						ProcessTypes: []string{"web"},
+						Origin:       "buildpack",
+					},
+				},
+				v7action.Warnings{"get-sidecars-warning"},
+				nil,
+			)
+		})
+
+		It("displays the sidecars", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting sidecars for app some-app in org some-org / space some-space as banana\.\.\.`))
+			Expect(testUI.Out).To(Say(`name\s+process types\s+memory\s+origin\s+command`))
+			Expect(testUI.Out).To(Say(`envoy\s+web, worker\s+64M\s+user\s+start-envoy`))
+			Expect(testUI.Out).To(Say(`agent\s+web\s+buildpack\s+start-agent`))
+			Expect(testUI.Err).To(Say("get-sidecars-warning"))
+
+			Expect(fakeActor.GetApplicationSidecarsByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetApplicationSidecarsByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+	})
+
+	When("the app has no sidecars", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSidecarsByNameAndSpaceReturns(nil, v7action.Warnings{"get-sidecars-warning"}, nil)
+		})
+
+		It("says that no sidecars were found", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("No sidecars found."))
+			Expect(testUI.Err).To(Say("get-sidecars-warning"))
+		})
+	})
+
+	When("getting the sidecars fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSidecarsByNameAndSpaceReturns(nil, v7action.Warnings{"get-sidecars-warning"}, errors.New("get-sidecars-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("get-sidecars-error"))
+			Expect(testUI.Err).To(Say("get-sidecars-warning"))
+		})
+	})
+})
@@ -20,6 +20,9 @@ type SpaceCommand struct {
 	relatedCommands    interface{} `related_commands:"set-space-isolation-segment, space-quota, space-users"`
 }
 
+// StructuredOutput marks space as displaying its results with --output.
+func (cmd SpaceCommand) StructuredOutput() {}
+
 func (cmd SpaceCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, false)
 	if err != nil {
@@ -50,6 +53,7 @@ func (cmd SpaceCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(spaceSummary)
 	table := [][]string{
 		{cmd.UI.TranslateText("name:"), spaceSummary.Name},
 		{cmd.UI.TranslateText("org:"), spaceSummary.OrgName},
@@ -14,6 +14,9 @@ type SpaceQuotaCommand struct {
 	relatedCommands interface{}     `related_commands:"space, space-quotas"`
 }
 
+// StructuredOutput marks space-quota as displaying its results with --output.
+func (cmd SpaceQuotaCommand) StructuredOutput() {}
+
 func (cmd SpaceQuotaCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, false)
 	if err != nil {
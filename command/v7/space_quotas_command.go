@@ -12,6 +12,9 @@ type SpaceQuotasCommand struct {
 	relatedCommands interface{} `related_commands:"space-quota, set-space-quota"`
 }
 
+// StructuredOutput marks space-quotas as displaying its results with --output.
+func (cmd SpaceQuotasCommand) StructuredOutput() {}
+
 func (cmd SpaceQuotasCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, false)
 	if err != nil {
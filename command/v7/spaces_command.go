@@ -13,6 +13,9 @@ type SpacesCommand struct {
 	Labels          string      `long:"labels" description:"Selector to filter spaces by labels"`
 }
 
+// StructuredOutput marks spaces as displaying its results with --output.
+func (cmd SpacesCommand) StructuredOutput() {}
+
 func (cmd SpacesCommand) Execute([]string) error {
 	err := cmd.SharedActor.CheckTarget(true, false)
 	if err != nil {
@@ -35,6 +38,7 @@ func (cmd SpacesCommand) Execute([]string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(spaces)
 
 	if len(spaces) == 0 {
 		cmd.UI.DisplayText("No spaces found.")
@@ -1,39 +1,50 @@
 package v7
 
 import (
+	"fmt"
+	"io"
+	"strings"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SharedSSHActor
 
 type SharedSSHActor interface {
 	ExecuteSecureShell(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions) error
+	ExecuteSecureShellOnInstances(newClient func() sharedaction.SecureShellClient, instances []sharedaction.InstanceSSHOptions, maxInFlight int, stdout io.Writer, stderr io.Writer) []sharedaction.InstanceSSHResult
 }
 
 type SSHCommand struct {
 	BaseCommand
 
-	RequiredArgs          flag.AppName             `positional-args:"yes"`
-	ProcessIndex          uint                     `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
-	Commands              []string                 `long:"command" short:"c" description:"Command to run"`
-	DisablePseudoTTY      bool                     `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
-	ForcePseudoTTY        bool                     `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
-	LocalPortForwardSpecs []flag.SSHPortForwarding `short:"L" description:"Local port forward specification"`
-	ProcessType           string                   `long:"process" default:"web" description:"App process name"`
-	RequestPseudoTTY      bool                     `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
-	SkipHostValidation    bool                     `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
-	SkipRemoteExecution   bool                     `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
-
-	usage           interface{} `usage:"CF_NAME ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]...\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]"`
+	RequiredArgs            flag.AppName                    `positional-args:"yes"`
+	AllInstances            bool                            `long:"all-instances" description:"Run the command on every instance of the process in parallel"`
+	ProcessIndex            uint                            `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
+	Commands                []string                        `long:"command" short:"c" description:"Command to run"`
+	DisablePseudoTTY        bool                            `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
+	ForcePseudoTTY          bool                            `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
+	LocalPortForwardSpecs   []flag.SSHPortForwarding        `short:"L" description:"Local port forward specification"`
+	MaxInFlight             *int                            `long:"max-in-flight" description:"Maximum number of instances to run the command on at once (Default: 10). Only applies when --all-instances flag is specified."`
+	ProcessType             string                          `long:"process" default:"web" description:"App process name"`
+	RemotePortForwardSpecs  []flag.SSHRemotePortForwarding  `short:"R" description:"Remote port forward specification"`
+	DynamicPortForwardSpecs []flag.SSHDynamicPortForwarding `short:"D" description:"Dynamic SOCKS5 port forward specification"`
+	RequestPseudoTTY        bool                            `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
+	SkipHostValidation      bool                            `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+	SkipRemoteExecution     bool                            `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
+
+	usage           interface{} `usage:"CF_NAME ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]...\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]... [-R [BIND_ADDRESS:]REMOTE_PORT:LOCAL_HOST:LOCAL_PORT]...\n   [-D [BIND_ADDRESS:]LOCAL_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]\n   CF_NAME ssh APP_NAME --all-instances [--process PROCESS] -c COMMAND... [--max-in-flight MAX_IN_FLIGHT]\n   [--skip-host-validation]\n\nEXAMPLES:\n   CF_NAME ssh my-app --all-instances -c \"ps aux\"\n   CF_NAME ssh my-app --process worker --all-instances --max-in-flight 3 -c \"netstat -tlnp\""`
 	relatedCommands interface{} `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
 	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
 
-	SSHActor  SharedSSHActor
-	SSHClient *clissh.SecureShell
+	SSHActor     SharedSSHActor
+	SSHClient    *clissh.SecureShell
+	NewSSHClient func() sharedaction.SecureShellClient
 }
 
 func (cmd *SSHCommand) Setup(config command.Config, ui command.UI) error {
@@ -46,6 +57,9 @@ func (cmd *SSHCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.SharedActor = sharedActor
 	cmd.SSHActor = sharedActor
 	cmd.SSHClient = clissh.NewDefaultSecureShell()
+	cmd.NewSSHClient = func() sharedaction.SecureShellClient {
+		return clissh.NewDefaultSecureShell()
+	}
 
 	return nil
 }
@@ -57,6 +71,15 @@ func (cmd SSHCommand) Execute(args []string) error {
 		return err
 	}
 
+	err = cmd.validateAllInstancesFlags()
+	if err != nil {
+		return err
+	}
+
+	if cmd.AllInstances {
+		return cmd.executeOnAllInstances()
+	}
+
 	ttyOption, err := cmd.EvaluateTTYOption()
 	if err != nil {
 		return err
@@ -67,6 +90,16 @@ func (cmd SSHCommand) Execute(args []string) error {
 		forwardSpecs = append(forwardSpecs, sharedaction.LocalPortForward(spec))
 	}
 
+	var remoteForwardSpecs []sharedaction.RemotePortForward
+	for _, spec := range cmd.RemotePortForwardSpecs {
+		remoteForwardSpecs = append(remoteForwardSpecs, sharedaction.RemotePortForward(spec))
+	}
+
+	var dynamicForwardSpecs []sharedaction.DynamicPortForward
+	for _, spec := range cmd.DynamicPortForwardSpecs {
+		dynamicForwardSpecs = append(dynamicForwardSpecs, sharedaction.DynamicPortForward(spec))
+	}
+
 	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
 		cmd.RequiredArgs.AppName,
 		cmd.Config.TargetedSpace().GUID,
@@ -81,15 +114,17 @@ func (cmd SSHCommand) Execute(args []string) error {
 	err = cmd.SSHActor.ExecuteSecureShell(
 		cmd.SSHClient,
 		sharedaction.SSHOptions{
-			Commands:              cmd.Commands,
-			Endpoint:              sshAuth.Endpoint,
-			HostKeyFingerprint:    sshAuth.HostKeyFingerprint,
-			LocalPortForwardSpecs: forwardSpecs,
-			Passcode:              sshAuth.Passcode,
-			SkipHostValidation:    cmd.SkipHostValidation,
-			SkipRemoteExecution:   cmd.SkipRemoteExecution,
-			TTYOption:             ttyOption,
-			Username:              sshAuth.Username,
+			Commands:                cmd.Commands,
+			DynamicPortForwardSpecs: dynamicForwardSpecs,
+			Endpoint:                sshAuth.Endpoint,
+			HostKeyFingerprint:      sshAuth.HostKeyFingerprint,
+			LocalPortForwardSpecs:   forwardSpecs,
+			Passcode:                sshAuth.Passcode,
+			RemotePortForwardSpecs:  remoteForwardSpecs,
+			SkipHostValidation:      cmd.SkipHostValidation,
+			SkipRemoteExecution:     cmd.SkipRemoteExecution,
+			TTYOption:               ttyOption,
+			Username:                sshAuth.Username,
 		})
 	if err != nil {
 		return err
@@ -98,6 +133,154 @@ func (cmd SSHCommand) Execute(args []string) error {
 	return nil
 }
 
+func (cmd SSHCommand) validateAllInstancesFlags() error {
+	if !cmd.AllInstances {
+		if cmd.MaxInFlight != nil {
+			return translatableerror.RequiredFlagsError{Arg1: "--max-in-flight", Arg2: "--all-instances"}
+		}
+		return nil
+	}
+
+	if cmd.MaxInFlight != nil && *cmd.MaxInFlight < 1 {
+		return translatableerror.IncorrectUsageError{Message: "--max-in-flight must be greater than or equal to 1"}
+	}
+
+	if len(cmd.Commands) == 0 {
+		return translatableerror.RequiredFlagsError{Arg1: "--all-instances", Arg2: "--command"}
+	}
+
+	conflictingFlags := []struct {
+		used bool
+		name string
+	}{
+		{len(cmd.LocalPortForwardSpecs) > 0, "-L"},
+		{len(cmd.RemotePortForwardSpecs) > 0, "-R"},
+		{len(cmd.DynamicPortForwardSpecs) > 0, "-D"},
+		{cmd.SkipRemoteExecution, "--skip-remote-execution"},
+		{cmd.DisablePseudoTTY, "--disable-pseudo-tty"},
+		{cmd.ForcePseudoTTY, "--force-pseudo-tty"},
+		{cmd.RequestPseudoTTY, "--request-pseudo-tty"},
+	}
+	for _, conflictingFlag := range conflictingFlags {
+		if conflictingFlag.used {
+			return translatableerror.ArgumentCombinationError{Args: []string{"--all-instances", conflictingFlag.name}}
+		}
+	}
+
+	return nil
+}
+
+func (cmd SSHCommand) executeOnAllInstances() error {
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	instanceAuths, warnings, err := cmd.Actor.GetSecureShellConfigurationsForAllInstances(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	templateValues := map[string]interface{}{
+		"InstanceCount": len(instanceAuths),
+		"ProcessType":   cmd.ProcessType,
+		"AppName":       cmd.RequiredArgs.AppName,
+		"OrgName":       cmd.Config.TargetedOrganization().Name,
+		"SpaceName":     cmd.Config.TargetedSpace().Name,
+		"Username":      user.Name,
+	}
+
+	if len(instanceAuths) == 0 {
+		cmd.UI.DisplayText("Process {{.ProcessType}} of app {{.AppName}} has no instances.", templateValues)
+		return nil
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Running command on {{.InstanceCount}} instances of process {{.ProcessType}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+	cmd.UI.DisplayNewline()
+
+	var instances []sharedaction.InstanceSSHOptions
+	for _, instanceAuth := range instanceAuths {
+		if instanceAuth.Username == "" {
+			continue
+		}
+
+		instances = append(instances, sharedaction.InstanceSSHOptions{
+			Index:       instanceAuth.Index,
+			GetPasscode: cmd.Actor.GetSSHPasscode,
+			SSHOptions: sharedaction.SSHOptions{
+				Commands:           cmd.Commands,
+				Endpoint:           instanceAuth.Endpoint,
+				HostKeyFingerprint: instanceAuth.HostKeyFingerprint,
+				SkipHostValidation: cmd.SkipHostValidation,
+				Username:           instanceAuth.Username,
+			},
+		})
+	}
+
+	maxInFlight := sharedaction.DefaultSSHMaxInFlight
+	if cmd.MaxInFlight != nil {
+		maxInFlight = *cmd.MaxInFlight
+	}
+
+	resultsByIndex := map[int64]sharedaction.InstanceSSHResult{}
+	for _, result := range cmd.SSHActor.ExecuteSecureShellOnInstances(cmd.NewSSHClient, instances, maxInFlight, cmd.UI.GetOut(), cmd.UI.GetErr()) {
+		resultsByIndex[result.Index] = result
+	}
+
+	var failures [][]string
+	exitStatus := 0
+	for _, instanceAuth := range instanceAuths {
+		result, ran := resultsByIndex[instanceAuth.Index]
+		switch {
+		case !ran:
+			failures = append(failures, []string{
+				fmt.Sprint(instanceAuth.Index),
+				"",
+				cmd.UI.TranslateText("instance is {{.State}}", map[string]interface{}{"State": strings.ToLower(string(instanceAuth.State))}),
+			})
+			result.ExitStatus = sharedaction.SSHConnectionFailedExitStatus
+		case result.Failed():
+			failures = append(failures, []string{
+				fmt.Sprint(instanceAuth.Index),
+				fmt.Sprint(result.ExitStatus),
+				result.Err.Error(),
+			})
+		}
+
+		if result.ExitStatus > exitStatus {
+			exitStatus = result.ExitStatus
+		}
+	}
+
+	if len(failures) == 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("instance"),
+		cmd.UI.TranslateText("exit status"),
+		cmd.UI.TranslateText("details"),
+	}}
+	table = append(table, failures...)
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	return translatableerror.SSHInstancesFailedError{
+		FailedCount:   len(failures),
+		InstanceCount: len(instanceAuths),
+		ExitStatus:    exitStatus,
+	}
+}
+
 // EvaluateTTYOption determines which TTY options are mutually exclusive and
 // returns an error accordingly.
 func (cmd SSHCommand) EvaluateTTYOption() (sharedaction.TTYOption, error) {
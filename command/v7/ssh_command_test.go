@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
@@ -127,6 +128,30 @@ var _ = Describe("ssh Command", func() {
 						}))
 					})
 
+					When("working with remote and dynamic port forwarding", func() {
+						BeforeEach(func() {
+							cmd.RemotePortForwardSpecs = []flag.SSHRemotePortForwarding{
+								{RemoteAddress: "localhost:9229", LocalAddress: "localhost:9229"},
+							}
+							cmd.DynamicPortForwardSpecs = []flag.SSHDynamicPortForwarding{
+								{LocalAddress: "localhost:1080"},
+							}
+						})
+
+						It("passes along port forwarding information", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(1))
+							_, sshOptionsArg := fakeSSHActor.ExecuteSecureShellArgsForCall(0)
+							Expect(sshOptionsArg.RemotePortForwardSpecs).To(Equal([]sharedaction.RemotePortForward{
+								{RemoteAddress: "localhost:9229", LocalAddress: "localhost:9229"},
+							}))
+							Expect(sshOptionsArg.DynamicPortForwardSpecs).To(Equal([]sharedaction.DynamicPortForward{
+								{LocalAddress: "localhost:1080"},
+							}))
+						})
+					})
+
 					When("working with local port forwarding", func() {
 						BeforeEach(func() {
 							cmd.LocalPortForwardSpecs = []flag.SSHPortForwarding{
@@ -183,6 +208,196 @@ var _ = Describe("ssh Command", func() {
 					Expect(testUI.Err).To(Say("some-warnings"))
 				})
 			})
+
+			When("running on all instances", func() {
+				BeforeEach(func() {
+					cmd.AllInstances = true
+					cmd.SkipRemoteExecution = false
+					cmd.NewSSHClient = func() sharedaction.SecureShellClient { return nil }
+
+					fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+					fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+					fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+					fakeActor.GetSecureShellConfigurationsForAllInstancesReturns(
+						[]v7action.InstanceSSHAuthentication{
+							{
+								Index: 0,
+								State: constant.ProcessInstanceRunning,
+								SSHAuthentication: v7action.SSHAuthentication{
+									Endpoint:           "some-endpoint",
+									HostKeyFingerprint: "some-fingerprint",
+									Username:           "cf:process-guid/0",
+								},
+							},
+							{Index: 1, State: constant.ProcessInstanceCrashed},
+							{
+								Index: 2,
+								State: constant.ProcessInstanceRunning,
+								SSHAuthentication: v7action.SSHAuthentication{
+									Endpoint:           "some-endpoint",
+									HostKeyFingerprint: "some-fingerprint",
+									Username:           "cf:process-guid/2",
+								},
+							},
+						},
+						v7action.Warnings{"some-warnings"},
+						nil)
+					fakeActor.GetSSHPasscodeReturns("some-passcode", nil)
+					fakeSSHActor.ExecuteSecureShellOnInstancesReturns([]sharedaction.InstanceSSHResult{
+						{Index: 0},
+						{Index: 2, ExitStatus: 3, Err: errors.New("Process exited with status 3")},
+					})
+				})
+
+				It("runs the command on every running instance with a passcode fetched when connecting", func() {
+					Expect(fakeActor.GetSecureShellConfigurationsForAllInstancesCallCount()).To(Equal(1))
+					appNameArg, spaceGUIDArg, processTypeArg := fakeActor.GetSecureShellConfigurationsForAllInstancesArgsForCall(0)
+					Expect(appNameArg).To(Equal(appName))
+					Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+					Expect(processTypeArg).To(Equal("some-process-type"))
+					Expect(testUI.Err).To(Say("some-warnings"))
+
+					Expect(testUI.Out).To(Say(`Running command on 3 instances of process some-process-type of app some-app in org some-org / space some-space as some-user\.\.\.`))
+
+					Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(0))
+					Expect(fakeSSHActor.ExecuteSecureShellOnInstancesCallCount()).To(Equal(1))
+					_, instances, maxInFlight, stdout, stderr := fakeSSHActor.ExecuteSecureShellOnInstancesArgsForCall(0)
+					Expect(maxInFlight).To(Equal(sharedaction.DefaultSSHMaxInFlight))
+					Expect(stdout).To(Equal(testUI.Out))
+					Expect(stderr).To(Equal(testUI.Err))
+
+					Expect(fakeActor.GetSSHPasscodeCallCount()).To(Equal(0))
+					for i := range instances {
+						Expect(instances[i].GetPasscode).ToNot(BeNil())
+						Expect(instances[i].GetPasscode()).To(Equal("some-passcode"))
+						instances[i].GetPasscode = nil
+					}
+					Expect(fakeActor.GetSSHPasscodeCallCount()).To(Equal(2))
+					Expect(instances).To(Equal([]sharedaction.InstanceSSHOptions{
+						{
+							Index: 0,
+							SSHOptions: sharedaction.SSHOptions{
+								Commands:           []string{"some", "commands"},
+								Endpoint:           "some-endpoint",
+								HostKeyFingerprint: "some-fingerprint",
+								SkipHostValidation: true,
+								Username:           "cf:process-guid/0",
+							},
+						},
+						{
+							Index: 2,
+							SSHOptions: sharedaction.SSHOptions{
+								Commands:           []string{"some", "commands"},
+								Endpoint:           "some-endpoint",
+								HostKeyFingerprint: "some-fingerprint",
+								SkipHostValidation: true,
+								Username:           "cf:process-guid/2",
+							},
+						},
+					}))
+				})
+
+				It("summarizes the failed instances and returns the combined exit status", func() {
+					Expect(testUI.Out).To(Say(`instance\s+exit status\s+details`))
+					Expect(testUI.Out).To(Say(`1\s+instance is crashed`))
+					Expect(testUI.Out).To(Say(`2\s+3\s+Process exited with status 3`))
+
+					Expect(executeErr).To(MatchError(translatableerror.SSHInstancesFailedError{
+						FailedCount:   2,
+						InstanceCount: 3,
+						ExitStatus:    sharedaction.SSHConnectionFailedExitStatus,
+					}))
+				})
+
+				When("the command succeeds on every instance", func() {
+					BeforeEach(func() {
+						maxInFlight := 2
+						cmd.MaxInFlight = &maxInFlight
+						fakeActor.GetSecureShellConfigurationsForAllInstancesReturns(
+							[]v7action.InstanceSSHAuthentication{
+								{Index: 0, State: constant.ProcessInstanceRunning, SSHAuthentication: v7action.SSHAuthentication{Username: "cf:process-guid/0"}},
+								{Index: 1, State: constant.ProcessInstanceRunning, SSHAuthentication: v7action.SSHAuthentication{Username: "cf:process-guid/1"}},
+							},
+							nil,
+							nil)
+						fakeSSHActor.ExecuteSecureShellOnInstancesReturns([]sharedaction.InstanceSSHResult{{Index: 0}, {Index: 1, ExitStatus: 0}})
+					})
+
+					It("uses the given concurrency limit and displays OK", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						_, _, maxInFlight, _, _ := fakeSSHActor.ExecuteSecureShellOnInstancesArgsForCall(0)
+						Expect(maxInFlight).To(Equal(2))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Out).ToNot(Say("exit status"))
+					})
+				})
+
+				When("the process has no instances", func() {
+					BeforeEach(func() {
+						fakeActor.GetSecureShellConfigurationsForAllInstancesReturns(nil, nil, nil)
+					})
+
+					It("says so without running anything", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say("Process some-process-type of app some-app has no instances."))
+						Expect(fakeSSHActor.ExecuteSecureShellOnInstancesCallCount()).To(Equal(0))
+					})
+				})
+
+				When("getting the instances fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetSecureShellConfigurationsForAllInstancesReturns(nil, v7action.Warnings{"some-warnings"}, errors.New("some-error"))
+					})
+
+					It("returns the error and displays all warnings", func() {
+						Expect(executeErr).To(MatchError("some-error"))
+						Expect(testUI.Err).To(Say("some-warnings"))
+						Expect(fakeSSHActor.ExecuteSecureShellOnInstancesCallCount()).To(Equal(0))
+					})
+				})
+
+				When("no command is given", func() {
+					BeforeEach(func() {
+						cmd.Commands = nil
+					})
+
+					It("returns a required flags error", func() {
+						Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--all-instances", Arg2: "--command"}))
+					})
+				})
+
+				When("--max-in-flight is less than 1", func() {
+					BeforeEach(func() {
+						maxInFlight := 0
+						cmd.MaxInFlight = &maxInFlight
+					})
+
+					It("returns an incorrect usage error", func() {
+						Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "--max-in-flight must be greater than or equal to 1"}))
+					})
+				})
+
+				When("port forwarding is requested", func() {
+					BeforeEach(func() {
+						cmd.LocalPortForwardSpecs = []flag.SSHPortForwarding{{LocalAddress: "localhost:8080", RemoteAddress: "localhost:8080"}}
+					})
+
+					It("returns an argument combination error", func() {
+						Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--all-instances", "-L"}}))
+					})
+				})
+			})
+
+			When("--max-in-flight is given without --all-instances", func() {
+				BeforeEach(func() {
+					maxInFlight := 2
+					cmd.MaxInFlight = &maxInFlight
+				})
+
+				It("returns a required flags error", func() {
+					Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--max-in-flight", Arg2: "--all-instances"}))
+				})
+			})
 		})
 	})
 
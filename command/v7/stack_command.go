@@ -14,6 +14,9 @@ type StackCommand struct {
 	relatedCommands interface{}    `related_commands:"app, push, stacks"`
 }
 
+// StructuredOutput marks stack as displaying its results with --output.
+func (cmd *StackCommand) StructuredOutput() {}
+
 func (cmd *StackCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -59,6 +62,7 @@ func (cmd *StackCommand) displayStackInfo() error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(stack)
 
 	cmd.UI.DisplayKeyValueTable("", [][]string{
 		{cmd.UI.TranslateText("name:"), stack.Name},
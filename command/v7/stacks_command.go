@@ -16,6 +16,9 @@ type StacksCommand struct {
 	Labels          string      `long:"labels" description:"Selector to filter stacks by labels"`
 }
 
+// StructuredOutput marks stacks as displaying its results with --output.
+func (cmd StacksCommand) StructuredOutput() {}
+
 func (cmd StacksCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -39,6 +42,7 @@ func (cmd StacksCommand) Execute(args []string) error {
 	}
 
 	sort.Slice(stacks, func(i, j int) bool { return sorting.LessIgnoreCase(stacks[i].Name, stacks[j].Name) })
+	cmd.UI.DisplayStructuredData(stacks)
 
 	cmd.displayTable(stacks)
 
@@ -40,6 +40,9 @@ func (cmd *StagePackageCommand) Setup(config command.Config, ui command.UI) erro
 	return nil
 }
 
+// StructuredOutput marks stage-package as displaying its results with --output.
+func (cmd StagePackageCommand) StructuredOutput() {}
+
 func (cmd StagePackageCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -94,6 +97,7 @@ func (cmd StagePackageCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(droplet)
 
 	cmd.UI.DisplayNewline()
 	cmd.UI.DisplayText("Package staged")
@@ -13,6 +13,10 @@ type StagingEnvironmentVariableGroupCommand struct {
 	relatedCommands interface{} `related_commands:"env, running-environment-variable-group"`
 }
 
+// StructuredOutput marks staging-environment-variable-group as displaying its results with
+// --output.
+func (cmd StagingEnvironmentVariableGroupCommand) StructuredOutput() {}
+
 func (cmd StagingEnvironmentVariableGroupCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -34,6 +38,7 @@ func (cmd StagingEnvironmentVariableGroupCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(envVars)
 
 	if len(envVars) == 0 {
 		cmd.UI.DisplayTextWithFlavor("No staging environment variable group has been set.")
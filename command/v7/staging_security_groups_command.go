@@ -11,6 +11,10 @@ type StagingSecurityGroupsCommand struct {
 	relatedCommands interface{} `related_commands:"bind-staging-security-group, security-group, unbind-staging-security-group"`
 }
 
+// StructuredOutput marks staging-security-groups as displaying its results with
+// --output.
+func (cmd StagingSecurityGroupsCommand) StructuredOutput() {}
+
 func (cmd StagingSecurityGroupsCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -32,6 +36,7 @@ func (cmd StagingSecurityGroupsCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(stagingSecurityGroups)
 
 	if len(stagingSecurityGroups) == 0 {
 		cmd.UI.DisplayText("No global staging security groups found.")
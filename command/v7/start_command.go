@@ -39,6 +39,9 @@ func (cmd *StartCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+// StructuredOutput marks start as displaying its results with --output.
+func (cmd StartCommand) StructuredOutput() {}
+
 func (cmd StartCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
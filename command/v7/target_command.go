@@ -16,6 +16,9 @@ type TargetCommand struct {
 	relatedCommands interface{} `related_commands:"create-org, create-space, login, orgs, spaces"`
 }
 
+// StructuredOutput marks target as displaying its results with --output.
+func (cmd *TargetCommand) StructuredOutput() {}
+
 func (cmd *TargetCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -145,8 +148,25 @@ func (cmd *TargetCommand) setSpace() error {
 	return nil
 }
 
+// targetSummary is what is displayed about the target as structured data.
+type targetSummary struct {
+	APIEndpoint  string `json:"api_endpoint"`
+	APIVersion   string `json:"api_version"`
+	User         string `json:"user,omitempty"`
+	Organization string `json:"org,omitempty"`
+	Space        string `json:"space,omitempty"`
+}
+
 // displayTargetTable neatly displays target information.
 func (cmd *TargetCommand) displayTargetTable(user configv3.User) {
+	cmd.UI.DisplayStructuredData(targetSummary{
+		APIEndpoint:  cmd.Config.Target(),
+		APIVersion:   cmd.Config.APIVersion(),
+		User:         user.Name,
+		Organization: cmd.Config.TargetedOrganization().Name,
+		Space:        cmd.Config.TargetedSpace().Name,
+	})
+
 	table := [][]string{
 		{cmd.UI.TranslateText("API endpoint:"), cmd.Config.Target()},
 		{cmd.UI.TranslateText("API version:"), cmd.Config.APIVersion()},
@@ -17,6 +17,9 @@ type TasksCommand struct {
 	relatedCommands interface{}  `related_commands:"apps, logs, run-task, terminate-task"`
 }
 
+// StructuredOutput marks tasks as displaying its results with --output.
+func (cmd TasksCommand) StructuredOutput() {}
+
 func (cmd TasksCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -49,6 +52,7 @@ func (cmd TasksCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+	cmd.UI.DisplayStructuredData(tasks)
 
 	if len(tasks) == 0 {
 		cmd.UI.DisplayText("No tasks found for application.")
@@ -0,0 +1,65 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+type UpdateSidecarCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppSidecar `positional-args:"yes"`
+	Command         string          `long:"command" short:"c" description:"The command the sidecar runs"`
+	MemoryLimit     flag.Megabytes  `short:"m" description:"Memory reserved for the sidecar out of the process memory limit (e.g. 256M, 1024M, 1G)"`
+	ProcessTypes    []string        `long:"process" description:"App process type the sidecar runs with, replacing the current ones. Can be specified multiple times"`
+	usage           interface{}     `usage:"CF_NAME update-sidecar APP_NAME SIDECAR_NAME [-c COMMAND] [--process PROCESS]... [-m MEMORY]\n\n   The app must be restarted for the changes to take effect.\n\nEXAMPLES:\n   CF_NAME update-sidecar my-app envoy -m 128M"`
+	relatedCommands interface{}     `related_commands:"create-sidecar, delete-sidecar, restart, sidecars"`
+}
+
+func (cmd UpdateSidecarCommand) Execute(args []string) error {
+	if cmd.Command == "" && len(cmd.ProcessTypes) == 0 && !cmd.MemoryLimit.IsSet {
+		return translatableerror.IncorrectUsageError{Message: "at least one of --command, --process or -m must be provided"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Updating sidecar {{.SidecarName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"SidecarName": cmd.RequiredArgs.SidecarName,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	})
+
+	sidecar := resources.Sidecar{
+		Name:         cmd.RequiredArgs.SidecarName,
+		ProcessTypes: cmd.ProcessTypes,
+		MemoryInMB:   cmd.MemoryLimit.NullUint64,
+	}
+	if cmd.Command != "" {
+		sidecar.Command = types.FilteredString{IsSet: true, Value: cmd.Command}
+	}
+
+	_, warnings, err := cmd.Actor.UpdateApplicationSidecarByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, sidecar)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Use 'cf restart {{.AppName}}' to ensure your sidecar changes take effect.", map[string]interface{}{
+		"AppName": cmd.RequiredArgs.AppName,
+	})
+
+	return nil
+}
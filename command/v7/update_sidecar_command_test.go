@@ -0,0 +1,104 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-sidecar Command", func() {
+	var (
+		cmd             v7.UpdateSidecarCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.UpdateSidecarCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.AppSidecar{AppName: "some-app", SidecarName: "envoy"},
+		}
+
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("no fields to update are provided", func() {
+		It("returns an incorrect usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{
+				Message: "at least one of --command, --process or -m must be provided",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the memory limit is provided", func() {
+		BeforeEach(func() {
+			cmd.MemoryLimit = flag.Megabytes{NullUint64: types.NullUint64{IsSet: true, Value: 128}}
+		})
+
+		When("updating the sidecar succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateApplicationSidecarByNameAndSpaceReturns(resources.Sidecar{}, v7action.Warnings{"update-warning"}, nil)
+			})
+
+			It("updates only the memory of the sidecar", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Updating sidecar envoy for app some-app in org some-org / space some-space as banana\.\.\.`))
+				Expect(testUI.Err).To(Say("update-warning"))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`TIP: Use 'cf restart some-app' to ensure your sidecar changes take effect\.`))
+
+				Expect(fakeActor.UpdateApplicationSidecarByNameAndSpaceCallCount()).To(Equal(1))
+				appName, spaceGUID, sidecar := fakeActor.UpdateApplicationSidecarByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(sidecar).To(Equal(resources.Sidecar{
+					Name:       "envoy",
+					MemoryInMB: types.NullUint64{IsSet: true, Value: 128},
+				}))
+			})
+		})
+
+		When("the sidecar does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateApplicationSidecarByNameAndSpaceReturns(resources.Sidecar{}, v7action.Warnings{"update-warning"}, actionerror.SidecarNotFoundError{Name: "envoy"})
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.SidecarNotFoundError{Name: "envoy"}))
+				Expect(testUI.Err).To(Say("update-warning"))
+			})
+		})
+	})
+})
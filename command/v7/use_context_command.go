@@ -0,0 +1,58 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type UseContextCommand struct {
+	UI     command.UI
+	Config command.Config
+
+	RequiredArgs    flag.ContextName `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME use-context CONTEXT_NAME\n\nEXAMPLES:\n   CF_NAME use-context production\n   CF_NAME --context staging apps"`
+	relatedCommands interface{}      `related_commands:"contexts, create-context, target"`
+}
+
+func (cmd *UseContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+// StructuredOutput marks use-context as displaying its results with --output.
+func (cmd UseContextCommand) StructuredOutput() {}
+
+func (cmd UseContextCommand) Execute(args []string) error {
+	err := cmd.Config.UseContext(cmd.RequiredArgs.Name)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Switched to context {{.Name}}.", map[string]interface{}{
+		"Name": cmd.RequiredArgs.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayStructuredData(targetSummary{
+		APIEndpoint:  cmd.Config.Target(),
+		APIVersion:   cmd.Config.APIVersion(),
+		Organization: cmd.Config.TargetedOrganizationName(),
+		Space:        cmd.Config.TargetedSpace().Name,
+	})
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("API endpoint:"), cmd.Config.Target()},
+		{cmd.UI.TranslateText("org:"), cmd.Config.TargetedOrganizationName()},
+		{cmd.UI.TranslateText("space:"), cmd.Config.TargetedSpace().Name},
+	}, 3)
+
+	if cmd.Config.Target() == "" {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("TIP: Use '{{.Command}}' to log in to an API endpoint in this context.", map[string]interface{}{
+			"Command": cmd.Config.BinaryName() + " login -a API_URL",
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("use-context Command", func() {
+	var (
+		cmd        UseContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+
+		cmd = UseContextCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.Name = "staging"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the context exists", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("https://api.staging.example.com")
+			fakeConfig.TargetedOrganizationNameReturns("some-org")
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space"})
+		})
+
+		It("switches to the context and displays its target", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeConfig.UseContextCallCount()).To(Equal(1))
+			Expect(fakeConfig.UseContextArgsForCall(0)).To(Equal("staging"))
+
+			Expect(testUI.Out).To(Say("Switched to context staging."))
+			Expect(testUI.Out).To(Say(`API endpoint:\s+https://api.staging.example.com`))
+			Expect(testUI.Out).To(Say(`org:\s+some-org`))
+			Expect(testUI.Out).To(Say(`space:\s+some-space`))
+			Expect(testUI.Out).ToNot(Say("TIP"))
+		})
+
+		When("the output format is json", func() {
+			BeforeEach(func() {
+				fakeConfig.APIVersionReturns("3.150.0")
+				testUI.SetOutputFormat(configv3.OutputFormatJSON)
+			})
+
+			It("displays the target as json and the text on stderr", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				testUI.FlushDeferred()
+
+				Expect(testUI.Err).To(Say("Switched to context staging."))
+				Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`{
+					"api_endpoint": "https://api.staging.example.com",
+					"api_version": "3.150.0",
+					"user": "",
+					"org": "some-org",
+					"space": "some-space"
+				}`))
+			})
+		})
+	})
+
+	When("the context has no API endpoint", func() {
+		It("displays a tip to log in", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("TIP: Use 'faceman login -a API_URL' to log in to an API endpoint in this context."))
+		})
+	})
+
+	When("the context does not exist", func() {
+		BeforeEach(func() {
+			fakeConfig.UseContextReturns(translatableerror.ContextNotFoundError{Name: "staging"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ContextNotFoundError{Name: "staging"}))
+			Expect(testUI.Out).ToNot(Say("Switched"))
+		})
+	})
+})
@@ -36,6 +36,38 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	ApplyRouteStub        func(string, v7action.ExportedRoute) (bool, v7action.Warnings, error)
+	applyRouteMutex       sync.RWMutex
+	applyRouteArgsForCall []struct {
+		arg1 string
+		arg2 v7action.ExportedRoute
+	}
+	applyRouteReturns struct {
+		result1 bool
+		result2 v7action.Warnings
+		result3 error
+	}
+	applyRouteReturnsOnCall map[int]struct {
+		result1 bool
+		result2 v7action.Warnings
+		result3 error
+	}
+	ApplyServiceInstanceStub        func(string, v7action.ExportedServiceInstance) (bool, v7action.Warnings, error)
+	applyServiceInstanceMutex       sync.RWMutex
+	applyServiceInstanceArgsForCall []struct {
+		arg1 string
+		arg2 v7action.ExportedServiceInstance
+	}
+	applyServiceInstanceReturns struct {
+		result1 bool
+		result2 v7action.Warnings
+		result3 error
+	}
+	applyServiceInstanceReturnsOnCall map[int]struct {
+		result1 bool
+		result2 v7action.Warnings
+		result3 error
+	}
 	ApplySpaceQuotaByNameStub        func(string, string, string) (v7action.Warnings, error)
 	applySpaceQuotaByNameMutex       sync.RWMutex
 	applySpaceQuotaByNameArgsForCall []struct {
@@ -51,6 +83,22 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	ApplyUserProvidedServiceStub        func(string, v7action.ExportedUserProvidedService) (bool, v7action.Warnings, error)
+	applyUserProvidedServiceMutex       sync.RWMutex
+	applyUserProvidedServiceArgsForCall []struct {
+		arg1 string
+		arg2 v7action.ExportedUserProvidedService
+	}
+	applyUserProvidedServiceReturns struct {
+		result1 bool
+		result2 v7action.Warnings
+		result3 error
+	}
+	applyUserProvidedServiceReturnsOnCall map[int]struct {
+		result1 bool
+		result2 v7action.Warnings
+		result3 error
+	}
 	AssignIsolationSegmentToSpaceByNameAndSpaceStub        func(string, string) (v7action.Warnings, error)
 	assignIsolationSegmentToSpaceByNameAndSpaceMutex       sync.RWMutex
 	assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall []struct {
@@ -205,6 +253,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	CreateApplicationSidecarByNameAndSpaceStub        func(string, string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)
+	createApplicationSidecarByNameAndSpaceMutex       sync.RWMutex
+	createApplicationSidecarByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 resources.Sidecar
+	}
+	createApplicationSidecarByNameAndSpaceReturns struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
+	createApplicationSidecarByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
 	CreateBitsPackageByApplicationStub        func(string) (resources.Package, v7action.Warnings, error)
 	createBitsPackageByApplicationMutex       sync.RWMutex
 	createBitsPackageByApplicationArgsForCall []struct {
@@ -571,6 +636,21 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	DeleteApplicationSidecarByNameAndSpaceStub        func(string, string, string) (v7action.Warnings, error)
+	deleteApplicationSidecarByNameAndSpaceMutex       sync.RWMutex
+	deleteApplicationSidecarByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	deleteApplicationSidecarByNameAndSpaceReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	deleteApplicationSidecarByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	DeleteBuildpackByNameAndStackStub        func(string, string) (v7action.Warnings, error)
 	deleteBuildpackByNameAndStackMutex       sync.RWMutex
 	deleteBuildpackByNameAndStackArgsForCall []struct {
@@ -987,6 +1067,62 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	EvaluateCanaryHealthStub        func(resources.Application, resources.Deployment, v7action.CanaryHealthCriteria, sharedaction.LogCacheClient) (v7action.CanaryHealthReport, v7action.Warnings, error)
+	evaluateCanaryHealthMutex       sync.RWMutex
+	evaluateCanaryHealthArgsForCall []struct {
+		arg1 resources.Application
+		arg2 resources.Deployment
+		arg3 v7action.CanaryHealthCriteria
+		arg4 sharedaction.LogCacheClient
+	}
+	evaluateCanaryHealthReturns struct {
+		result1 v7action.CanaryHealthReport
+		result2 v7action.Warnings
+		result3 error
+	}
+	evaluateCanaryHealthReturnsOnCall map[int]struct {
+		result1 v7action.CanaryHealthReport
+		result2 v7action.Warnings
+		result3 error
+	}
+	ExportSpaceStub        func(string) (v7action.SpaceExport, v7action.Warnings, error)
+	exportSpaceMutex       sync.RWMutex
+	exportSpaceArgsForCall []struct {
+		arg1 string
+	}
+	exportSpaceReturns struct {
+		result1 v7action.SpaceExport
+		result2 v7action.Warnings
+		result3 error
+	}
+	exportSpaceReturnsOnCall map[int]struct {
+		result1 v7action.SpaceExport
+		result2 v7action.Warnings
+		result3 error
+	}
+	FinishAuthorizationCodeLoginStub        func(*v7action.AuthorizationCodeLogin, time.Duration) error
+	finishAuthorizationCodeLoginMutex       sync.RWMutex
+	finishAuthorizationCodeLoginArgsForCall []struct {
+		arg1 *v7action.AuthorizationCodeLogin
+		arg2 time.Duration
+	}
+	finishAuthorizationCodeLoginReturns struct {
+		result1 error
+	}
+	finishAuthorizationCodeLoginReturnsOnCall map[int]struct {
+		result1 error
+	}
+	FinishDeviceLoginStub        func(v7action.DeviceAuthorization) error
+	finishDeviceLoginMutex       sync.RWMutex
+	finishDeviceLoginArgsForCall []struct {
+		arg1 v7action.DeviceAuthorization
+	}
+	finishDeviceLoginReturns struct {
+		result1 error
+	}
+	finishDeviceLoginReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetAppFeatureStub        func(string, string) (resources.ApplicationFeature, v7action.Warnings, error)
 	getAppFeatureMutex       sync.RWMutex
 	getAppFeatureArgsForCall []struct {
@@ -1145,6 +1281,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationSidecarsByNameAndSpaceStub        func(string, string) ([]resources.Sidecar, v7action.Warnings, error)
+	getApplicationSidecarsByNameAndSpaceMutex       sync.RWMutex
+	getApplicationSidecarsByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationSidecarsByNameAndSpaceReturns struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationSidecarsByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationTasksStub        func(string, v7action.SortOrder) ([]resources.Task, v7action.Warnings, error)
 	getApplicationTasksMutex       sync.RWMutex
 	getApplicationTasksArgsForCall []struct {
@@ -1177,6 +1329,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationsBySpaceStub        func(string) ([]resources.Application, v7action.Warnings, error)
+	getApplicationsBySpaceMutex       sync.RWMutex
+	getApplicationsBySpaceArgsForCall []struct {
+		arg1 string
+	}
+	getApplicationsBySpaceReturns struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationsBySpaceReturnsOnCall map[int]struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetBuildpackLabelsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
 	getBuildpackLabelsMutex       sync.RWMutex
 	getBuildpackLabelsArgsForCall []struct {
@@ -1235,6 +1402,36 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetDeploymentStub        func(string) (resources.Deployment, v7action.Warnings, error)
+	getDeploymentMutex       sync.RWMutex
+	getDeploymentArgsForCall []struct {
+		arg1 string
+	}
+	getDeploymentReturns struct {
+		result1 resources.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDeploymentReturnsOnCall map[int]struct {
+		result1 resources.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetDeploymentSummariesForAppStub        func(string) ([]v7action.DeploymentSummary, v7action.Warnings, error)
+	getDeploymentSummariesForAppMutex       sync.RWMutex
+	getDeploymentSummariesForAppArgsForCall []struct {
+		arg1 string
+	}
+	getDeploymentSummariesForAppReturns struct {
+		result1 []v7action.DeploymentSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDeploymentSummariesForAppReturnsOnCall map[int]struct {
+		result1 []v7action.DeploymentSummary
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetDetailedAppSummaryStub        func(string, string, bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)
 	getDetailedAppSummaryMutex       sync.RWMutex
 	getDetailedAppSummaryArgsForCall []struct {
@@ -1252,6 +1449,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetDetailedDeploymentSummaryStub        func(string, string) (v7action.DetailedDeploymentSummary, v7action.Warnings, error)
+	getDetailedDeploymentSummaryMutex       sync.RWMutex
+	getDetailedDeploymentSummaryArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getDetailedDeploymentSummaryReturns struct {
+		result1 v7action.DetailedDeploymentSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDetailedDeploymentSummaryReturnsOnCall map[int]struct {
+		result1 v7action.DetailedDeploymentSummary
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetDomainStub        func(string) (resources.Domain, v7action.Warnings, error)
 	getDomainMutex       sync.RWMutex
 	getDomainArgsForCall []struct {
@@ -1344,6 +1557,71 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetEventsByApplicationNameAndSpaceStub        func(string, string, v7action.EventFilters) ([]v7action.Event, v7action.Warnings, error)
+	getEventsByApplicationNameAndSpaceMutex       sync.RWMutex
+	getEventsByApplicationNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.EventFilters
+	}
+	getEventsByApplicationNameAndSpaceReturns struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}
+	getEventsByApplicationNameAndSpaceReturnsOnCall map[int]struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetEventsByOrganizationStub        func(string, v7action.EventFilters) ([]v7action.Event, v7action.Warnings, error)
+	getEventsByOrganizationMutex       sync.RWMutex
+	getEventsByOrganizationArgsForCall []struct {
+		arg1 string
+		arg2 v7action.EventFilters
+	}
+	getEventsByOrganizationReturns struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}
+	getEventsByOrganizationReturnsOnCall map[int]struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetEventsBySpaceStub        func(string, v7action.EventFilters) ([]v7action.Event, v7action.Warnings, error)
+	getEventsBySpaceMutex       sync.RWMutex
+	getEventsBySpaceArgsForCall []struct {
+		arg1 string
+		arg2 v7action.EventFilters
+	}
+	getEventsBySpaceReturns struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}
+	getEventsBySpaceReturnsOnCall map[int]struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetExistingApplicationsByNamesAndSpaceStub        func([]string, string) ([]resources.Application, v7action.Warnings, error)
+	getExistingApplicationsByNamesAndSpaceMutex       sync.RWMutex
+	getExistingApplicationsByNamesAndSpaceArgsForCall []struct {
+		arg1 []string
+		arg2 string
+	}
+	getExistingApplicationsByNamesAndSpaceReturns struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	getExistingApplicationsByNamesAndSpaceReturnsOnCall map[int]struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetFeatureFlagByNameStub        func(string) (resources.FeatureFlag, v7action.Warnings, error)
 	getFeatureFlagByNameMutex       sync.RWMutex
 	getFeatureFlagByNameArgsForCall []struct {
@@ -1670,38 +1948,20 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
-	GetRecentEventsByApplicationNameAndSpaceStub        func(string, string) ([]v7action.Event, v7action.Warnings, error)
-	getRecentEventsByApplicationNameAndSpaceMutex       sync.RWMutex
-	getRecentEventsByApplicationNameAndSpaceArgsForCall []struct {
-		arg1 string
-		arg2 string
-	}
-	getRecentEventsByApplicationNameAndSpaceReturns struct {
-		result1 []v7action.Event
-		result2 v7action.Warnings
-		result3 error
-	}
-	getRecentEventsByApplicationNameAndSpaceReturnsOnCall map[int]struct {
-		result1 []v7action.Event
-		result2 v7action.Warnings
-		result3 error
-	}
-	GetRecentLogsForApplicationByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient) ([]sharedaction.LogMessage, v7action.Warnings, error)
-	getRecentLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
-	getRecentLogsForApplicationByNameAndSpaceArgsForCall []struct {
-		arg1 string
-		arg2 string
-		arg3 sharedaction.LogCacheClient
+	GetRecentLogsForApplicationsStub        func([]resources.Application, sharedaction.LogCacheClient, v7action.LogOptions) ([]v7action.AppLogMessage, error)
+	getRecentLogsForApplicationsMutex       sync.RWMutex
+	getRecentLogsForApplicationsArgsForCall []struct {
+		arg1 []resources.Application
+		arg2 sharedaction.LogCacheClient
+		arg3 v7action.LogOptions
 	}
-	getRecentLogsForApplicationByNameAndSpaceReturns struct {
-		result1 []sharedaction.LogMessage
-		result2 v7action.Warnings
-		result3 error
+	getRecentLogsForApplicationsReturns struct {
+		result1 []v7action.AppLogMessage
+		result2 error
 	}
-	getRecentLogsForApplicationByNameAndSpaceReturnsOnCall map[int]struct {
-		result1 []sharedaction.LogMessage
-		result2 v7action.Warnings
-		result3 error
+	getRecentLogsForApplicationsReturnsOnCall map[int]struct {
+		result1 []v7action.AppLogMessage
+		result2 error
 	}
 	GetRevisionByApplicationAndVersionStub        func(string, int) (resources.Revision, v7action.Warnings, error)
 	getRevisionByApplicationAndVersionMutex       sync.RWMutex
@@ -1917,6 +2177,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetSecureShellConfigurationsForAllInstancesStub        func(string, string, string) ([]v7action.InstanceSSHAuthentication, v7action.Warnings, error)
+	getSecureShellConfigurationsForAllInstancesMutex       sync.RWMutex
+	getSecureShellConfigurationsForAllInstancesArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getSecureShellConfigurationsForAllInstancesReturns struct {
+		result1 []v7action.InstanceSSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}
+	getSecureShellConfigurationsForAllInstancesReturnsOnCall map[int]struct {
+		result1 []v7action.InstanceSSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetSecurityGroupStub        func(string) (resources.SecurityGroup, v7action.Warnings, error)
 	getSecurityGroupMutex       sync.RWMutex
 	getSecurityGroupArgsForCall []struct {
@@ -2380,6 +2657,23 @@ type FakeActor struct {
 		result4 v7action.Warnings
 		result5 error
 	}
+	GetStreamingLogsForApplicationsStub        func([]resources.Application, sharedaction.LogCacheClient, v7action.LogOptions) (<-chan v7action.AppLogMessage, <-chan error, context.CancelFunc)
+	getStreamingLogsForApplicationsMutex       sync.RWMutex
+	getStreamingLogsForApplicationsArgsForCall []struct {
+		arg1 []resources.Application
+		arg2 sharedaction.LogCacheClient
+		arg3 v7action.LogOptions
+	}
+	getStreamingLogsForApplicationsReturns struct {
+		result1 <-chan v7action.AppLogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+	}
+	getStreamingLogsForApplicationsReturnsOnCall map[int]struct {
+		result1 <-chan v7action.AppLogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+	}
 	GetTaskBySequenceIDAndApplicationStub        func(int, string) (resources.Task, v7action.Warnings, error)
 	getTaskBySequenceIDAndApplicationMutex       sync.RWMutex
 	getTaskBySequenceIDAndApplicationArgsForCall []struct {
@@ -3058,6 +3352,31 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	StartAuthorizationCodeLoginStub        func(string) (*v7action.AuthorizationCodeLogin, error)
+	startAuthorizationCodeLoginMutex       sync.RWMutex
+	startAuthorizationCodeLoginArgsForCall []struct {
+		arg1 string
+	}
+	startAuthorizationCodeLoginReturns struct {
+		result1 *v7action.AuthorizationCodeLogin
+		result2 error
+	}
+	startAuthorizationCodeLoginReturnsOnCall map[int]struct {
+		result1 *v7action.AuthorizationCodeLogin
+		result2 error
+	}
+	StartDeviceLoginStub        func() (v7action.DeviceAuthorization, error)
+	startDeviceLoginMutex       sync.RWMutex
+	startDeviceLoginArgsForCall []struct {
+	}
+	startDeviceLoginReturns struct {
+		result1 v7action.DeviceAuthorization
+		result2 error
+	}
+	startDeviceLoginReturnsOnCall map[int]struct {
+		result1 v7action.DeviceAuthorization
+		result2 error
+	}
 	StopApplicationStub        func(string) (v7action.Warnings, error)
 	stopApplicationMutex       sync.RWMutex
 	stopApplicationArgsForCall []struct {
@@ -3235,6 +3554,23 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateApplicationSidecarByNameAndSpaceStub        func(string, string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)
+	updateApplicationSidecarByNameAndSpaceMutex       sync.RWMutex
+	updateApplicationSidecarByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 resources.Sidecar
+	}
+	updateApplicationSidecarByNameAndSpaceReturns struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
+	updateApplicationSidecarByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
 	UpdateBuildpackByNameAndStackStub        func(string, string, resources.Buildpack) (resources.Buildpack, v7action.Warnings, error)
 	updateBuildpackByNameAndStackMutex       sync.RWMutex
 	updateBuildpackByNameAndStackArgsForCall []struct {
@@ -3698,50 +4034,186 @@ func (fake *FakeActor) ApplyOrganizationQuotaByNameReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
-func (fake *FakeActor) ApplySpaceQuotaByName(arg1 string, arg2 string, arg3 string) (v7action.Warnings, error) {
-	fake.applySpaceQuotaByNameMutex.Lock()
-	ret, specificReturn := fake.applySpaceQuotaByNameReturnsOnCall[len(fake.applySpaceQuotaByNameArgsForCall)]
-	fake.applySpaceQuotaByNameArgsForCall = append(fake.applySpaceQuotaByNameArgsForCall, struct {
+func (fake *FakeActor) ApplyRoute(arg1 string, arg2 v7action.ExportedRoute) (bool, v7action.Warnings, error) {
+	fake.applyRouteMutex.Lock()
+	ret, specificReturn := fake.applyRouteReturnsOnCall[len(fake.applyRouteArgsForCall)]
+	fake.applyRouteArgsForCall = append(fake.applyRouteArgsForCall, struct {
 		arg1 string
-		arg2 string
-		arg3 string
-	}{arg1, arg2, arg3})
-	stub := fake.ApplySpaceQuotaByNameStub
-	fakeReturns := fake.applySpaceQuotaByNameReturns
-	fake.recordInvocation("ApplySpaceQuotaByName", []interface{}{arg1, arg2, arg3})
-	fake.applySpaceQuotaByNameMutex.Unlock()
+		arg2 v7action.ExportedRoute
+	}{arg1, arg2})
+	stub := fake.ApplyRouteStub
+	fakeReturns := fake.applyRouteReturns
+	fake.recordInvocation("ApplyRoute", []interface{}{arg1, arg2})
+	fake.applyRouteMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeActor) ApplySpaceQuotaByNameCallCount() int {
-	fake.applySpaceQuotaByNameMutex.RLock()
-	defer fake.applySpaceQuotaByNameMutex.RUnlock()
-	return len(fake.applySpaceQuotaByNameArgsForCall)
+func (fake *FakeActor) ApplyRouteCallCount() int {
+	fake.applyRouteMutex.RLock()
+	defer fake.applyRouteMutex.RUnlock()
+	return len(fake.applyRouteArgsForCall)
 }
 
-func (fake *FakeActor) ApplySpaceQuotaByNameCalls(stub func(string, string, string) (v7action.Warnings, error)) {
-	fake.applySpaceQuotaByNameMutex.Lock()
-	defer fake.applySpaceQuotaByNameMutex.Unlock()
-	fake.ApplySpaceQuotaByNameStub = stub
+func (fake *FakeActor) ApplyRouteCalls(stub func(string, v7action.ExportedRoute) (bool, v7action.Warnings, error)) {
+	fake.applyRouteMutex.Lock()
+	defer fake.applyRouteMutex.Unlock()
+	fake.ApplyRouteStub = stub
 }
 
-func (fake *FakeActor) ApplySpaceQuotaByNameArgsForCall(i int) (string, string, string) {
-	fake.applySpaceQuotaByNameMutex.RLock()
-	defer fake.applySpaceQuotaByNameMutex.RUnlock()
-	argsForCall := fake.applySpaceQuotaByNameArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeActor) ApplyRouteArgsForCall(i int) (string, v7action.ExportedRoute) {
+	fake.applyRouteMutex.RLock()
+	defer fake.applyRouteMutex.RUnlock()
+	argsForCall := fake.applyRouteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeActor) ApplySpaceQuotaByNameReturns(result1 v7action.Warnings, result2 error) {
-	fake.applySpaceQuotaByNameMutex.Lock()
-	defer fake.applySpaceQuotaByNameMutex.Unlock()
-	fake.ApplySpaceQuotaByNameStub = nil
+func (fake *FakeActor) ApplyRouteReturns(result1 bool, result2 v7action.Warnings, result3 error) {
+	fake.applyRouteMutex.Lock()
+	defer fake.applyRouteMutex.Unlock()
+	fake.ApplyRouteStub = nil
+	fake.applyRouteReturns = struct {
+		result1 bool
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) ApplyRouteReturnsOnCall(i int, result1 bool, result2 v7action.Warnings, result3 error) {
+	fake.applyRouteMutex.Lock()
+	defer fake.applyRouteMutex.Unlock()
+	fake.ApplyRouteStub = nil
+	if fake.applyRouteReturnsOnCall == nil {
+		fake.applyRouteReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.applyRouteReturnsOnCall[i] = struct {
+		result1 bool
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) ApplyServiceInstance(arg1 string, arg2 v7action.ExportedServiceInstance) (bool, v7action.Warnings, error) {
+	fake.applyServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.applyServiceInstanceReturnsOnCall[len(fake.applyServiceInstanceArgsForCall)]
+	fake.applyServiceInstanceArgsForCall = append(fake.applyServiceInstanceArgsForCall, struct {
+		arg1 string
+		arg2 v7action.ExportedServiceInstance
+	}{arg1, arg2})
+	stub := fake.ApplyServiceInstanceStub
+	fakeReturns := fake.applyServiceInstanceReturns
+	fake.recordInvocation("ApplyServiceInstance", []interface{}{arg1, arg2})
+	fake.applyServiceInstanceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) ApplyServiceInstanceCallCount() int {
+	fake.applyServiceInstanceMutex.RLock()
+	defer fake.applyServiceInstanceMutex.RUnlock()
+	return len(fake.applyServiceInstanceArgsForCall)
+}
+
+func (fake *FakeActor) ApplyServiceInstanceCalls(stub func(string, v7action.ExportedServiceInstance) (bool, v7action.Warnings, error)) {
+	fake.applyServiceInstanceMutex.Lock()
+	defer fake.applyServiceInstanceMutex.Unlock()
+	fake.ApplyServiceInstanceStub = stub
+}
+
+func (fake *FakeActor) ApplyServiceInstanceArgsForCall(i int) (string, v7action.ExportedServiceInstance) {
+	fake.applyServiceInstanceMutex.RLock()
+	defer fake.applyServiceInstanceMutex.RUnlock()
+	argsForCall := fake.applyServiceInstanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) ApplyServiceInstanceReturns(result1 bool, result2 v7action.Warnings, result3 error) {
+	fake.applyServiceInstanceMutex.Lock()
+	defer fake.applyServiceInstanceMutex.Unlock()
+	fake.ApplyServiceInstanceStub = nil
+	fake.applyServiceInstanceReturns = struct {
+		result1 bool
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) ApplyServiceInstanceReturnsOnCall(i int, result1 bool, result2 v7action.Warnings, result3 error) {
+	fake.applyServiceInstanceMutex.Lock()
+	defer fake.applyServiceInstanceMutex.Unlock()
+	fake.ApplyServiceInstanceStub = nil
+	if fake.applyServiceInstanceReturnsOnCall == nil {
+		fake.applyServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.applyServiceInstanceReturnsOnCall[i] = struct {
+		result1 bool
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) ApplySpaceQuotaByName(arg1 string, arg2 string, arg3 string) (v7action.Warnings, error) {
+	fake.applySpaceQuotaByNameMutex.Lock()
+	ret, specificReturn := fake.applySpaceQuotaByNameReturnsOnCall[len(fake.applySpaceQuotaByNameArgsForCall)]
+	fake.applySpaceQuotaByNameArgsForCall = append(fake.applySpaceQuotaByNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ApplySpaceQuotaByNameStub
+	fakeReturns := fake.applySpaceQuotaByNameReturns
+	fake.recordInvocation("ApplySpaceQuotaByName", []interface{}{arg1, arg2, arg3})
+	fake.applySpaceQuotaByNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) ApplySpaceQuotaByNameCallCount() int {
+	fake.applySpaceQuotaByNameMutex.RLock()
+	defer fake.applySpaceQuotaByNameMutex.RUnlock()
+	return len(fake.applySpaceQuotaByNameArgsForCall)
+}
+
+func (fake *FakeActor) ApplySpaceQuotaByNameCalls(stub func(string, string, string) (v7action.Warnings, error)) {
+	fake.applySpaceQuotaByNameMutex.Lock()
+	defer fake.applySpaceQuotaByNameMutex.Unlock()
+	fake.ApplySpaceQuotaByNameStub = stub
+}
+
+func (fake *FakeActor) ApplySpaceQuotaByNameArgsForCall(i int) (string, string, string) {
+	fake.applySpaceQuotaByNameMutex.RLock()
+	defer fake.applySpaceQuotaByNameMutex.RUnlock()
+	argsForCall := fake.applySpaceQuotaByNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) ApplySpaceQuotaByNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.applySpaceQuotaByNameMutex.Lock()
+	defer fake.applySpaceQuotaByNameMutex.Unlock()
+	fake.ApplySpaceQuotaByNameStub = nil
 	fake.applySpaceQuotaByNameReturns = struct {
 		result1 v7action.Warnings
 		result2 error
@@ -3764,6 +4236,74 @@ func (fake *FakeActor) ApplySpaceQuotaByNameReturnsOnCall(i int, result1 v7actio
 	}{result1, result2}
 }
 
+func (fake *FakeActor) ApplyUserProvidedService(arg1 string, arg2 v7action.ExportedUserProvidedService) (bool, v7action.Warnings, error) {
+	fake.applyUserProvidedServiceMutex.Lock()
+	ret, specificReturn := fake.applyUserProvidedServiceReturnsOnCall[len(fake.applyUserProvidedServiceArgsForCall)]
+	fake.applyUserProvidedServiceArgsForCall = append(fake.applyUserProvidedServiceArgsForCall, struct {
+		arg1 string
+		arg2 v7action.ExportedUserProvidedService
+	}{arg1, arg2})
+	stub := fake.ApplyUserProvidedServiceStub
+	fakeReturns := fake.applyUserProvidedServiceReturns
+	fake.recordInvocation("ApplyUserProvidedService", []interface{}{arg1, arg2})
+	fake.applyUserProvidedServiceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) ApplyUserProvidedServiceCallCount() int {
+	fake.applyUserProvidedServiceMutex.RLock()
+	defer fake.applyUserProvidedServiceMutex.RUnlock()
+	return len(fake.applyUserProvidedServiceArgsForCall)
+}
+
+func (fake *FakeActor) ApplyUserProvidedServiceCalls(stub func(string, v7action.ExportedUserProvidedService) (bool, v7action.Warnings, error)) {
+	fake.applyUserProvidedServiceMutex.Lock()
+	defer fake.applyUserProvidedServiceMutex.Unlock()
+	fake.ApplyUserProvidedServiceStub = stub
+}
+
+func (fake *FakeActor) ApplyUserProvidedServiceArgsForCall(i int) (string, v7action.ExportedUserProvidedService) {
+	fake.applyUserProvidedServiceMutex.RLock()
+	defer fake.applyUserProvidedServiceMutex.RUnlock()
+	argsForCall := fake.applyUserProvidedServiceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) ApplyUserProvidedServiceReturns(result1 bool, result2 v7action.Warnings, result3 error) {
+	fake.applyUserProvidedServiceMutex.Lock()
+	defer fake.applyUserProvidedServiceMutex.Unlock()
+	fake.ApplyUserProvidedServiceStub = nil
+	fake.applyUserProvidedServiceReturns = struct {
+		result1 bool
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) ApplyUserProvidedServiceReturnsOnCall(i int, result1 bool, result2 v7action.Warnings, result3 error) {
+	fake.applyUserProvidedServiceMutex.Lock()
+	defer fake.applyUserProvidedServiceMutex.Unlock()
+	fake.ApplyUserProvidedServiceStub = nil
+	if fake.applyUserProvidedServiceReturnsOnCall == nil {
+		fake.applyUserProvidedServiceReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.applyUserProvidedServiceReturnsOnCall[i] = struct {
+		result1 bool
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) AssignIsolationSegmentToSpaceByNameAndSpace(arg1 string, arg2 string) (v7action.Warnings, error) {
 	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.assignIsolationSegmentToSpaceByNameAndSpaceReturnsOnCall[len(fake.assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall)]
@@ -4457,6 +4997,75 @@ func (fake *FakeActor) CreateApplicationInSpaceReturnsOnCall(i int, result1 reso
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) CreateApplicationSidecarByNameAndSpace(arg1 string, arg2 string, arg3 resources.Sidecar) (resources.Sidecar, v7action.Warnings, error) {
+	fake.createApplicationSidecarByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.createApplicationSidecarByNameAndSpaceReturnsOnCall[len(fake.createApplicationSidecarByNameAndSpaceArgsForCall)]
+	fake.createApplicationSidecarByNameAndSpaceArgsForCall = append(fake.createApplicationSidecarByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 resources.Sidecar
+	}{arg1, arg2, arg3})
+	stub := fake.CreateApplicationSidecarByNameAndSpaceStub
+	fakeReturns := fake.createApplicationSidecarByNameAndSpaceReturns
+	fake.recordInvocation("CreateApplicationSidecarByNameAndSpace", []interface{}{arg1, arg2, arg3})
+	fake.createApplicationSidecarByNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) CreateApplicationSidecarByNameAndSpaceCallCount() int {
+	fake.createApplicationSidecarByNameAndSpaceMutex.RLock()
+	defer fake.createApplicationSidecarByNameAndSpaceMutex.RUnlock()
+	return len(fake.createApplicationSidecarByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) CreateApplicationSidecarByNameAndSpaceCalls(stub func(string, string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)) {
+	fake.createApplicationSidecarByNameAndSpaceMutex.Lock()
+	defer fake.createApplicationSidecarByNameAndSpaceMutex.Unlock()
+	fake.CreateApplicationSidecarByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) CreateApplicationSidecarByNameAndSpaceArgsForCall(i int) (string, string, resources.Sidecar) {
+	fake.createApplicationSidecarByNameAndSpaceMutex.RLock()
+	defer fake.createApplicationSidecarByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.createApplicationSidecarByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) CreateApplicationSidecarByNameAndSpaceReturns(result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.createApplicationSidecarByNameAndSpaceMutex.Lock()
+	defer fake.createApplicationSidecarByNameAndSpaceMutex.Unlock()
+	fake.CreateApplicationSidecarByNameAndSpaceStub = nil
+	fake.createApplicationSidecarByNameAndSpaceReturns = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) CreateApplicationSidecarByNameAndSpaceReturnsOnCall(i int, result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.createApplicationSidecarByNameAndSpaceMutex.Lock()
+	defer fake.createApplicationSidecarByNameAndSpaceMutex.Unlock()
+	fake.CreateApplicationSidecarByNameAndSpaceStub = nil
+	if fake.createApplicationSidecarByNameAndSpaceReturnsOnCall == nil {
+		fake.createApplicationSidecarByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 resources.Sidecar
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationSidecarByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) CreateBitsPackageByApplication(arg1 string) (resources.Package, v7action.Warnings, error) {
 	fake.createBitsPackageByApplicationMutex.Lock()
 	ret, specificReturn := fake.createBitsPackageByApplicationReturnsOnCall[len(fake.createBitsPackageByApplicationArgsForCall)]
@@ -6060,6 +6669,72 @@ func (fake *FakeActor) DeleteApplicationByNameAndSpaceReturnsOnCall(i int, resul
 	}{result1, result2}
 }
 
+func (fake *FakeActor) DeleteApplicationSidecarByNameAndSpace(arg1 string, arg2 string, arg3 string) (v7action.Warnings, error) {
+	fake.deleteApplicationSidecarByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationSidecarByNameAndSpaceReturnsOnCall[len(fake.deleteApplicationSidecarByNameAndSpaceArgsForCall)]
+	fake.deleteApplicationSidecarByNameAndSpaceArgsForCall = append(fake.deleteApplicationSidecarByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteApplicationSidecarByNameAndSpaceStub
+	fakeReturns := fake.deleteApplicationSidecarByNameAndSpaceReturns
+	fake.recordInvocation("DeleteApplicationSidecarByNameAndSpace", []interface{}{arg1, arg2, arg3})
+	fake.deleteApplicationSidecarByNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarByNameAndSpaceCallCount() int {
+	fake.deleteApplicationSidecarByNameAndSpaceMutex.RLock()
+	defer fake.deleteApplicationSidecarByNameAndSpaceMutex.RUnlock()
+	return len(fake.deleteApplicationSidecarByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarByNameAndSpaceCalls(stub func(string, string, string) (v7action.Warnings, error)) {
+	fake.deleteApplicationSidecarByNameAndSpaceMutex.Lock()
+	defer fake.deleteApplicationSidecarByNameAndSpaceMutex.Unlock()
+	fake.DeleteApplicationSidecarByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarByNameAndSpaceArgsForCall(i int) (string, string, string) {
+	fake.deleteApplicationSidecarByNameAndSpaceMutex.RLock()
+	defer fake.deleteApplicationSidecarByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.deleteApplicationSidecarByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarByNameAndSpaceReturns(result1 v7action.Warnings, result2 error) {
+	fake.deleteApplicationSidecarByNameAndSpaceMutex.Lock()
+	defer fake.deleteApplicationSidecarByNameAndSpaceMutex.Unlock()
+	fake.DeleteApplicationSidecarByNameAndSpaceStub = nil
+	fake.deleteApplicationSidecarByNameAndSpaceReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarByNameAndSpaceReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.deleteApplicationSidecarByNameAndSpaceMutex.Lock()
+	defer fake.deleteApplicationSidecarByNameAndSpaceMutex.Unlock()
+	fake.DeleteApplicationSidecarByNameAndSpaceStub = nil
+	if fake.deleteApplicationSidecarByNameAndSpaceReturnsOnCall == nil {
+		fake.deleteApplicationSidecarByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteApplicationSidecarByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) DeleteBuildpackByNameAndStack(arg1 string, arg2 string) (v7action.Warnings, error) {
 	fake.deleteBuildpackByNameAndStackMutex.Lock()
 	ret, specificReturn := fake.deleteBuildpackByNameAndStackReturnsOnCall[len(fake.deleteBuildpackByNameAndStackArgsForCall)]
@@ -7919,19 +8594,21 @@ func (fake *FakeActor) EntitleIsolationSegmentToOrganizationByNameReturnsOnCall(
 	}{result1, result2}
 }
 
-func (fake *FakeActor) GetAppFeature(arg1 string, arg2 string) (resources.ApplicationFeature, v7action.Warnings, error) {
-	fake.getAppFeatureMutex.Lock()
-	ret, specificReturn := fake.getAppFeatureReturnsOnCall[len(fake.getAppFeatureArgsForCall)]
-	fake.getAppFeatureArgsForCall = append(fake.getAppFeatureArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.GetAppFeatureStub
-	fakeReturns := fake.getAppFeatureReturns
-	fake.recordInvocation("GetAppFeature", []interface{}{arg1, arg2})
-	fake.getAppFeatureMutex.Unlock()
+func (fake *FakeActor) EvaluateCanaryHealth(arg1 resources.Application, arg2 resources.Deployment, arg3 v7action.CanaryHealthCriteria, arg4 sharedaction.LogCacheClient) (v7action.CanaryHealthReport, v7action.Warnings, error) {
+	fake.evaluateCanaryHealthMutex.Lock()
+	ret, specificReturn := fake.evaluateCanaryHealthReturnsOnCall[len(fake.evaluateCanaryHealthArgsForCall)]
+	fake.evaluateCanaryHealthArgsForCall = append(fake.evaluateCanaryHealthArgsForCall, struct {
+		arg1 resources.Application
+		arg2 resources.Deployment
+		arg3 v7action.CanaryHealthCriteria
+		arg4 sharedaction.LogCacheClient
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.EvaluateCanaryHealthStub
+	fakeReturns := fake.evaluateCanaryHealthReturns
+	fake.recordInvocation("EvaluateCanaryHealth", []interface{}{arg1, arg2, arg3, arg4})
+	fake.evaluateCanaryHealthMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -7939,68 +8616,326 @@ func (fake *FakeActor) GetAppFeature(arg1 string, arg2 string) (resources.Applic
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeActor) GetAppFeatureCallCount() int {
-	fake.getAppFeatureMutex.RLock()
-	defer fake.getAppFeatureMutex.RUnlock()
-	return len(fake.getAppFeatureArgsForCall)
+func (fake *FakeActor) EvaluateCanaryHealthCallCount() int {
+	fake.evaluateCanaryHealthMutex.RLock()
+	defer fake.evaluateCanaryHealthMutex.RUnlock()
+	return len(fake.evaluateCanaryHealthArgsForCall)
 }
 
-func (fake *FakeActor) GetAppFeatureCalls(stub func(string, string) (resources.ApplicationFeature, v7action.Warnings, error)) {
-	fake.getAppFeatureMutex.Lock()
-	defer fake.getAppFeatureMutex.Unlock()
-	fake.GetAppFeatureStub = stub
+func (fake *FakeActor) EvaluateCanaryHealthCalls(stub func(resources.Application, resources.Deployment, v7action.CanaryHealthCriteria, sharedaction.LogCacheClient) (v7action.CanaryHealthReport, v7action.Warnings, error)) {
+	fake.evaluateCanaryHealthMutex.Lock()
+	defer fake.evaluateCanaryHealthMutex.Unlock()
+	fake.EvaluateCanaryHealthStub = stub
 }
 
-func (fake *FakeActor) GetAppFeatureArgsForCall(i int) (string, string) {
-	fake.getAppFeatureMutex.RLock()
-	defer fake.getAppFeatureMutex.RUnlock()
-	argsForCall := fake.getAppFeatureArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeActor) EvaluateCanaryHealthArgsForCall(i int) (resources.Application, resources.Deployment, v7action.CanaryHealthCriteria, sharedaction.LogCacheClient) {
+	fake.evaluateCanaryHealthMutex.RLock()
+	defer fake.evaluateCanaryHealthMutex.RUnlock()
+	argsForCall := fake.evaluateCanaryHealthArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakeActor) GetAppFeatureReturns(result1 resources.ApplicationFeature, result2 v7action.Warnings, result3 error) {
-	fake.getAppFeatureMutex.Lock()
-	defer fake.getAppFeatureMutex.Unlock()
-	fake.GetAppFeatureStub = nil
-	fake.getAppFeatureReturns = struct {
-		result1 resources.ApplicationFeature
+func (fake *FakeActor) EvaluateCanaryHealthReturns(result1 v7action.CanaryHealthReport, result2 v7action.Warnings, result3 error) {
+	fake.evaluateCanaryHealthMutex.Lock()
+	defer fake.evaluateCanaryHealthMutex.Unlock()
+	fake.EvaluateCanaryHealthStub = nil
+	fake.evaluateCanaryHealthReturns = struct {
+		result1 v7action.CanaryHealthReport
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetAppFeatureReturnsOnCall(i int, result1 resources.ApplicationFeature, result2 v7action.Warnings, result3 error) {
-	fake.getAppFeatureMutex.Lock()
-	defer fake.getAppFeatureMutex.Unlock()
-	fake.GetAppFeatureStub = nil
-	if fake.getAppFeatureReturnsOnCall == nil {
-		fake.getAppFeatureReturnsOnCall = make(map[int]struct {
-			result1 resources.ApplicationFeature
+func (fake *FakeActor) EvaluateCanaryHealthReturnsOnCall(i int, result1 v7action.CanaryHealthReport, result2 v7action.Warnings, result3 error) {
+	fake.evaluateCanaryHealthMutex.Lock()
+	defer fake.evaluateCanaryHealthMutex.Unlock()
+	fake.EvaluateCanaryHealthStub = nil
+	if fake.evaluateCanaryHealthReturnsOnCall == nil {
+		fake.evaluateCanaryHealthReturnsOnCall = make(map[int]struct {
+			result1 v7action.CanaryHealthReport
 			result2 v7action.Warnings
 			result3 error
 		})
 	}
-	fake.getAppFeatureReturnsOnCall[i] = struct {
-		result1 resources.ApplicationFeature
+	fake.evaluateCanaryHealthReturnsOnCall[i] = struct {
+		result1 v7action.CanaryHealthReport
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetAppSummariesForSpace(arg1 string, arg2 string, arg3 bool) ([]v7action.ApplicationSummary, v7action.Warnings, error) {
-	fake.getAppSummariesForSpaceMutex.Lock()
-	ret, specificReturn := fake.getAppSummariesForSpaceReturnsOnCall[len(fake.getAppSummariesForSpaceArgsForCall)]
-	fake.getAppSummariesForSpaceArgsForCall = append(fake.getAppSummariesForSpaceArgsForCall, struct {
+func (fake *FakeActor) ExportSpace(arg1 string) (v7action.SpaceExport, v7action.Warnings, error) {
+	fake.exportSpaceMutex.Lock()
+	ret, specificReturn := fake.exportSpaceReturnsOnCall[len(fake.exportSpaceArgsForCall)]
+	fake.exportSpaceArgsForCall = append(fake.exportSpaceArgsForCall, struct {
 		arg1 string
-		arg2 string
-		arg3 bool
-	}{arg1, arg2, arg3})
-	stub := fake.GetAppSummariesForSpaceStub
-	fakeReturns := fake.getAppSummariesForSpaceReturns
-	fake.recordInvocation("GetAppSummariesForSpace", []interface{}{arg1, arg2, arg3})
-	fake.getAppSummariesForSpaceMutex.Unlock()
+	}{arg1})
+	stub := fake.ExportSpaceStub
+	fakeReturns := fake.exportSpaceReturns
+	fake.recordInvocation("ExportSpace", []interface{}{arg1})
+	fake.exportSpaceMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) ExportSpaceCallCount() int {
+	fake.exportSpaceMutex.RLock()
+	defer fake.exportSpaceMutex.RUnlock()
+	return len(fake.exportSpaceArgsForCall)
+}
+
+func (fake *FakeActor) ExportSpaceCalls(stub func(string) (v7action.SpaceExport, v7action.Warnings, error)) {
+	fake.exportSpaceMutex.Lock()
+	defer fake.exportSpaceMutex.Unlock()
+	fake.ExportSpaceStub = stub
+}
+
+func (fake *FakeActor) ExportSpaceArgsForCall(i int) string {
+	fake.exportSpaceMutex.RLock()
+	defer fake.exportSpaceMutex.RUnlock()
+	argsForCall := fake.exportSpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) ExportSpaceReturns(result1 v7action.SpaceExport, result2 v7action.Warnings, result3 error) {
+	fake.exportSpaceMutex.Lock()
+	defer fake.exportSpaceMutex.Unlock()
+	fake.ExportSpaceStub = nil
+	fake.exportSpaceReturns = struct {
+		result1 v7action.SpaceExport
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) ExportSpaceReturnsOnCall(i int, result1 v7action.SpaceExport, result2 v7action.Warnings, result3 error) {
+	fake.exportSpaceMutex.Lock()
+	defer fake.exportSpaceMutex.Unlock()
+	fake.ExportSpaceStub = nil
+	if fake.exportSpaceReturnsOnCall == nil {
+		fake.exportSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7action.SpaceExport
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.exportSpaceReturnsOnCall[i] = struct {
+		result1 v7action.SpaceExport
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) FinishAuthorizationCodeLogin(arg1 *v7action.AuthorizationCodeLogin, arg2 time.Duration) error {
+	fake.finishAuthorizationCodeLoginMutex.Lock()
+	ret, specificReturn := fake.finishAuthorizationCodeLoginReturnsOnCall[len(fake.finishAuthorizationCodeLoginArgsForCall)]
+	fake.finishAuthorizationCodeLoginArgsForCall = append(fake.finishAuthorizationCodeLoginArgsForCall, struct {
+		arg1 *v7action.AuthorizationCodeLogin
+		arg2 time.Duration
+	}{arg1, arg2})
+	stub := fake.FinishAuthorizationCodeLoginStub
+	fakeReturns := fake.finishAuthorizationCodeLoginReturns
+	fake.recordInvocation("FinishAuthorizationCodeLogin", []interface{}{arg1, arg2})
+	fake.finishAuthorizationCodeLoginMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeActor) FinishAuthorizationCodeLoginCallCount() int {
+	fake.finishAuthorizationCodeLoginMutex.RLock()
+	defer fake.finishAuthorizationCodeLoginMutex.RUnlock()
+	return len(fake.finishAuthorizationCodeLoginArgsForCall)
+}
+
+func (fake *FakeActor) FinishAuthorizationCodeLoginCalls(stub func(*v7action.AuthorizationCodeLogin, time.Duration) error) {
+	fake.finishAuthorizationCodeLoginMutex.Lock()
+	defer fake.finishAuthorizationCodeLoginMutex.Unlock()
+	fake.FinishAuthorizationCodeLoginStub = stub
+}
+
+func (fake *FakeActor) FinishAuthorizationCodeLoginArgsForCall(i int) (*v7action.AuthorizationCodeLogin, time.Duration) {
+	fake.finishAuthorizationCodeLoginMutex.RLock()
+	defer fake.finishAuthorizationCodeLoginMutex.RUnlock()
+	argsForCall := fake.finishAuthorizationCodeLoginArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) FinishAuthorizationCodeLoginReturns(result1 error) {
+	fake.finishAuthorizationCodeLoginMutex.Lock()
+	defer fake.finishAuthorizationCodeLoginMutex.Unlock()
+	fake.FinishAuthorizationCodeLoginStub = nil
+	fake.finishAuthorizationCodeLoginReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActor) FinishAuthorizationCodeLoginReturnsOnCall(i int, result1 error) {
+	fake.finishAuthorizationCodeLoginMutex.Lock()
+	defer fake.finishAuthorizationCodeLoginMutex.Unlock()
+	fake.FinishAuthorizationCodeLoginStub = nil
+	if fake.finishAuthorizationCodeLoginReturnsOnCall == nil {
+		fake.finishAuthorizationCodeLoginReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.finishAuthorizationCodeLoginReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActor) FinishDeviceLogin(arg1 v7action.DeviceAuthorization) error {
+	fake.finishDeviceLoginMutex.Lock()
+	ret, specificReturn := fake.finishDeviceLoginReturnsOnCall[len(fake.finishDeviceLoginArgsForCall)]
+	fake.finishDeviceLoginArgsForCall = append(fake.finishDeviceLoginArgsForCall, struct {
+		arg1 v7action.DeviceAuthorization
+	}{arg1})
+	stub := fake.FinishDeviceLoginStub
+	fakeReturns := fake.finishDeviceLoginReturns
+	fake.recordInvocation("FinishDeviceLogin", []interface{}{arg1})
+	fake.finishDeviceLoginMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeActor) FinishDeviceLoginCallCount() int {
+	fake.finishDeviceLoginMutex.RLock()
+	defer fake.finishDeviceLoginMutex.RUnlock()
+	return len(fake.finishDeviceLoginArgsForCall)
+}
+
+func (fake *FakeActor) FinishDeviceLoginCalls(stub func(v7action.DeviceAuthorization) error) {
+	fake.finishDeviceLoginMutex.Lock()
+	defer fake.finishDeviceLoginMutex.Unlock()
+	fake.FinishDeviceLoginStub = stub
+}
+
+func (fake *FakeActor) FinishDeviceLoginArgsForCall(i int) v7action.DeviceAuthorization {
+	fake.finishDeviceLoginMutex.RLock()
+	defer fake.finishDeviceLoginMutex.RUnlock()
+	argsForCall := fake.finishDeviceLoginArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) FinishDeviceLoginReturns(result1 error) {
+	fake.finishDeviceLoginMutex.Lock()
+	defer fake.finishDeviceLoginMutex.Unlock()
+	fake.FinishDeviceLoginStub = nil
+	fake.finishDeviceLoginReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActor) FinishDeviceLoginReturnsOnCall(i int, result1 error) {
+	fake.finishDeviceLoginMutex.Lock()
+	defer fake.finishDeviceLoginMutex.Unlock()
+	fake.FinishDeviceLoginStub = nil
+	if fake.finishDeviceLoginReturnsOnCall == nil {
+		fake.finishDeviceLoginReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.finishDeviceLoginReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActor) GetAppFeature(arg1 string, arg2 string) (resources.ApplicationFeature, v7action.Warnings, error) {
+	fake.getAppFeatureMutex.Lock()
+	ret, specificReturn := fake.getAppFeatureReturnsOnCall[len(fake.getAppFeatureArgsForCall)]
+	fake.getAppFeatureArgsForCall = append(fake.getAppFeatureArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetAppFeatureStub
+	fakeReturns := fake.getAppFeatureReturns
+	fake.recordInvocation("GetAppFeature", []interface{}{arg1, arg2})
+	fake.getAppFeatureMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetAppFeatureCallCount() int {
+	fake.getAppFeatureMutex.RLock()
+	defer fake.getAppFeatureMutex.RUnlock()
+	return len(fake.getAppFeatureArgsForCall)
+}
+
+func (fake *FakeActor) GetAppFeatureCalls(stub func(string, string) (resources.ApplicationFeature, v7action.Warnings, error)) {
+	fake.getAppFeatureMutex.Lock()
+	defer fake.getAppFeatureMutex.Unlock()
+	fake.GetAppFeatureStub = stub
+}
+
+func (fake *FakeActor) GetAppFeatureArgsForCall(i int) (string, string) {
+	fake.getAppFeatureMutex.RLock()
+	defer fake.getAppFeatureMutex.RUnlock()
+	argsForCall := fake.getAppFeatureArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetAppFeatureReturns(result1 resources.ApplicationFeature, result2 v7action.Warnings, result3 error) {
+	fake.getAppFeatureMutex.Lock()
+	defer fake.getAppFeatureMutex.Unlock()
+	fake.GetAppFeatureStub = nil
+	fake.getAppFeatureReturns = struct {
+		result1 resources.ApplicationFeature
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAppFeatureReturnsOnCall(i int, result1 resources.ApplicationFeature, result2 v7action.Warnings, result3 error) {
+	fake.getAppFeatureMutex.Lock()
+	defer fake.getAppFeatureMutex.Unlock()
+	fake.GetAppFeatureStub = nil
+	if fake.getAppFeatureReturnsOnCall == nil {
+		fake.getAppFeatureReturnsOnCall = make(map[int]struct {
+			result1 resources.ApplicationFeature
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getAppFeatureReturnsOnCall[i] = struct {
+		result1 resources.ApplicationFeature
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAppSummariesForSpace(arg1 string, arg2 string, arg3 bool) ([]v7action.ApplicationSummary, v7action.Warnings, error) {
+	fake.getAppSummariesForSpaceMutex.Lock()
+	ret, specificReturn := fake.getAppSummariesForSpaceReturnsOnCall[len(fake.getAppSummariesForSpaceArgsForCall)]
+	fake.getAppSummariesForSpaceArgsForCall = append(fake.getAppSummariesForSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.GetAppSummariesForSpaceStub
+	fakeReturns := fake.getAppSummariesForSpaceReturns
+	fake.recordInvocation("GetAppSummariesForSpace", []interface{}{arg1, arg2, arg3})
+	fake.getAppSummariesForSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -8597,6 +9532,74 @@ func (fake *FakeActor) GetApplicationRoutesReturnsOnCall(i int, result1 []resour
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetApplicationSidecarsByNameAndSpace(arg1 string, arg2 string) ([]resources.Sidecar, v7action.Warnings, error) {
+	fake.getApplicationSidecarsByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationSidecarsByNameAndSpaceReturnsOnCall[len(fake.getApplicationSidecarsByNameAndSpaceArgsForCall)]
+	fake.getApplicationSidecarsByNameAndSpaceArgsForCall = append(fake.getApplicationSidecarsByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetApplicationSidecarsByNameAndSpaceStub
+	fakeReturns := fake.getApplicationSidecarsByNameAndSpaceReturns
+	fake.recordInvocation("GetApplicationSidecarsByNameAndSpace", []interface{}{arg1, arg2})
+	fake.getApplicationSidecarsByNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationSidecarsByNameAndSpaceCallCount() int {
+	fake.getApplicationSidecarsByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationSidecarsByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationSidecarsByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationSidecarsByNameAndSpaceCalls(stub func(string, string) ([]resources.Sidecar, v7action.Warnings, error)) {
+	fake.getApplicationSidecarsByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationSidecarsByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationSidecarsByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetApplicationSidecarsByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationSidecarsByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationSidecarsByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationSidecarsByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetApplicationSidecarsByNameAndSpaceReturns(result1 []resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationSidecarsByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationSidecarsByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationSidecarsByNameAndSpaceStub = nil
+	fake.getApplicationSidecarsByNameAndSpaceReturns = struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationSidecarsByNameAndSpaceReturnsOnCall(i int, result1 []resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationSidecarsByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationSidecarsByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationSidecarsByNameAndSpaceStub = nil
+	if fake.getApplicationSidecarsByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationSidecarsByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []resources.Sidecar
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationSidecarsByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetApplicationTasks(arg1 string, arg2 v7action.SortOrder) ([]resources.Task, v7action.Warnings, error) {
 	fake.getApplicationTasksMutex.Lock()
 	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
@@ -8738,6 +9741,73 @@ func (fake *FakeActor) GetApplicationsByNamesAndSpaceReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetApplicationsBySpace(arg1 string) ([]resources.Application, v7action.Warnings, error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationsBySpaceReturnsOnCall[len(fake.getApplicationsBySpaceArgsForCall)]
+	fake.getApplicationsBySpaceArgsForCall = append(fake.getApplicationsBySpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetApplicationsBySpaceStub
+	fakeReturns := fake.getApplicationsBySpaceReturns
+	fake.recordInvocation("GetApplicationsBySpace", []interface{}{arg1})
+	fake.getApplicationsBySpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceCallCount() int {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return len(fake.getApplicationsBySpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceCalls(stub func(string) ([]resources.Application, v7action.Warnings, error)) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = stub
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceArgsForCall(i int) string {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationsBySpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceReturns(result1 []resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = nil
+	fake.getApplicationsBySpaceReturns = struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceReturnsOnCall(i int, result1 []resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = nil
+	if fake.getApplicationsBySpaceReturnsOnCall == nil {
+		fake.getApplicationsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []resources.Application
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsBySpaceReturnsOnCall[i] = struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetBuildpackLabels(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
 	fake.getBuildpackLabelsMutex.Lock()
 	ret, specificReturn := fake.getBuildpackLabelsReturnsOnCall[len(fake.getBuildpackLabelsArgsForCall)]
@@ -8996,6 +10066,140 @@ func (fake *FakeActor) GetDefaultDomainReturnsOnCall(i int, result1 resources.Do
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetDeployment(arg1 string) (resources.Deployment, v7action.Warnings, error) {
+	fake.getDeploymentMutex.Lock()
+	ret, specificReturn := fake.getDeploymentReturnsOnCall[len(fake.getDeploymentArgsForCall)]
+	fake.getDeploymentArgsForCall = append(fake.getDeploymentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetDeploymentStub
+	fakeReturns := fake.getDeploymentReturns
+	fake.recordInvocation("GetDeployment", []interface{}{arg1})
+	fake.getDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetDeploymentCallCount() int {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	return len(fake.getDeploymentArgsForCall)
+}
+
+func (fake *FakeActor) GetDeploymentCalls(stub func(string) (resources.Deployment, v7action.Warnings, error)) {
+	fake.getDeploymentMutex.Lock()
+	defer fake.getDeploymentMutex.Unlock()
+	fake.GetDeploymentStub = stub
+}
+
+func (fake *FakeActor) GetDeploymentArgsForCall(i int) string {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	argsForCall := fake.getDeploymentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetDeploymentReturns(result1 resources.Deployment, result2 v7action.Warnings, result3 error) {
+	fake.getDeploymentMutex.Lock()
+	defer fake.getDeploymentMutex.Unlock()
+	fake.GetDeploymentStub = nil
+	fake.getDeploymentReturns = struct {
+		result1 resources.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetDeploymentReturnsOnCall(i int, result1 resources.Deployment, result2 v7action.Warnings, result3 error) {
+	fake.getDeploymentMutex.Lock()
+	defer fake.getDeploymentMutex.Unlock()
+	fake.GetDeploymentStub = nil
+	if fake.getDeploymentReturnsOnCall == nil {
+		fake.getDeploymentReturnsOnCall = make(map[int]struct {
+			result1 resources.Deployment
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDeploymentReturnsOnCall[i] = struct {
+		result1 resources.Deployment
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetDeploymentSummariesForApp(arg1 string) ([]v7action.DeploymentSummary, v7action.Warnings, error) {
+	fake.getDeploymentSummariesForAppMutex.Lock()
+	ret, specificReturn := fake.getDeploymentSummariesForAppReturnsOnCall[len(fake.getDeploymentSummariesForAppArgsForCall)]
+	fake.getDeploymentSummariesForAppArgsForCall = append(fake.getDeploymentSummariesForAppArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetDeploymentSummariesForAppStub
+	fakeReturns := fake.getDeploymentSummariesForAppReturns
+	fake.recordInvocation("GetDeploymentSummariesForApp", []interface{}{arg1})
+	fake.getDeploymentSummariesForAppMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetDeploymentSummariesForAppCallCount() int {
+	fake.getDeploymentSummariesForAppMutex.RLock()
+	defer fake.getDeploymentSummariesForAppMutex.RUnlock()
+	return len(fake.getDeploymentSummariesForAppArgsForCall)
+}
+
+func (fake *FakeActor) GetDeploymentSummariesForAppCalls(stub func(string) ([]v7action.DeploymentSummary, v7action.Warnings, error)) {
+	fake.getDeploymentSummariesForAppMutex.Lock()
+	defer fake.getDeploymentSummariesForAppMutex.Unlock()
+	fake.GetDeploymentSummariesForAppStub = stub
+}
+
+func (fake *FakeActor) GetDeploymentSummariesForAppArgsForCall(i int) string {
+	fake.getDeploymentSummariesForAppMutex.RLock()
+	defer fake.getDeploymentSummariesForAppMutex.RUnlock()
+	argsForCall := fake.getDeploymentSummariesForAppArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetDeploymentSummariesForAppReturns(result1 []v7action.DeploymentSummary, result2 v7action.Warnings, result3 error) {
+	fake.getDeploymentSummariesForAppMutex.Lock()
+	defer fake.getDeploymentSummariesForAppMutex.Unlock()
+	fake.GetDeploymentSummariesForAppStub = nil
+	fake.getDeploymentSummariesForAppReturns = struct {
+		result1 []v7action.DeploymentSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetDeploymentSummariesForAppReturnsOnCall(i int, result1 []v7action.DeploymentSummary, result2 v7action.Warnings, result3 error) {
+	fake.getDeploymentSummariesForAppMutex.Lock()
+	defer fake.getDeploymentSummariesForAppMutex.Unlock()
+	fake.GetDeploymentSummariesForAppStub = nil
+	if fake.getDeploymentSummariesForAppReturnsOnCall == nil {
+		fake.getDeploymentSummariesForAppReturnsOnCall = make(map[int]struct {
+			result1 []v7action.DeploymentSummary
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDeploymentSummariesForAppReturnsOnCall[i] = struct {
+		result1 []v7action.DeploymentSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetDetailedAppSummary(arg1 string, arg2 string, arg3 bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error) {
 	fake.getDetailedAppSummaryMutex.Lock()
 	ret, specificReturn := fake.getDetailedAppSummaryReturnsOnCall[len(fake.getDetailedAppSummaryArgsForCall)]
@@ -9065,6 +10269,74 @@ func (fake *FakeActor) GetDetailedAppSummaryReturnsOnCall(i int, result1 v7actio
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetDetailedDeploymentSummary(arg1 string, arg2 string) (v7action.DetailedDeploymentSummary, v7action.Warnings, error) {
+	fake.getDetailedDeploymentSummaryMutex.Lock()
+	ret, specificReturn := fake.getDetailedDeploymentSummaryReturnsOnCall[len(fake.getDetailedDeploymentSummaryArgsForCall)]
+	fake.getDetailedDeploymentSummaryArgsForCall = append(fake.getDetailedDeploymentSummaryArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetDetailedDeploymentSummaryStub
+	fakeReturns := fake.getDetailedDeploymentSummaryReturns
+	fake.recordInvocation("GetDetailedDeploymentSummary", []interface{}{arg1, arg2})
+	fake.getDetailedDeploymentSummaryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetDetailedDeploymentSummaryCallCount() int {
+	fake.getDetailedDeploymentSummaryMutex.RLock()
+	defer fake.getDetailedDeploymentSummaryMutex.RUnlock()
+	return len(fake.getDetailedDeploymentSummaryArgsForCall)
+}
+
+func (fake *FakeActor) GetDetailedDeploymentSummaryCalls(stub func(string, string) (v7action.DetailedDeploymentSummary, v7action.Warnings, error)) {
+	fake.getDetailedDeploymentSummaryMutex.Lock()
+	defer fake.getDetailedDeploymentSummaryMutex.Unlock()
+	fake.GetDetailedDeploymentSummaryStub = stub
+}
+
+func (fake *FakeActor) GetDetailedDeploymentSummaryArgsForCall(i int) (string, string) {
+	fake.getDetailedDeploymentSummaryMutex.RLock()
+	defer fake.getDetailedDeploymentSummaryMutex.RUnlock()
+	argsForCall := fake.getDetailedDeploymentSummaryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetDetailedDeploymentSummaryReturns(result1 v7action.DetailedDeploymentSummary, result2 v7action.Warnings, result3 error) {
+	fake.getDetailedDeploymentSummaryMutex.Lock()
+	defer fake.getDetailedDeploymentSummaryMutex.Unlock()
+	fake.GetDetailedDeploymentSummaryStub = nil
+	fake.getDetailedDeploymentSummaryReturns = struct {
+		result1 v7action.DetailedDeploymentSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetDetailedDeploymentSummaryReturnsOnCall(i int, result1 v7action.DetailedDeploymentSummary, result2 v7action.Warnings, result3 error) {
+	fake.getDetailedDeploymentSummaryMutex.Lock()
+	defer fake.getDetailedDeploymentSummaryMutex.Unlock()
+	fake.GetDetailedDeploymentSummaryStub = nil
+	if fake.getDetailedDeploymentSummaryReturnsOnCall == nil {
+		fake.getDetailedDeploymentSummaryReturnsOnCall = make(map[int]struct {
+			result1 v7action.DetailedDeploymentSummary
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDetailedDeploymentSummaryReturnsOnCall[i] = struct {
+		result1 v7action.DetailedDeploymentSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetDomain(arg1 string) (resources.Domain, v7action.Warnings, error) {
 	fake.getDomainMutex.Lock()
 	ret, specificReturn := fake.getDomainReturnsOnCall[len(fake.getDomainArgsForCall)]
@@ -9407,11 +10679,289 @@ func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpace(arg1 str
 	fake.getEnvironmentVariablesByApplicationNameAndSpaceArgsForCall = append(fake.getEnvironmentVariablesByApplicationNameAndSpaceArgsForCall, struct {
 		arg1 string
 		arg2 string
-	}{arg1, arg2})
-	stub := fake.GetEnvironmentVariablesByApplicationNameAndSpaceStub
-	fakeReturns := fake.getEnvironmentVariablesByApplicationNameAndSpaceReturns
-	fake.recordInvocation("GetEnvironmentVariablesByApplicationNameAndSpace", []interface{}{arg1, arg2})
-	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
+	}{arg1, arg2})
+	stub := fake.GetEnvironmentVariablesByApplicationNameAndSpaceStub
+	fakeReturns := fake.getEnvironmentVariablesByApplicationNameAndSpaceReturns
+	fake.recordInvocation("GetEnvironmentVariablesByApplicationNameAndSpace", []interface{}{arg1, arg2})
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpaceCallCount() int {
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
+	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
+	return len(fake.getEnvironmentVariablesByApplicationNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpaceCalls(stub func(string, string) (v7action.EnvironmentVariableGroups, v7action.Warnings, error)) {
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Lock()
+	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
+	fake.GetEnvironmentVariablesByApplicationNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
+	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getEnvironmentVariablesByApplicationNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpaceReturns(result1 v7action.EnvironmentVariableGroups, result2 v7action.Warnings, result3 error) {
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Lock()
+	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
+	fake.GetEnvironmentVariablesByApplicationNameAndSpaceStub = nil
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceReturns = struct {
+		result1 v7action.EnvironmentVariableGroups
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall(i int, result1 v7action.EnvironmentVariableGroups, result2 v7action.Warnings, result3 error) {
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Lock()
+	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
+	fake.GetEnvironmentVariablesByApplicationNameAndSpaceStub = nil
+	if fake.getEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall == nil {
+		fake.getEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7action.EnvironmentVariableGroups
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v7action.EnvironmentVariableGroups
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetEventsByApplicationNameAndSpace(arg1 string, arg2 string, arg3 v7action.EventFilters) ([]v7action.Event, v7action.Warnings, error) {
+	fake.getEventsByApplicationNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getEventsByApplicationNameAndSpaceReturnsOnCall[len(fake.getEventsByApplicationNameAndSpaceArgsForCall)]
+	fake.getEventsByApplicationNameAndSpaceArgsForCall = append(fake.getEventsByApplicationNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.EventFilters
+	}{arg1, arg2, arg3})
+	stub := fake.GetEventsByApplicationNameAndSpaceStub
+	fakeReturns := fake.getEventsByApplicationNameAndSpaceReturns
+	fake.recordInvocation("GetEventsByApplicationNameAndSpace", []interface{}{arg1, arg2, arg3})
+	fake.getEventsByApplicationNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetEventsByApplicationNameAndSpaceCallCount() int {
+	fake.getEventsByApplicationNameAndSpaceMutex.RLock()
+	defer fake.getEventsByApplicationNameAndSpaceMutex.RUnlock()
+	return len(fake.getEventsByApplicationNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetEventsByApplicationNameAndSpaceCalls(stub func(string, string, v7action.EventFilters) ([]v7action.Event, v7action.Warnings, error)) {
+	fake.getEventsByApplicationNameAndSpaceMutex.Lock()
+	defer fake.getEventsByApplicationNameAndSpaceMutex.Unlock()
+	fake.GetEventsByApplicationNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetEventsByApplicationNameAndSpaceArgsForCall(i int) (string, string, v7action.EventFilters) {
+	fake.getEventsByApplicationNameAndSpaceMutex.RLock()
+	defer fake.getEventsByApplicationNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getEventsByApplicationNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetEventsByApplicationNameAndSpaceReturns(result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getEventsByApplicationNameAndSpaceMutex.Lock()
+	defer fake.getEventsByApplicationNameAndSpaceMutex.Unlock()
+	fake.GetEventsByApplicationNameAndSpaceStub = nil
+	fake.getEventsByApplicationNameAndSpaceReturns = struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetEventsByApplicationNameAndSpaceReturnsOnCall(i int, result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getEventsByApplicationNameAndSpaceMutex.Lock()
+	defer fake.getEventsByApplicationNameAndSpaceMutex.Unlock()
+	fake.GetEventsByApplicationNameAndSpaceStub = nil
+	if fake.getEventsByApplicationNameAndSpaceReturnsOnCall == nil {
+		fake.getEventsByApplicationNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []v7action.Event
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getEventsByApplicationNameAndSpaceReturnsOnCall[i] = struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetEventsByOrganization(arg1 string, arg2 v7action.EventFilters) ([]v7action.Event, v7action.Warnings, error) {
+	fake.getEventsByOrganizationMutex.Lock()
+	ret, specificReturn := fake.getEventsByOrganizationReturnsOnCall[len(fake.getEventsByOrganizationArgsForCall)]
+	fake.getEventsByOrganizationArgsForCall = append(fake.getEventsByOrganizationArgsForCall, struct {
+		arg1 string
+		arg2 v7action.EventFilters
+	}{arg1, arg2})
+	stub := fake.GetEventsByOrganizationStub
+	fakeReturns := fake.getEventsByOrganizationReturns
+	fake.recordInvocation("GetEventsByOrganization", []interface{}{arg1, arg2})
+	fake.getEventsByOrganizationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetEventsByOrganizationCallCount() int {
+	fake.getEventsByOrganizationMutex.RLock()
+	defer fake.getEventsByOrganizationMutex.RUnlock()
+	return len(fake.getEventsByOrganizationArgsForCall)
+}
+
+func (fake *FakeActor) GetEventsByOrganizationCalls(stub func(string, v7action.EventFilters) ([]v7action.Event, v7action.Warnings, error)) {
+	fake.getEventsByOrganizationMutex.Lock()
+	defer fake.getEventsByOrganizationMutex.Unlock()
+	fake.GetEventsByOrganizationStub = stub
+}
+
+func (fake *FakeActor) GetEventsByOrganizationArgsForCall(i int) (string, v7action.EventFilters) {
+	fake.getEventsByOrganizationMutex.RLock()
+	defer fake.getEventsByOrganizationMutex.RUnlock()
+	argsForCall := fake.getEventsByOrganizationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetEventsByOrganizationReturns(result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getEventsByOrganizationMutex.Lock()
+	defer fake.getEventsByOrganizationMutex.Unlock()
+	fake.GetEventsByOrganizationStub = nil
+	fake.getEventsByOrganizationReturns = struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetEventsByOrganizationReturnsOnCall(i int, result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getEventsByOrganizationMutex.Lock()
+	defer fake.getEventsByOrganizationMutex.Unlock()
+	fake.GetEventsByOrganizationStub = nil
+	if fake.getEventsByOrganizationReturnsOnCall == nil {
+		fake.getEventsByOrganizationReturnsOnCall = make(map[int]struct {
+			result1 []v7action.Event
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getEventsByOrganizationReturnsOnCall[i] = struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetEventsBySpace(arg1 string, arg2 v7action.EventFilters) ([]v7action.Event, v7action.Warnings, error) {
+	fake.getEventsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getEventsBySpaceReturnsOnCall[len(fake.getEventsBySpaceArgsForCall)]
+	fake.getEventsBySpaceArgsForCall = append(fake.getEventsBySpaceArgsForCall, struct {
+		arg1 string
+		arg2 v7action.EventFilters
+	}{arg1, arg2})
+	stub := fake.GetEventsBySpaceStub
+	fakeReturns := fake.getEventsBySpaceReturns
+	fake.recordInvocation("GetEventsBySpace", []interface{}{arg1, arg2})
+	fake.getEventsBySpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetEventsBySpaceCallCount() int {
+	fake.getEventsBySpaceMutex.RLock()
+	defer fake.getEventsBySpaceMutex.RUnlock()
+	return len(fake.getEventsBySpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetEventsBySpaceCalls(stub func(string, v7action.EventFilters) ([]v7action.Event, v7action.Warnings, error)) {
+	fake.getEventsBySpaceMutex.Lock()
+	defer fake.getEventsBySpaceMutex.Unlock()
+	fake.GetEventsBySpaceStub = stub
+}
+
+func (fake *FakeActor) GetEventsBySpaceArgsForCall(i int) (string, v7action.EventFilters) {
+	fake.getEventsBySpaceMutex.RLock()
+	defer fake.getEventsBySpaceMutex.RUnlock()
+	argsForCall := fake.getEventsBySpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetEventsBySpaceReturns(result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getEventsBySpaceMutex.Lock()
+	defer fake.getEventsBySpaceMutex.Unlock()
+	fake.GetEventsBySpaceStub = nil
+	fake.getEventsBySpaceReturns = struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetEventsBySpaceReturnsOnCall(i int, result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getEventsBySpaceMutex.Lock()
+	defer fake.getEventsBySpaceMutex.Unlock()
+	fake.GetEventsBySpaceStub = nil
+	if fake.getEventsBySpaceReturnsOnCall == nil {
+		fake.getEventsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v7action.Event
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getEventsBySpaceReturnsOnCall[i] = struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetExistingApplicationsByNamesAndSpace(arg1 []string, arg2 string) ([]resources.Application, v7action.Warnings, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getExistingApplicationsByNamesAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getExistingApplicationsByNamesAndSpaceReturnsOnCall[len(fake.getExistingApplicationsByNamesAndSpaceArgsForCall)]
+	fake.getExistingApplicationsByNamesAndSpaceArgsForCall = append(fake.getExistingApplicationsByNamesAndSpaceArgsForCall, struct {
+		arg1 []string
+		arg2 string
+	}{arg1Copy, arg2})
+	stub := fake.GetExistingApplicationsByNamesAndSpaceStub
+	fakeReturns := fake.getExistingApplicationsByNamesAndSpaceReturns
+	fake.recordInvocation("GetExistingApplicationsByNamesAndSpace", []interface{}{arg1Copy, arg2})
+	fake.getExistingApplicationsByNamesAndSpaceMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -9421,49 +10971,49 @@ func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpace(arg1 str
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpaceCallCount() int {
-	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
-	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
-	return len(fake.getEnvironmentVariablesByApplicationNameAndSpaceArgsForCall)
+func (fake *FakeActor) GetExistingApplicationsByNamesAndSpaceCallCount() int {
+	fake.getExistingApplicationsByNamesAndSpaceMutex.RLock()
+	defer fake.getExistingApplicationsByNamesAndSpaceMutex.RUnlock()
+	return len(fake.getExistingApplicationsByNamesAndSpaceArgsForCall)
 }
 
-func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpaceCalls(stub func(string, string) (v7action.EnvironmentVariableGroups, v7action.Warnings, error)) {
-	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Lock()
-	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
-	fake.GetEnvironmentVariablesByApplicationNameAndSpaceStub = stub
+func (fake *FakeActor) GetExistingApplicationsByNamesAndSpaceCalls(stub func([]string, string) ([]resources.Application, v7action.Warnings, error)) {
+	fake.getExistingApplicationsByNamesAndSpaceMutex.Lock()
+	defer fake.getExistingApplicationsByNamesAndSpaceMutex.Unlock()
+	fake.GetExistingApplicationsByNamesAndSpaceStub = stub
 }
 
-func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpaceArgsForCall(i int) (string, string) {
-	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
-	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
-	argsForCall := fake.getEnvironmentVariablesByApplicationNameAndSpaceArgsForCall[i]
+func (fake *FakeActor) GetExistingApplicationsByNamesAndSpaceArgsForCall(i int) ([]string, string) {
+	fake.getExistingApplicationsByNamesAndSpaceMutex.RLock()
+	defer fake.getExistingApplicationsByNamesAndSpaceMutex.RUnlock()
+	argsForCall := fake.getExistingApplicationsByNamesAndSpaceArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpaceReturns(result1 v7action.EnvironmentVariableGroups, result2 v7action.Warnings, result3 error) {
-	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Lock()
-	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
-	fake.GetEnvironmentVariablesByApplicationNameAndSpaceStub = nil
-	fake.getEnvironmentVariablesByApplicationNameAndSpaceReturns = struct {
-		result1 v7action.EnvironmentVariableGroups
+func (fake *FakeActor) GetExistingApplicationsByNamesAndSpaceReturns(result1 []resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.getExistingApplicationsByNamesAndSpaceMutex.Lock()
+	defer fake.getExistingApplicationsByNamesAndSpaceMutex.Unlock()
+	fake.GetExistingApplicationsByNamesAndSpaceStub = nil
+	fake.getExistingApplicationsByNamesAndSpaceReturns = struct {
+		result1 []resources.Application
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall(i int, result1 v7action.EnvironmentVariableGroups, result2 v7action.Warnings, result3 error) {
-	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Lock()
-	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
-	fake.GetEnvironmentVariablesByApplicationNameAndSpaceStub = nil
-	if fake.getEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall == nil {
-		fake.getEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall = make(map[int]struct {
-			result1 v7action.EnvironmentVariableGroups
+func (fake *FakeActor) GetExistingApplicationsByNamesAndSpaceReturnsOnCall(i int, result1 []resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.getExistingApplicationsByNamesAndSpaceMutex.Lock()
+	defer fake.getExistingApplicationsByNamesAndSpaceMutex.Unlock()
+	fake.GetExistingApplicationsByNamesAndSpaceStub = nil
+	if fake.getExistingApplicationsByNamesAndSpaceReturnsOnCall == nil {
+		fake.getExistingApplicationsByNamesAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []resources.Application
 			result2 v7action.Warnings
 			result3 error
 		})
 	}
-	fake.getEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall[i] = struct {
-		result1 v7action.EnvironmentVariableGroups
+	fake.getExistingApplicationsByNamesAndSpaceReturnsOnCall[i] = struct {
+		result1 []resources.Application
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
@@ -10896,141 +12446,75 @@ func (fake *FakeActor) GetRawApplicationManifestByNameAndSpaceReturnsOnCall(i in
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpace(arg1 string, arg2 string) ([]v7action.Event, v7action.Warnings, error) {
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.Lock()
-	ret, specificReturn := fake.getRecentEventsByApplicationNameAndSpaceReturnsOnCall[len(fake.getRecentEventsByApplicationNameAndSpaceArgsForCall)]
-	fake.getRecentEventsByApplicationNameAndSpaceArgsForCall = append(fake.getRecentEventsByApplicationNameAndSpaceArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.GetRecentEventsByApplicationNameAndSpaceStub
-	fakeReturns := fake.getRecentEventsByApplicationNameAndSpaceReturns
-	fake.recordInvocation("GetRecentEventsByApplicationNameAndSpace", []interface{}{arg1, arg2})
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.Unlock()
-	if stub != nil {
-		return stub(arg1, arg2)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
-	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
-}
-
-func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpaceCallCount() int {
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.RLock()
-	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.RUnlock()
-	return len(fake.getRecentEventsByApplicationNameAndSpaceArgsForCall)
-}
-
-func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpaceCalls(stub func(string, string) ([]v7action.Event, v7action.Warnings, error)) {
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.Lock()
-	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.Unlock()
-	fake.GetRecentEventsByApplicationNameAndSpaceStub = stub
-}
-
-func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpaceArgsForCall(i int) (string, string) {
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.RLock()
-	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.RUnlock()
-	argsForCall := fake.getRecentEventsByApplicationNameAndSpaceArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
-}
-
-func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpaceReturns(result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.Lock()
-	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.Unlock()
-	fake.GetRecentEventsByApplicationNameAndSpaceStub = nil
-	fake.getRecentEventsByApplicationNameAndSpaceReturns = struct {
-		result1 []v7action.Event
-		result2 v7action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpaceReturnsOnCall(i int, result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.Lock()
-	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.Unlock()
-	fake.GetRecentEventsByApplicationNameAndSpaceStub = nil
-	if fake.getRecentEventsByApplicationNameAndSpaceReturnsOnCall == nil {
-		fake.getRecentEventsByApplicationNameAndSpaceReturnsOnCall = make(map[int]struct {
-			result1 []v7action.Event
-			result2 v7action.Warnings
-			result3 error
-		})
+func (fake *FakeActor) GetRecentLogsForApplications(arg1 []resources.Application, arg2 sharedaction.LogCacheClient, arg3 v7action.LogOptions) ([]v7action.AppLogMessage, error) {
+	var arg1Copy []resources.Application
+	if arg1 != nil {
+		arg1Copy = make([]resources.Application, len(arg1))
+		copy(arg1Copy, arg1)
 	}
-	fake.getRecentEventsByApplicationNameAndSpaceReturnsOnCall[i] = struct {
-		result1 []v7action.Event
-		result2 v7action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient) ([]sharedaction.LogMessage, v7action.Warnings, error) {
-	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
-	ret, specificReturn := fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall)]
-	fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall, struct {
-		arg1 string
-		arg2 string
-		arg3 sharedaction.LogCacheClient
-	}{arg1, arg2, arg3})
-	stub := fake.GetRecentLogsForApplicationByNameAndSpaceStub
-	fakeReturns := fake.getRecentLogsForApplicationByNameAndSpaceReturns
-	fake.recordInvocation("GetRecentLogsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3})
-	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.getRecentLogsForApplicationsMutex.Lock()
+	ret, specificReturn := fake.getRecentLogsForApplicationsReturnsOnCall[len(fake.getRecentLogsForApplicationsArgsForCall)]
+	fake.getRecentLogsForApplicationsArgsForCall = append(fake.getRecentLogsForApplicationsArgsForCall, struct {
+		arg1 []resources.Application
+		arg2 sharedaction.LogCacheClient
+		arg3 v7action.LogOptions
+	}{arg1Copy, arg2, arg3})
+	stub := fake.GetRecentLogsForApplicationsStub
+	fakeReturns := fake.getRecentLogsForApplicationsReturns
+	fake.recordInvocation("GetRecentLogsForApplications", []interface{}{arg1Copy, arg2, arg3})
+	fake.getRecentLogsForApplicationsMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceCallCount() int {
-	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
-	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
-	return len(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall)
+func (fake *FakeActor) GetRecentLogsForApplicationsCallCount() int {
+	fake.getRecentLogsForApplicationsMutex.RLock()
+	defer fake.getRecentLogsForApplicationsMutex.RUnlock()
+	return len(fake.getRecentLogsForApplicationsArgsForCall)
 }
 
-func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceCalls(stub func(string, string, sharedaction.LogCacheClient) ([]sharedaction.LogMessage, v7action.Warnings, error)) {
-	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
-	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
-	fake.GetRecentLogsForApplicationByNameAndSpaceStub = stub
+func (fake *FakeActor) GetRecentLogsForApplicationsCalls(stub func([]resources.Application, sharedaction.LogCacheClient, v7action.LogOptions) ([]v7action.AppLogMessage, error)) {
+	fake.getRecentLogsForApplicationsMutex.Lock()
+	defer fake.getRecentLogsForApplicationsMutex.Unlock()
+	fake.GetRecentLogsForApplicationsStub = stub
 }
 
-func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, sharedaction.LogCacheClient) {
-	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
-	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
-	argsForCall := fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i]
+func (fake *FakeActor) GetRecentLogsForApplicationsArgsForCall(i int) ([]resources.Application, sharedaction.LogCacheClient, v7action.LogOptions) {
+	fake.getRecentLogsForApplicationsMutex.RLock()
+	defer fake.getRecentLogsForApplicationsMutex.RUnlock()
+	argsForCall := fake.getRecentLogsForApplicationsArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceReturns(result1 []sharedaction.LogMessage, result2 v7action.Warnings, result3 error) {
-	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
-	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
-	fake.GetRecentLogsForApplicationByNameAndSpaceStub = nil
-	fake.getRecentLogsForApplicationByNameAndSpaceReturns = struct {
-		result1 []sharedaction.LogMessage
-		result2 v7action.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeActor) GetRecentLogsForApplicationsReturns(result1 []v7action.AppLogMessage, result2 error) {
+	fake.getRecentLogsForApplicationsMutex.Lock()
+	defer fake.getRecentLogsForApplicationsMutex.Unlock()
+	fake.GetRecentLogsForApplicationsStub = nil
+	fake.getRecentLogsForApplicationsReturns = struct {
+		result1 []v7action.AppLogMessage
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceReturnsOnCall(i int, result1 []sharedaction.LogMessage, result2 v7action.Warnings, result3 error) {
-	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
-	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
-	fake.GetRecentLogsForApplicationByNameAndSpaceStub = nil
-	if fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall == nil {
-		fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
-			result1 []sharedaction.LogMessage
-			result2 v7action.Warnings
-			result3 error
+func (fake *FakeActor) GetRecentLogsForApplicationsReturnsOnCall(i int, result1 []v7action.AppLogMessage, result2 error) {
+	fake.getRecentLogsForApplicationsMutex.Lock()
+	defer fake.getRecentLogsForApplicationsMutex.Unlock()
+	fake.GetRecentLogsForApplicationsStub = nil
+	if fake.getRecentLogsForApplicationsReturnsOnCall == nil {
+		fake.getRecentLogsForApplicationsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.AppLogMessage
+			result2 error
 		})
 	}
-	fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
-		result1 []sharedaction.LogMessage
-		result2 v7action.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.getRecentLogsForApplicationsReturnsOnCall[i] = struct {
+		result1 []v7action.AppLogMessage
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeActor) GetRevisionByApplicationAndVersion(arg1 string, arg2 int) (resources.Revision, v7action.Warnings, error) {
@@ -11956,6 +13440,75 @@ func (fake *FakeActor) GetSecureShellConfigurationByApplicationNameSpaceProcessT
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetSecureShellConfigurationsForAllInstances(arg1 string, arg2 string, arg3 string) ([]v7action.InstanceSSHAuthentication, v7action.Warnings, error) {
+	fake.getSecureShellConfigurationsForAllInstancesMutex.Lock()
+	ret, specificReturn := fake.getSecureShellConfigurationsForAllInstancesReturnsOnCall[len(fake.getSecureShellConfigurationsForAllInstancesArgsForCall)]
+	fake.getSecureShellConfigurationsForAllInstancesArgsForCall = append(fake.getSecureShellConfigurationsForAllInstancesArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetSecureShellConfigurationsForAllInstancesStub
+	fakeReturns := fake.getSecureShellConfigurationsForAllInstancesReturns
+	fake.recordInvocation("GetSecureShellConfigurationsForAllInstances", []interface{}{arg1, arg2, arg3})
+	fake.getSecureShellConfigurationsForAllInstancesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationsForAllInstancesCallCount() int {
+	fake.getSecureShellConfigurationsForAllInstancesMutex.RLock()
+	defer fake.getSecureShellConfigurationsForAllInstancesMutex.RUnlock()
+	return len(fake.getSecureShellConfigurationsForAllInstancesArgsForCall)
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationsForAllInstancesCalls(stub func(string, string, string) ([]v7action.InstanceSSHAuthentication, v7action.Warnings, error)) {
+	fake.getSecureShellConfigurationsForAllInstancesMutex.Lock()
+	defer fake.getSecureShellConfigurationsForAllInstancesMutex.Unlock()
+	fake.GetSecureShellConfigurationsForAllInstancesStub = stub
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationsForAllInstancesArgsForCall(i int) (string, string, string) {
+	fake.getSecureShellConfigurationsForAllInstancesMutex.RLock()
+	defer fake.getSecureShellConfigurationsForAllInstancesMutex.RUnlock()
+	argsForCall := fake.getSecureShellConfigurationsForAllInstancesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationsForAllInstancesReturns(result1 []v7action.InstanceSSHAuthentication, result2 v7action.Warnings, result3 error) {
+	fake.getSecureShellConfigurationsForAllInstancesMutex.Lock()
+	defer fake.getSecureShellConfigurationsForAllInstancesMutex.Unlock()
+	fake.GetSecureShellConfigurationsForAllInstancesStub = nil
+	fake.getSecureShellConfigurationsForAllInstancesReturns = struct {
+		result1 []v7action.InstanceSSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationsForAllInstancesReturnsOnCall(i int, result1 []v7action.InstanceSSHAuthentication, result2 v7action.Warnings, result3 error) {
+	fake.getSecureShellConfigurationsForAllInstancesMutex.Lock()
+	defer fake.getSecureShellConfigurationsForAllInstancesMutex.Unlock()
+	fake.GetSecureShellConfigurationsForAllInstancesStub = nil
+	if fake.getSecureShellConfigurationsForAllInstancesReturnsOnCall == nil {
+		fake.getSecureShellConfigurationsForAllInstancesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.InstanceSSHAuthentication
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getSecureShellConfigurationsForAllInstancesReturnsOnCall[i] = struct {
+		result1 []v7action.InstanceSSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetSecurityGroup(arg1 string) (resources.SecurityGroup, v7action.Warnings, error) {
 	fake.getSecurityGroupMutex.Lock()
 	ret, specificReturn := fake.getSecurityGroupReturnsOnCall[len(fake.getSecurityGroupArgsForCall)]
@@ -13915,6 +15468,80 @@ func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpaceReturnsOnCall
 	}{result1, result2, result3, result4, result5}
 }
 
+func (fake *FakeActor) GetStreamingLogsForApplications(arg1 []resources.Application, arg2 sharedaction.LogCacheClient, arg3 v7action.LogOptions) (<-chan v7action.AppLogMessage, <-chan error, context.CancelFunc) {
+	var arg1Copy []resources.Application
+	if arg1 != nil {
+		arg1Copy = make([]resources.Application, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getStreamingLogsForApplicationsMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsForApplicationsReturnsOnCall[len(fake.getStreamingLogsForApplicationsArgsForCall)]
+	fake.getStreamingLogsForApplicationsArgsForCall = append(fake.getStreamingLogsForApplicationsArgsForCall, struct {
+		arg1 []resources.Application
+		arg2 sharedaction.LogCacheClient
+		arg3 v7action.LogOptions
+	}{arg1Copy, arg2, arg3})
+	stub := fake.GetStreamingLogsForApplicationsStub
+	fakeReturns := fake.getStreamingLogsForApplicationsReturns
+	fake.recordInvocation("GetStreamingLogsForApplications", []interface{}{arg1Copy, arg2, arg3})
+	fake.getStreamingLogsForApplicationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetStreamingLogsForApplicationsCallCount() int {
+	fake.getStreamingLogsForApplicationsMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsMutex.RUnlock()
+	return len(fake.getStreamingLogsForApplicationsArgsForCall)
+}
+
+func (fake *FakeActor) GetStreamingLogsForApplicationsCalls(stub func([]resources.Application, sharedaction.LogCacheClient, v7action.LogOptions) (<-chan v7action.AppLogMessage, <-chan error, context.CancelFunc)) {
+	fake.getStreamingLogsForApplicationsMutex.Lock()
+	defer fake.getStreamingLogsForApplicationsMutex.Unlock()
+	fake.GetStreamingLogsForApplicationsStub = stub
+}
+
+func (fake *FakeActor) GetStreamingLogsForApplicationsArgsForCall(i int) ([]resources.Application, sharedaction.LogCacheClient, v7action.LogOptions) {
+	fake.getStreamingLogsForApplicationsMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsMutex.RUnlock()
+	argsForCall := fake.getStreamingLogsForApplicationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetStreamingLogsForApplicationsReturns(result1 <-chan v7action.AppLogMessage, result2 <-chan error, result3 context.CancelFunc) {
+	fake.getStreamingLogsForApplicationsMutex.Lock()
+	defer fake.getStreamingLogsForApplicationsMutex.Unlock()
+	fake.GetStreamingLogsForApplicationsStub = nil
+	fake.getStreamingLogsForApplicationsReturns = struct {
+		result1 <-chan v7action.AppLogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetStreamingLogsForApplicationsReturnsOnCall(i int, result1 <-chan v7action.AppLogMessage, result2 <-chan error, result3 context.CancelFunc) {
+	fake.getStreamingLogsForApplicationsMutex.Lock()
+	defer fake.getStreamingLogsForApplicationsMutex.Unlock()
+	fake.GetStreamingLogsForApplicationsStub = nil
+	if fake.getStreamingLogsForApplicationsReturnsOnCall == nil {
+		fake.getStreamingLogsForApplicationsReturnsOnCall = make(map[int]struct {
+			result1 <-chan v7action.AppLogMessage
+			result2 <-chan error
+			result3 context.CancelFunc
+		})
+	}
+	fake.getStreamingLogsForApplicationsReturnsOnCall[i] = struct {
+		result1 <-chan v7action.AppLogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetTaskBySequenceIDAndApplication(arg1 int, arg2 string) (resources.Task, v7action.Warnings, error) {
 	fake.getTaskBySequenceIDAndApplicationMutex.Lock()
 	ret, specificReturn := fake.getTaskBySequenceIDAndApplicationReturnsOnCall[len(fake.getTaskBySequenceIDAndApplicationArgsForCall)]
@@ -16953,6 +18580,126 @@ func (fake *FakeActor) StartApplicationReturnsOnCall(i int, result1 v7action.War
 	}{result1, result2}
 }
 
+func (fake *FakeActor) StartAuthorizationCodeLogin(arg1 string) (*v7action.AuthorizationCodeLogin, error) {
+	fake.startAuthorizationCodeLoginMutex.Lock()
+	ret, specificReturn := fake.startAuthorizationCodeLoginReturnsOnCall[len(fake.startAuthorizationCodeLoginArgsForCall)]
+	fake.startAuthorizationCodeLoginArgsForCall = append(fake.startAuthorizationCodeLoginArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.StartAuthorizationCodeLoginStub
+	fakeReturns := fake.startAuthorizationCodeLoginReturns
+	fake.recordInvocation("StartAuthorizationCodeLogin", []interface{}{arg1})
+	fake.startAuthorizationCodeLoginMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) StartAuthorizationCodeLoginCallCount() int {
+	fake.startAuthorizationCodeLoginMutex.RLock()
+	defer fake.startAuthorizationCodeLoginMutex.RUnlock()
+	return len(fake.startAuthorizationCodeLoginArgsForCall)
+}
+
+func (fake *FakeActor) StartAuthorizationCodeLoginCalls(stub func(string) (*v7action.AuthorizationCodeLogin, error)) {
+	fake.startAuthorizationCodeLoginMutex.Lock()
+	defer fake.startAuthorizationCodeLoginMutex.Unlock()
+	fake.StartAuthorizationCodeLoginStub = stub
+}
+
+func (fake *FakeActor) StartAuthorizationCodeLoginArgsForCall(i int) string {
+	fake.startAuthorizationCodeLoginMutex.RLock()
+	defer fake.startAuthorizationCodeLoginMutex.RUnlock()
+	argsForCall := fake.startAuthorizationCodeLoginArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) StartAuthorizationCodeLoginReturns(result1 *v7action.AuthorizationCodeLogin, result2 error) {
+	fake.startAuthorizationCodeLoginMutex.Lock()
+	defer fake.startAuthorizationCodeLoginMutex.Unlock()
+	fake.StartAuthorizationCodeLoginStub = nil
+	fake.startAuthorizationCodeLoginReturns = struct {
+		result1 *v7action.AuthorizationCodeLogin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) StartAuthorizationCodeLoginReturnsOnCall(i int, result1 *v7action.AuthorizationCodeLogin, result2 error) {
+	fake.startAuthorizationCodeLoginMutex.Lock()
+	defer fake.startAuthorizationCodeLoginMutex.Unlock()
+	fake.StartAuthorizationCodeLoginStub = nil
+	if fake.startAuthorizationCodeLoginReturnsOnCall == nil {
+		fake.startAuthorizationCodeLoginReturnsOnCall = make(map[int]struct {
+			result1 *v7action.AuthorizationCodeLogin
+			result2 error
+		})
+	}
+	fake.startAuthorizationCodeLoginReturnsOnCall[i] = struct {
+		result1 *v7action.AuthorizationCodeLogin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) StartDeviceLogin() (v7action.DeviceAuthorization, error) {
+	fake.startDeviceLoginMutex.Lock()
+	ret, specificReturn := fake.startDeviceLoginReturnsOnCall[len(fake.startDeviceLoginArgsForCall)]
+	fake.startDeviceLoginArgsForCall = append(fake.startDeviceLoginArgsForCall, struct {
+	}{})
+	stub := fake.StartDeviceLoginStub
+	fakeReturns := fake.startDeviceLoginReturns
+	fake.recordInvocation("StartDeviceLogin", []interface{}{})
+	fake.startDeviceLoginMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) StartDeviceLoginCallCount() int {
+	fake.startDeviceLoginMutex.RLock()
+	defer fake.startDeviceLoginMutex.RUnlock()
+	return len(fake.startDeviceLoginArgsForCall)
+}
+
+func (fake *FakeActor) StartDeviceLoginCalls(stub func() (v7action.DeviceAuthorization, error)) {
+	fake.startDeviceLoginMutex.Lock()
+	defer fake.startDeviceLoginMutex.Unlock()
+	fake.StartDeviceLoginStub = stub
+}
+
+func (fake *FakeActor) StartDeviceLoginReturns(result1 v7action.DeviceAuthorization, result2 error) {
+	fake.startDeviceLoginMutex.Lock()
+	defer fake.startDeviceLoginMutex.Unlock()
+	fake.StartDeviceLoginStub = nil
+	fake.startDeviceLoginReturns = struct {
+		result1 v7action.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) StartDeviceLoginReturnsOnCall(i int, result1 v7action.DeviceAuthorization, result2 error) {
+	fake.startDeviceLoginMutex.Lock()
+	defer fake.startDeviceLoginMutex.Unlock()
+	fake.StartDeviceLoginStub = nil
+	if fake.startDeviceLoginReturnsOnCall == nil {
+		fake.startDeviceLoginReturnsOnCall = make(map[int]struct {
+			result1 v7action.DeviceAuthorization
+			result2 error
+		})
+	}
+	fake.startDeviceLoginReturnsOnCall[i] = struct {
+		result1 v7action.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) StopApplication(arg1 string) (v7action.Warnings, error) {
 	fake.stopApplicationMutex.Lock()
 	ret, specificReturn := fake.stopApplicationReturnsOnCall[len(fake.stopApplicationArgsForCall)]
@@ -17744,6 +19491,75 @@ func (fake *FakeActor) UpdateApplicationLabelsByApplicationNameReturnsOnCall(i i
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateApplicationSidecarByNameAndSpace(arg1 string, arg2 string, arg3 resources.Sidecar) (resources.Sidecar, v7action.Warnings, error) {
+	fake.updateApplicationSidecarByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.updateApplicationSidecarByNameAndSpaceReturnsOnCall[len(fake.updateApplicationSidecarByNameAndSpaceArgsForCall)]
+	fake.updateApplicationSidecarByNameAndSpaceArgsForCall = append(fake.updateApplicationSidecarByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 resources.Sidecar
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateApplicationSidecarByNameAndSpaceStub
+	fakeReturns := fake.updateApplicationSidecarByNameAndSpaceReturns
+	fake.recordInvocation("UpdateApplicationSidecarByNameAndSpace", []interface{}{arg1, arg2, arg3})
+	fake.updateApplicationSidecarByNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarByNameAndSpaceCallCount() int {
+	fake.updateApplicationSidecarByNameAndSpaceMutex.RLock()
+	defer fake.updateApplicationSidecarByNameAndSpaceMutex.RUnlock()
+	return len(fake.updateApplicationSidecarByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarByNameAndSpaceCalls(stub func(string, string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)) {
+	fake.updateApplicationSidecarByNameAndSpaceMutex.Lock()
+	defer fake.updateApplicationSidecarByNameAndSpaceMutex.Unlock()
+	fake.UpdateApplicationSidecarByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarByNameAndSpaceArgsForCall(i int) (string, string, resources.Sidecar) {
+	fake.updateApplicationSidecarByNameAndSpaceMutex.RLock()
+	defer fake.updateApplicationSidecarByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.updateApplicationSidecarByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarByNameAndSpaceReturns(result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.updateApplicationSidecarByNameAndSpaceMutex.Lock()
+	defer fake.updateApplicationSidecarByNameAndSpaceMutex.Unlock()
+	fake.UpdateApplicationSidecarByNameAndSpaceStub = nil
+	fake.updateApplicationSidecarByNameAndSpaceReturns = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarByNameAndSpaceReturnsOnCall(i int, result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.updateApplicationSidecarByNameAndSpaceMutex.Lock()
+	defer fake.updateApplicationSidecarByNameAndSpaceMutex.Unlock()
+	fake.UpdateApplicationSidecarByNameAndSpaceStub = nil
+	if fake.updateApplicationSidecarByNameAndSpaceReturnsOnCall == nil {
+		fake.updateApplicationSidecarByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 resources.Sidecar
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.updateApplicationSidecarByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) UpdateBuildpackByNameAndStack(arg1 string, arg2 string, arg3 resources.Buildpack) (resources.Buildpack, v7action.Warnings, error) {
 	fake.updateBuildpackByNameAndStackMutex.Lock()
 	ret, specificReturn := fake.updateBuildpackByNameAndStackReturnsOnCall[len(fake.updateBuildpackByNameAndStackArgsForCall)]
@@ -19478,8 +21294,14 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.applyOrganizationQuotaByNameMutex.RLock()
 	defer fake.applyOrganizationQuotaByNameMutex.RUnlock()
+	fake.applyRouteMutex.RLock()
+	defer fake.applyRouteMutex.RUnlock()
+	fake.applyServiceInstanceMutex.RLock()
+	defer fake.applyServiceInstanceMutex.RUnlock()
 	fake.applySpaceQuotaByNameMutex.RLock()
 	defer fake.applySpaceQuotaByNameMutex.RUnlock()
+	fake.applyUserProvidedServiceMutex.RLock()
+	defer fake.applyUserProvidedServiceMutex.RUnlock()
 	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RLock()
 	defer fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RUnlock()
 	fake.authenticateMutex.RLock()
@@ -19502,6 +21324,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.createApplicationDropletMutex.RUnlock()
 	fake.createApplicationInSpaceMutex.RLock()
 	defer fake.createApplicationInSpaceMutex.RUnlock()
+	fake.createApplicationSidecarByNameAndSpaceMutex.RLock()
+	defer fake.createApplicationSidecarByNameAndSpaceMutex.RUnlock()
 	fake.createBitsPackageByApplicationMutex.RLock()
 	defer fake.createBitsPackageByApplicationMutex.RUnlock()
 	fake.createBuildpackMutex.RLock()
@@ -19550,6 +21374,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
 	fake.deleteApplicationByNameAndSpaceMutex.RLock()
 	defer fake.deleteApplicationByNameAndSpaceMutex.RUnlock()
+	fake.deleteApplicationSidecarByNameAndSpaceMutex.RLock()
+	defer fake.deleteApplicationSidecarByNameAndSpaceMutex.RUnlock()
 	fake.deleteBuildpackByNameAndStackMutex.RLock()
 	defer fake.deleteBuildpackByNameAndStackMutex.RUnlock()
 	fake.deleteDomainMutex.RLock()
@@ -19606,6 +21432,14 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.enableServiceAccessMutex.RUnlock()
 	fake.entitleIsolationSegmentToOrganizationByNameMutex.RLock()
 	defer fake.entitleIsolationSegmentToOrganizationByNameMutex.RUnlock()
+	fake.evaluateCanaryHealthMutex.RLock()
+	defer fake.evaluateCanaryHealthMutex.RUnlock()
+	fake.exportSpaceMutex.RLock()
+	defer fake.exportSpaceMutex.RUnlock()
+	fake.finishAuthorizationCodeLoginMutex.RLock()
+	defer fake.finishAuthorizationCodeLoginMutex.RUnlock()
+	fake.finishDeviceLoginMutex.RLock()
+	defer fake.finishDeviceLoginMutex.RUnlock()
 	fake.getAppFeatureMutex.RLock()
 	defer fake.getAppFeatureMutex.RUnlock()
 	fake.getAppSummariesForSpaceMutex.RLock()
@@ -19626,10 +21460,14 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationRevisionsDeployedMutex.RUnlock()
 	fake.getApplicationRoutesMutex.RLock()
 	defer fake.getApplicationRoutesMutex.RUnlock()
+	fake.getApplicationSidecarsByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationSidecarsByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationTasksMutex.RLock()
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getApplicationsByNamesAndSpaceMutex.RLock()
 	defer fake.getApplicationsByNamesAndSpaceMutex.RUnlock()
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
 	fake.getBuildpackLabelsMutex.RLock()
 	defer fake.getBuildpackLabelsMutex.RUnlock()
 	fake.getBuildpacksMutex.RLock()
@@ -19638,8 +21476,14 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getCurrentUserMutex.RUnlock()
 	fake.getDefaultDomainMutex.RLock()
 	defer fake.getDefaultDomainMutex.RUnlock()
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	fake.getDeploymentSummariesForAppMutex.RLock()
+	defer fake.getDeploymentSummariesForAppMutex.RUnlock()
 	fake.getDetailedAppSummaryMutex.RLock()
 	defer fake.getDetailedAppSummaryMutex.RUnlock()
+	fake.getDetailedDeploymentSummaryMutex.RLock()
+	defer fake.getDetailedDeploymentSummaryMutex.RUnlock()
 	fake.getDomainMutex.RLock()
 	defer fake.getDomainMutex.RUnlock()
 	fake.getDomainByNameMutex.RLock()
@@ -19652,6 +21496,14 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getEnvironmentVariableGroupMutex.RUnlock()
 	fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
 	defer fake.getEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
+	fake.getEventsByApplicationNameAndSpaceMutex.RLock()
+	defer fake.getEventsByApplicationNameAndSpaceMutex.RUnlock()
+	fake.getEventsByOrganizationMutex.RLock()
+	defer fake.getEventsByOrganizationMutex.RUnlock()
+	fake.getEventsBySpaceMutex.RLock()
+	defer fake.getEventsBySpaceMutex.RUnlock()
+	fake.getExistingApplicationsByNamesAndSpaceMutex.RLock()
+	defer fake.getExistingApplicationsByNamesAndSpaceMutex.RUnlock()
 	fake.getFeatureFlagByNameMutex.RLock()
 	defer fake.getFeatureFlagByNameMutex.RUnlock()
 	fake.getFeatureFlagsMutex.RLock()
@@ -19696,10 +21548,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
 	fake.getRawApplicationManifestByNameAndSpaceMutex.RLock()
 	defer fake.getRawApplicationManifestByNameAndSpaceMutex.RUnlock()
-	fake.getRecentEventsByApplicationNameAndSpaceMutex.RLock()
-	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.RUnlock()
-	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
-	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getRecentLogsForApplicationsMutex.RLock()
+	defer fake.getRecentLogsForApplicationsMutex.RUnlock()
 	fake.getRevisionByApplicationAndVersionMutex.RLock()
 	defer fake.getRevisionByApplicationAndVersionMutex.RUnlock()
 	fake.getRevisionsByApplicationNameAndSpaceMutex.RLock()
@@ -19728,6 +21578,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getSSHPasscodeMutex.RUnlock()
 	fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
 	defer fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
+	fake.getSecureShellConfigurationsForAllInstancesMutex.RLock()
+	defer fake.getSecureShellConfigurationsForAllInstancesMutex.RUnlock()
 	fake.getSecurityGroupMutex.RLock()
 	defer fake.getSecurityGroupMutex.RUnlock()
 	fake.getSecurityGroupSummaryMutex.RLock()
@@ -19786,6 +21638,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getStacksMutex.RUnlock()
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getStreamingLogsForApplicationsMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsMutex.RUnlock()
 	fake.getTaskBySequenceIDAndApplicationMutex.RLock()
 	defer fake.getTaskBySequenceIDAndApplicationMutex.RUnlock()
 	fake.getUAAAPIVersionMutex.RLock()
@@ -19878,6 +21732,10 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.stagePackageMutex.RUnlock()
 	fake.startApplicationMutex.RLock()
 	defer fake.startApplicationMutex.RUnlock()
+	fake.startAuthorizationCodeLoginMutex.RLock()
+	defer fake.startAuthorizationCodeLoginMutex.RUnlock()
+	fake.startDeviceLoginMutex.RLock()
+	defer fake.startDeviceLoginMutex.RUnlock()
 	fake.stopApplicationMutex.RLock()
 	defer fake.stopApplicationMutex.RUnlock()
 	fake.terminateTaskMutex.RLock()
@@ -19902,6 +21760,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.updateApplicationMutex.RUnlock()
 	fake.updateApplicationLabelsByApplicationNameMutex.RLock()
 	defer fake.updateApplicationLabelsByApplicationNameMutex.RUnlock()
+	fake.updateApplicationSidecarByNameAndSpaceMutex.RLock()
+	defer fake.updateApplicationSidecarByNameAndSpaceMutex.RUnlock()
 	fake.updateBuildpackByNameAndStackMutex.RLock()
 	defer fake.updateBuildpackByNameAndStackMutex.RUnlock()
 	fake.updateBuildpackLabelsByBuildpackNameAndStackMutex.RLock()
@@ -10,12 +10,13 @@ import (
 )
 
 type FakeManifestParser struct {
-	InterpolateManifestStub        func(string, []string, []template.VarKV) ([]byte, error)
+	InterpolateManifestStub        func(string, []string, []template.VarKV, []string) ([]byte, error)
 	interpolateManifestMutex       sync.RWMutex
 	interpolateManifestArgsForCall []struct {
 		arg1 string
 		arg2 []string
 		arg3 []template.VarKV
+		arg4 []string
 	}
 	interpolateManifestReturns struct {
 		result1 []byte
@@ -52,11 +53,47 @@ type FakeManifestParser struct {
 		result1 manifestparser.Manifest
 		result2 error
 	}
+	ResolvedSecretsStub        func() []string
+	resolvedSecretsMutex       sync.RWMutex
+	resolvedSecretsArgsForCall []struct {
+	}
+	resolvedSecretsReturns struct {
+		result1 []string
+	}
+	resolvedSecretsReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	ValidateManifestStub        func(string) ([]manifestparser.ValidationError, error)
+	validateManifestMutex       sync.RWMutex
+	validateManifestArgsForCall []struct {
+		arg1 string
+	}
+	validateManifestReturns struct {
+		result1 []manifestparser.ValidationError
+		result2 error
+	}
+	validateManifestReturnsOnCall map[int]struct {
+		result1 []manifestparser.ValidationError
+		result2 error
+	}
+	ValidateRawManifestStub        func([]byte) ([]manifestparser.ValidationError, error)
+	validateRawManifestMutex       sync.RWMutex
+	validateRawManifestArgsForCall []struct {
+		arg1 []byte
+	}
+	validateRawManifestReturns struct {
+		result1 []manifestparser.ValidationError
+		result2 error
+	}
+	validateRawManifestReturnsOnCall map[int]struct {
+		result1 []manifestparser.ValidationError
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeManifestParser) InterpolateManifest(arg1 string, arg2 []string, arg3 []template.VarKV) ([]byte, error) {
+func (fake *FakeManifestParser) InterpolateManifest(arg1 string, arg2 []string, arg3 []template.VarKV, arg4 []string) ([]byte, error) {
 	var arg2Copy []string
 	if arg2 != nil {
 		arg2Copy = make([]string, len(arg2))
@@ -67,22 +104,29 @@ func (fake *FakeManifestParser) InterpolateManifest(arg1 string, arg2 []string,
 		arg3Copy = make([]template.VarKV, len(arg3))
 		copy(arg3Copy, arg3)
 	}
+	var arg4Copy []string
+	if arg4 != nil {
+		arg4Copy = make([]string, len(arg4))
+		copy(arg4Copy, arg4)
+	}
 	fake.interpolateManifestMutex.Lock()
 	ret, specificReturn := fake.interpolateManifestReturnsOnCall[len(fake.interpolateManifestArgsForCall)]
 	fake.interpolateManifestArgsForCall = append(fake.interpolateManifestArgsForCall, struct {
 		arg1 string
 		arg2 []string
 		arg3 []template.VarKV
-	}{arg1, arg2Copy, arg3Copy})
-	fake.recordInvocation("InterpolateManifest", []interface{}{arg1, arg2Copy, arg3Copy})
+		arg4 []string
+	}{arg1, arg2Copy, arg3Copy, arg4Copy})
+	stub := fake.InterpolateManifestStub
+	fakeReturns := fake.interpolateManifestReturns
+	fake.recordInvocation("InterpolateManifest", []interface{}{arg1, arg2Copy, arg3Copy, arg4Copy})
 	fake.interpolateManifestMutex.Unlock()
-	if fake.InterpolateManifestStub != nil {
-		return fake.InterpolateManifestStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.interpolateManifestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -92,17 +136,17 @@ func (fake *FakeManifestParser) InterpolateManifestCallCount() int {
 	return len(fake.interpolateManifestArgsForCall)
 }
 
-func (fake *FakeManifestParser) InterpolateManifestCalls(stub func(string, []string, []template.VarKV) ([]byte, error)) {
+func (fake *FakeManifestParser) InterpolateManifestCalls(stub func(string, []string, []template.VarKV, []string) ([]byte, error)) {
 	fake.interpolateManifestMutex.Lock()
 	defer fake.interpolateManifestMutex.Unlock()
 	fake.InterpolateManifestStub = stub
 }
 
-func (fake *FakeManifestParser) InterpolateManifestArgsForCall(i int) (string, []string, []template.VarKV) {
+func (fake *FakeManifestParser) InterpolateManifestArgsForCall(i int) (string, []string, []template.VarKV, []string) {
 	fake.interpolateManifestMutex.RLock()
 	defer fake.interpolateManifestMutex.RUnlock()
 	argsForCall := fake.interpolateManifestArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeManifestParser) InterpolateManifestReturns(result1 []byte, result2 error) {
@@ -137,15 +181,16 @@ func (fake *FakeManifestParser) MarshalManifest(arg1 manifestparser.Manifest) ([
 	fake.marshalManifestArgsForCall = append(fake.marshalManifestArgsForCall, struct {
 		arg1 manifestparser.Manifest
 	}{arg1})
+	stub := fake.MarshalManifestStub
+	fakeReturns := fake.marshalManifestReturns
 	fake.recordInvocation("MarshalManifest", []interface{}{arg1})
 	fake.marshalManifestMutex.Unlock()
-	if fake.MarshalManifestStub != nil {
-		return fake.MarshalManifestStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.marshalManifestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -206,15 +251,16 @@ func (fake *FakeManifestParser) ParseManifest(arg1 string, arg2 []byte) (manifes
 		arg1 string
 		arg2 []byte
 	}{arg1, arg2Copy})
+	stub := fake.ParseManifestStub
+	fakeReturns := fake.parseManifestReturns
 	fake.recordInvocation("ParseManifest", []interface{}{arg1, arg2Copy})
 	fake.parseManifestMutex.Unlock()
-	if fake.ParseManifestStub != nil {
-		return fake.ParseManifestStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.parseManifestReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -263,6 +309,192 @@ func (fake *FakeManifestParser) ParseManifestReturnsOnCall(i int, result1 manife
 	}{result1, result2}
 }
 
+func (fake *FakeManifestParser) ResolvedSecrets() []string {
+	fake.resolvedSecretsMutex.Lock()
+	ret, specificReturn := fake.resolvedSecretsReturnsOnCall[len(fake.resolvedSecretsArgsForCall)]
+	fake.resolvedSecretsArgsForCall = append(fake.resolvedSecretsArgsForCall, struct {
+	}{})
+	stub := fake.ResolvedSecretsStub
+	fakeReturns := fake.resolvedSecretsReturns
+	fake.recordInvocation("ResolvedSecrets", []interface{}{})
+	fake.resolvedSecretsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeManifestParser) ResolvedSecretsCallCount() int {
+	fake.resolvedSecretsMutex.RLock()
+	defer fake.resolvedSecretsMutex.RUnlock()
+	return len(fake.resolvedSecretsArgsForCall)
+}
+
+func (fake *FakeManifestParser) ResolvedSecretsCalls(stub func() []string) {
+	fake.resolvedSecretsMutex.Lock()
+	defer fake.resolvedSecretsMutex.Unlock()
+	fake.ResolvedSecretsStub = stub
+}
+
+func (fake *FakeManifestParser) ResolvedSecretsReturns(result1 []string) {
+	fake.resolvedSecretsMutex.Lock()
+	defer fake.resolvedSecretsMutex.Unlock()
+	fake.ResolvedSecretsStub = nil
+	fake.resolvedSecretsReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeManifestParser) ResolvedSecretsReturnsOnCall(i int, result1 []string) {
+	fake.resolvedSecretsMutex.Lock()
+	defer fake.resolvedSecretsMutex.Unlock()
+	fake.ResolvedSecretsStub = nil
+	if fake.resolvedSecretsReturnsOnCall == nil {
+		fake.resolvedSecretsReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.resolvedSecretsReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeManifestParser) ValidateManifest(arg1 string) ([]manifestparser.ValidationError, error) {
+	fake.validateManifestMutex.Lock()
+	ret, specificReturn := fake.validateManifestReturnsOnCall[len(fake.validateManifestArgsForCall)]
+	fake.validateManifestArgsForCall = append(fake.validateManifestArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateManifestStub
+	fakeReturns := fake.validateManifestReturns
+	fake.recordInvocation("ValidateManifest", []interface{}{arg1})
+	fake.validateManifestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManifestParser) ValidateManifestCallCount() int {
+	fake.validateManifestMutex.RLock()
+	defer fake.validateManifestMutex.RUnlock()
+	return len(fake.validateManifestArgsForCall)
+}
+
+func (fake *FakeManifestParser) ValidateManifestCalls(stub func(string) ([]manifestparser.ValidationError, error)) {
+	fake.validateManifestMutex.Lock()
+	defer fake.validateManifestMutex.Unlock()
+	fake.ValidateManifestStub = stub
+}
+
+func (fake *FakeManifestParser) ValidateManifestArgsForCall(i int) string {
+	fake.validateManifestMutex.RLock()
+	defer fake.validateManifestMutex.RUnlock()
+	argsForCall := fake.validateManifestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeManifestParser) ValidateManifestReturns(result1 []manifestparser.ValidationError, result2 error) {
+	fake.validateManifestMutex.Lock()
+	defer fake.validateManifestMutex.Unlock()
+	fake.ValidateManifestStub = nil
+	fake.validateManifestReturns = struct {
+		result1 []manifestparser.ValidationError
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManifestParser) ValidateManifestReturnsOnCall(i int, result1 []manifestparser.ValidationError, result2 error) {
+	fake.validateManifestMutex.Lock()
+	defer fake.validateManifestMutex.Unlock()
+	fake.ValidateManifestStub = nil
+	if fake.validateManifestReturnsOnCall == nil {
+		fake.validateManifestReturnsOnCall = make(map[int]struct {
+			result1 []manifestparser.ValidationError
+			result2 error
+		})
+	}
+	fake.validateManifestReturnsOnCall[i] = struct {
+		result1 []manifestparser.ValidationError
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManifestParser) ValidateRawManifest(arg1 []byte) ([]manifestparser.ValidationError, error) {
+	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.validateRawManifestMutex.Lock()
+	ret, specificReturn := fake.validateRawManifestReturnsOnCall[len(fake.validateRawManifestArgsForCall)]
+	fake.validateRawManifestArgsForCall = append(fake.validateRawManifestArgsForCall, struct {
+		arg1 []byte
+	}{arg1Copy})
+	stub := fake.ValidateRawManifestStub
+	fakeReturns := fake.validateRawManifestReturns
+	fake.recordInvocation("ValidateRawManifest", []interface{}{arg1Copy})
+	fake.validateRawManifestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManifestParser) ValidateRawManifestCallCount() int {
+	fake.validateRawManifestMutex.RLock()
+	defer fake.validateRawManifestMutex.RUnlock()
+	return len(fake.validateRawManifestArgsForCall)
+}
+
+func (fake *FakeManifestParser) ValidateRawManifestCalls(stub func([]byte) ([]manifestparser.ValidationError, error)) {
+	fake.validateRawManifestMutex.Lock()
+	defer fake.validateRawManifestMutex.Unlock()
+	fake.ValidateRawManifestStub = stub
+}
+
+func (fake *FakeManifestParser) ValidateRawManifestArgsForCall(i int) []byte {
+	fake.validateRawManifestMutex.RLock()
+	defer fake.validateRawManifestMutex.RUnlock()
+	argsForCall := fake.validateRawManifestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeManifestParser) ValidateRawManifestReturns(result1 []manifestparser.ValidationError, result2 error) {
+	fake.validateRawManifestMutex.Lock()
+	defer fake.validateRawManifestMutex.Unlock()
+	fake.ValidateRawManifestStub = nil
+	fake.validateRawManifestReturns = struct {
+		result1 []manifestparser.ValidationError
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManifestParser) ValidateRawManifestReturnsOnCall(i int, result1 []manifestparser.ValidationError, result2 error) {
+	fake.validateRawManifestMutex.Lock()
+	defer fake.validateRawManifestMutex.Unlock()
+	fake.ValidateRawManifestStub = nil
+	if fake.validateRawManifestReturnsOnCall == nil {
+		fake.validateRawManifestReturnsOnCall = make(map[int]struct {
+			result1 []manifestparser.ValidationError
+			result2 error
+		})
+	}
+	fake.validateRawManifestReturnsOnCall[i] = struct {
+		result1 []manifestparser.ValidationError
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeManifestParser) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -272,6 +504,12 @@ func (fake *FakeManifestParser) Invocations() map[string][][]interface{} {
 	defer fake.marshalManifestMutex.RUnlock()
 	fake.parseManifestMutex.RLock()
 	defer fake.parseManifestMutex.RUnlock()
+	fake.resolvedSecretsMutex.RLock()
+	defer fake.resolvedSecretsMutex.RUnlock()
+	fake.validateManifestMutex.RLock()
+	defer fake.validateManifestMutex.RUnlock()
+	fake.validateRawManifestMutex.RLock()
+	defer fake.validateRawManifestMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
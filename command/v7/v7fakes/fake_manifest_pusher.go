@@ -0,0 +1,111 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeManifestPusher struct {
+	PushManifestStub        func(string) error
+	pushManifestMutex       sync.RWMutex
+	pushManifestArgsForCall []struct {
+		arg1 string
+	}
+	pushManifestReturns struct {
+		result1 error
+	}
+	pushManifestReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeManifestPusher) PushManifest(arg1 string) error {
+	fake.pushManifestMutex.Lock()
+	ret, specificReturn := fake.pushManifestReturnsOnCall[len(fake.pushManifestArgsForCall)]
+	fake.pushManifestArgsForCall = append(fake.pushManifestArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.PushManifestStub
+	fakeReturns := fake.pushManifestReturns
+	fake.recordInvocation("PushManifest", []interface{}{arg1})
+	fake.pushManifestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeManifestPusher) PushManifestCallCount() int {
+	fake.pushManifestMutex.RLock()
+	defer fake.pushManifestMutex.RUnlock()
+	return len(fake.pushManifestArgsForCall)
+}
+
+func (fake *FakeManifestPusher) PushManifestCalls(stub func(string) error) {
+	fake.pushManifestMutex.Lock()
+	defer fake.pushManifestMutex.Unlock()
+	fake.PushManifestStub = stub
+}
+
+func (fake *FakeManifestPusher) PushManifestArgsForCall(i int) string {
+	fake.pushManifestMutex.RLock()
+	defer fake.pushManifestMutex.RUnlock()
+	argsForCall := fake.pushManifestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeManifestPusher) PushManifestReturns(result1 error) {
+	fake.pushManifestMutex.Lock()
+	defer fake.pushManifestMutex.Unlock()
+	fake.PushManifestStub = nil
+	fake.pushManifestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManifestPusher) PushManifestReturnsOnCall(i int, result1 error) {
+	fake.pushManifestMutex.Lock()
+	defer fake.pushManifestMutex.Unlock()
+	fake.PushManifestStub = nil
+	if fake.pushManifestReturnsOnCall == nil {
+		fake.pushManifestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pushManifestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManifestPusher) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.pushManifestMutex.RLock()
+	defer fake.pushManifestMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeManifestPusher) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.ManifestPusher = new(FakeManifestPusher)
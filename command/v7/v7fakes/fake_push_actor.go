@@ -23,6 +23,40 @@ type FakePushActor struct {
 	actualizeReturnsOnCall map[int]struct {
 		result1 <-chan *v7pushaction.PushEvent
 	}
+	CreateDryRunPlanStub        func(v7pushaction.PushPlan, manifestparser.Application) (v7pushaction.DryRunPlan, v7action.Warnings, error)
+	createDryRunPlanMutex       sync.RWMutex
+	createDryRunPlanArgsForCall []struct {
+		arg1 v7pushaction.PushPlan
+		arg2 manifestparser.Application
+	}
+	createDryRunPlanReturns struct {
+		result1 v7pushaction.DryRunPlan
+		result2 v7action.Warnings
+		result3 error
+	}
+	createDryRunPlanReturnsOnCall map[int]struct {
+		result1 v7pushaction.DryRunPlan
+		result2 v7action.Warnings
+		result3 error
+	}
+	CreateDryRunPushPlansStub        func(string, string, manifestparser.Manifest, v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error)
+	createDryRunPushPlansMutex       sync.RWMutex
+	createDryRunPushPlansArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 manifestparser.Manifest
+		arg4 v7pushaction.FlagOverrides
+	}
+	createDryRunPushPlansReturns struct {
+		result1 []v7pushaction.PushPlan
+		result2 v7action.Warnings
+		result3 error
+	}
+	createDryRunPushPlansReturnsOnCall map[int]struct {
+		result1 []v7pushaction.PushPlan
+		result2 v7action.Warnings
+		result3 error
+	}
 	CreatePushPlansStub        func(string, string, manifestparser.Manifest, v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error)
 	createPushPlansMutex       sync.RWMutex
 	createPushPlansArgsForCall []struct {
@@ -55,6 +89,18 @@ type FakePushActor struct {
 		result1 manifestparser.Manifest
 		result2 error
 	}
+	RepushApplicationSourceStub        func(v7pushaction.PushPlan, v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent
+	repushApplicationSourceMutex       sync.RWMutex
+	repushApplicationSourceArgsForCall []struct {
+		arg1 v7pushaction.PushPlan
+		arg2 v7pushaction.ProgressBar
+	}
+	repushApplicationSourceReturns struct {
+		result1 <-chan *v7pushaction.PushEvent
+	}
+	repushApplicationSourceReturnsOnCall map[int]struct {
+		result1 <-chan *v7pushaction.PushEvent
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -66,15 +112,16 @@ func (fake *FakePushActor) Actualize(arg1 v7pushaction.PushPlan, arg2 v7pushacti
 		arg1 v7pushaction.PushPlan
 		arg2 v7pushaction.ProgressBar
 	}{arg1, arg2})
+	stub := fake.ActualizeStub
+	fakeReturns := fake.actualizeReturns
 	fake.recordInvocation("Actualize", []interface{}{arg1, arg2})
 	fake.actualizeMutex.Unlock()
-	if fake.ActualizeStub != nil {
-		return fake.ActualizeStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.actualizeReturns
 	return fakeReturns.result1
 }
 
@@ -120,6 +167,144 @@ func (fake *FakePushActor) ActualizeReturnsOnCall(i int, result1 <-chan *v7pusha
 	}{result1}
 }
 
+func (fake *FakePushActor) CreateDryRunPlan(arg1 v7pushaction.PushPlan, arg2 manifestparser.Application) (v7pushaction.DryRunPlan, v7action.Warnings, error) {
+	fake.createDryRunPlanMutex.Lock()
+	ret, specificReturn := fake.createDryRunPlanReturnsOnCall[len(fake.createDryRunPlanArgsForCall)]
+	fake.createDryRunPlanArgsForCall = append(fake.createDryRunPlanArgsForCall, struct {
+		arg1 v7pushaction.PushPlan
+		arg2 manifestparser.Application
+	}{arg1, arg2})
+	stub := fake.CreateDryRunPlanStub
+	fakeReturns := fake.createDryRunPlanReturns
+	fake.recordInvocation("CreateDryRunPlan", []interface{}{arg1, arg2})
+	fake.createDryRunPlanMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePushActor) CreateDryRunPlanCallCount() int {
+	fake.createDryRunPlanMutex.RLock()
+	defer fake.createDryRunPlanMutex.RUnlock()
+	return len(fake.createDryRunPlanArgsForCall)
+}
+
+func (fake *FakePushActor) CreateDryRunPlanCalls(stub func(v7pushaction.PushPlan, manifestparser.Application) (v7pushaction.DryRunPlan, v7action.Warnings, error)) {
+	fake.createDryRunPlanMutex.Lock()
+	defer fake.createDryRunPlanMutex.Unlock()
+	fake.CreateDryRunPlanStub = stub
+}
+
+func (fake *FakePushActor) CreateDryRunPlanArgsForCall(i int) (v7pushaction.PushPlan, manifestparser.Application) {
+	fake.createDryRunPlanMutex.RLock()
+	defer fake.createDryRunPlanMutex.RUnlock()
+	argsForCall := fake.createDryRunPlanArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePushActor) CreateDryRunPlanReturns(result1 v7pushaction.DryRunPlan, result2 v7action.Warnings, result3 error) {
+	fake.createDryRunPlanMutex.Lock()
+	defer fake.createDryRunPlanMutex.Unlock()
+	fake.CreateDryRunPlanStub = nil
+	fake.createDryRunPlanReturns = struct {
+		result1 v7pushaction.DryRunPlan
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) CreateDryRunPlanReturnsOnCall(i int, result1 v7pushaction.DryRunPlan, result2 v7action.Warnings, result3 error) {
+	fake.createDryRunPlanMutex.Lock()
+	defer fake.createDryRunPlanMutex.Unlock()
+	fake.CreateDryRunPlanStub = nil
+	if fake.createDryRunPlanReturnsOnCall == nil {
+		fake.createDryRunPlanReturnsOnCall = make(map[int]struct {
+			result1 v7pushaction.DryRunPlan
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.createDryRunPlanReturnsOnCall[i] = struct {
+		result1 v7pushaction.DryRunPlan
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) CreateDryRunPushPlans(arg1 string, arg2 string, arg3 manifestparser.Manifest, arg4 v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error) {
+	fake.createDryRunPushPlansMutex.Lock()
+	ret, specificReturn := fake.createDryRunPushPlansReturnsOnCall[len(fake.createDryRunPushPlansArgsForCall)]
+	fake.createDryRunPushPlansArgsForCall = append(fake.createDryRunPushPlansArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 manifestparser.Manifest
+		arg4 v7pushaction.FlagOverrides
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateDryRunPushPlansStub
+	fakeReturns := fake.createDryRunPushPlansReturns
+	fake.recordInvocation("CreateDryRunPushPlans", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createDryRunPushPlansMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePushActor) CreateDryRunPushPlansCallCount() int {
+	fake.createDryRunPushPlansMutex.RLock()
+	defer fake.createDryRunPushPlansMutex.RUnlock()
+	return len(fake.createDryRunPushPlansArgsForCall)
+}
+
+func (fake *FakePushActor) CreateDryRunPushPlansCalls(stub func(string, string, manifestparser.Manifest, v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error)) {
+	fake.createDryRunPushPlansMutex.Lock()
+	defer fake.createDryRunPushPlansMutex.Unlock()
+	fake.CreateDryRunPushPlansStub = stub
+}
+
+func (fake *FakePushActor) CreateDryRunPushPlansArgsForCall(i int) (string, string, manifestparser.Manifest, v7pushaction.FlagOverrides) {
+	fake.createDryRunPushPlansMutex.RLock()
+	defer fake.createDryRunPushPlansMutex.RUnlock()
+	argsForCall := fake.createDryRunPushPlansArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePushActor) CreateDryRunPushPlansReturns(result1 []v7pushaction.PushPlan, result2 v7action.Warnings, result3 error) {
+	fake.createDryRunPushPlansMutex.Lock()
+	defer fake.createDryRunPushPlansMutex.Unlock()
+	fake.CreateDryRunPushPlansStub = nil
+	fake.createDryRunPushPlansReturns = struct {
+		result1 []v7pushaction.PushPlan
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) CreateDryRunPushPlansReturnsOnCall(i int, result1 []v7pushaction.PushPlan, result2 v7action.Warnings, result3 error) {
+	fake.createDryRunPushPlansMutex.Lock()
+	defer fake.createDryRunPushPlansMutex.Unlock()
+	fake.CreateDryRunPushPlansStub = nil
+	if fake.createDryRunPushPlansReturnsOnCall == nil {
+		fake.createDryRunPushPlansReturnsOnCall = make(map[int]struct {
+			result1 []v7pushaction.PushPlan
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.createDryRunPushPlansReturnsOnCall[i] = struct {
+		result1 []v7pushaction.PushPlan
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakePushActor) CreatePushPlans(arg1 string, arg2 string, arg3 manifestparser.Manifest, arg4 v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error) {
 	fake.createPushPlansMutex.Lock()
 	ret, specificReturn := fake.createPushPlansReturnsOnCall[len(fake.createPushPlansArgsForCall)]
@@ -129,15 +314,16 @@ func (fake *FakePushActor) CreatePushPlans(arg1 string, arg2 string, arg3 manife
 		arg3 manifestparser.Manifest
 		arg4 v7pushaction.FlagOverrides
 	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreatePushPlansStub
+	fakeReturns := fake.createPushPlansReturns
 	fake.recordInvocation("CreatePushPlans", []interface{}{arg1, arg2, arg3, arg4})
 	fake.createPushPlansMutex.Unlock()
-	if fake.CreatePushPlansStub != nil {
-		return fake.CreatePushPlansStub(arg1, arg2, arg3, arg4)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.createPushPlansReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -196,15 +382,16 @@ func (fake *FakePushActor) HandleFlagOverrides(arg1 manifestparser.Manifest, arg
 		arg1 manifestparser.Manifest
 		arg2 v7pushaction.FlagOverrides
 	}{arg1, arg2})
+	stub := fake.HandleFlagOverridesStub
+	fakeReturns := fake.handleFlagOverridesReturns
 	fake.recordInvocation("HandleFlagOverrides", []interface{}{arg1, arg2})
 	fake.handleFlagOverridesMutex.Unlock()
-	if fake.HandleFlagOverridesStub != nil {
-		return fake.HandleFlagOverridesStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.handleFlagOverridesReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -253,15 +440,83 @@ func (fake *FakePushActor) HandleFlagOverridesReturnsOnCall(i int, result1 manif
 	}{result1, result2}
 }
 
+func (fake *FakePushActor) RepushApplicationSource(arg1 v7pushaction.PushPlan, arg2 v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+	fake.repushApplicationSourceMutex.Lock()
+	ret, specificReturn := fake.repushApplicationSourceReturnsOnCall[len(fake.repushApplicationSourceArgsForCall)]
+	fake.repushApplicationSourceArgsForCall = append(fake.repushApplicationSourceArgsForCall, struct {
+		arg1 v7pushaction.PushPlan
+		arg2 v7pushaction.ProgressBar
+	}{arg1, arg2})
+	stub := fake.RepushApplicationSourceStub
+	fakeReturns := fake.repushApplicationSourceReturns
+	fake.recordInvocation("RepushApplicationSource", []interface{}{arg1, arg2})
+	fake.repushApplicationSourceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePushActor) RepushApplicationSourceCallCount() int {
+	fake.repushApplicationSourceMutex.RLock()
+	defer fake.repushApplicationSourceMutex.RUnlock()
+	return len(fake.repushApplicationSourceArgsForCall)
+}
+
+func (fake *FakePushActor) RepushApplicationSourceCalls(stub func(v7pushaction.PushPlan, v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent) {
+	fake.repushApplicationSourceMutex.Lock()
+	defer fake.repushApplicationSourceMutex.Unlock()
+	fake.RepushApplicationSourceStub = stub
+}
+
+func (fake *FakePushActor) RepushApplicationSourceArgsForCall(i int) (v7pushaction.PushPlan, v7pushaction.ProgressBar) {
+	fake.repushApplicationSourceMutex.RLock()
+	defer fake.repushApplicationSourceMutex.RUnlock()
+	argsForCall := fake.repushApplicationSourceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePushActor) RepushApplicationSourceReturns(result1 <-chan *v7pushaction.PushEvent) {
+	fake.repushApplicationSourceMutex.Lock()
+	defer fake.repushApplicationSourceMutex.Unlock()
+	fake.RepushApplicationSourceStub = nil
+	fake.repushApplicationSourceReturns = struct {
+		result1 <-chan *v7pushaction.PushEvent
+	}{result1}
+}
+
+func (fake *FakePushActor) RepushApplicationSourceReturnsOnCall(i int, result1 <-chan *v7pushaction.PushEvent) {
+	fake.repushApplicationSourceMutex.Lock()
+	defer fake.repushApplicationSourceMutex.Unlock()
+	fake.RepushApplicationSourceStub = nil
+	if fake.repushApplicationSourceReturnsOnCall == nil {
+		fake.repushApplicationSourceReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v7pushaction.PushEvent
+		})
+	}
+	fake.repushApplicationSourceReturnsOnCall[i] = struct {
+		result1 <-chan *v7pushaction.PushEvent
+	}{result1}
+}
+
 func (fake *FakePushActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.actualizeMutex.RLock()
 	defer fake.actualizeMutex.RUnlock()
+	fake.createDryRunPlanMutex.RLock()
+	defer fake.createDryRunPlanMutex.RUnlock()
+	fake.createDryRunPushPlansMutex.RLock()
+	defer fake.createDryRunPushPlansMutex.RUnlock()
 	fake.createPushPlansMutex.RLock()
 	defer fake.createPushPlansMutex.RUnlock()
 	fake.handleFlagOverridesMutex.RLock()
 	defer fake.handleFlagOverridesMutex.RUnlock()
+	fake.repushApplicationSourceMutex.RLock()
+	defer fake.repushApplicationSourceMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,114 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeSharedSCPActor struct {
+	ExecuteSecureCopyStub        func(sharedaction.SecureShellClient, sharedaction.SecureCopyOptions) error
+	executeSecureCopyMutex       sync.RWMutex
+	executeSecureCopyArgsForCall []struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SecureCopyOptions
+	}
+	executeSecureCopyReturns struct {
+		result1 error
+	}
+	executeSecureCopyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSharedSCPActor) ExecuteSecureCopy(arg1 sharedaction.SecureShellClient, arg2 sharedaction.SecureCopyOptions) error {
+	fake.executeSecureCopyMutex.Lock()
+	ret, specificReturn := fake.executeSecureCopyReturnsOnCall[len(fake.executeSecureCopyArgsForCall)]
+	fake.executeSecureCopyArgsForCall = append(fake.executeSecureCopyArgsForCall, struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SecureCopyOptions
+	}{arg1, arg2})
+	stub := fake.ExecuteSecureCopyStub
+	fakeReturns := fake.executeSecureCopyReturns
+	fake.recordInvocation("ExecuteSecureCopy", []interface{}{arg1, arg2})
+	fake.executeSecureCopyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSharedSCPActor) ExecuteSecureCopyCallCount() int {
+	fake.executeSecureCopyMutex.RLock()
+	defer fake.executeSecureCopyMutex.RUnlock()
+	return len(fake.executeSecureCopyArgsForCall)
+}
+
+func (fake *FakeSharedSCPActor) ExecuteSecureCopyCalls(stub func(sharedaction.SecureShellClient, sharedaction.SecureCopyOptions) error) {
+	fake.executeSecureCopyMutex.Lock()
+	defer fake.executeSecureCopyMutex.Unlock()
+	fake.ExecuteSecureCopyStub = stub
+}
+
+func (fake *FakeSharedSCPActor) ExecuteSecureCopyArgsForCall(i int) (sharedaction.SecureShellClient, sharedaction.SecureCopyOptions) {
+	fake.executeSecureCopyMutex.RLock()
+	defer fake.executeSecureCopyMutex.RUnlock()
+	argsForCall := fake.executeSecureCopyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSharedSCPActor) ExecuteSecureCopyReturns(result1 error) {
+	fake.executeSecureCopyMutex.Lock()
+	defer fake.executeSecureCopyMutex.Unlock()
+	fake.ExecuteSecureCopyStub = nil
+	fake.executeSecureCopyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSharedSCPActor) ExecuteSecureCopyReturnsOnCall(i int, result1 error) {
+	fake.executeSecureCopyMutex.Lock()
+	defer fake.executeSecureCopyMutex.Unlock()
+	fake.ExecuteSecureCopyStub = nil
+	if fake.executeSecureCopyReturnsOnCall == nil {
+		fake.executeSecureCopyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.executeSecureCopyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSharedSCPActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.executeSecureCopyMutex.RLock()
+	defer fake.executeSecureCopyMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSharedSCPActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.SharedSCPActor = new(FakeSharedSCPActor)
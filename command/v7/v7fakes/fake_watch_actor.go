@@ -0,0 +1,286 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeWatchActor struct {
+	ExecuteSecureSyncStub        func(sharedaction.SecureShellClient, sharedaction.SecureSyncOptions) error
+	executeSecureSyncMutex       sync.RWMutex
+	executeSecureSyncArgsForCall []struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SecureSyncOptions
+	}
+	executeSecureSyncReturns struct {
+		result1 error
+	}
+	executeSecureSyncReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SnapshotDirectoryStub        func(string) (sharedaction.DirectorySnapshot, error)
+	snapshotDirectoryMutex       sync.RWMutex
+	snapshotDirectoryArgsForCall []struct {
+		arg1 string
+	}
+	snapshotDirectoryReturns struct {
+		result1 sharedaction.DirectorySnapshot
+		result2 error
+	}
+	snapshotDirectoryReturnsOnCall map[int]struct {
+		result1 sharedaction.DirectorySnapshot
+		result2 error
+	}
+	WaitForDirectoryChangesStub        func(string, sharedaction.DirectorySnapshot, time.Duration, time.Duration, <-chan struct{}) (sharedaction.DirectorySnapshot, sharedaction.DirectoryChanges, error)
+	waitForDirectoryChangesMutex       sync.RWMutex
+	waitForDirectoryChangesArgsForCall []struct {
+		arg1 string
+		arg2 sharedaction.DirectorySnapshot
+		arg3 time.Duration
+		arg4 time.Duration
+		arg5 <-chan struct{}
+	}
+	waitForDirectoryChangesReturns struct {
+		result1 sharedaction.DirectorySnapshot
+		result2 sharedaction.DirectoryChanges
+		result3 error
+	}
+	waitForDirectoryChangesReturnsOnCall map[int]struct {
+		result1 sharedaction.DirectorySnapshot
+		result2 sharedaction.DirectoryChanges
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeWatchActor) ExecuteSecureSync(arg1 sharedaction.SecureShellClient, arg2 sharedaction.SecureSyncOptions) error {
+	fake.executeSecureSyncMutex.Lock()
+	ret, specificReturn := fake.executeSecureSyncReturnsOnCall[len(fake.executeSecureSyncArgsForCall)]
+	fake.executeSecureSyncArgsForCall = append(fake.executeSecureSyncArgsForCall, struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SecureSyncOptions
+	}{arg1, arg2})
+	stub := fake.ExecuteSecureSyncStub
+	fakeReturns := fake.executeSecureSyncReturns
+	fake.recordInvocation("ExecuteSecureSync", []interface{}{arg1, arg2})
+	fake.executeSecureSyncMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWatchActor) ExecuteSecureSyncCallCount() int {
+	fake.executeSecureSyncMutex.RLock()
+	defer fake.executeSecureSyncMutex.RUnlock()
+	return len(fake.executeSecureSyncArgsForCall)
+}
+
+func (fake *FakeWatchActor) ExecuteSecureSyncCalls(stub func(sharedaction.SecureShellClient, sharedaction.SecureSyncOptions) error) {
+	fake.executeSecureSyncMutex.Lock()
+	defer fake.executeSecureSyncMutex.Unlock()
+	fake.ExecuteSecureSyncStub = stub
+}
+
+func (fake *FakeWatchActor) ExecuteSecureSyncArgsForCall(i int) (sharedaction.SecureShellClient, sharedaction.SecureSyncOptions) {
+	fake.executeSecureSyncMutex.RLock()
+	defer fake.executeSecureSyncMutex.RUnlock()
+	argsForCall := fake.executeSecureSyncArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWatchActor) ExecuteSecureSyncReturns(result1 error) {
+	fake.executeSecureSyncMutex.Lock()
+	defer fake.executeSecureSyncMutex.Unlock()
+	fake.ExecuteSecureSyncStub = nil
+	fake.executeSecureSyncReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWatchActor) ExecuteSecureSyncReturnsOnCall(i int, result1 error) {
+	fake.executeSecureSyncMutex.Lock()
+	defer fake.executeSecureSyncMutex.Unlock()
+	fake.ExecuteSecureSyncStub = nil
+	if fake.executeSecureSyncReturnsOnCall == nil {
+		fake.executeSecureSyncReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.executeSecureSyncReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWatchActor) SnapshotDirectory(arg1 string) (sharedaction.DirectorySnapshot, error) {
+	fake.snapshotDirectoryMutex.Lock()
+	ret, specificReturn := fake.snapshotDirectoryReturnsOnCall[len(fake.snapshotDirectoryArgsForCall)]
+	fake.snapshotDirectoryArgsForCall = append(fake.snapshotDirectoryArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SnapshotDirectoryStub
+	fakeReturns := fake.snapshotDirectoryReturns
+	fake.recordInvocation("SnapshotDirectory", []interface{}{arg1})
+	fake.snapshotDirectoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWatchActor) SnapshotDirectoryCallCount() int {
+	fake.snapshotDirectoryMutex.RLock()
+	defer fake.snapshotDirectoryMutex.RUnlock()
+	return len(fake.snapshotDirectoryArgsForCall)
+}
+
+func (fake *FakeWatchActor) SnapshotDirectoryCalls(stub func(string) (sharedaction.DirectorySnapshot, error)) {
+	fake.snapshotDirectoryMutex.Lock()
+	defer fake.snapshotDirectoryMutex.Unlock()
+	fake.SnapshotDirectoryStub = stub
+}
+
+func (fake *FakeWatchActor) SnapshotDirectoryArgsForCall(i int) string {
+	fake.snapshotDirectoryMutex.RLock()
+	defer fake.snapshotDirectoryMutex.RUnlock()
+	argsForCall := fake.snapshotDirectoryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWatchActor) SnapshotDirectoryReturns(result1 sharedaction.DirectorySnapshot, result2 error) {
+	fake.snapshotDirectoryMutex.Lock()
+	defer fake.snapshotDirectoryMutex.Unlock()
+	fake.SnapshotDirectoryStub = nil
+	fake.snapshotDirectoryReturns = struct {
+		result1 sharedaction.DirectorySnapshot
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWatchActor) SnapshotDirectoryReturnsOnCall(i int, result1 sharedaction.DirectorySnapshot, result2 error) {
+	fake.snapshotDirectoryMutex.Lock()
+	defer fake.snapshotDirectoryMutex.Unlock()
+	fake.SnapshotDirectoryStub = nil
+	if fake.snapshotDirectoryReturnsOnCall == nil {
+		fake.snapshotDirectoryReturnsOnCall = make(map[int]struct {
+			result1 sharedaction.DirectorySnapshot
+			result2 error
+		})
+	}
+	fake.snapshotDirectoryReturnsOnCall[i] = struct {
+		result1 sharedaction.DirectorySnapshot
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWatchActor) WaitForDirectoryChanges(arg1 string, arg2 sharedaction.DirectorySnapshot, arg3 time.Duration, arg4 time.Duration, arg5 <-chan struct{}) (sharedaction.DirectorySnapshot, sharedaction.DirectoryChanges, error) {
+	fake.waitForDirectoryChangesMutex.Lock()
+	ret, specificReturn := fake.waitForDirectoryChangesReturnsOnCall[len(fake.waitForDirectoryChangesArgsForCall)]
+	fake.waitForDirectoryChangesArgsForCall = append(fake.waitForDirectoryChangesArgsForCall, struct {
+		arg1 string
+		arg2 sharedaction.DirectorySnapshot
+		arg3 time.Duration
+		arg4 time.Duration
+		arg5 <-chan struct{}
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.WaitForDirectoryChangesStub
+	fakeReturns := fake.waitForDirectoryChangesReturns
+	fake.recordInvocation("WaitForDirectoryChanges", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.waitForDirectoryChangesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeWatchActor) WaitForDirectoryChangesCallCount() int {
+	fake.waitForDirectoryChangesMutex.RLock()
+	defer fake.waitForDirectoryChangesMutex.RUnlock()
+	return len(fake.waitForDirectoryChangesArgsForCall)
+}
+
+func (fake *FakeWatchActor) WaitForDirectoryChangesCalls(stub func(string, sharedaction.DirectorySnapshot, time.Duration, time.Duration, <-chan struct{}) (sharedaction.DirectorySnapshot, sharedaction.DirectoryChanges, error)) {
+	fake.waitForDirectoryChangesMutex.Lock()
+	defer fake.waitForDirectoryChangesMutex.Unlock()
+	fake.WaitForDirectoryChangesStub = stub
+}
+
+func (fake *FakeWatchActor) WaitForDirectoryChangesArgsForCall(i int) (string, sharedaction.DirectorySnapshot, time.Duration, time.Duration, <-chan struct{}) {
+	fake.waitForDirectoryChangesMutex.RLock()
+	defer fake.waitForDirectoryChangesMutex.RUnlock()
+	argsForCall := fake.waitForDirectoryChangesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeWatchActor) WaitForDirectoryChangesReturns(result1 sharedaction.DirectorySnapshot, result2 sharedaction.DirectoryChanges, result3 error) {
+	fake.waitForDirectoryChangesMutex.Lock()
+	defer fake.waitForDirectoryChangesMutex.Unlock()
+	fake.WaitForDirectoryChangesStub = nil
+	fake.waitForDirectoryChangesReturns = struct {
+		result1 sharedaction.DirectorySnapshot
+		result2 sharedaction.DirectoryChanges
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWatchActor) WaitForDirectoryChangesReturnsOnCall(i int, result1 sharedaction.DirectorySnapshot, result2 sharedaction.DirectoryChanges, result3 error) {
+	fake.waitForDirectoryChangesMutex.Lock()
+	defer fake.waitForDirectoryChangesMutex.Unlock()
+	fake.WaitForDirectoryChangesStub = nil
+	if fake.waitForDirectoryChangesReturnsOnCall == nil {
+		fake.waitForDirectoryChangesReturnsOnCall = make(map[int]struct {
+			result1 sharedaction.DirectorySnapshot
+			result2 sharedaction.DirectoryChanges
+			result3 error
+		})
+	}
+	fake.waitForDirectoryChangesReturnsOnCall[i] = struct {
+		result1 sharedaction.DirectorySnapshot
+		result2 sharedaction.DirectoryChanges
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWatchActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.executeSecureSyncMutex.RLock()
+	defer fake.executeSecureSyncMutex.RUnlock()
+	fake.snapshotDirectoryMutex.RLock()
+	defer fake.snapshotDirectoryMutex.RUnlock()
+	fake.waitForDirectoryChangesMutex.RLock()
+	defer fake.waitForDirectoryChangesMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeWatchActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.WatchActor = new(FakeWatchActor)
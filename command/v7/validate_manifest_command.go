@@ -0,0 +1,69 @@
+package v7
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+type ValidateManifestCommand struct {
+	UI     command.UI
+	Config command.Config
+
+	PathToManifest  flag.ManifestPathWithExistenceCheck `short:"f" description:"Path to app manifest"`
+	usage           interface{}                         `usage:"CF_NAME validate-manifest [-f APP_MANIFEST_PATH]\n\n   Checks the fields of the manifest against the manifest schema without contacting the API.\n   Fields set with ((variables)) are not checked. push performs the same checks before\n   pushing and fails on invalid fields, but only warns about unknown fields.\n\nEXAMPLES:\n   CF_NAME validate-manifest\n   CF_NAME validate-manifest -f ./my-app/manifest.yml"`
+	relatedCommands interface{}                         `related_commands:"apply-manifest, diff-manifest, push"`
+
+	ManifestLocator ManifestLocator
+	ManifestParser  ManifestParser
+	CWD             string
+}
+
+func (cmd *ValidateManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.ManifestLocator = manifestparser.NewLocator()
+	cmd.ManifestParser = &manifestparser.ManifestParser{}
+
+	currentDir, err := os.Getwd()
+	cmd.CWD = currentDir
+	return err
+}
+
+func (cmd ValidateManifestCommand) Execute(args []string) error {
+	readPath := cmd.CWD
+	if cmd.PathToManifest != "" {
+		readPath = string(cmd.PathToManifest)
+	}
+
+	pathToManifest, exists, err := cmd.ManifestLocator.Path(readPath)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: readPath}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Validating manifest {{.PathToManifest}}...", map[string]interface{}{
+		"PathToManifest": pathToManifest,
+	})
+
+	validationErrors, err := cmd.ManifestParser.ValidateManifest(pathToManifest)
+	if err != nil {
+		return err
+	}
+
+	if len(validationErrors) > 0 {
+		shared.DisplayManifestValidationErrors(cmd.UI, pathToManifest, validationErrors)
+		return translatableerror.ManifestValidationFailedError{PathToManifest: pathToManifest}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,103 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("validate-manifest Command", func() {
+	var (
+		cmd         ValidateManifestCommand
+		testUI      *ui.UI
+		fakeConfig  *commandfakes.FakeConfig
+		fakeParser  *v7fakes.FakeManifestParser
+		fakeLocator *v7fakes.FakeManifestLocator
+		executeErr  error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeParser = new(v7fakes.FakeManifestParser)
+		fakeLocator = new(v7fakes.FakeManifestLocator)
+
+		cmd = ValidateManifestCommand{
+			UI:              testUI,
+			Config:          fakeConfig,
+			ManifestParser:  fakeParser,
+			ManifestLocator: fakeLocator,
+			CWD:             "fake-directory",
+		}
+
+		fakeLocator.PathReturns("/fake/manifest.yml", true, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("validates the manifest in the current directory", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(fakeLocator.PathArgsForCall(0)).To(Equal("fake-directory"))
+		Expect(fakeParser.ValidateManifestArgsForCall(0)).To(Equal("/fake/manifest.yml"))
+		Expect(testUI.Out).To(Say("Validating manifest /fake/manifest.yml..."))
+		Expect(testUI.Out).To(Say("OK"))
+	})
+
+	When("a manifest path is given", func() {
+		BeforeEach(func() {
+			cmd.PathToManifest = flag.ManifestPathWithExistenceCheck("some/manifest.yml")
+		})
+
+		It("validates that manifest", func() {
+			Expect(fakeLocator.PathArgsForCall(0)).To(Equal("some/manifest.yml"))
+		})
+	})
+
+	When("there is no manifest", func() {
+		BeforeEach(func() {
+			fakeLocator.PathReturns("", false, nil)
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: "fake-directory"}))
+			Expect(fakeParser.ValidateManifestCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the manifest has problems", func() {
+		BeforeEach(func() {
+			fakeParser.ValidateManifestReturns([]manifestparser.ValidationError{
+				{Line: 3, Column: 3, Field: "applications[0].healthcheck-type", Message: "unknown field; did you mean 'health-check-type'?", UnknownField: true},
+				{Line: 4, Column: 11, Field: "applications[0].memory", Message: "must be an amount with a unit"},
+			}, nil)
+		})
+
+		It("displays them and fails", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ManifestValidationFailedError{PathToManifest: "/fake/manifest.yml"}))
+			Expect(testUI.Err).To(Say(`/fake/manifest.yml:3:3: applications\[0\].healthcheck-type: unknown field; did you mean 'health-check-type'\?`))
+			Expect(testUI.Err).To(Say(`/fake/manifest.yml:4:11: applications\[0\].memory: must be an amount with a unit`))
+			Expect(testUI.Out).NotTo(Say("OK"))
+		})
+	})
+
+	When("the manifest cannot be read", func() {
+		BeforeEach(func() {
+			fakeParser.ValidateManifestReturns(nil, errors.New("read-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("read-error"))
+		})
+	})
+})
@@ -27,7 +27,11 @@ var _ = Describe("auth command", func() {
 
 			Eventually(session).Should(Say("USAGE:"))
 			Eventually(session).Should(Say("cf auth USERNAME PASSWORD\n"))
+			Eventually(session).Should(Say(`cf auth --assertion-file PATH \[--origin ORIGIN\]\n`))
+			Eventually(session).Should(Say(`cf auth --assertion-env VARIABLE \[--origin ORIGIN\]\n`))
 			Eventually(session).Should(Say("cf auth CLIENT_ID CLIENT_SECRET --client-credentials\n\n"))
+			Eventually(session).Should(Say("With --assertion-file or --assertion-env the JWT is exchanged using the JWT bearer grant\n"))
+			Eventually(session).Should(Say("as the 'cf' UAA client, which must be allowed to use that grant type.\n\n"))
 
 			Eventually(session).Should(Say("ENVIRONMENT VARIABLES:"))
 			Eventually(session).Should(Say(`CF_USERNAME=user\s+Authenticating user. Overridden if USERNAME argument is provided.`))
@@ -40,9 +44,12 @@ var _ = Describe("auth command", func() {
 
 			Eventually(session).Should(Say("EXAMPLES:"))
 			Eventually(session).Should(Say("cf auth name@example\\.com \"my password\" \\(use quotes for passwords with a space\\)"))
-			Eventually(session).Should(Say("cf auth name@example\\.com \\\"\\\\\"password\\\\\"\\\" \\(escape quotes if used in password\\)\n\n"))
+			Eventually(session).Should(Say("cf auth name@example\\.com \\\"\\\\\"password\\\\\"\\\" \\(escape quotes if used in password\\)\n"))
+			Eventually(session).Should(Say("cf auth --assertion-env CI_ID_TOKEN \\(exchange the OIDC ID token of a CI job using the JWT bearer grant\\)\n\n"))
 
 			Eventually(session).Should(Say("OPTIONS:"))
+			Eventually(session).Should(Say("--assertion-env\\s+Authenticate with the JWT in this environment variable, such as an OIDC ID token issued to a CI job\n"))
+			Eventually(session).Should(Say("--assertion-file\\s+Authenticate with the JWT in this file, such as an OIDC ID token issued to a CI job\n"))
 			Eventually(session).Should(Say("--client-credentials\\s+Use \\(non-user\\) service account \\(also called client credentials\\)\n"))
 			Eventually(session).Should(Say("--origin\\s+Indicates the identity provider to be used for authentication\n\n"))
 
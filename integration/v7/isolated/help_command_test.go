@@ -53,6 +53,8 @@ var _ = Describe("help command", func() {
 			Eventually(session).Should(Say("Global options:"))
 			Eventually(session).Should(Say("  --help, -h                         Show help"))
 			Eventually(session).Should(Say("  -v                                 Print API request diagnostics to stdout"))
+			Eventually(session).Should(Say("  --output                           Display results as json or yaml, other output goes to stderr"))
+			Eventually(session).Should(Say("  --context                          Run the command against the named context instead of the current one"))
 
 			Eventually(session).Should(Say(`TIP: Use 'cf help -a' to see all commands\.`))
 			Eventually(session).Should(Exit(0))
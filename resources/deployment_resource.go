@@ -15,16 +15,58 @@ type Deployment struct {
 	LastStatusChange string
 	Options          DeploymentOpts
 	RevisionGUID     string
+	RevisionVersion  int
 	DropletGUID      string
-	CreatedAt        string
-	UpdatedAt        string
-	Relationships    Relationships
-	NewProcesses     []Process
-	Strategy         constant.DeploymentStrategy
+	// PreviousDropletGUID is the droplet the app was running before the
+	// deployment.
+	PreviousDropletGUID string
+	CreatedAt           string
+	UpdatedAt           string
+	Relationships       Relationships
+	NewProcesses        []Process
+	Strategy            constant.DeploymentStrategy
+	CanaryStatus        CanaryStatus
 }
 
 type DeploymentOpts struct {
-	MaxInFlight int `json:"max_in_flight"`
+	MaxInFlight             int                      `json:"max_in_flight"`
+	CanaryDeploymentOptions *CanaryDeploymentOptions `json:"canary,omitempty"`
+}
+
+// CanaryDeploymentOptions are the steps a canary deployment pauses at.
+type CanaryDeploymentOptions struct {
+	Steps []CanaryStep `json:"steps"`
+}
+
+// CanaryStep is a single canary step. InstanceWeight is the percentage of
+// the desired instances that run the new version at this step.
+type CanaryStep struct {
+	InstanceWeight int64 `json:"instance_weight"`
+}
+
+// ValidInstanceSteps returns true if the instance weights of the canary steps
+// are increasing percentages between 1 and 100.
+func ValidInstanceSteps(weights []int64) bool {
+	var previous int64
+	for _, weight := range weights {
+		if weight < 1 || weight > 100 || weight <= previous {
+			return false
+		}
+		previous = weight
+	}
+	return true
+}
+
+// CanaryStatus is the progress of a canary deployment through its steps.
+type CanaryStatus struct {
+	Steps CanaryStepStatus `json:"steps"`
+}
+
+// CanaryStepStatus holds the step a canary deployment is currently at and
+// the total number of steps. Steps are numbered from 1.
+type CanaryStepStatus struct {
+	CurrentStep int `json:"current"`
+	TotalSteps  int `json:"total"`
 }
 
 // MarshalJSON converts a Deployment into a Cloud Controller Deployment.
@@ -74,6 +116,7 @@ func (d *Deployment) UnmarshalJSON(data []byte) error {
 	var ccDeployment struct {
 		GUID          string                   `json:"guid,omitempty"`
 		CreatedAt     string                   `json:"created_at,omitempty"`
+		UpdatedAt     string                   `json:"updated_at,omitempty"`
 		Relationships Relationships            `json:"relationships,omitempty"`
 		State         constant.DeploymentState `json:"state,omitempty"`
 		Status        struct {
@@ -82,8 +125,14 @@ func (d *Deployment) UnmarshalJSON(data []byte) error {
 			}
 			Value  constant.DeploymentStatusValue  `json:"value"`
 			Reason constant.DeploymentStatusReason `json:"reason"`
+			Canary CanaryStatus                    `json:"canary"`
 		} `json:"status"`
-		Droplet      Droplet                     `json:"droplet,omitempty"`
+		Droplet         Droplet `json:"droplet,omitempty"`
+		PreviousDroplet Droplet `json:"previous_droplet,omitempty"`
+		Revision        struct {
+			GUID    string `json:"guid"`
+			Version int    `json:"version"`
+		} `json:"revision"`
 		NewProcesses []Process                   `json:"new_processes,omitempty"`
 		Strategy     constant.DeploymentStrategy `json:"strategy"`
 		Options      DeploymentOpts              `json:"options,omitempty"`
@@ -96,15 +145,20 @@ func (d *Deployment) UnmarshalJSON(data []byte) error {
 
 	d.GUID = ccDeployment.GUID
 	d.CreatedAt = ccDeployment.CreatedAt
+	d.UpdatedAt = ccDeployment.UpdatedAt
 	d.Relationships = ccDeployment.Relationships
 	d.State = ccDeployment.State
 	d.StatusValue = ccDeployment.Status.Value
 	d.StatusReason = ccDeployment.Status.Reason
 	d.LastStatusChange = ccDeployment.Status.Details.LastStatusChange
 	d.DropletGUID = ccDeployment.Droplet.GUID
+	d.PreviousDropletGUID = ccDeployment.PreviousDroplet.GUID
+	d.RevisionGUID = ccDeployment.Revision.GUID
+	d.RevisionVersion = ccDeployment.Revision.Version
 	d.NewProcesses = ccDeployment.NewProcesses
 	d.Strategy = ccDeployment.Strategy
 	d.Options = ccDeployment.Options
+	d.CanaryStatus = ccDeployment.Status.Canary
 
 	return nil
 }
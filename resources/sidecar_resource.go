@@ -1,9 +1,43 @@
 package resources
 
-import "code.cloudfoundry.org/cli/types"
+import (
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/cli/types"
+)
 
 type Sidecar struct {
 	GUID    string               `json:"guid"`
 	Name    string               `json:"name"`
 	Command types.FilteredString `json:"command"`
+	// ProcessTypes are the types of the app processes the sidecar runs with.
+	ProcessTypes []string `json:"process_types"`
+	// MemoryInMB is the amount of the process memory reserved for the sidecar.
+	MemoryInMB types.NullUint64 `json:"memory_in_mb"`
+	// Origin is either "user" or "buildpack".
+	Origin string `json:"origin"`
+}
+
+// MarshalJSON converts a Sidecar into a Cloud Controller Sidecar. Only the
+// fields that are set are sent, so that it can be used for both creating and
+// updating a sidecar.
+func (s Sidecar) MarshalJSON() ([]byte, error) {
+	var ccSidecar struct {
+		Name         string      `json:"name,omitempty"`
+		Command      interface{} `json:"command,omitempty"`
+		ProcessTypes []string    `json:"process_types,omitempty"`
+		MemoryInMB   json.Number `json:"memory_in_mb,omitempty"`
+	}
+
+	ccSidecar.Name = s.Name
+	if s.Command.IsSet {
+		ccSidecar.Command = &s.Command
+	}
+	ccSidecar.ProcessTypes = s.ProcessTypes
+	if s.MemoryInMB.IsSet {
+		ccSidecar.MemoryInMB = json.Number(fmt.Sprint(s.MemoryInMB.Value))
+	}
+
+	return json.Marshal(ccSidecar)
 }
@@ -0,0 +1,44 @@
+// Package browser opens URLs in the user's web browser.
+package browser
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrNoBrowser is returned when no browser can be opened, such as in a
+// headless shell.
+var ErrNoBrowser = errors.New("no browser is available")
+
+// Open opens url in the browser given by $BROWSER, or else in the default
+// browser of the system. A blank $BROWSER is ignored. It does not wait for the
+// browser to exit.
+func Open(url string) error {
+	browserArgs := strings.Fields(os.Getenv("BROWSER"))
+
+	var command *exec.Cmd
+	switch {
+	case len(browserArgs) > 0:
+		command = exec.Command(browserArgs[0], append(browserArgs[1:], url)...)
+	case runtime.GOOS == "darwin":
+		command = exec.Command("open", url)
+	case runtime.GOOS == "windows":
+		command = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	case os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == "":
+		return ErrNoBrowser
+	default:
+		command = exec.Command("xdg-open", url)
+	}
+
+	err := command.Start()
+	if err != nil {
+		return ErrNoBrowser
+	}
+	go func() {
+		_ = command.Wait()
+	}()
+	return nil
+}
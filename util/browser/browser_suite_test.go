@@ -0,0 +1,13 @@
+package browser_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestBrowser(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Browser Suite")
+}
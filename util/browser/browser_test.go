@@ -0,0 +1,59 @@
+package browser_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+
+	. "code.cloudfoundry.org/cli/util/browser"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Open", func() {
+	var dir string
+
+	BeforeEach(func() {
+		if runtime.GOOS == "windows" {
+			Skip("the fake browser is a shell script")
+		}
+
+		var err error
+		dir, err = os.MkdirTemp("", "browser")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	When("$BROWSER is set", func() {
+		It("opens the URL with it, passing its arguments", func() {
+			browser := filepath.Join(dir, "browser")
+			opened := filepath.Join(dir, "opened")
+			Expect(os.WriteFile(browser, []byte("#!/bin/sh\necho \"$@\" > "+opened+"\n"), 0700)).To(Succeed())
+			GinkgoT().Setenv("BROWSER", browser+" --new-window")
+
+			Expect(Open("https://example.com/login")).To(Succeed())
+			Eventually(func() (string, error) {
+				contents, err := os.ReadFile(opened)
+				return string(contents), err
+			}).Should(Equal("--new-window https://example.com/login\n"))
+		})
+	})
+
+	When("$BROWSER is blank and there is no display", func() {
+		BeforeEach(func() {
+			if runtime.GOOS != "linux" {
+				Skip("the system browser is only unavailable without a display on Linux")
+			}
+			GinkgoT().Setenv("BROWSER", "   ")
+			GinkgoT().Setenv("DISPLAY", "")
+			GinkgoT().Setenv("WAYLAND_DISPLAY", "")
+		})
+
+		It("falls back to the system browser, which is not available", func() {
+			Expect(Open("https://example.com/login")).To(MatchError(ErrNoBrowser))
+		})
+	})
+})
@@ -0,0 +1,310 @@
+package clissh
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Upload copies the local file or directory at localPath to remotePath on the
+// connected instance using the SCP protocol. Directories are only copied when
+// recursive is set.
+func (c *SecureShell) Upload(localPath string, remotePath string, recursive bool) error {
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() && !recursive {
+		return fmt.Errorf("%s is a directory (use -r to copy recursively)", localPath)
+	}
+
+	return c.runSCP(scpCommand("-t", remotePath, recursive), func(remoteIn io.Writer, remoteOut *bufio.Reader) error {
+		err := readSCPAck(remoteOut)
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return sendSCPDirectory(remoteIn, remoteOut, localPath, info)
+		}
+		return sendSCPFile(remoteIn, remoteOut, localPath, info)
+	})
+}
+
+// Download copies the remote file or directory at remotePath on the connected
+// instance to localPath using the SCP protocol. Directories are only copied
+// when recursive is set. When localPath is an existing directory, the copy is
+// placed inside it.
+func (c *SecureShell) Download(remotePath string, localPath string, recursive bool) error {
+	return c.runSCP(scpCommand("-f", remotePath, recursive), func(remoteIn io.Writer, remoteOut *bufio.Reader) error {
+		return receiveSCP(remoteIn, remoteOut, localPath)
+	})
+}
+
+func (c *SecureShell) runSCP(command string, transfer func(io.Writer, *bufio.Reader) error) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	inPipe, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	err = session.Start(command)
+	if err != nil {
+		return err
+	}
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	transferErr := transfer(inPipe, bufio.NewReader(outPipe))
+	_ = inPipe.Close()
+
+	waitErr := session.Wait()
+	if transferErr != nil {
+		return transferErr
+	}
+	return waitErr
+}
+
+func scpCommand(mode string, remotePath string, recursive bool) string {
+	command := "scp " + mode
+	if recursive {
+		command += " -r"
+	}
+	return command + " " + shellQuote(remotePath)
+}
+
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+func sendSCPFile(remoteIn io.Writer, remoteOut *bufio.Reader, localPath string, info os.FileInfo) error {
+	file, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(remoteIn, "C%04o %d %s\n", info.Mode().Perm(), info.Size(), info.Name())
+	if err != nil {
+		return err
+	}
+	err = readSCPAck(remoteOut)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.CopyN(remoteIn, file, info.Size())
+	if err != nil {
+		return err
+	}
+	_, err = remoteIn.Write([]byte{0})
+	if err != nil {
+		return err
+	}
+	return readSCPAck(remoteOut)
+}
+
+func sendSCPDirectory(remoteIn io.Writer, remoteOut *bufio.Reader, localPath string, info os.FileInfo) error {
+	_, err := fmt.Fprintf(remoteIn, "D%04o 0 %s\n", info.Mode().Perm(), info.Name())
+	if err != nil {
+		return err
+	}
+	err = readSCPAck(remoteOut)
+	if err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(localPath)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		entryPath := filepath.Join(localPath, entry.Name())
+		entryInfo, err := os.Stat(entryPath)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case entryInfo.IsDir():
+			err = sendSCPDirectory(remoteIn, remoteOut, entryPath, entryInfo)
+		case entryInfo.Mode().IsRegular():
+			err = sendSCPFile(remoteIn, remoteOut, entryPath, entryInfo)
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprint(remoteIn, "E\n")
+	if err != nil {
+		return err
+	}
+	return readSCPAck(remoteOut)
+}
+
+func receiveSCP(remoteIn io.Writer, remoteOut *bufio.Reader, localPath string) error {
+	var directories []string
+
+	targetIsDir := false
+	if info, err := os.Stat(localPath); err == nil && info.IsDir() {
+		targetIsDir = true
+	}
+
+	destination := func(name string) (string, error) {
+		if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+			return "", fmt.Errorf("scp: refusing to write unexpected file name %q", name)
+		}
+		if len(directories) > 0 {
+			return filepath.Join(directories[len(directories)-1], name), nil
+		}
+		if targetIsDir {
+			return filepath.Join(localPath, name), nil
+		}
+		return localPath, nil
+	}
+
+	for {
+		_, err := remoteIn.Write([]byte{0})
+		if err != nil {
+			return err
+		}
+
+		line, err := remoteOut.ReadString('\n')
+		if err == io.EOF && line == "" {
+			if len(directories) > 0 {
+				return errors.New("scp: unexpected end of transfer")
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			return errors.New("scp: unexpected empty protocol message")
+		}
+
+		switch line[0] {
+		case 1, 2:
+			return fmt.Errorf("scp: %s", line[1:])
+		case 'T':
+			continue
+		case 'E':
+			if len(directories) == 0 {
+				return errors.New("scp: unexpected end of directory")
+			}
+			directories = directories[:len(directories)-1]
+		case 'D':
+			mode, _, name, err := parseSCPHeader(line)
+			if err != nil {
+				return err
+			}
+			path, err := destination(name)
+			if err != nil {
+				return err
+			}
+			err = os.MkdirAll(path, mode|0700)
+			if err != nil {
+				return err
+			}
+			directories = append(directories, path)
+		case 'C':
+			mode, size, name, err := parseSCPHeader(line)
+			if err != nil {
+				return err
+			}
+			path, err := destination(name)
+			if err != nil {
+				return err
+			}
+			_, err = remoteIn.Write([]byte{0})
+			if err != nil {
+				return err
+			}
+			err = receiveSCPFile(remoteOut, path, mode, size)
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("scp: unexpected protocol message %q", line)
+		}
+	}
+}
+
+func receiveSCPFile(remoteOut *bufio.Reader, path string, mode os.FileMode, size int64) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.CopyN(file, remoteOut, size)
+	closeErr := file.Close()
+	if err != nil {
+		return err
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+
+	return readSCPAck(remoteOut)
+}
+
+func parseSCPHeader(line string) (os.FileMode, int64, string, error) {
+	parts := strings.SplitN(line[1:], " ", 3)
+	if len(parts) != 3 {
+		return 0, 0, "", fmt.Errorf("scp: malformed protocol message %q", line)
+	}
+
+	mode, err := strconv.ParseUint(parts[0], 8, 32)
+	if err != nil {
+		return 0, 0, "", fmt.Errorf("scp: malformed file mode in %q", line)
+	}
+
+	size, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || size < 0 {
+		return 0, 0, "", fmt.Errorf("scp: malformed file size in %q", line)
+	}
+
+	return os.FileMode(mode).Perm(), size, parts[2], nil
+}
+
+func readSCPAck(remoteOut *bufio.Reader) error {
+	code, err := remoteOut.ReadByte()
+	if err != nil {
+		return err
+	}
+
+	switch code {
+	case 0:
+		return nil
+	case 1, 2:
+		message, _ := remoteOut.ReadString('\n')
+		return fmt.Errorf("scp: %s", strings.TrimSuffix(message, "\n"))
+	default:
+		return fmt.Errorf("scp: unexpected response %q", code)
+	}
+}
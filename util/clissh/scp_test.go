@@ -0,0 +1,242 @@
+package clissh_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/clissh/clisshfakes"
+	"code.cloudfoundry.org/diego-ssh/test_helpers/fake_ssh"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type bufferCloser struct {
+	bytes.Buffer
+}
+
+func (*bufferCloser) Close() error {
+	return nil
+}
+
+var _ = Describe("SCP", func() {
+	var (
+		fakeSecureDialer  *clisshfakes.FakeSecureDialer
+		fakeSecureClient  *clisshfakes.FakeSecureClient
+		fakeSecureSession *clisshfakes.FakeSecureSession
+
+		remoteIn    *bufferCloser
+		secureShell *SecureShell
+		tempDir     string
+	)
+
+	BeforeEach(func() {
+		fakeSecureDialer = new(clisshfakes.FakeSecureDialer)
+		fakeSecureClient = new(clisshfakes.FakeSecureClient)
+		fakeSecureSession = new(clisshfakes.FakeSecureSession)
+
+		fakeSecureClient.NewSessionReturns(fakeSecureSession, nil)
+		fakeSecureClient.ConnReturns(new(fake_ssh.FakeConn))
+		fakeSecureDialer.DialReturns(fakeSecureClient, nil)
+
+		remoteIn = new(bufferCloser)
+		fakeSecureSession.StdinPipeReturns(remoteIn, nil)
+
+		tempDir = GinkgoT().TempDir()
+
+		secureShell = NewSecureShell(
+			fakeSecureDialer,
+			new(clisshfakes.FakeTerminalHelper),
+			new(clisshfakes.FakeListenerFactory),
+			DefaultKeepAliveInterval,
+		)
+		Expect(secureShell.Connect("some-user", "some-passcode", "some-endpoint", "", true)).To(Succeed())
+	})
+
+	Describe("Upload", func() {
+		var (
+			localPath string
+			recursive bool
+			uploadErr error
+		)
+
+		BeforeEach(func() {
+			localPath = filepath.Join(tempDir, "config.yml")
+			Expect(os.WriteFile(localPath, []byte("hello"), 0640)).To(Succeed())
+			recursive = false
+
+			fakeSecureSession.StdoutPipeReturns(strings.NewReader("\x00\x00\x00"), nil)
+		})
+
+		JustBeforeEach(func() {
+			uploadErr = secureShell.Upload(localPath, "/home/vcap/app/it's here", recursive)
+		})
+
+		It("starts a remote scp sink and sends the file", func() {
+			Expect(uploadErr).ToNot(HaveOccurred())
+
+			Expect(fakeSecureSession.StartCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal(`scp -t '/home/vcap/app/it'\''s here'`))
+
+			Expect(remoteIn.String()).To(Equal("C0640 5 config.yml\nhello\x00"))
+			Expect(fakeSecureSession.WaitCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+
+		When("the local path is a directory", func() {
+			BeforeEach(func() {
+				localPath = filepath.Join(tempDir, "dir")
+				Expect(os.MkdirAll(filepath.Join(localPath, "nested"), 0750)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(localPath, "a.txt"), []byte("a"), 0600)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(localPath, "nested", "b.txt"), []byte("bb"), 0644)).To(Succeed())
+
+				fakeSecureSession.StdoutPipeReturns(strings.NewReader(strings.Repeat("\x00", 10)), nil)
+			})
+
+			When("recursive is not set", func() {
+				It("returns an error without starting a session", func() {
+					Expect(uploadErr).To(MatchError(ContainSubstring("is a directory (use -r to copy recursively)")))
+					Expect(fakeSecureClient.NewSessionCallCount()).To(Equal(0))
+				})
+			})
+
+			When("recursive is set", func() {
+				BeforeEach(func() {
+					recursive = true
+				})
+
+				It("sends the whole tree", func() {
+					Expect(uploadErr).ToNot(HaveOccurred())
+					Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal(`scp -t -r '/home/vcap/app/it'\''s here'`))
+					Expect(remoteIn.String()).To(Equal(
+						"D0750 0 dir\n" +
+							"C0600 1 a.txt\na\x00" +
+							"D0750 0 nested\n" +
+							"C0644 2 b.txt\nbb\x00" +
+							"E\n" +
+							"E\n",
+					))
+				})
+			})
+		})
+
+		When("the remote rejects the transfer", func() {
+			BeforeEach(func() {
+				fakeSecureSession.StdoutPipeReturns(strings.NewReader("\x00\x01scp: /home/vcap/app: Permission denied\n"), nil)
+			})
+
+			It("returns the remote error", func() {
+				Expect(uploadErr).To(MatchError("scp: scp: /home/vcap/app: Permission denied"))
+				Expect(fakeSecureSession.WaitCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the remote command fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(errors.New("exit status 1"))
+			})
+
+			It("returns the error", func() {
+				Expect(uploadErr).To(MatchError("exit status 1"))
+			})
+		})
+	})
+
+	Describe("Download", func() {
+		var (
+			localPath   string
+			recursive   bool
+			downloadErr error
+		)
+
+		BeforeEach(func() {
+			localPath = filepath.Join(tempDir, "heap.hprof")
+			recursive = false
+			fakeSecureSession.StdoutPipeReturns(strings.NewReader("C0600 4 dump.hprof\nheap\x00"), nil)
+		})
+
+		JustBeforeEach(func() {
+			downloadErr = secureShell.Download("/home/vcap/app/dump.hprof", localPath, recursive)
+		})
+
+		It("starts a remote scp source and writes the file to the local path", func() {
+			Expect(downloadErr).ToNot(HaveOccurred())
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal(`scp -f '/home/vcap/app/dump.hprof'`))
+
+			contents, err := os.ReadFile(localPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("heap"))
+
+			info, err := os.Stat(localPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+			Expect(remoteIn.String()).To(Equal("\x00\x00\x00"))
+		})
+
+		When("the local path is an existing directory", func() {
+			BeforeEach(func() {
+				localPath = tempDir
+			})
+
+			It("writes the file inside it", func() {
+				Expect(downloadErr).ToNot(HaveOccurred())
+				Expect(filepath.Join(tempDir, "dump.hprof")).To(BeARegularFile())
+			})
+		})
+
+		When("recursive is set", func() {
+			BeforeEach(func() {
+				recursive = true
+				localPath = filepath.Join(tempDir, "logs")
+				fakeSecureSession.StdoutPipeReturns(strings.NewReader(
+					"T1700000000 0 1700000000 0\n"+
+						"D0755 0 remote-logs\n"+
+						"C0644 3 a.log\naaa\x00"+
+						"D0755 0 old\n"+
+						"C0644 1 b.log\nb\x00"+
+						"E\n"+
+						"E\n",
+				), nil)
+			})
+
+			It("recreates the tree at the local path", func() {
+				Expect(downloadErr).ToNot(HaveOccurred())
+				Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal(`scp -f -r '/home/vcap/app/dump.hprof'`))
+
+				contents, err := os.ReadFile(filepath.Join(localPath, "a.log"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("aaa"))
+
+				contents, err = os.ReadFile(filepath.Join(localPath, "old", "b.log"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("b"))
+			})
+		})
+
+		When("the remote sends a file name that escapes the target", func() {
+			BeforeEach(func() {
+				localPath = tempDir
+				fakeSecureSession.StdoutPipeReturns(strings.NewReader("C0644 1 ../evil\nx\x00"), nil)
+			})
+
+			It("refuses to write it", func() {
+				Expect(downloadErr).To(MatchError(`scp: refusing to write unexpected file name "../evil"`))
+				Expect(filepath.Join(tempDir, "..", "evil")).ToNot(BeAnExistingFile())
+			})
+		})
+
+		When("the remote reports an error", func() {
+			BeforeEach(func() {
+				fakeSecureSession.StdoutPipeReturns(strings.NewReader("\x01scp: /home/vcap/app/dump.hprof: No such file or directory\n"), nil)
+			})
+
+			It("returns the remote error", func() {
+				Expect(downloadErr).To(MatchError("scp: scp: /home/vcap/app/dump.hprof: No such file or directory"))
+			})
+		})
+	})
+})
@@ -0,0 +1,166 @@
+package clissh
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	socksVersion5 = 0x05
+
+	socksMethodNoAuth       = 0x00
+	socksMethodNoAcceptable = 0xff
+
+	socksCommandConnect = 0x01
+
+	socksAddressIPv4   = 0x01
+	socksAddressDomain = 0x03
+	socksAddressIPv6   = 0x04
+
+	socksReplySucceeded           = 0x00
+	socksReplyGeneralFailure      = 0x01
+	socksReplyCommandNotSupported = 0x07
+	socksReplyAddressNotSupported = 0x08
+)
+
+var errSOCKSAddressNotSupported = errors.New("unsupported SOCKS address type")
+
+// handleSOCKSConnection serves a single SOCKS5 CONNECT request without
+// authentication, opening the requested connection from the instance.
+func (c *SecureShell) handleSOCKSConnection(conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+
+	err := negotiateSOCKSMethod(reader, conn)
+	if err != nil {
+		log.Errorln("socks negotiation:", err)
+		return
+	}
+
+	command, targetAddr, err := readSOCKSRequest(reader)
+	if err != nil {
+		if err == errSOCKSAddressNotSupported {
+			_ = writeSOCKSReply(conn, socksReplyAddressNotSupported)
+		}
+		log.Errorln("socks request:", err)
+		return
+	}
+
+	if command != socksCommandConnect {
+		_ = writeSOCKSReply(conn, socksReplyCommandNotSupported)
+		return
+	}
+
+	target, err := c.secureClient.Dial("tcp", targetAddr)
+	if err != nil {
+		_ = writeSOCKSReply(conn, socksReplyGeneralFailure)
+		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+		return
+	}
+	defer target.Close()
+
+	err = writeSOCKSReply(conn, socksReplySucceeded)
+	if err != nil {
+		return
+	}
+
+	proxyConnections(&bufferedConn{Conn: conn, reader: reader}, target)
+}
+
+func negotiateSOCKSMethod(reader *bufio.Reader, conn net.Conn) error {
+	header := make([]byte, 2)
+	_, err := io.ReadFull(reader, header)
+	if err != nil {
+		return err
+	}
+	if header[0] != socksVersion5 {
+		return fmt.Errorf("unsupported SOCKS version %d", header[0])
+	}
+
+	methods := make([]byte, header[1])
+	_, err = io.ReadFull(reader, methods)
+	if err != nil {
+		return err
+	}
+
+	for _, method := range methods {
+		if method == socksMethodNoAuth {
+			_, err = conn.Write([]byte{socksVersion5, socksMethodNoAuth})
+			return err
+		}
+	}
+
+	_, _ = conn.Write([]byte{socksVersion5, socksMethodNoAcceptable})
+	return errors.New("no supported SOCKS authentication method offered")
+}
+
+func readSOCKSRequest(reader *bufio.Reader) (byte, string, error) {
+	header := make([]byte, 4)
+	_, err := io.ReadFull(reader, header)
+	if err != nil {
+		return 0, "", err
+	}
+	if header[0] != socksVersion5 {
+		return 0, "", fmt.Errorf("unsupported SOCKS version %d", header[0])
+	}
+
+	var host string
+	switch header[3] {
+	case socksAddressIPv4, socksAddressIPv6:
+		size := net.IPv4len
+		if header[3] == socksAddressIPv6 {
+			size = net.IPv6len
+		}
+		ip := make([]byte, size)
+		_, err = io.ReadFull(reader, ip)
+		if err != nil {
+			return 0, "", err
+		}
+		host = net.IP(ip).String()
+	case socksAddressDomain:
+		length, err := reader.ReadByte()
+		if err != nil {
+			return 0, "", err
+		}
+		domain := make([]byte, length)
+		_, err = io.ReadFull(reader, domain)
+		if err != nil {
+			return 0, "", err
+		}
+		host = string(domain)
+	default:
+		return 0, "", errSOCKSAddressNotSupported
+	}
+
+	port := make([]byte, 2)
+	_, err = io.ReadFull(reader, port)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return header[1], net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port)))), nil
+}
+
+func writeSOCKSReply(conn net.Conn, reply byte) error {
+	_, err := conn.Write([]byte{socksVersion5, reply, 0x00, socksAddressIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
+
+// bufferedConn reads through the buffered reader used during the SOCKS
+// handshake so that no client data read ahead of time is lost.
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (b *bufferedConn) Read(p []byte) (int, error) {
+	return b.reader.Read(p)
+}
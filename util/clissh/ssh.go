@@ -37,6 +37,19 @@ type LocalPortForward struct {
 	RemoteAddress string
 }
 
+// RemotePortForward asks the instance to listen on RemoteAddress and forwards
+// every connection it accepts to LocalAddress on this machine.
+type RemotePortForward struct {
+	RemoteAddress string
+	LocalAddress  string
+}
+
+// DynamicPortForward runs a SOCKS5 proxy on LocalAddress whose connections
+// are opened from the instance.
+type DynamicPortForward struct {
+	LocalAddress string
+}
+
 type SecureShell struct {
 	secureDialer    SecureDialer
 	secureClient    SecureClient
@@ -205,6 +218,46 @@ func (c *SecureShell) InteractiveSession(commands []string, terminalRequest TTYR
 	return result
 }
 
+// RunCommand runs commands on the connected instance without a terminal or
+// standard input, copying the remote output to stdout and stderr. A non-zero
+// remote exit status is returned as an *ssh.ExitError.
+func (c *SecureShell) RunCommand(commands []string, stdout io.Writer, stderr io.Writer) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	errPipe, err := session.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	err = session.Start(strings.Join(commands, " "))
+	if err != nil {
+		return err
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndDone(wg, stdout, outPipe)
+	go copyAndDone(wg, stderr, errPipe)
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	wg.Wait()
+	return session.Wait()
+}
+
 func (c *SecureShell) LocalPortForward(localPortForwardSpecs []LocalPortForward) error {
 	for _, spec := range localPortForwardSpecs {
 		listener, err := c.listenerFactory.Listen("tcp", spec.LocalAddress)
@@ -219,6 +272,34 @@ func (c *SecureShell) LocalPortForward(localPortForwardSpecs []LocalPortForward)
 	return nil
 }
 
+func (c *SecureShell) RemotePortForward(remotePortForwardSpecs []RemotePortForward) error {
+	for _, spec := range remotePortForwardSpecs {
+		listener, err := c.secureClient.Listen("tcp", spec.RemoteAddress)
+		if err != nil {
+			return fmt.Errorf("remote port forwarding on %s failed: %s", spec.RemoteAddress, err.Error())
+		}
+		c.localListeners = append(c.localListeners, listener)
+
+		go c.remoteForwardAcceptLoop(listener, spec.LocalAddress)
+	}
+
+	return nil
+}
+
+func (c *SecureShell) DynamicPortForward(dynamicPortForwardSpecs []DynamicPortForward) error {
+	for _, spec := range dynamicPortForwardSpecs {
+		listener, err := c.listenerFactory.Listen("tcp", spec.LocalAddress)
+		if err != nil {
+			return err
+		}
+		c.localListeners = append(c.localListeners, listener)
+
+		go c.forwardAcceptLoop(listener, c.handleSOCKSConnection)
+	}
+
+	return nil
+}
+
 func (c *SecureShell) Wait() error {
 	keepaliveStopCh := make(chan struct{})
 	defer close(keepaliveStopCh)
@@ -250,15 +331,35 @@ func (c *SecureShell) handleForwardConnection(conn net.Conn, targetAddr string)
 	}
 	defer target.Close()
 
-	wg := &sync.WaitGroup{}
-	wg.Add(2)
+	proxyConnections(conn, target)
+}
 
-	go copyAndClose(wg, conn, target)
-	go copyAndClose(wg, target, conn)
-	wg.Wait()
+func (c *SecureShell) handleRemoteForwardConnection(conn net.Conn, targetAddr string) {
+	defer conn.Close()
+
+	target, err := net.Dial("tcp", targetAddr)
+	if err != nil {
+		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+		return
+	}
+	defer target.Close()
+
+	proxyConnections(conn, target)
 }
 
 func (c *SecureShell) localForwardAcceptLoop(listener net.Listener, addr string) {
+	c.forwardAcceptLoop(listener, func(conn net.Conn) {
+		c.handleForwardConnection(conn, addr)
+	})
+}
+
+func (c *SecureShell) remoteForwardAcceptLoop(listener net.Listener, addr string) {
+	c.forwardAcceptLoop(listener, func(conn net.Conn) {
+		c.handleRemoteForwardConnection(conn, addr)
+	})
+}
+
+func (c *SecureShell) forwardAcceptLoop(listener net.Listener, handleConnection func(net.Conn)) {
 	defer listener.Close()
 
 	for {
@@ -272,7 +373,7 @@ func (c *SecureShell) localForwardAcceptLoop(listener net.Listener, addr string)
 			return
 		}
 
-		go c.handleForwardConnection(conn, addr)
+		go handleConnection(conn)
 	}
 }
 
@@ -347,6 +448,15 @@ func copyAndClose(wg *sync.WaitGroup, dest io.WriteCloser, src io.Reader) {
 	}
 }
 
+func proxyConnections(conn net.Conn, target net.Conn) {
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndClose(wg, conn, target)
+	go copyAndClose(wg, target, conn)
+	wg.Wait()
+}
+
 func copyAndDone(wg *sync.WaitGroup, dest io.Writer, src io.Reader) {
 	_, err := io.Copy(dest, src)
 	if err != nil {
@@ -8,11 +8,13 @@
 package clissh_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"net"
 	"os"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -186,6 +188,9 @@ var _ = Describe("CLI SSH", Serial, FlakeAttempts(9), func() {
 
 		BeforeEach(func() {
 			stdin = new(fake_io.FakeReadCloser)
+			stdin.ReadStub = func(p []byte) (int, error) {
+				return 0, io.EOF
+			}
 			stdout = new(fake_io.FakeWriter)
 			stderr = new(fake_io.FakeWriter)
 
@@ -820,6 +825,63 @@ var _ = Describe("CLI SSH", Serial, FlakeAttempts(9), func() {
 		})
 	})
 
+	Describe("RunCommand", Serial, func() {
+		var (
+			stdout, stderr *bytes.Buffer
+			runErr         error
+		)
+
+		BeforeEach(func() {
+			stdout = new(bytes.Buffer)
+			stderr = new(bytes.Buffer)
+			commands = []string{"ps", "aux"}
+
+			fakeSecureSession.StdoutPipeReturns(strings.NewReader("process list\n"), nil)
+			fakeSecureSession.StderrPipeReturns(strings.NewReader("a warning\n"), nil)
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			runErr = secureShell.RunCommand(commands, stdout, stderr)
+		})
+
+		It("runs the command without a terminal or stdin and copies its output", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+
+			Expect(fakeSecureSession.StartCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("ps aux"))
+			Expect(fakeSecureSession.RequestPtyCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.StdinPipeCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.WaitCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+
+			Expect(stdout.String()).To(Equal("process list\n"))
+			Expect(stderr.String()).To(Equal("a warning\n"))
+		})
+
+		When("the session cannot be allocated", func() {
+			BeforeEach(func() {
+				fakeSecureClient.NewSessionReturns(nil, errors.New("no session"))
+			})
+
+			It("returns an error", func() {
+				Expect(runErr).To(MatchError("SSH session allocation failed: no session"))
+			})
+		})
+
+		When("the remote command fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(errors.New("exit status 3"))
+			})
+
+			It("returns the error from waiting on the session", func() {
+				Expect(runErr).To(MatchError("exit status 3"))
+			})
+		})
+	})
+
 	Describe("LocalPortForward", Serial, func() {
 		var (
 			forwardErr error
@@ -1149,6 +1211,256 @@ var _ = Describe("CLI SSH", Serial, FlakeAttempts(9), func() {
 		})
 	})
 
+	Describe("RemotePortForward", Serial, func() {
+		var (
+			forwardErr error
+
+			echoListener   net.Listener
+			remoteListener net.Listener
+			remoteAddress  string
+
+			forwardSpecs []RemotePortForward
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			go func() {
+				for {
+					conn, err := echoListener.Accept()
+					if err != nil {
+						return
+					}
+					go func() {
+						io.Copy(conn, conn) //nolint:errcheck
+						conn.Close()
+					}()
+				}
+			}()
+
+			remoteListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			remoteAddress = remoteListener.Addr().String()
+			fakeSecureClient.ListenReturns(remoteListener, nil)
+
+			forwardSpecs = []RemotePortForward{{
+				RemoteAddress: "localhost:9000",
+				LocalAddress:  echoListener.Addr().String(),
+			}}
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.RemotePortForward(forwardSpecs)
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			echoListener.Close()
+		})
+
+		It("asks the instance to listen on the remote address", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:9000"))
+		})
+
+		It("copies data between remote connections and the local address", func() {
+			conn, err := net.Dial("tcp", remoteAddress)
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			msg := "Hello from the instance\n"
+			_, err = conn.Write([]byte(msg))
+			Expect(err).NotTo(HaveOccurred())
+
+			response := make([]byte, len(msg))
+			_, err = io.ReadFull(conn, response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(response)).To(Equal(msg))
+		})
+
+		When("the instance refuses to listen", func() {
+			BeforeEach(func() {
+				remoteListener.Close()
+				fakeSecureClient.ListenReturns(nil, errors.New("tcpip-forward request denied by peer"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("remote port forwarding on localhost:9000 failed: tcpip-forward request denied by peer"))
+			})
+		})
+
+		When("the client is closed", func() {
+			var fakeRemoteListener *fake_net.FakeListener
+
+			BeforeEach(func() {
+				remoteListener.Close()
+				fakeRemoteListener = new(fake_net.FakeListener)
+				BlockAcceptOnClose(fakeRemoteListener)
+				fakeSecureClient.ListenReturns(fakeRemoteListener, nil)
+			})
+
+			It("closes the remote listener", func() {
+				Eventually(fakeRemoteListener.AcceptCallCount).Should(Equal(1))
+
+				Expect(secureShell.Close()).To(Succeed())
+				Eventually(fakeRemoteListener.CloseCallCount).Should(Equal(2))
+			})
+		})
+	})
+
+	Describe("DynamicPortForward", Serial, func() {
+		var (
+			forwardErr error
+
+			echoListener      net.Listener
+			realLocalListener net.Listener
+			localAddress      string
+		)
+
+		socksConnect := func(request []byte) (net.Conn, []byte) {
+			conn, err := net.Dial("tcp", localAddress)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = conn.Write([]byte{0x05, 0x01, 0x00})
+			Expect(err).NotTo(HaveOccurred())
+			methodReply := make([]byte, 2)
+			_, err = io.ReadFull(conn, methodReply)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(methodReply).To(Equal([]byte{0x05, 0x00}))
+
+			_, err = conn.Write(request)
+			Expect(err).NotTo(HaveOccurred())
+			reply := make([]byte, 10)
+			_, err = io.ReadFull(conn, reply)
+			Expect(err).NotTo(HaveOccurred())
+
+			return conn, reply
+		}
+
+		echoPort := func() []byte {
+			_, port, err := net.SplitHostPort(echoListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			var portNumber int
+			_, err = fmt.Sscanf(port, "%d", &portNumber)
+			Expect(err).NotTo(HaveOccurred())
+			return []byte{byte(portNumber >> 8), byte(portNumber)}
+		}
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			go func() {
+				for {
+					conn, err := echoListener.Accept()
+					if err != nil {
+						return
+					}
+					go func() {
+						io.Copy(conn, conn) //nolint:errcheck
+						conn.Close()
+					}()
+				}
+			}()
+
+			realLocalListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			localAddress = realLocalListener.Addr().String()
+			fakeListenerFactory.ListenReturns(realLocalListener, nil)
+
+			fakeSecureClient.DialStub = net.Dial
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.DynamicPortForward([]DynamicPortForward{{LocalAddress: "localhost:1080"}})
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			echoListener.Close()
+			realLocalListener.Close()
+		})
+
+		It("listens on the local address", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+			network, addr := fakeListenerFactory.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:1080"))
+		})
+
+		It("proxies SOCKS5 connect requests for IPv4 addresses through the instance", func() {
+			conn, reply := socksConnect(append([]byte{0x05, 0x01, 0x00, 0x01, 127, 0, 0, 1}, echoPort()...))
+			defer conn.Close()
+			Expect(reply[:2]).To(Equal([]byte{0x05, 0x00}))
+
+			Eventually(fakeSecureClient.DialCallCount).Should(Equal(1))
+			network, addr := fakeSecureClient.DialArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal(echoListener.Addr().String()))
+
+			msg := "Hello through SOCKS\n"
+			_, err := conn.Write([]byte(msg))
+			Expect(err).NotTo(HaveOccurred())
+			response := make([]byte, len(msg))
+			_, err = io.ReadFull(conn, response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(response)).To(Equal(msg))
+		})
+
+		It("proxies SOCKS5 connect requests for domain names through the instance", func() {
+			request := append([]byte{0x05, 0x01, 0x00, 0x03, byte(len("some-service.internal"))}, []byte("some-service.internal")...)
+			conn, reply := socksConnect(append(request, 0x1f, 0x90))
+			defer conn.Close()
+			Expect(reply[:2]).To(Equal([]byte{0x05, 0x01}))
+
+			Eventually(fakeSecureClient.DialCallCount).Should(Equal(1))
+			_, addr := fakeSecureClient.DialArgsForCall(0)
+			Expect(addr).To(Equal("some-service.internal:8080"))
+		})
+
+		It("rejects commands other than connect", func() {
+			conn, reply := socksConnect(append([]byte{0x05, 0x02, 0x00, 0x01, 127, 0, 0, 1}, echoPort()...))
+			defer conn.Close()
+			Expect(reply[:2]).To(Equal([]byte{0x05, 0x07}))
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(0))
+		})
+
+		When("the client offers no supported authentication method", func() {
+			It("rejects the connection", func() {
+				conn, err := net.Dial("tcp", localAddress)
+				Expect(err).NotTo(HaveOccurred())
+				defer conn.Close()
+
+				_, err = conn.Write([]byte{0x05, 0x01, 0x02})
+				Expect(err).NotTo(HaveOccurred())
+				methodReply := make([]byte, 2)
+				_, err = io.ReadFull(conn, methodReply)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(methodReply).To(Equal([]byte{0x05, 0xff}))
+			})
+		})
+
+		When("listen fails", func() {
+			BeforeEach(func() {
+				fakeListenerFactory.ListenReturns(nil, errors.New("address in use"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("address in use"))
+			})
+		})
+	})
+
 	Describe("Wait", Serial, func() {
 		var waitErr error
 
@@ -55,8 +55,10 @@ func (p *CommandParser) ParseCommandFromArgs(ui *ui.UI, args []string) (int, err
 func (p *CommandParser) executionWrapper(cmd flags.Commander, args []string) error {
 	cfConfig := p.Config
 	cfConfig.Flags = configv3.FlagOverride{
-		Verbose: common.Commands.VerboseOrVersion,
+		OutputFormat: common.Commands.OutputFormat,
+		Verbose:      common.Commands.VerboseOrVersion,
 	}
+	p.UI.SetOutputFormat(cfConfig.OutputFormat())
 	defer p.UI.FlushDeferred()
 
 	err := preventExtraArgs(args)
@@ -64,11 +66,22 @@ func (p *CommandParser) executionWrapper(cmd flags.Commander, args []string) err
 		return p.handleError(err)
 	}
 
+	if _, ok := cmd.(command.StructuredCommander); !ok && cfConfig.OutputFormat() != configv3.OutputFormatDefault {
+		return p.handleError(translatableerror.OutputFormatNotSupportedError{})
+	}
+
 	err = cfConfig.CreatePluginHome()
 	if err != nil {
 		return p.handleError(err)
 	}
 
+	if common.Commands.Context != "" {
+		err = cfConfig.OverrideContext(common.Commands.Context)
+		if err != nil {
+			return p.handleError(err)
+		}
+	}
+
 	defer func() {
 		configWriteErr := cfConfig.WriteConfig()
 		if configWriteErr != nil {
@@ -76,6 +89,14 @@ func (p *CommandParser) executionWrapper(cmd flags.Commander, args []string) err
 		}
 	}()
 
+	defer func() {
+		if credentialStoreErr := cfConfig.CredentialStoreError(); credentialStoreErr != nil {
+			p.UI.DisplayWarning("Unable to read credentials from the credential store, so you are not logged in: {{.Error}}", map[string]interface{}{
+				"Error": credentialStoreErr.Error(),
+			})
+		}
+	}()
+
 	if extendedCmd, ok := cmd.(command.ExtendedCommander); ok {
 		log.SetOutput(os.Stderr)
 		log.SetLevel(log.Level(cfConfig.LogLevel()))
@@ -121,6 +142,9 @@ func (p *CommandParser) handleError(passedErr error) error {
 	case translatableerror.CurlExit22Error:
 		p.UI.DisplayError(translatedErr)
 		return passedErr
+	case translatableerror.SSHInstancesFailedError:
+		p.UI.DisplayError(translatedErr)
+		return passedErr
 	}
 
 	p.UI.DisplayError(translatedErr)
@@ -233,6 +257,8 @@ func (p *CommandParser) parse(args []string, commandList interface{}) (int, erro
 		return exitError.ExitStatus(), nil
 	} else if curlError, ok := err.(translatableerror.CurlExit22Error); ok {
 		return 22, curlError
+	} else if sshError, ok := err.(translatableerror.SSHInstancesFailedError); ok {
+		return sshError.ExitStatus, nil
 	}
 
 	fmt.Fprintf(os.Stderr, "Unexpected error: %s\n", err.Error())
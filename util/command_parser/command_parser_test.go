@@ -78,4 +78,32 @@ var _ = Describe("Command 'Parser'", func() {
 		})
 
 	})
+
+	Describe("the output flag", func() {
+		var parser command_parser.CommandParser
+
+		BeforeEach(func() {
+			var err error
+			parser, err = command_parser.NewCommandParser(v3Config)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			common.Commands.OutputFormat = ""
+		})
+
+		When("the command has no results to display as json or yaml", func() {
+			It("fails without running the command", func() {
+				exitCode, err := parser.ParseCommandFromArgs(pluginUI, []string{"--output", "json", "ssh", "some-app"})
+				Expect(exitCode).To(Equal(1))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("fails for commands that only make changes", func() {
+				exitCode, err := parser.ParseCommandFromArgs(pluginUI, []string{"--output", "json", "delete-sidecar", "some-app", "some-sidecar"})
+				Expect(exitCode).To(Equal(1))
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
 })
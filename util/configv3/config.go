@@ -32,6 +32,19 @@ type Config struct {
 
 	pluginsConfig PluginsConfig
 
+	// contextOverride is the context given with the --context flag.
+	contextOverride string
+
+	// credentialStore keeps the credentials out of the config.json when one is
+	// selected, and storedCredentials are the credentials it had for each
+	// context when they were last loaded or saved. credentialsRead records the
+	// contexts whose credentials were read from it, and credentialStoreErr the
+	// first error from reading them.
+	credentialStore    CredentialStore
+	storedCredentials  map[string]Credentials
+	credentialsRead    map[string]bool
+	credentialStoreErr error
+
 	UserConfig
 }
 
@@ -0,0 +1,231 @@
+package configv3
+
+import (
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// DefaultContextName is the name of the context that the target, tokens and
+// targeted org and space of a config written before contexts existed are
+// migrated into.
+const DefaultContextName = "default"
+
+// TargetContext is a named API target together with the tokens and the
+// targeted org and space used with it.
+//
+// The fields of the current context are also stored at the top level of the
+// config file, where every command and older versions of the CLI read and
+// write them. The contexts are synced with them when the config is written
+// and when switching contexts.
+type TargetContext struct {
+	AccessToken              string       `json:"AccessToken"`
+	APIVersion               string       `json:"APIVersion"`
+	AuthorizationEndpoint    string       `json:"AuthorizationEndpoint"`
+	CFOnK8s                  CFOnK8s      `json:"CFOnK8s"`
+	DopplerEndpoint          string       `json:"DopplerEndPoint"`
+	LogCacheEndpoint         string       `json:"LogCacheEndPoint"`
+	MinCLIVersion            string       `json:"MinCLIVersion"`
+	MinRecommendedCLIVersion string       `json:"MinRecommendedCLIVersion"`
+	NetworkPolicyV1Endpoint  string       `json:"NetworkPolicyV1Endpoint"`
+	TargetedOrganization     Organization `json:"OrganizationFields"`
+	RefreshToken             string       `json:"RefreshToken"`
+	RoutingEndpoint          string       `json:"RoutingAPIEndpoint"`
+	TargetedSpace            Space        `json:"SpaceFields"`
+	SSHOAuthClient           string       `json:"SSHOAuthClient"`
+	SkipSSLValidation        bool         `json:"SSLDisabled"`
+	Target                   string       `json:"Target"`
+	UAAEndpoint              string       `json:"UaaEndpoint"`
+	UAAGrantType             string       `json:"UAAGrantType"`
+	UAAOAuthClient           string       `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret     string       `json:"UAAOAuthClientSecret"`
+}
+
+// CurrentContext returns the name of the context in use, which is the
+// context given with the --context flag if there is one.
+func (config *Config) CurrentContext() string {
+	if config.contextOverride != "" {
+		return config.contextOverride
+	}
+	return config.ConfigFile.currentContext()
+}
+
+// Contexts returns every context by name.
+func (config *Config) Contexts() map[string]TargetContext {
+	config.syncCurrentContext()
+	return config.ConfigFile.Contexts
+}
+
+// CreateContext adds a context with no target. When fromCurrent is true, the
+// context is a copy of the current context instead.
+func (config *Config) CreateContext(name string, fromCurrent bool) error {
+	if fromCurrent {
+		config.loadCurrentCredentials()
+	}
+	config.syncCurrentContext()
+	if _, exists := config.ConfigFile.Contexts[name]; exists {
+		return translatableerror.ContextAlreadyExistsError{Name: name}
+	}
+
+	context := TargetContext{
+		SSHOAuthClient:       DefaultSSHOAuthClient,
+		UAAOAuthClient:       DefaultUAAOAuthClient,
+		UAAOAuthClientSecret: DefaultUAAOAuthClientSecret,
+	}
+	if fromCurrent {
+		context = config.ConfigFile.Contexts[config.CurrentContext()]
+	}
+	config.ConfigFile.Contexts[name] = context
+	return nil
+}
+
+// RenameContext renames a context, including the current one.
+func (config *Config) RenameContext(oldName string, newName string) error {
+	config.loadCredentials(oldName)
+	config.syncCurrentContext()
+	context, exists := config.ConfigFile.Contexts[oldName]
+	if !exists {
+		return translatableerror.ContextNotFoundError{Name: oldName}
+	}
+	if _, exists = config.ConfigFile.Contexts[newName]; exists {
+		return translatableerror.ContextAlreadyExistsError{Name: newName}
+	}
+
+	delete(config.ConfigFile.Contexts, oldName)
+	config.ConfigFile.Contexts[newName] = context
+	if config.ConfigFile.CurrentContext == oldName {
+		config.ConfigFile.CurrentContext = newName
+	}
+	if config.contextOverride == oldName {
+		config.contextOverride = newName
+	}
+	return nil
+}
+
+// DeleteContext removes a context. The current context cannot be removed.
+func (config *Config) DeleteContext(name string) error {
+	config.loadCredentials(name)
+	config.syncCurrentContext()
+	if _, exists := config.ConfigFile.Contexts[name]; !exists {
+		return translatableerror.ContextNotFoundError{Name: name}
+	}
+	if name == config.ConfigFile.CurrentContext || name == config.contextOverride {
+		return translatableerror.CurrentContextDeletionError{Name: name}
+	}
+
+	delete(config.ConfigFile.Contexts, name)
+	return nil
+}
+
+// UseContext makes the named context the current context.
+func (config *Config) UseContext(name string) error {
+	err := config.switchContext(name)
+	if err != nil {
+		return err
+	}
+
+	config.ConfigFile.CurrentContext = name
+	config.contextOverride = ""
+	return nil
+}
+
+// OverrideContext uses the named context until the CLI exits without
+// changing the current context. Changes to it, such as refreshed tokens, are
+// still saved to the context when the config is written.
+func (config *Config) OverrideContext(name string) error {
+	err := config.switchContext(name)
+	if err != nil {
+		return err
+	}
+
+	if name != config.ConfigFile.currentContext() {
+		config.contextOverride = name
+	}
+	return nil
+}
+
+func (config *Config) switchContext(name string) error {
+	config.syncCurrentContext()
+	context, exists := config.ConfigFile.Contexts[name]
+	if !exists {
+		return translatableerror.ContextNotFoundError{Name: name}
+	}
+
+	config.ConfigFile.applyContext(context)
+	return nil
+}
+
+// syncCurrentContext saves the top level fields of the config file to the
+// context in use, migrating a config without contexts into the default
+// context.
+func (config *Config) syncCurrentContext() {
+	if config.ConfigFile.Contexts == nil {
+		config.ConfigFile.Contexts = map[string]TargetContext{}
+	}
+	config.ConfigFile.CurrentContext = config.ConfigFile.currentContext()
+	config.ConfigFile.Contexts[config.CurrentContext()] = config.ConfigFile.context()
+}
+
+// persistedConfigFile returns the config file to write, which has the
+// current context at its top level even while --context is in use.
+func (config *Config) persistedConfigFile() JSONConfig {
+	config.syncCurrentContext()
+	configFile := config.ConfigFile
+	if config.contextOverride != "" {
+		configFile.applyContext(configFile.Contexts[configFile.CurrentContext])
+	}
+	return configFile
+}
+
+func (configFile JSONConfig) currentContext() string {
+	if configFile.CurrentContext == "" {
+		return DefaultContextName
+	}
+	return configFile.CurrentContext
+}
+
+func (configFile JSONConfig) context() TargetContext {
+	return TargetContext{
+		AccessToken:              configFile.AccessToken,
+		APIVersion:               configFile.APIVersion,
+		AuthorizationEndpoint:    configFile.AuthorizationEndpoint,
+		CFOnK8s:                  configFile.CFOnK8s,
+		DopplerEndpoint:          configFile.DopplerEndpoint,
+		LogCacheEndpoint:         configFile.LogCacheEndpoint,
+		MinCLIVersion:            configFile.MinCLIVersion,
+		MinRecommendedCLIVersion: configFile.MinRecommendedCLIVersion,
+		NetworkPolicyV1Endpoint:  configFile.NetworkPolicyV1Endpoint,
+		TargetedOrganization:     configFile.TargetedOrganization,
+		RefreshToken:             configFile.RefreshToken,
+		RoutingEndpoint:          configFile.RoutingEndpoint,
+		TargetedSpace:            configFile.TargetedSpace,
+		SSHOAuthClient:           configFile.SSHOAuthClient,
+		SkipSSLValidation:        configFile.SkipSSLValidation,
+		Target:                   configFile.Target,
+		UAAEndpoint:              configFile.UAAEndpoint,
+		UAAGrantType:             configFile.UAAGrantType,
+		UAAOAuthClient:           configFile.UAAOAuthClient,
+		UAAOAuthClientSecret:     configFile.UAAOAuthClientSecret,
+	}
+}
+
+func (configFile *JSONConfig) applyContext(context TargetContext) {
+	configFile.AccessToken = context.AccessToken
+	configFile.APIVersion = context.APIVersion
+	configFile.AuthorizationEndpoint = context.AuthorizationEndpoint
+	configFile.CFOnK8s = context.CFOnK8s
+	configFile.DopplerEndpoint = context.DopplerEndpoint
+	configFile.LogCacheEndpoint = context.LogCacheEndpoint
+	configFile.MinCLIVersion = context.MinCLIVersion
+	configFile.MinRecommendedCLIVersion = context.MinRecommendedCLIVersion
+	configFile.NetworkPolicyV1Endpoint = context.NetworkPolicyV1Endpoint
+	configFile.TargetedOrganization = context.TargetedOrganization
+	configFile.RefreshToken = context.RefreshToken
+	configFile.RoutingEndpoint = context.RoutingEndpoint
+	configFile.TargetedSpace = context.TargetedSpace
+	configFile.SSHOAuthClient = context.SSHOAuthClient
+	configFile.SkipSSLValidation = context.SkipSSLValidation
+	configFile.Target = context.Target
+	configFile.UAAEndpoint = context.UAAEndpoint
+	configFile.UAAGrantType = context.UAAGrantType
+	configFile.UAAOAuthClient = context.UAAOAuthClient
+	configFile.UAAOAuthClientSecret = context.UAAOAuthClientSecret
+}
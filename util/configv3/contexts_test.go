@@ -0,0 +1,163 @@
+package configv3_test
+
+import (
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Contexts", func() {
+	var (
+		homeDir string
+		config  *Config
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+		setConfig(homeDir, `{
+			"ConfigVersion": 4,
+			"Target": "https://api.one.example.com",
+			"AccessToken": "bearer one-token",
+			"RefreshToken": "one-refresh-token",
+			"OrganizationFields": {"GUID": "one-org-guid", "Name": "one-org"},
+			"SpaceFields": {"GUID": "one-space-guid", "Name": "one-space"},
+			"ColorEnabled": "true"
+		}`)
+
+		var err error
+		config, err = LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	reload := func() *Config {
+		Expect(config.WriteConfig()).To(Succeed())
+		reloaded, err := LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+		return reloaded
+	}
+
+	It("migrates a config without contexts into the default context", func() {
+		Expect(config.CurrentContext()).To(Equal(DefaultContextName))
+
+		reloaded := reload()
+		Expect(reloaded.ConfigFile.CurrentContext).To(Equal("default"))
+		Expect(reloaded.Contexts()).To(HaveLen(1))
+		Expect(reloaded.Contexts()["default"].Target).To(Equal("https://api.one.example.com"))
+		Expect(reloaded.Contexts()["default"].TargetedSpace.Name).To(Equal("one-space"))
+	})
+
+	Describe("CreateContext and UseContext", func() {
+		BeforeEach(func() {
+			Expect(config.CreateContext("two", false)).To(Succeed())
+		})
+
+		It("creates a context without a target", func() {
+			context := config.Contexts()["two"]
+			Expect(context.Target).To(BeEmpty())
+			Expect(context.AccessToken).To(BeEmpty())
+			Expect(context.UAAOAuthClient).To(Equal(DefaultUAAOAuthClient))
+			Expect(config.CurrentContext()).To(Equal("default"))
+		})
+
+		It("switches the target, tokens, org and space and keeps the other settings", func() {
+			Expect(config.UseContext("two")).To(Succeed())
+			Expect(config.CurrentContext()).To(Equal("two"))
+			Expect(config.Target()).To(BeEmpty())
+			Expect(config.AccessToken()).To(BeEmpty())
+			Expect(config.HasTargetedOrganization()).To(BeFalse())
+
+			config.SetTargetInformation(TargetInformationArgs{Api: "https://api.two.example.com"})
+			config.SetTokenInformation("bearer two-token", "two-refresh-token", "ssh-proxy")
+
+			reloaded := reload()
+			Expect(reloaded.CurrentContext()).To(Equal("two"))
+			Expect(reloaded.Target()).To(Equal("https://api.two.example.com"))
+			Expect(reloaded.ConfigFile.ColorEnabled).To(Equal("true"))
+
+			Expect(reloaded.UseContext("default")).To(Succeed())
+			Expect(reloaded.Target()).To(Equal("https://api.one.example.com"))
+			Expect(reloaded.AccessToken()).To(Equal("bearer one-token"))
+			Expect(reloaded.TargetedOrganization().Name).To(Equal("one-org"))
+			Expect(reloaded.Contexts()["two"].AccessToken).To(Equal("bearer two-token"))
+		})
+
+		It("copies the current context when asked to", func() {
+			Expect(config.CreateContext("copy", true)).To(Succeed())
+			Expect(config.Contexts()["copy"].Target).To(Equal("https://api.one.example.com"))
+			Expect(config.Contexts()["copy"].RefreshToken).To(Equal("one-refresh-token"))
+		})
+
+		It("does not create a context that already exists", func() {
+			Expect(config.CreateContext("two", true)).To(MatchError(translatableerror.ContextAlreadyExistsError{Name: "two"}))
+		})
+
+		It("does not switch to a context that does not exist", func() {
+			Expect(config.UseContext("three")).To(MatchError(translatableerror.ContextNotFoundError{Name: "three"}))
+			Expect(config.CurrentContext()).To(Equal("default"))
+		})
+	})
+
+	Describe("OverrideContext", func() {
+		BeforeEach(func() {
+			Expect(config.CreateContext("two", false)).To(Succeed())
+			Expect(config.OverrideContext("two")).To(Succeed())
+		})
+
+		It("uses the context without making it the current context", func() {
+			Expect(config.CurrentContext()).To(Equal("two"))
+			Expect(config.Target()).To(BeEmpty())
+
+			config.SetTokenInformation("bearer two-token", "two-refresh-token", "ssh-proxy")
+
+			reloaded := reload()
+			Expect(reloaded.CurrentContext()).To(Equal("default"))
+			Expect(reloaded.Target()).To(Equal("https://api.one.example.com"))
+			Expect(reloaded.AccessToken()).To(Equal("bearer one-token"))
+			Expect(reloaded.Contexts()["two"].AccessToken).To(Equal("bearer two-token"))
+		})
+
+		It("does not delete the context in use", func() {
+			Expect(config.DeleteContext("two")).To(MatchError(translatableerror.CurrentContextDeletionError{Name: "two"}))
+		})
+	})
+
+	Describe("RenameContext", func() {
+		It("renames the current context", func() {
+			Expect(config.RenameContext("default", "one")).To(Succeed())
+			Expect(config.CurrentContext()).To(Equal("one"))
+			Expect(config.Contexts()).To(HaveKey("one"))
+			Expect(config.Contexts()).ToNot(HaveKey("default"))
+		})
+
+		It("does not rename a context that does not exist", func() {
+			Expect(config.RenameContext("three", "four")).To(MatchError(translatableerror.ContextNotFoundError{Name: "three"}))
+		})
+
+		It("does not rename a context to the name of another one", func() {
+			Expect(config.CreateContext("two", false)).To(Succeed())
+			Expect(config.RenameContext("two", "default")).To(MatchError(translatableerror.ContextAlreadyExistsError{Name: "default"}))
+		})
+	})
+
+	Describe("DeleteContext", func() {
+		It("deletes a context that is not in use", func() {
+			Expect(config.CreateContext("two", false)).To(Succeed())
+			Expect(config.DeleteContext("two")).To(Succeed())
+			Expect(reload().Contexts()).ToNot(HaveKey("two"))
+		})
+
+		It("does not delete the current context", func() {
+			Expect(config.DeleteContext("default")).To(MatchError(translatableerror.CurrentContextDeletionError{Name: "default"}))
+		})
+
+		It("does not delete a context that does not exist", func() {
+			Expect(config.DeleteContext("three")).To(MatchError(translatableerror.ContextNotFoundError{Name: "three"}))
+		})
+	})
+})
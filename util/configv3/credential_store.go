@@ -0,0 +1,241 @@
+package configv3
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// PlaintextCredentialStore keeps credentials in the config.json. It is the
+	// default.
+	PlaintextCredentialStore = "plaintext"
+
+	// EncryptedFileCredentialStore keeps credentials in a file next to the
+	// config.json, encrypted with the passphrase in
+	// $CF_CREDENTIAL_STORE_PASSPHRASE.
+	EncryptedFileCredentialStore = "encrypted-file"
+
+	// HelperCredentialStorePrefix prefixes the name or path of a credential
+	// helper, as in "helper:osxkeychain".
+	HelperCredentialStorePrefix = "helper:"
+)
+
+// Credentials are the secrets of a context that a credential store keeps out
+// of the config.json.
+type Credentials struct {
+	AccessToken          string
+	RefreshToken         string
+	UAAOAuthClientSecret string
+}
+
+// CredentialStore saves the credentials of each context by context name. Get
+// returns empty credentials for a context it has no credentials for.
+type CredentialStore interface {
+	Get(name string) (Credentials, error)
+	Store(name string, credentials Credentials) error
+	Erase(name string) error
+}
+
+// NewCredentialStore returns the credential store with the given name, or nil
+// when credentials are kept in the config.json. When the store cannot be used,
+// such as when its passphrase is missing, every call to the returned store
+// fails with the reason.
+func NewCredentialStore(name string, passphrase string) CredentialStore {
+	switch {
+	case name == "" || name == PlaintextCredentialStore:
+		return nil
+	case name == EncryptedFileCredentialStore:
+		if passphrase == "" {
+			return unavailableCredentialStore{fmt.Errorf("the %s credential store requires CF_CREDENTIAL_STORE_PASSPHRASE to be set", EncryptedFileCredentialStore)}
+		}
+		return NewEncryptedFileStore(filepath.Join(configDirectory(), "credentials.enc"), passphrase)
+	case strings.HasPrefix(name, HelperCredentialStorePrefix) && len(name) > len(HelperCredentialStorePrefix):
+		return NewHelperStore(strings.TrimPrefix(name, HelperCredentialStorePrefix), configDirectory())
+	default:
+		return unavailableCredentialStore{fmt.Errorf("unknown credential store '%s'; use %s, %s or %sNAME", name, PlaintextCredentialStore, EncryptedFileCredentialStore, HelperCredentialStorePrefix)}
+	}
+}
+
+// unavailableCredentialStore stands in for a selected credential store that
+// cannot be used, so that the credentials are neither read from nor written to
+// the config.json.
+type unavailableCredentialStore struct {
+	err error
+}
+
+func (store unavailableCredentialStore) Get(string) (Credentials, error) {
+	return Credentials{}, store.err
+}
+
+func (store unavailableCredentialStore) Store(string, Credentials) error {
+	return store.err
+}
+
+func (store unavailableCredentialStore) Erase(string) error {
+	return store.err
+}
+
+// selectCredentialStore selects the credential store from $CF_CREDENTIAL_STORE
+// or the config.json. The credentials are read from it later, one context at a
+// time, when they are first used.
+func (config *Config) selectCredentialStore() {
+	name := config.ENV.CFCredentialStore
+	if name == "" {
+		name = config.ConfigFile.CredentialStore
+	}
+
+	store := NewCredentialStore(name, config.ENV.CFCredentialStorePassphrase)
+	if store == nil {
+		config.ConfigFile.CredentialStore = ""
+		return
+	}
+
+	config.ConfigFile.CredentialStore = name
+	config.credentialStore = store
+	config.storedCredentials = map[string]Credentials{}
+	config.credentialsRead = map[string]bool{}
+}
+
+// CredentialStoreError returns the error from reading credentials from the
+// credential store, if any. The contexts whose credentials could not be read
+// are treated as logged out.
+func (config *Config) CredentialStoreError() error {
+	return config.credentialStoreErr
+}
+
+// loadCredentials reads the credentials of the named context from the
+// credential store the first time they are needed. Credentials still in the
+// config.json, such as those written before the store was selected, are kept
+// and moved into the store when the config is written.
+func (config *Config) loadCredentials(name string) {
+	if config.credentialStore == nil || config.credentialsRead[name] {
+		return
+	}
+	config.credentialsRead[name] = true
+
+	credentials, err := config.credentialStore.Get(name)
+	if err != nil {
+		if config.credentialStoreErr == nil {
+			config.credentialStoreErr = err
+		}
+		return
+	}
+	config.storedCredentials[name] = credentials
+
+	if name == config.CurrentContext() {
+		config.ConfigFile.AccessToken, config.ConfigFile.RefreshToken, config.ConfigFile.UAAOAuthClientSecret =
+			mergeCredentials(credentials, config.ConfigFile.AccessToken, config.ConfigFile.RefreshToken, config.ConfigFile.UAAOAuthClientSecret)
+	}
+	if context, ok := config.ConfigFile.Contexts[name]; ok {
+		context.AccessToken, context.RefreshToken, context.UAAOAuthClientSecret =
+			mergeCredentials(credentials, context.AccessToken, context.RefreshToken, context.UAAOAuthClientSecret)
+		config.ConfigFile.Contexts[name] = context
+	}
+}
+
+func (config *Config) loadCurrentCredentials() {
+	config.loadCredentials(config.CurrentContext())
+}
+
+// CurrentUser returns the user the access token of the current context
+// belongs to.
+func (config *Config) CurrentUser() (User, error) {
+	config.loadCurrentCredentials()
+	return config.UserConfig.CurrentUser()
+}
+
+// CurrentUserName returns the name of the user the access token of the
+// current context belongs to.
+func (config *Config) CurrentUserName() (string, error) {
+	config.loadCurrentCredentials()
+	return config.UserConfig.CurrentUserName()
+}
+
+// saveCredentials writes the credentials of every context that changed since
+// they were loaded to the credential store, and erases the credentials of
+// removed contexts. The contexts whose credentials were never loaded are left
+// alone unless they have new credentials.
+func (config *Config) saveCredentials() error {
+	if config.credentialStore == nil {
+		return nil
+	}
+
+	config.syncCurrentContext()
+	for name, context := range config.ConfigFile.Contexts {
+		credentials := context.credentials()
+		if credentials == config.storedCredentials[name] {
+			continue
+		}
+
+		var err error
+		if credentials == (Credentials{}) {
+			err = config.credentialStore.Erase(name)
+		} else {
+			err = config.credentialStore.Store(name, credentials)
+		}
+		if err != nil {
+			return err
+		}
+		config.storedCredentials[name] = credentials
+	}
+
+	for name := range config.storedCredentials {
+		if _, exists := config.ConfigFile.Contexts[name]; exists {
+			continue
+		}
+		if config.storedCredentials[name] != (Credentials{}) {
+			err := config.credentialStore.Erase(name)
+			if err != nil {
+				return err
+			}
+		}
+		delete(config.storedCredentials, name)
+	}
+
+	return nil
+}
+
+// withoutCredentials returns a copy of the config file that has no credentials
+// in it when they are kept in a credential store.
+func (config *Config) withoutCredentials(configFile JSONConfig) JSONConfig {
+	if config.credentialStore == nil {
+		return configFile
+	}
+
+	configFile.AccessToken = ""
+	configFile.RefreshToken = ""
+	configFile.UAAOAuthClientSecret = ""
+
+	contexts := make(map[string]TargetContext, len(configFile.Contexts))
+	for name, context := range configFile.Contexts {
+		context.AccessToken = ""
+		context.RefreshToken = ""
+		context.UAAOAuthClientSecret = ""
+		contexts[name] = context
+	}
+	configFile.Contexts = contexts
+
+	return configFile
+}
+
+func (context TargetContext) credentials() Credentials {
+	return Credentials{
+		AccessToken:          context.AccessToken,
+		RefreshToken:         context.RefreshToken,
+		UAAOAuthClientSecret: context.UAAOAuthClientSecret,
+	}
+}
+
+func mergeCredentials(credentials Credentials, accessToken string, refreshToken string, clientSecret string) (string, string, string) {
+	if accessToken == "" {
+		accessToken = credentials.AccessToken
+	}
+	if refreshToken == "" {
+		refreshToken = credentials.RefreshToken
+	}
+	if clientSecret == "" {
+		clientSecret = credentials.UAAOAuthClientSecret
+	}
+	return accessToken, refreshToken, clientSecret
+}
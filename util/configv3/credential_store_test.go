@@ -0,0 +1,169 @@
+package configv3_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Credential stores", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+		setConfig(homeDir, `{
+			"ConfigVersion": 4,
+			"Target": "https://api.example.com",
+			"AccessToken": "bearer some-token",
+			"RefreshToken": "some-refresh-token",
+			"UAAOAuthClient": "some-client",
+			"UAAOAuthClientSecret": "some-client-secret"
+		}`)
+	})
+
+	AfterEach(func() {
+		Expect(os.Unsetenv("CF_CREDENTIAL_STORE")).To(Succeed())
+		Expect(os.Unsetenv("CF_CREDENTIAL_STORE_PASSPHRASE")).To(Succeed())
+		teardown(homeDir)
+	})
+
+	readConfigFile := func() string {
+		raw, err := os.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+		Expect(err).ToNot(HaveOccurred())
+		return string(raw)
+	}
+
+	When("no credential store is selected", func() {
+		It("keeps the credentials in the config file", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.WriteConfig()).To(Succeed())
+
+			Expect(readConfigFile()).To(ContainSubstring("some-refresh-token"))
+			Expect(readConfigFile()).ToNot(ContainSubstring("CredentialStore"))
+		})
+	})
+
+	When("the encrypted-file credential store is selected", func() {
+		BeforeEach(func() {
+			Expect(os.Setenv("CF_CREDENTIAL_STORE", "encrypted-file")).To(Succeed())
+			Expect(os.Setenv("CF_CREDENTIAL_STORE_PASSPHRASE", "some-passphrase")).To(Succeed())
+
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.WriteConfig()).To(Succeed())
+		})
+
+		It("moves the credentials out of the config file into an encrypted file", func() {
+			configFile := readConfigFile()
+			Expect(configFile).ToNot(ContainSubstring("some-token"))
+			Expect(configFile).ToNot(ContainSubstring("some-client-secret"))
+			Expect(configFile).To(ContainSubstring(`"CredentialStore": "encrypted-file"`))
+
+			raw, err := os.ReadFile(filepath.Join(homeDir, ".cf", "credentials.enc"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(raw)).ToNot(ContainSubstring("some-refresh-token"))
+		})
+
+		It("reads the credentials back using the store recorded in the config file", func() {
+			Expect(os.Unsetenv("CF_CREDENTIAL_STORE")).To(Succeed())
+
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.AccessToken()).To(Equal("bearer some-token"))
+			Expect(config.RefreshToken()).To(Equal("some-refresh-token"))
+			Expect(config.UAAOAuthClientSecret()).To(Equal("some-client-secret"))
+		})
+
+		It("keeps the credentials of each context", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.CreateContext("other", false)).To(Succeed())
+			Expect(config.UseContext("other")).To(Succeed())
+			config.SetTokenInformation("bearer other-token", "other-refresh-token", "ssh-client")
+			Expect(config.WriteConfig()).To(Succeed())
+			Expect(readConfigFile()).ToNot(ContainSubstring("other-token"))
+
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.AccessToken()).To(Equal("bearer other-token"))
+			Expect(config.UseContext("default")).To(Succeed())
+			Expect(config.AccessToken()).To(Equal("bearer some-token"))
+		})
+
+		It("erases the credentials when logging out", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			config.UnsetUserInformation()
+			Expect(config.WriteConfig()).To(Succeed())
+
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.AccessToken()).To(BeEmpty())
+			Expect(config.RefreshToken()).To(BeEmpty())
+		})
+
+		When("the passphrase is wrong", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_CREDENTIAL_STORE_PASSPHRASE", "wrong-passphrase")).To(Succeed())
+			})
+
+			It("does not read the store until the credentials are used", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.CredentialStoreError()).ToNot(HaveOccurred())
+				Expect(config.Target()).To(Equal("https://api.example.com"))
+				Expect(config.CredentialStoreError()).ToNot(HaveOccurred())
+			})
+
+			It("treats the user as logged out and keeps the stored credentials", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AccessToken()).To(BeEmpty())
+				Expect(config.CredentialStoreError()).To(MatchError(ContainSubstring("unable to decrypt credential file")))
+				Expect(config.WriteConfig()).To(Succeed())
+
+				Expect(os.Setenv("CF_CREDENTIAL_STORE_PASSPHRASE", "some-passphrase")).To(Succeed())
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AccessToken()).To(Equal("bearer some-token"))
+			})
+
+			It("does not write new credentials to the config file", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				config.SetAccessToken("bearer new-token")
+				Expect(config.WriteConfig()).To(MatchError(ContainSubstring("unable to decrypt credential file")))
+				Expect(readConfigFile()).ToNot(ContainSubstring("new-token"))
+			})
+		})
+
+		It("treats the user as logged out when the passphrase is missing", func() {
+			Expect(os.Unsetenv("CF_CREDENTIAL_STORE_PASSPHRASE")).To(Succeed())
+
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.AccessToken()).To(BeEmpty())
+			Expect(config.CredentialStoreError()).To(MatchError(ContainSubstring("requires CF_CREDENTIAL_STORE_PASSPHRASE")))
+		})
+	})
+
+	When("an unknown credential store is selected", func() {
+		BeforeEach(func() {
+			Expect(os.Setenv("CF_CREDENTIAL_STORE", "vault")).To(Succeed())
+		})
+
+		It("uses the credentials still in the config file but does not write them back", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.Target()).To(Equal("https://api.example.com"))
+			Expect(config.AccessToken()).To(Equal("bearer some-token"))
+			Expect(config.CredentialStoreError()).To(MatchError(ContainSubstring("unknown credential store 'vault'")))
+			Expect(config.WriteConfig()).To(MatchError(ContainSubstring("unknown credential store 'vault'")))
+		})
+	})
+})
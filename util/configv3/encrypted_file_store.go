@@ -0,0 +1,190 @@
+package configv3
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	encryptedFileKeyLength  = 32
+	encryptedFileSaltLength = 16
+)
+
+// EncryptedFileStore is a CredentialStore that keeps the credentials of every
+// context in one file, encrypted with AES-GCM using a key derived from a
+// passphrase with scrypt.
+type EncryptedFileStore struct {
+	path       string
+	passphrase string
+
+	loaded      bool
+	salt        []byte
+	key         []byte
+	credentials map[string]Credentials
+}
+
+type encryptedFile struct {
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// NewEncryptedFileStore returns an EncryptedFileStore for the file at path.
+// The file is created when credentials are first stored.
+func NewEncryptedFileStore(path string, passphrase string) *EncryptedFileStore {
+	return &EncryptedFileStore{
+		path:       path,
+		passphrase: passphrase,
+	}
+}
+
+// Get returns the credentials stored for the named context.
+func (store *EncryptedFileStore) Get(name string) (Credentials, error) {
+	err := store.load()
+	if err != nil {
+		return Credentials{}, err
+	}
+	return store.credentials[name], nil
+}
+
+// Store saves the credentials for the named context.
+func (store *EncryptedFileStore) Store(name string, credentials Credentials) error {
+	err := store.load()
+	if err != nil {
+		return err
+	}
+	store.credentials[name] = credentials
+	return store.save()
+}
+
+// Erase removes the credentials for the named context.
+func (store *EncryptedFileStore) Erase(name string) error {
+	err := store.load()
+	if err != nil {
+		return err
+	}
+	if _, exists := store.credentials[name]; !exists {
+		return nil
+	}
+	delete(store.credentials, name)
+	return store.save()
+}
+
+func (store *EncryptedFileStore) load() error {
+	if store.loaded {
+		return nil
+	}
+
+	store.credentials = map[string]Credentials{}
+
+	raw, err := os.ReadFile(store.path)
+	if errors.Is(err, os.ErrNotExist) {
+		store.salt = make([]byte, encryptedFileSaltLength)
+		_, err = rand.Read(store.salt)
+		if err != nil {
+			return err
+		}
+		store.key, err = store.deriveKey(store.salt)
+		if err != nil {
+			return err
+		}
+		store.loaded = true
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var file encryptedFile
+	err = json.Unmarshal(raw, &file)
+	if err != nil {
+		return fmt.Errorf("unable to read credential file %s: %s", store.path, err)
+	}
+
+	store.salt = file.Salt
+	store.key, err = store.deriveKey(file.Salt)
+	if err != nil {
+		return err
+	}
+
+	gcm, err := store.cipher()
+	if err != nil {
+		return err
+	}
+	plaintext, err := gcm.Open(nil, file.Nonce, file.Ciphertext, nil)
+	if err != nil {
+		return fmt.Errorf("unable to decrypt credential file %s; check CF_CREDENTIAL_STORE_PASSPHRASE", store.path)
+	}
+
+	err = json.Unmarshal(plaintext, &store.credentials)
+	if err != nil {
+		return fmt.Errorf("unable to read credential file %s: %s", store.path, err)
+	}
+
+	store.loaded = true
+	return nil
+}
+
+func (store *EncryptedFileStore) save() error {
+	plaintext, err := json.Marshal(store.credentials)
+	if err != nil {
+		return err
+	}
+
+	gcm, err := store.cipher()
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(encryptedFile{
+		Salt:       store.salt,
+		Nonce:      nonce,
+		Ciphertext: gcm.Seal(nil, nonce, plaintext, nil),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(store.path), 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := os.CreateTemp(filepath.Dir(store.path), "temp-credentials")
+	if err != nil {
+		return err
+	}
+	tempFile.Close()
+
+	err = os.WriteFile(tempFile.Name(), raw, 0600)
+	if err != nil {
+		_ = os.Remove(tempFile.Name())
+		return err
+	}
+	return os.Rename(tempFile.Name(), store.path)
+}
+
+func (store *EncryptedFileStore) deriveKey(salt []byte) ([]byte, error) {
+	return scrypt.Key([]byte(store.passphrase), salt, 1<<15, 8, 1, encryptedFileKeyLength)
+}
+
+func (store *EncryptedFileStore) cipher() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(store.key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -8,23 +8,26 @@ import (
 
 // EnvOverride represents all the environment variables read by the CF CLI
 type EnvOverride struct {
-	BinaryName       string
-	CFColor          string
-	CFDialTimeout    string
-	CFHome           string
-	CFLogLevel       string
-	CFPassword       string
-	CFPluginHome     string
-	CFStagingTimeout string
-	CFStartupTimeout string
-	CFTrace          string
-	CFUsername       string
-	DockerPassword   string
-	Experimental     string
-	ForceTTY         string
-	HTTPSProxy       string
-	Lang             string
-	LCAll            string
+	BinaryName                  string
+	CFColor                     string
+	CFCredentialStore           string
+	CFCredentialStorePassphrase string
+	CFDialTimeout               string
+	CFHome                      string
+	CFLogLevel                  string
+	CFPassword                  string
+	CFPluginHome                string
+	CFStagingTimeout            string
+	CFStartupTimeout            string
+	CFTrace                     string
+	CFUsername                  string
+	DockerPassword              string
+	Experimental                string
+	ForceTTY                    string
+	HTTPSProxy                  string
+	Lang                        string
+	LCAll                       string
+	ManifestVarsHelper          string
 }
 
 // BinaryName returns the running name of the CF CLI
@@ -61,6 +64,13 @@ func (config *Config) DockerPassword() string {
 	return config.ENV.DockerPassword
 }
 
+// ManifestVarsHelper returns the path of the executable that resolves
+// ((helper:NAME)) manifest variables, from the $CF_MANIFEST_VARS_HELPER
+// environment variable.
+func (config *Config) ManifestVarsHelper() string {
+	return config.ENV.ManifestVarsHelper
+}
+
 // Experimental returns whether or not to run experimental CLI commands. This
 // is based on the following:
 //  1. The $CF_CLI_EXPERIMENTAL environment variable if set
@@ -21,13 +21,14 @@ var _ = Describe("Config", func() {
 	When("there are environment variables set", func() {
 		BeforeEach(func() {
 			config.ENV = EnvOverride{
-				CFDialTimeout:    "1234",
-				CFPassword:       "I am password.",
-				CFStagingTimeout: "8675",
-				CFStartupTimeout: "309",
-				CFUsername:       "i-R-user",
-				DockerPassword:   "banana",
-				HTTPSProxy:       "proxy.com",
+				CFDialTimeout:      "1234",
+				CFPassword:         "I am password.",
+				CFStagingTimeout:   "8675",
+				CFStartupTimeout:   "309",
+				CFUsername:         "i-R-user",
+				DockerPassword:     "banana",
+				HTTPSProxy:         "proxy.com",
+				ManifestVarsHelper: "/bin/vars-helper",
 			}
 		})
 
@@ -37,6 +38,7 @@ var _ = Describe("Config", func() {
 			Expect(config.DialTimeout()).To(Equal(1234 * time.Second))
 			Expect(config.DockerPassword()).To(Equal("banana"))
 			Expect(config.HTTPSProxy()).To(Equal("proxy.com"))
+			Expect(config.ManifestVarsHelper()).To(Equal("/bin/vars-helper"))
 			Expect(config.StagingTimeout()).To(Equal(time.Duration(8675) * time.Minute))
 			Expect(config.StartupTimeout()).To(Equal(time.Duration(309) * time.Minute))
 		})
@@ -2,5 +2,6 @@ package configv3
 
 // FlagOverride represents all the global flags passed to the CF CLI
 type FlagOverride struct {
-	Verbose bool
+	OutputFormat string
+	Verbose      bool
 }
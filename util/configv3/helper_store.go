@@ -0,0 +1,124 @@
+package configv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// helperExecutablePrefix is prepended to helper names that are not paths, so
+// that the credential helpers installed for docker, such as
+// docker-credential-osxkeychain, can be used as they are.
+const helperExecutablePrefix = "docker-credential-"
+
+// HelperStore is a CredentialStore that runs an external credential helper
+// using the docker credential helper protocol:
+//
+//	HELPER get     reads a server URL on stdin and writes
+//	               {"ServerURL": ..., "Username": ..., "Secret": ...} on stdout
+//	HELPER store   reads {"ServerURL": ..., "Username": ..., "Secret": ...} on stdin
+//	HELPER erase   reads a server URL on stdin
+//
+// The credentials of a context are stored as the JSON encoded secret of the
+// server URL "cf://CONTEXT@CONFIG_DIR", so that contexts in different CF homes
+// do not share credentials.
+type HelperStore struct {
+	helper    string
+	configDir string
+}
+
+type helperCredentials struct {
+	ServerURL string
+	Username  string
+	Secret    string
+}
+
+// NewHelperStore returns a HelperStore that runs the given helper. A helper
+// that is a name rather than a path is run as docker-credential-NAME from the
+// PATH.
+func NewHelperStore(helper string, configDir string) *HelperStore {
+	if !strings.ContainsAny(helper, `/\`) {
+		helper = helperExecutablePrefix + helper
+	}
+	return &HelperStore{
+		helper:    helper,
+		configDir: configDir,
+	}
+}
+
+// Get returns the credentials stored for the named context.
+func (store *HelperStore) Get(name string) (Credentials, error) {
+	output, err := store.run("get", store.serverURL(name))
+	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), "credentials not found") {
+			return Credentials{}, nil
+		}
+		return Credentials{}, err
+	}
+
+	var stored helperCredentials
+	err = json.Unmarshal(output, &stored)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("credential helper %s returned invalid credentials: %s", store.helper, err)
+	}
+
+	var credentials Credentials
+	err = json.Unmarshal([]byte(stored.Secret), &credentials)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("credential helper %s returned invalid credentials: %s", store.helper, err)
+	}
+	return credentials, nil
+}
+
+// Store saves the credentials for the named context.
+func (store *HelperStore) Store(name string, credentials Credentials) error {
+	secret, err := json.Marshal(credentials)
+	if err != nil {
+		return err
+	}
+
+	input, err := json.Marshal(helperCredentials{
+		ServerURL: store.serverURL(name),
+		Username:  "cf",
+		Secret:    string(secret),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = store.run("store", string(input))
+	return err
+}
+
+// Erase removes the credentials for the named context.
+func (store *HelperStore) Erase(name string) error {
+	_, err := store.run("erase", store.serverURL(name))
+	if err != nil && strings.Contains(strings.ToLower(err.Error()), "credentials not found") {
+		return nil
+	}
+	return err
+}
+
+func (store *HelperStore) serverURL(name string) string {
+	return fmt.Sprintf("cf://%s@%s", name, store.configDir)
+}
+
+func (store *HelperStore) run(action string, input string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	helper := exec.Command(store.helper, action)
+	helper.Stdin = strings.NewReader(input)
+	helper.Stdout = &stdout
+	helper.Stderr = &stderr
+
+	err := helper.Run()
+	if err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			message = strings.TrimSpace(stdout.String())
+		}
+		return nil, fmt.Errorf("credential helper %s %s failed: %s %s", store.helper, action, err, message)
+	}
+	return stdout.Bytes(), nil
+}
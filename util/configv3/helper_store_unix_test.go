@@ -0,0 +1,148 @@
+//go:build !windows
+// +build !windows
+
+package configv3_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeHelper stores each secret in a file named after the base64 encoded
+// server URL, and fails like the docker helpers do when there is none. Each
+// get is recorded in the calls file.
+const fakeHelper = `#!/bin/sh
+dir="$(dirname "$0")/secrets"
+mkdir -p "$dir"
+case "$1" in
+get)
+	url=$(cat)
+	echo "get $url" >> "$(dirname "$0")/calls"
+	key=$(printf '%s' "$url" | base64 | tr -d '\n/')
+	if [ ! -f "$dir/$key" ]; then
+		echo "credentials not found in native keychain"
+		exit 1
+	fi
+	cat "$dir/$key"
+	;;
+store)
+	input=$(cat)
+	url=$(printf '%s' "$input" | sed 's/.*"ServerURL":"\([^"]*\)".*/\1/')
+	key=$(printf '%s' "$url" | base64 | tr -d '\n/')
+	printf '%s' "$input" > "$dir/$key"
+	;;
+erase)
+	key=$(cat | base64 | tr -d '\n/')
+	rm -f "$dir/$key"
+	;;
+esac
+`
+
+var _ = Describe("HelperStore", func() {
+	var (
+		helperDir string
+		store     *HelperStore
+	)
+
+	BeforeEach(func() {
+		var err error
+		helperDir, err = os.MkdirTemp("", "cli-credential-helper")
+		Expect(err).ToNot(HaveOccurred())
+
+		helperPath := filepath.Join(helperDir, "docker-credential-fake")
+		Expect(os.WriteFile(helperPath, []byte(fakeHelper), 0700)).To(Succeed())
+
+		store = NewHelperStore(helperPath, "/some/home/.cf")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(helperDir)).To(Succeed())
+	})
+
+	It("returns empty credentials for a context that has none", func() {
+		credentials, err := store.Get("default")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(credentials).To(Equal(Credentials{}))
+	})
+
+	It("stores, gets and erases the credentials of a context", func() {
+		credentials := Credentials{
+			AccessToken:          "bearer some-token",
+			RefreshToken:         "some-refresh-token",
+			UAAOAuthClientSecret: "some-secret",
+		}
+		Expect(store.Store("default", credentials)).To(Succeed())
+
+		Expect(store.Get("default")).To(Equal(credentials))
+		Expect(store.Get("other")).To(Equal(Credentials{}))
+
+		Expect(store.Erase("default")).To(Succeed())
+		Expect(store.Get("default")).To(Equal(Credentials{}))
+	})
+
+	It("returns an error when the helper cannot be run", func() {
+		store = NewHelperStore(filepath.Join(helperDir, "missing"), "/some/home/.cf")
+		_, err := store.Get("default")
+		Expect(err).To(MatchError(ContainSubstring("credential helper")))
+	})
+})
+
+var _ = Describe("the helper credential store", func() {
+	var (
+		homeDir   string
+		helperDir string
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+		setConfig(homeDir, `{
+			"ConfigVersion": 4,
+			"Target": "https://api.example.com",
+			"CurrentContext": "default",
+			"Contexts": {
+				"default": {"Target": "https://api.example.com"},
+				"other": {"Target": "https://api.other.com"}
+			}
+		}`)
+
+		var err error
+		helperDir, err = os.MkdirTemp("", "cli-credential-helper")
+		Expect(err).ToNot(HaveOccurred())
+		helperPath := filepath.Join(helperDir, "docker-credential-fake")
+		Expect(os.WriteFile(helperPath, []byte(fakeHelper), 0700)).To(Succeed())
+		Expect(os.Setenv("CF_CREDENTIAL_STORE", "helper:"+helperPath)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.Unsetenv("CF_CREDENTIAL_STORE")).To(Succeed())
+		Expect(os.RemoveAll(helperDir)).To(Succeed())
+		teardown(homeDir)
+	})
+
+	helperCalls := func() string {
+		raw, err := os.ReadFile(filepath.Join(helperDir, "calls"))
+		if os.IsNotExist(err) {
+			return ""
+		}
+		Expect(err).ToNot(HaveOccurred())
+		return string(raw)
+	}
+
+	It("reads the credentials of the current context once, when they are first used", func() {
+		config, err := LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.Target()).To(Equal("https://api.example.com"))
+		Expect(helperCalls()).To(BeEmpty())
+
+		Expect(config.AccessToken()).To(BeEmpty())
+		Expect(config.RefreshToken()).To(BeEmpty())
+		Expect(config.WriteConfig()).To(Succeed())
+
+		Expect(helperCalls()).To(MatchRegexp(`^get cf://default@[^\n]*\n$`))
+	})
+})
@@ -13,6 +13,12 @@ func ConfigFilePath() string {
 	return filepath.Join(homeDirectory(), ".cf", "config.json")
 }
 
+// PushCacheFilePath returns the location of the cache of file checksums and
+// resource matches that push keeps between runs
+func PushCacheFilePath() string {
+	return filepath.Join(configDirectory(), "push-cache.json")
+}
+
 func configDirectory() string {
 	return filepath.Join(homeDirectory(), ".cf")
 }
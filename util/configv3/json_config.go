@@ -6,32 +6,35 @@ import (
 
 // JSONConfig represents .cf/config.json.
 type JSONConfig struct {
-	AccessToken              string             `json:"AccessToken"`
-	APIVersion               string             `json:"APIVersion"`
-	AsyncTimeout             int                `json:"AsyncTimeout"`
-	AuthorizationEndpoint    string             `json:"AuthorizationEndpoint"`
-	CFOnK8s                  CFOnK8s            `json:"CFOnK8s"`
-	ColorEnabled             string             `json:"ColorEnabled"`
-	ConfigVersion            int                `json:"ConfigVersion"`
-	DopplerEndpoint          string             `json:"DopplerEndPoint"`
-	Locale                   string             `json:"Locale"`
-	LogCacheEndpoint         string             `json:"LogCacheEndPoint"`
-	MinCLIVersion            string             `json:"MinCLIVersion"`
-	MinRecommendedCLIVersion string             `json:"MinRecommendedCLIVersion"`
-	NetworkPolicyV1Endpoint  string             `json:"NetworkPolicyV1Endpoint"`
-	TargetedOrganization     Organization       `json:"OrganizationFields"`
-	PluginRepositories       []PluginRepository `json:"PluginRepos"`
-	RefreshToken             string             `json:"RefreshToken"`
-	RoutingEndpoint          string             `json:"RoutingAPIEndpoint"`
-	TargetedSpace            Space              `json:"SpaceFields"`
-	SSHOAuthClient           string             `json:"SSHOAuthClient"`
-	SkipSSLValidation        bool               `json:"SSLDisabled"`
-	Target                   string             `json:"Target"`
-	Trace                    string             `json:"Trace"`
-	UAAEndpoint              string             `json:"UaaEndpoint"`
-	UAAGrantType             string             `json:"UAAGrantType"`
-	UAAOAuthClient           string             `json:"UAAOAuthClient"`
-	UAAOAuthClientSecret     string             `json:"UAAOAuthClientSecret"`
+	AccessToken              string                   `json:"AccessToken"`
+	APIVersion               string                   `json:"APIVersion"`
+	AsyncTimeout             int                      `json:"AsyncTimeout"`
+	AuthorizationEndpoint    string                   `json:"AuthorizationEndpoint"`
+	CFOnK8s                  CFOnK8s                  `json:"CFOnK8s"`
+	ColorEnabled             string                   `json:"ColorEnabled"`
+	ConfigVersion            int                      `json:"ConfigVersion"`
+	Contexts                 map[string]TargetContext `json:"Contexts,omitempty"`
+	CredentialStore          string                   `json:"CredentialStore,omitempty"`
+	CurrentContext           string                   `json:"CurrentContext,omitempty"`
+	DopplerEndpoint          string                   `json:"DopplerEndPoint"`
+	Locale                   string                   `json:"Locale"`
+	LogCacheEndpoint         string                   `json:"LogCacheEndPoint"`
+	MinCLIVersion            string                   `json:"MinCLIVersion"`
+	MinRecommendedCLIVersion string                   `json:"MinRecommendedCLIVersion"`
+	NetworkPolicyV1Endpoint  string                   `json:"NetworkPolicyV1Endpoint"`
+	TargetedOrganization     Organization             `json:"OrganizationFields"`
+	PluginRepositories       []PluginRepository       `json:"PluginRepos"`
+	RefreshToken             string                   `json:"RefreshToken"`
+	RoutingEndpoint          string                   `json:"RoutingAPIEndpoint"`
+	TargetedSpace            Space                    `json:"SpaceFields"`
+	SSHOAuthClient           string                   `json:"SSHOAuthClient"`
+	SkipSSLValidation        bool                     `json:"SSLDisabled"`
+	Target                   string                   `json:"Target"`
+	Trace                    string                   `json:"Trace"`
+	UAAEndpoint              string                   `json:"UaaEndpoint"`
+	UAAGrantType             string                   `json:"UAAGrantType"`
+	UAAOAuthClient           string                   `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret     string                   `json:"UAAOAuthClientSecret"`
 }
 
 // Organization contains basic information about the targeted organization.
@@ -57,6 +60,7 @@ type User struct {
 
 // AccessToken returns the access token for making authenticated API calls.
 func (config *Config) AccessToken() string {
+	config.loadCurrentCredentials()
 	return config.ConfigFile.AccessToken
 }
 
@@ -108,6 +112,7 @@ func (config *Config) NetworkPolicyV1Endpoint() string {
 
 // RefreshToken returns the refresh token for getting a new access token.
 func (config *Config) RefreshToken() string {
+	config.loadCurrentCredentials()
 	return config.ConfigFile.RefreshToken
 }
 
@@ -123,6 +128,7 @@ func (config *Config) SetAsyncTimeout(timeout int) {
 
 // SetAccessToken sets the current access token.
 func (config *Config) SetAccessToken(accessToken string) {
+	config.loadCurrentCredentials()
 	config.ConfigFile.AccessToken = accessToken
 }
 
@@ -153,6 +159,7 @@ func (config *Config) SetOrganizationInformation(guid string, name string) {
 
 // SetRefreshToken sets the current refresh token.
 func (config *Config) SetRefreshToken(refreshToken string) {
+	config.loadCurrentCredentials()
 	config.ConfigFile.RefreshToken = refreshToken
 }
 
@@ -205,6 +212,7 @@ func (config *Config) SetTargetInformation(args TargetInformationArgs) {
 
 // SetTokenInformation sets the current token/user information.
 func (config *Config) SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string) {
+	config.loadCurrentCredentials()
 	config.ConfigFile.AccessToken = accessToken
 	config.ConfigFile.RefreshToken = refreshToken
 	config.ConfigFile.SSHOAuthClient = sshOAuthClient
@@ -217,6 +225,7 @@ func (config *Config) SetTrace(trace string) {
 
 // SetUAAClientCredentials sets the client credentials.
 func (config *Config) SetUAAClientCredentials(client string, clientSecret string) {
+	config.loadCurrentCredentials()
 	config.ConfigFile.UAAOAuthClient = client
 	config.ConfigFile.UAAOAuthClientSecret = clientSecret
 }
@@ -282,6 +291,7 @@ func (config *Config) UAAOAuthClient() string {
 
 // UAAOAuthClientSecret returns the CLI's UAA client secret.
 func (config *Config) UAAOAuthClientSecret() string {
+	config.loadCurrentCredentials()
 	return config.ConfigFile.UAAOAuthClientSecret
 }
 
@@ -117,23 +117,28 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	}
 
 	config.ENV = EnvOverride{
-		BinaryName:       filepath.Base(os.Args[0]),
-		CFColor:          os.Getenv("CF_COLOR"),
-		CFDialTimeout:    os.Getenv("CF_DIAL_TIMEOUT"),
-		CFLogLevel:       os.Getenv("CF_LOG_LEVEL"),
-		CFPassword:       os.Getenv("CF_PASSWORD"),
-		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
-		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
-		CFStartupTimeout: os.Getenv("CF_STARTUP_TIMEOUT"),
-		CFTrace:          os.Getenv("CF_TRACE"),
-		CFUsername:       os.Getenv("CF_USERNAME"),
-		DockerPassword:   os.Getenv("CF_DOCKER_PASSWORD"),
-		Experimental:     os.Getenv("CF_CLI_EXPERIMENTAL"),
-		ForceTTY:         os.Getenv("FORCE_TTY"),
-		HTTPSProxy:       os.Getenv("https_proxy"),
-		Lang:             os.Getenv("LANG"),
-		LCAll:            os.Getenv("LC_ALL"),
-	}
+		BinaryName:                  filepath.Base(os.Args[0]),
+		CFColor:                     os.Getenv("CF_COLOR"),
+		CFCredentialStore:           os.Getenv("CF_CREDENTIAL_STORE"),
+		CFCredentialStorePassphrase: os.Getenv("CF_CREDENTIAL_STORE_PASSPHRASE"),
+		CFDialTimeout:               os.Getenv("CF_DIAL_TIMEOUT"),
+		CFLogLevel:                  os.Getenv("CF_LOG_LEVEL"),
+		CFPassword:                  os.Getenv("CF_PASSWORD"),
+		CFPluginHome:                os.Getenv("CF_PLUGIN_HOME"),
+		CFStagingTimeout:            os.Getenv("CF_STAGING_TIMEOUT"),
+		CFStartupTimeout:            os.Getenv("CF_STARTUP_TIMEOUT"),
+		CFTrace:                     os.Getenv("CF_TRACE"),
+		CFUsername:                  os.Getenv("CF_USERNAME"),
+		DockerPassword:              os.Getenv("CF_DOCKER_PASSWORD"),
+		Experimental:                os.Getenv("CF_CLI_EXPERIMENTAL"),
+		ForceTTY:                    os.Getenv("FORCE_TTY"),
+		HTTPSProxy:                  os.Getenv("https_proxy"),
+		Lang:                        os.Getenv("LANG"),
+		LCAll:                       os.Getenv("LC_ALL"),
+		ManifestVarsHelper:          os.Getenv("CF_MANIFEST_VARS_HELPER"),
+	}
+
+	config.selectCredentialStore()
 
 	err = config.loadPluginConfig()
 	if err != nil {
@@ -0,0 +1,22 @@
+package configv3
+
+const (
+	// OutputFormatDefault means that commands display their results as human
+	// readable tables and text.
+	OutputFormatDefault OutputFormat = ""
+
+	// OutputFormatJSON means that commands display their results as JSON.
+	OutputFormatJSON OutputFormat = "json"
+
+	// OutputFormatYAML means that commands display their results as YAML.
+	OutputFormatYAML OutputFormat = "yaml"
+)
+
+// OutputFormat is the format in which commands display their results.
+type OutputFormat string
+
+// OutputFormat returns the output format based off of the '--output' global
+// flag. Defaults to OutputFormatDefault if the flag is not set.
+func (config *Config) OutputFormat() OutputFormat {
+	return OutputFormat(config.Flags.OutputFormat)
+}
@@ -0,0 +1,32 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	DescribeTable("OutputFormat",
+		func(flagVal string, expected OutputFormat) {
+			config, err := LoadConfig(FlagOverride{OutputFormat: flagVal})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(config.OutputFormat()).To(Equal(expected))
+		},
+
+		Entry("no flag set", "", OutputFormatDefault),
+		Entry("json", "json", OutputFormatJSON),
+		Entry("yaml", "yaml", OutputFormatYAML),
+	)
+})
@@ -16,22 +16,24 @@ type Docker struct {
 // add a field for the CLI to extract from the manifest, just add it to this
 // struct.
 type Application struct {
-	Name                    string                   `yaml:"name"`
-	DiskQuota               string                   `yaml:"disk-quota,omitempty"`
-	Docker                  *Docker                  `yaml:"docker,omitempty"`
-	HealthCheckType         constant.HealthCheckType `yaml:"health-check-type,omitempty"`
-	HealthCheckEndpoint     string                   `yaml:"health-check-http-endpoint,omitempty"`
-	HealthCheckTimeout      int64                    `yaml:"timeout,omitempty"`
-	Instances               *int                     `yaml:"instances,omitempty"`
-	Path                    string                   `yaml:"path,omitempty"`
-	Processes               []Process                `yaml:"processes,omitempty"`
-	Memory                  string                   `yaml:"memory,omitempty"`
-	NoRoute                 bool                     `yaml:"no-route,omitempty"`
-	RandomRoute             bool                     `yaml:"random-route,omitempty"`
-	DefaultRoute            bool                     `yaml:"default-route,omitempty"`
-	Stack                   string                   `yaml:"stack,omitempty"`
-	LogRateLimit            string                   `yaml:"log-rate-limit-per-second,omitempty"`
-	RemainingManifestFields map[string]interface{}   `yaml:"-,inline"`
+	Name                    string                      `yaml:"name"`
+	DiskQuota               string                      `yaml:"disk-quota,omitempty"`
+	Docker                  *Docker                     `yaml:"docker,omitempty"`
+	HealthCheckType         constant.HealthCheckType    `yaml:"health-check-type,omitempty"`
+	HealthCheckEndpoint     string                      `yaml:"health-check-http-endpoint,omitempty"`
+	HealthCheckTimeout      int64                       `yaml:"timeout,omitempty"`
+	Instances               *int                        `yaml:"instances,omitempty"`
+	InstanceSteps           []int64                     `yaml:"instance-steps,omitempty"`
+	Path                    string                      `yaml:"path,omitempty"`
+	Processes               []Process                   `yaml:"processes,omitempty"`
+	Memory                  string                      `yaml:"memory,omitempty"`
+	NoRoute                 bool                        `yaml:"no-route,omitempty"`
+	RandomRoute             bool                        `yaml:"random-route,omitempty"`
+	DefaultRoute            bool                        `yaml:"default-route,omitempty"`
+	Stack                   string                      `yaml:"stack,omitempty"`
+	Strategy                constant.DeploymentStrategy `yaml:"strategy,omitempty"`
+	LogRateLimit            string                      `yaml:"log-rate-limit-per-second,omitempty"`
+	RemainingManifestFields map[string]interface{}      `yaml:"-,inline"`
 }
 
 func (application Application) HasBuildpacks() bool {
@@ -39,6 +41,30 @@ func (application Application) HasBuildpacks() bool {
 	return ok
 }
 
+// Routes returns the route URLs listed under the application's routes key,
+// in the order they appear in the manifest.
+func (application Application) Routes() []string {
+	rawRoutes, ok := application.RemainingManifestFields["routes"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var routes []string
+	for _, rawRoute := range rawRoutes {
+		var route interface{}
+		switch r := rawRoute.(type) {
+		case map[interface{}]interface{}:
+			route = r["route"]
+		case map[string]interface{}:
+			route = r["route"]
+		}
+		if url, ok := route.(string); ok && url != "" {
+			routes = append(routes, url)
+		}
+	}
+	return routes
+}
+
 func (application *Application) SetBuildpacks(buildpacks []string) {
 	if application.RemainingManifestFields == nil {
 		application.RemainingManifestFields = map[string]interface{}{}
@@ -1,6 +1,7 @@
 package manifestparser_test
 
 import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	. "code.cloudfoundry.org/cli/util/manifestparser"
 	"gopkg.in/yaml.v2"
 
@@ -188,6 +189,34 @@ stack: cflinuxfs4
 			})
 		})
 
+		Context("when instance-steps are provided", func() {
+			BeforeEach(func() {
+				rawYAML = []byte(`---
+instance-steps: [10, 25, 50, 100]
+`)
+			})
+
+			It("unmarshals the instance steps and removes them from the remaining fields", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(application.InstanceSteps).To(Equal([]int64{10, 25, 50, 100}))
+				Expect(application.RemainingManifestFields).To(Equal(emptyMap))
+			})
+		})
+
+		Context("when a strategy is provided", func() {
+			BeforeEach(func() {
+				rawYAML = []byte(`---
+strategy: canary
+`)
+			})
+
+			It("unmarshals the strategy and removes it from the remaining fields", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(application.Strategy).To(Equal(constant.DeploymentStrategyCanary))
+				Expect(application.RemainingManifestFields).To(Equal(emptyMap))
+			})
+		})
+
 		Context("when an unknown field is provided", func() {
 			BeforeEach(func() {
 				rawYAML = []byte(`---
@@ -534,4 +563,34 @@ log-rate-limit-per-second: 5K
 			})
 		})
 	})
+
+	Describe("Routes", func() {
+		var app Application
+
+		When("the app has routes", func() {
+			BeforeEach(func() {
+				app = Application{RemainingManifestFields: map[string]interface{}{
+					"routes": []interface{}{
+						map[interface{}]interface{}{"route": "app.example.com"},
+						map[string]interface{}{"route": "tcp.example.com:1024", "protocol": "tcp"},
+						map[interface{}]interface{}{"protocol": "http2"},
+					},
+				}}
+			})
+
+			It("returns the route URLs in order", func() {
+				Expect(app.Routes()).To(Equal([]string{"app.example.com", "tcp.example.com:1024"}))
+			})
+		})
+
+		When("the app has no routes", func() {
+			BeforeEach(func() {
+				app = Application{}
+			})
+
+			It("returns nothing", func() {
+				Expect(app.Routes()).To(BeEmpty())
+			})
+		})
+	})
 })
@@ -0,0 +1,15 @@
+package manifestparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+type OpsFileError struct {
+	Path string
+	Err  error
+}
+
+func (e OpsFileError) Error() string {
+	return fmt.Sprintf("Unable to apply ops file %s: %s", e.Path, strings.Replace(e.Err.Error(), "\n", " ", -1))
+}
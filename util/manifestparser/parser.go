@@ -5,25 +5,42 @@ import (
 	"os"
 
 	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/cppforlife/go-patch/patch"
 	"gopkg.in/yaml.v2"
 )
 
-type ManifestParser struct{}
+type ManifestParser struct {
+	// CredentialHelper is the executable that resolves ((helper:NAME))
+	// variables.
+	CredentialHelper string
+
+	resolvedSecrets []string
+}
 
 // InterpolateAndParse reads the manifest at the provided paths, interpolates
-// variables if a vars file is provided, and sets the current manifest to the
-// resulting manifest.
+// variables if a vars file is provided or the manifest refers to variable
+// sources such as ((env:NAME)), applies the ops files to the
+// interpolated manifest, and sets the current manifest to the resulting
+// manifest.
 // For manifests with only 1 application, appName will override the name of the
 // single app defined.
 // For manifests with multiple applications, appName will filter the
 // applications and leave only a single application in the resulting parsed
 // manifest structure.
-func (m ManifestParser) InterpolateManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]byte, error) {
+func (m *ManifestParser) InterpolateManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV, pathsToOpsFiles []string) ([]byte, error) {
+	m.resolvedSecrets = nil
+
 	rawManifest, err := os.ReadFile(pathToManifest)
 	if err != nil {
 		return nil, err
 	}
 
+	sources := newVariableSourceResolver(pathToManifest, m.CredentialHelper)
+	rawManifest, err = sources.rewrite(rawManifest)
+	if err != nil {
+		return nil, err
+	}
+
 	tpl := template.NewTemplate(rawManifest)
 	fileVars := template.StaticVariables{}
 
@@ -49,15 +66,120 @@ func (m ManifestParser) InterpolateManifest(pathToManifest string, pathsToVarsFi
 		fileVars[kv.Name] = kv.Value
 	}
 
-	rawManifest, err = tpl.Evaluate(fileVars, nil, template.EvaluateOpts{ExpectAllKeys: true})
+	ops, err := readOpsFiles(pathsToOpsFiles, fileVars, sources)
 	if err != nil {
+		return nil, err
+	}
+
+	rawManifest, err = tpl.Evaluate(withSourceVars(fileVars, sources), nil, template.EvaluateOpts{ExpectAllKeys: true, PostVarSubstitutionOp: ops})
+	if err != nil {
+		if opsErr, ok := err.(OpsFileError); ok {
+			return nil, opsErr
+		}
 		return nil, InterpolationError{Err: err}
 	}
 
+	m.resolvedSecrets = sources.secrets
+	if len(sources.names) > 0 {
+		// Interpolating the manifest again with markers instead of the
+		// resolved values shows which fields the variable sources ended up
+		// in. If that fails, the resolved values themselves are redacted.
+		markedManifest, markErr := interpolateMarkers(tpl, fileVars, pathsToOpsFiles, sources.withMarkers())
+		if markErr == nil {
+			m.resolvedSecrets = interpolatedValues(markedManifest, rawManifest)
+		}
+	}
 	return rawManifest, nil
 }
 
-func (m ManifestParser) ParseManifest(pathToManifest string, rawManifest []byte) (Manifest, error) {
+func interpolateMarkers(tpl template.Template, fileVars template.StaticVariables, pathsToOpsFiles []string, markers *variableSourceResolver) ([]byte, error) {
+	ops, err := readOpsFiles(pathsToOpsFiles, fileVars, markers)
+	if err != nil {
+		return nil, err
+	}
+	return tpl.Evaluate(withSourceVars(fileVars, markers), nil, template.EvaluateOpts{ExpectAllKeys: true, PostVarSubstitutionOp: ops})
+}
+
+// withSourceVars returns the variables together with the values of the
+// variable sources.
+func withSourceVars(vars template.StaticVariables, sources *variableSourceResolver) template.StaticVariables {
+	allVars := template.StaticVariables{}
+	for name, value := range vars {
+		allVars[name] = value
+	}
+	for name, value := range sources.vars {
+		allVars[name] = value
+	}
+	return allVars
+}
+
+// ResolvedSecrets returns the values of the fields that variable sources were
+// interpolated into in the last interpolated manifest, so that they can be
+// redacted when the manifest is displayed.
+func (m *ManifestParser) ResolvedSecrets() []string {
+	return m.resolvedSecrets
+}
+
+func readOpsFiles(paths []string, vars template.StaticVariables, sources *variableSourceResolver) (patch.Ops, error) {
+	var ops patch.Ops
+	for _, path := range paths {
+		op, err := readOpsFile(path, vars, sources)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+// readOpsFile reads the BOSH-style ops file at path. Variables in the ops
+// file are interpolated with the manifest variables and variable sources.
+func readOpsFile(path string, vars template.StaticVariables, sources *variableSourceResolver) (patch.Op, error) {
+	rawOpsFile, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	rawOpsFile, err = sources.rewrite(rawOpsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	rawOpsFile, err = template.NewTemplate(rawOpsFile).Evaluate(withSourceVars(vars, sources), nil, template.EvaluateOpts{ExpectAllKeys: true})
+	if err != nil {
+		return nil, OpsFileError{Path: path, Err: err}
+	}
+
+	var definitions []patch.OpDefinition
+	err = yaml.Unmarshal(rawOpsFile, &definitions)
+	if err != nil {
+		return nil, OpsFileError{Path: path, Err: err}
+	}
+
+	ops, err := patch.NewOpsFromDefinitions(definitions)
+	if err != nil {
+		return nil, OpsFileError{Path: path, Err: err}
+	}
+
+	return opsFileOp{path: path, ops: ops}, nil
+}
+
+// opsFileOp applies the operations of one ops file and attributes any
+// failure to that file.
+type opsFileOp struct {
+	path string
+	ops  patch.Ops
+}
+
+func (op opsFileOp) Apply(doc interface{}) (interface{}, error) {
+	doc, err := op.ops.Apply(doc)
+	if err != nil {
+		return nil, OpsFileError{Path: op.path, Err: err}
+	}
+	return doc, nil
+}
+
+func (m *ManifestParser) ParseManifest(pathToManifest string, rawManifest []byte) (Manifest, error) {
 	var parsedManifest Manifest
 	err := yaml.Unmarshal(rawManifest, &parsedManifest)
 	if err != nil {
@@ -73,6 +195,6 @@ func (m ManifestParser) ParseManifest(pathToManifest string, rawManifest []byte)
 	return parsedManifest, nil
 }
 
-func (m ManifestParser) MarshalManifest(manifest Manifest) ([]byte, error) {
+func (m *ManifestParser) MarshalManifest(manifest Manifest) ([]byte, error) {
 	return yaml.Marshal(manifest)
 }
@@ -21,6 +21,7 @@ var _ = Describe("ManifestParser", func() {
 			pathToManifest   string
 			pathsToVarsFiles []string
 			vars             []template.VarKV
+			pathsToOpsFiles  []string
 
 			interpolatedManifest []byte
 			executeErr           error
@@ -32,8 +33,10 @@ var _ = Describe("ManifestParser", func() {
 			Expect(tempFile.Close()).ToNot(HaveOccurred())
 			pathToManifest = tempFile.Name()
 			vars = nil
+			parser = ManifestParser{}
 
 			pathsToVarsFiles = nil
+			pathsToOpsFiles = nil
 		})
 
 		AfterEach(func() {
@@ -41,10 +44,13 @@ var _ = Describe("ManifestParser", func() {
 			for _, path := range pathsToVarsFiles {
 				Expect(os.RemoveAll(path)).ToNot(HaveOccurred())
 			}
+			for _, path := range pathsToOpsFiles {
+				Expect(os.RemoveAll(path)).ToNot(HaveOccurred())
+			}
 		})
 
 		JustBeforeEach(func() {
-			interpolatedManifest, executeErr = parser.InterpolateManifest(pathToManifest, pathsToVarsFiles, vars)
+			interpolatedManifest, executeErr = parser.InterpolateManifest(pathToManifest, pathsToVarsFiles, vars, pathsToOpsFiles)
 		})
 
 		When("the manifest does *not* need interpolation", func() {
@@ -226,6 +232,217 @@ applications:
 				})
 			})
 		})
+
+		When("ops files are provided", func() {
+			writeOpsFile := func(contents string) {
+				tempFile, err := os.CreateTemp("", "ops-file-test-")
+				Expect(err).ToNot(HaveOccurred())
+				_, err = tempFile.WriteString(contents)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tempFile.Close()).ToNot(HaveOccurred())
+				pathsToOpsFiles = append(pathsToOpsFiles, tempFile.Name())
+			}
+
+			BeforeEach(func() {
+				givenManifest = []byte(`---
+applications:
+- name: ((app))
+  instances: 1
+  env:
+    STAGE: dev
+`)
+				Expect(os.WriteFile(pathToManifest, givenManifest, 0666)).To(Succeed())
+				vars = []template.VarKV{{Name: "app", Value: "spark"}, {Name: "stage", Value: "prod"}}
+
+				writeOpsFile(`
+- type: replace
+  path: /applications/name=spark/instances
+  value: 4
+- type: replace
+  path: /applications/name=spark/env/STAGE
+  value: ((stage))
+`)
+				writeOpsFile(`
+- type: replace
+  path: /applications/name=spark/routes?/-
+  value: {route: spark.example.com}
+`)
+			})
+
+			It("applies them in order to the interpolated manifest", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(interpolatedManifest)).To(Equal(`applications:
+- env:
+    STAGE: prod
+  instances: 4
+  name: spark
+  routes:
+  - route: spark.example.com
+`))
+			})
+
+			When("an operation does not match the manifest", func() {
+				BeforeEach(func() {
+					writeOpsFile(`
+- type: replace
+  path: /applications/name=flame/instances
+  value: 2
+`)
+				})
+
+				It("returns an error naming the ops file", func() {
+					Expect(executeErr).To(MatchError(ContainSubstring("Unable to apply ops file %s:", pathsToOpsFiles[2])))
+					Expect(executeErr).To(BeAssignableToTypeOf(OpsFileError{}))
+				})
+			})
+
+			When("an ops file is not valid", func() {
+				BeforeEach(func() {
+					writeOpsFile(`
+- type: merge
+  path: /applications
+`)
+				})
+
+				It("returns an error naming the ops file", func() {
+					Expect(executeErr).To(MatchError(ContainSubstring("Unable to apply ops file %s:", pathsToOpsFiles[2])))
+				})
+			})
+		})
+
+		When("the manifest refers to variable sources", func() {
+			var dir string
+
+			BeforeEach(func() {
+				var err error
+				dir, err = os.MkdirTemp("", "variable-sources-test-")
+				Expect(err).ToNot(HaveOccurred())
+				pathToManifest = filepath.Join(dir, "manifest.yml")
+
+				Expect(os.WriteFile(filepath.Join(dir, "token.txt"), []byte("file-token\n"), 0600)).To(Succeed())
+				helper := filepath.Join(dir, "helper")
+				Expect(os.WriteFile(helper, []byte(`#!/bin/sh
+if [ "$1 $2" = "get db-credentials" ]; then
+  echo '{"value": {"username": "admin", "password": "helper-password"}}'
+else
+  echo "unknown credential $2" >&2
+  exit 1
+fi
+`), 0700)).To(Succeed())
+				parser.CredentialHelper = helper
+
+				os.Setenv("VARIABLE_SOURCES_TEST_STAGE", "env-stage")
+
+				givenManifest = []byte(`---
+applications:
+- name: spark
+  env:
+    STAGE: ((env:VARIABLE_SOURCES_TEST_STAGE))
+    TOKEN: ((file:token.txt))
+    DB_PASSWORD: ((helper:db-credentials.password))
+    DB_URL: postgres://((helper:db-credentials.username))@db
+    STAGE_AGAIN: ((env:VARIABLE_SOURCES_TEST_STAGE))
+`)
+				Expect(os.WriteFile(pathToManifest, givenManifest, 0666)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				os.Unsetenv("VARIABLE_SOURCES_TEST_STAGE")
+				Expect(os.RemoveAll(dir)).To(Succeed())
+			})
+
+			It("interpolates the values from the sources", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(interpolatedManifest)).To(Equal(`applications:
+- env:
+    DB_PASSWORD: helper-password
+    DB_URL: postgres://admin@db
+    STAGE: env-stage
+    STAGE_AGAIN: env-stage
+    TOKEN: file-token
+  name: spark
+`))
+			})
+
+			It("records the values of the fields they were interpolated into as secrets", func() {
+				Expect(parser.ResolvedSecrets()).To(ConsistOf("env-stage", "file-token", "postgres://admin@db", "helper-password"))
+			})
+
+			When("ops files refer to variable sources", func() {
+				BeforeEach(func() {
+					pathsToOpsFiles = []string{filepath.Join(dir, "ops.yml")}
+					Expect(os.WriteFile(pathsToOpsFiles[0], []byte(`
+- type: replace
+  path: /applications/name=spark/env/TOKEN
+  value: ((env:VARIABLE_SOURCES_TEST_STAGE))-token
+`), 0600)).To(Succeed())
+				})
+
+				It("interpolates them too", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(string(interpolatedManifest)).To(ContainSubstring("TOKEN: env-stage-token"))
+					Expect(parser.ResolvedSecrets()).To(ContainElement("env-stage-token"))
+					Expect(parser.ResolvedSecrets()).NotTo(ContainElement("file-token"))
+				})
+			})
+
+			When("an environment variable is not set", func() {
+				BeforeEach(func() {
+					Expect(os.WriteFile(pathToManifest, []byte("applications:\n- name: ((env:VARIABLE_SOURCES_TEST_UNSET))\n"), 0666)).To(Succeed())
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError(VariableSourceError{
+						Placeholder: "((env:VARIABLE_SOURCES_TEST_UNSET))",
+						Err:         errors.New("environment variable VARIABLE_SOURCES_TEST_UNSET is not set"),
+					}))
+				})
+			})
+
+			When("placeholders are commented out", func() {
+				BeforeEach(func() {
+					Expect(os.WriteFile(pathToManifest, []byte(`applications:
+- name: spark
+  env:
+    # OLD_SECRET: ((env:VARIABLE_SOURCES_TEST_UNSET))
+    # DB_PASSWORD: ((helper:missing))
+    STAGE: ((env:VARIABLE_SOURCES_TEST_STAGE)) # ((file:missing.txt))
+`), 0666)).To(Succeed())
+				})
+
+				It("does not resolve them", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(string(interpolatedManifest)).To(Equal(`applications:
+- env:
+    STAGE: env-stage
+  name: spark
+`))
+					Expect(parser.ResolvedSecrets()).To(ConsistOf("env-stage"))
+				})
+			})
+
+			When("the credential helper fails", func() {
+				BeforeEach(func() {
+					Expect(os.WriteFile(pathToManifest, []byte("applications:\n- name: ((helper:missing))\n"), 0666)).To(Succeed())
+				})
+
+				It("returns an error that includes its output", func() {
+					Expect(executeErr).To(MatchError(ContainSubstring("Unable to resolve ((helper:missing)): credential helper")))
+					Expect(executeErr).To(MatchError(ContainSubstring("unknown credential missing")))
+					Expect(parser.ResolvedSecrets()).To(BeEmpty())
+				})
+			})
+
+			When("no credential helper is configured", func() {
+				BeforeEach(func() {
+					parser.CredentialHelper = ""
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError(ContainSubstring("no credential helper is configured")))
+				})
+			})
+		})
 	})
 
 	Describe("ParseManifest", func() {
@@ -0,0 +1,539 @@
+package manifestparser
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/cf/util/spellcheck"
+	"code.cloudfoundry.org/cli/resources"
+	"gopkg.in/yaml.v3"
+)
+
+// ValidationError describes a part of a manifest that does not match the
+// manifest schema.
+type ValidationError struct {
+	Line   int
+	Column int
+	// Field is the path to the invalid field, e.g.
+	// applications[0].processes[1].memory. It is empty for YAML syntax errors.
+	Field   string
+	Message string
+	// UnknownField is set when the field is not part of the schema. The cloud
+	// controller ignores such fields, so they are usually misspelled.
+	UnknownField bool
+}
+
+func (e ValidationError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Message)
+	}
+	return fmt.Sprintf("%d:%d: %s: %s", e.Line, e.Column, e.Field, e.Message)
+}
+
+// ValidateManifest checks the manifest at the provided path against the
+// manifest schema and returns every problem it finds, in the order they appear
+// in the file. Values containing ((variables)) are not checked because they
+// are only known once the manifest is interpolated.
+func (m *ManifestParser) ValidateManifest(pathToManifest string) ([]ValidationError, error) {
+	rawManifest, err := os.ReadFile(pathToManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.ValidateRawManifest(rawManifest)
+}
+
+// ValidateRawManifest checks a manifest, such as one returned by
+// InterpolateManifest, against the manifest schema. Lines and columns refer to
+// rawManifest.
+func (m *ManifestParser) ValidateRawManifest(rawManifest []byte) ([]ValidationError, error) {
+	var document yaml.Node
+	err := yaml.Unmarshal(rawManifest, &document)
+	if err != nil {
+		return []ValidationError{syntaxValidationError(err)}, nil
+	}
+
+	v := &manifestValidator{}
+	if len(document.Content) == 0 {
+		v.errors = append(v.errors, ValidationError{Line: 1, Column: 1, Message: "Manifest must have at least one application."})
+		return v.errors, nil
+	}
+
+	root := document.Content[0]
+	v.validateMapping(root, "", manifestRules)
+	if root.Kind == yaml.MappingNode && v.lookup(root, "applications") == nil {
+		v.fail(root, "", "Manifest must have at least one application.")
+	}
+
+	sort.SliceStable(v.errors, func(i, j int) bool {
+		if v.errors[i].Line != v.errors[j].Line {
+			return v.errors[i].Line < v.errors[j].Line
+		}
+		return v.errors[i].Column < v.errors[j].Column
+	})
+	return v.errors, nil
+}
+
+var yamlLineErrorRegexp = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+
+func syntaxValidationError(err error) ValidationError {
+	matches := yamlLineErrorRegexp.FindStringSubmatch(err.Error())
+	if matches == nil {
+		return ValidationError{Message: err.Error()}
+	}
+	line, _ := strconv.Atoi(matches[1])
+	return ValidationError{Line: line, Message: "invalid YAML: " + matches[2]}
+}
+
+// fieldRule checks the value of a field. field is the path to the field.
+type fieldRule func(v *manifestValidator, node *yaml.Node, field string)
+
+var manifestRules = map[string]fieldRule{
+	"applications": sequenceOf(mappingOf(applicationRules, validateApplication), 1),
+	"version":      integerRule(1),
+}
+
+var applicationRules = map[string]fieldRule{
+	"buildpack":                            stringRule,
+	"buildpacks":                           sequenceOf(stringRule, 0),
+	"cnb-credentials":                      mappingRule,
+	"command":                              stringRule,
+	"default-route":                        booleanRule,
+	"disk-quota":                           megabytesRule,
+	"disk_quota":                           megabytesRule,
+	"docker":                               mappingOf(dockerRules, requireFields("image")),
+	"env":                                  mappingRule,
+	"features":                             mappingOfValues(booleanRule),
+	"health-check-http-endpoint":           stringRule,
+	"health-check-interval":                integerRule(1),
+	"health-check-invocation-timeout":      integerRule(1),
+	"health-check-type":                    oneOf("port", "process", "http", "none"),
+	"instance-steps":                       instanceStepsRule,
+	"instances":                            integerRule(0),
+	"lifecycle":                            oneOf("buildpack", "docker", "cnb"),
+	"log-rate-limit-per-second":            logRateLimitRule,
+	"memory":                               megabytesRule,
+	"metadata":                             mappingOf(metadataRules, nil),
+	"name":                                 stringRule,
+	"no-route":                             booleanRule,
+	"path":                                 stringRule,
+	"processes":                            sequenceOf(mappingOf(processRules, validateProcess), 0),
+	"random-route":                         booleanRule,
+	"readiness-health-check-http-endpoint": stringRule,
+	"readiness-health-check-interval":      integerRule(1),
+	"readiness-health-check-invocation-timeout": integerRule(1),
+	"readiness-health-check-type":               oneOf("port", "process", "http"),
+	"routes":                                    sequenceOf(mappingOf(routeRules, requireFields("route")), 0),
+	"services":                                  sequenceOf(serviceRule, 0),
+	"sidecars":                                  sequenceOf(mappingOf(sidecarRules, requireFields("name", "command", "process_types")), 0),
+	"stack":                                     stringRule,
+	"strategy":                                  oneOf("rolling", "canary"),
+	"timeout":                                   integerRule(1),
+}
+
+// removedApplicationFields were supported by earlier manifest versions and
+// are ignored by the cloud controller now.
+var removedApplicationFields = map[string]string{
+	"domain":      "routes",
+	"domains":     "routes",
+	"host":        "routes",
+	"hosts":       "routes",
+	"no-hostname": "routes",
+	"inherit":     "YAML anchors",
+}
+
+var processRules = map[string]fieldRule{
+	"command":                                   stringRule,
+	"disk-quota":                                megabytesRule,
+	"disk_quota":                                megabytesRule,
+	"health-check-http-endpoint":                stringRule,
+	"health-check-interval":                     integerRule(1),
+	"health-check-invocation-timeout":           integerRule(1),
+	"health-check-type":                         oneOf("port", "process", "http", "none"),
+	"instances":                                 integerRule(0),
+	"log-rate-limit-per-second":                 logRateLimitRule,
+	"memory":                                    megabytesRule,
+	"readiness-health-check-http-endpoint":      stringRule,
+	"readiness-health-check-interval":           integerRule(1),
+	"readiness-health-check-invocation-timeout": integerRule(1),
+	"readiness-health-check-type":               oneOf("port", "process", "http"),
+	"timeout":                                   integerRule(1),
+	"type":                                      stringRule,
+}
+
+var dockerRules = map[string]fieldRule{
+	"image":    stringRule,
+	"username": stringRule,
+}
+
+var metadataRules = map[string]fieldRule{
+	"annotations": mappingOfValues(stringRule),
+	"labels":      mappingOfValues(stringRule),
+}
+
+var routeRules = map[string]fieldRule{
+	"options":  mappingOf(map[string]fieldRule{"loadbalancing": stringRule}, nil),
+	"protocol": oneOf("http1", "http2", "tcp"),
+	"route":    routeURLRule,
+}
+
+var serviceBindingRules = map[string]fieldRule{
+	"binding_name": stringRule,
+	"name":         stringRule,
+	"parameters":   mappingRule,
+}
+
+var sidecarRules = map[string]fieldRule{
+	"command":       stringRule,
+	"memory":        megabytesRule,
+	"name":          stringRule,
+	"process_types": sequenceOf(stringRule, 1),
+}
+
+type manifestValidator struct {
+	errors []ValidationError
+}
+
+func (v *manifestValidator) fail(node *yaml.Node, field string, format string, args ...interface{}) {
+	v.errors = append(v.errors, ValidationError{
+		Line:    node.Line,
+		Column:  node.Column,
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *manifestValidator) failUnknown(node *yaml.Node, field string, message string) {
+	v.errors = append(v.errors, ValidationError{
+		Line:         node.Line,
+		Column:       node.Column,
+		Field:        field,
+		Message:      message,
+		UnknownField: true,
+	})
+}
+
+// check resolves aliases and applies rule to node unless its value is only
+// known after interpolation.
+func (v *manifestValidator) check(node *yaml.Node, field string, rule fieldRule) {
+	node = resolveAlias(node)
+	if node.Kind == yaml.ScalarNode && strings.Contains(node.Value, "((") {
+		return
+	}
+	rule(v, node, field)
+}
+
+type mappingField struct {
+	key   *yaml.Node
+	value *yaml.Node
+}
+
+// fields returns the fields of a mapping, including the ones merged into it
+// with the YAML merge key.
+func (v *manifestValidator) fields(node *yaml.Node) []mappingField {
+	node = resolveAlias(node)
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	var fields []mappingField
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if key.Value == "<<" {
+			value = resolveAlias(value)
+			merged := []*yaml.Node{value}
+			if value.Kind == yaml.SequenceNode {
+				merged = value.Content
+			}
+			for _, mergedNode := range merged {
+				fields = append(fields, v.fields(mergedNode)...)
+			}
+			continue
+		}
+		fields = append(fields, mappingField{key: key, value: value})
+	}
+	return fields
+}
+
+func (v *manifestValidator) lookup(node *yaml.Node, key string) *yaml.Node {
+	var found *yaml.Node
+	for _, field := range v.fields(node) {
+		if field.key.Value == key {
+			found = resolveAlias(field.value)
+		}
+	}
+	return found
+}
+
+func (v *manifestValidator) validateMapping(node *yaml.Node, field string, rules map[string]fieldRule) {
+	if node.Kind != yaml.MappingNode {
+		v.fail(node, field, "must be a map")
+		return
+	}
+
+	for _, f := range v.fields(node) {
+		if strings.Contains(f.key.Value, "((") {
+			continue
+		}
+		fieldPath := joinField(field, f.key.Value)
+
+		rule, known := rules[f.key.Value]
+		if !known {
+			v.failUnknown(f.key, fieldPath, unknownFieldMessage(f.key.Value, rules))
+			continue
+		}
+		v.check(f.value, fieldPath, rule)
+	}
+}
+
+func unknownFieldMessage(key string, rules map[string]fieldRule) string {
+	if replacement, removed := removedApplicationFields[key]; removed && rules["routes"] != nil {
+		return fmt.Sprintf("unknown field; '%s' is no longer supported, use '%s' instead", key, replacement)
+	}
+
+	var known []string
+	for name := range rules {
+		known = append(known, name)
+	}
+	sort.Strings(known)
+
+	suggestions := spellcheck.NewCommandSuggester(known).Recommend(key)
+	if len(suggestions) == 0 {
+		return "unknown field"
+	}
+	sort.Strings(suggestions)
+	return fmt.Sprintf("unknown field; did you mean '%s'?", suggestions[0])
+}
+
+func joinField(parent string, key string) string {
+	if parent == "" {
+		return key
+	}
+	return parent + "." + key
+}
+
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode && node.Alias != nil {
+		node = node.Alias
+	}
+	return node
+}
+
+func isNull(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.Tag == "!!null"
+}
+
+func mappingOf(rules map[string]fieldRule, validate fieldRule) fieldRule {
+	return func(v *manifestValidator, node *yaml.Node, field string) {
+		v.validateMapping(node, field, rules)
+		if validate != nil && node.Kind == yaml.MappingNode {
+			validate(v, node, field)
+		}
+	}
+}
+
+func mappingOfValues(rule fieldRule) fieldRule {
+	return func(v *manifestValidator, node *yaml.Node, field string) {
+		if node.Kind != yaml.MappingNode {
+			v.fail(node, field, "must be a map")
+			return
+		}
+		for _, f := range v.fields(node) {
+			v.check(f.value, joinField(field, f.key.Value), rule)
+		}
+	}
+}
+
+func mappingRule(v *manifestValidator, node *yaml.Node, field string) {
+	if node.Kind != yaml.MappingNode && !isNull(node) {
+		v.fail(node, field, "must be a map")
+	}
+}
+
+func sequenceOf(rule fieldRule, minimumLength int) fieldRule {
+	return func(v *manifestValidator, node *yaml.Node, field string) {
+		if node.Kind != yaml.SequenceNode {
+			v.fail(node, field, "must be a list")
+			return
+		}
+		if len(node.Content) < minimumLength {
+			v.fail(node, field, "must have at least %d item(s)", minimumLength)
+		}
+		for i, item := range node.Content {
+			v.check(item, fmt.Sprintf("%s[%d]", field, i), rule)
+		}
+	}
+}
+
+func requireFields(names ...string) fieldRule {
+	return func(v *manifestValidator, node *yaml.Node, field string) {
+		for _, name := range names {
+			value := v.lookup(node, name)
+			if value == nil || isNull(value) || (value.Kind == yaml.ScalarNode && value.Value == "") {
+				v.fail(node, field, "missing required field '%s'", name)
+			}
+		}
+	}
+}
+
+func stringRule(v *manifestValidator, node *yaml.Node, field string) {
+	if node.Kind != yaml.ScalarNode {
+		v.fail(node, field, "must be a string")
+	}
+}
+
+func booleanRule(v *manifestValidator, node *yaml.Node, field string) {
+	if node.Kind == yaml.ScalarNode {
+		switch strings.ToLower(node.Value) {
+		case "true", "false", "yes", "no", "on", "off":
+			return
+		}
+	}
+	v.fail(node, field, "must be true or false")
+}
+
+func integerRule(minimum int) fieldRule {
+	return func(v *manifestValidator, node *yaml.Node, field string) {
+		value, err := strconv.Atoi(node.Value)
+		if node.Kind != yaml.ScalarNode || err != nil {
+			v.fail(node, field, "must be a whole number")
+			return
+		}
+		if value < minimum {
+			v.fail(node, field, "must be greater than or equal to %d", minimum)
+		}
+	}
+}
+
+func oneOf(values ...string) fieldRule {
+	return func(v *manifestValidator, node *yaml.Node, field string) {
+		if node.Kind == yaml.ScalarNode {
+			for _, value := range values {
+				if node.Value == value {
+					return
+				}
+			}
+		}
+		v.fail(node, field, "must be one of: %s", strings.Join(values, ", "))
+	}
+}
+
+// instanceStepsRule checks that the canary instance steps are increasing
+// percentages between 1 and 100, as the --instance-steps flag does.
+func instanceStepsRule(v *manifestValidator, node *yaml.Node, field string) {
+	sequenceOf(integerRule(1), 1)(v, node, field)
+	if node.Kind != yaml.SequenceNode {
+		return
+	}
+
+	var weights []int64
+	for _, item := range node.Content {
+		weight, err := strconv.ParseInt(resolveAlias(item).Value, 10, 64)
+		if err != nil {
+			return
+		}
+		weights = append(weights, weight)
+	}
+	if !resources.ValidInstanceSteps(weights) {
+		v.fail(node, field, "must be increasing percentages between 1 and 100")
+	}
+}
+
+func megabytesRule(v *manifestValidator, node *yaml.Node, field string) {
+	_, err := bytefmt.ToMegabytes(node.Value)
+	if node.Kind != yaml.ScalarNode || err != nil {
+		v.fail(node, field, "must be an amount with a unit of B, K, KB, M, MB, G, GB, T or TB (e.g. 256M, 1G)")
+	}
+}
+
+func logRateLimitRule(v *manifestValidator, node *yaml.Node, field string) {
+	if node.Kind == yaml.ScalarNode && node.Value == "-1" {
+		return
+	}
+	_, err := bytefmt.ToBytes(node.Value)
+	if node.Kind != yaml.ScalarNode || err != nil {
+		v.fail(node, field, "must be -1 (unlimited) or an amount with a unit of B, K, KB, M, MB, G, GB, T or TB (e.g. 16K)")
+	}
+}
+
+func routeURLRule(v *manifestValidator, node *yaml.Node, field string) {
+	if node.Kind != yaml.ScalarNode {
+		v.fail(node, field, "must be a string")
+		return
+	}
+
+	url := node.Value
+	switch {
+	case strings.Contains(url, "://"):
+		v.fail(node, field, "must not include a scheme such as http://")
+		return
+	case strings.ContainsAny(url, " \t"):
+		v.fail(node, field, "must not contain spaces")
+		return
+	}
+
+	hostAndPort := strings.SplitN(url, "/", 2)[0]
+	host := hostAndPort
+	if i := strings.LastIndex(hostAndPort, ":"); i != -1 {
+		host = hostAndPort[:i]
+		port, err := strconv.Atoi(hostAndPort[i+1:])
+		if err != nil || port < 1 || port > 65535 {
+			v.fail(node, field, "must have a port between 1 and 65535")
+			return
+		}
+	}
+	if !strings.Contains(host, ".") || strings.HasPrefix(host, ".") || strings.HasSuffix(host, ".") {
+		v.fail(node, field, "must be a host and domain with an optional port or path (e.g. my-app.example.com/path)")
+	}
+}
+
+func serviceRule(v *manifestValidator, node *yaml.Node, field string) {
+	if node.Kind == yaml.ScalarNode {
+		return
+	}
+	mappingOf(serviceBindingRules, requireFields("name"))(v, node, field)
+}
+
+func validateApplication(v *manifestValidator, node *yaml.Node, field string) {
+	requireFields("name")(v, node, field)
+	validateHealthCheck(v, node, field, "")
+	validateHealthCheck(v, node, field, "readiness-")
+
+	instanceSteps := v.lookup(node, "instance-steps")
+	strategy := v.lookup(node, "strategy")
+	if instanceSteps != nil && strategy != nil && strategy.Value != "canary" && !strings.Contains(strategy.Value, "((") {
+		v.fail(instanceSteps, joinField(field, "instance-steps"), "requires 'strategy: canary', but it is '%s'", strategy.Value)
+	}
+
+	if v.lookup(node, "docker") != nil {
+		for _, buildpackField := range []string{"buildpack", "buildpacks"} {
+			if value := v.lookup(node, buildpackField); value != nil {
+				v.fail(value, joinField(field, buildpackField), "cannot be used together with 'docker'")
+			}
+		}
+	}
+}
+
+func validateProcess(v *manifestValidator, node *yaml.Node, field string) {
+	requireFields("type")(v, node, field)
+	validateHealthCheck(v, node, field, "")
+	validateHealthCheck(v, node, field, "readiness-")
+}
+
+// validateHealthCheck checks that an HTTP endpoint is only set for HTTP
+// health checks. prefix selects the liveness or the readiness health check.
+func validateHealthCheck(v *manifestValidator, node *yaml.Node, field string, prefix string) {
+	endpoint := v.lookup(node, prefix+"health-check-http-endpoint")
+	checkType := v.lookup(node, prefix+"health-check-type")
+	if endpoint == nil || checkType == nil || strings.Contains(checkType.Value, "((") {
+		return
+	}
+	if checkType.Value != "http" {
+		v.fail(endpoint, joinField(field, prefix+"health-check-http-endpoint"), "requires '%shealth-check-type: http', but it is '%s'", prefix, checkType.Value)
+	}
+}
@@ -0,0 +1,305 @@
+package manifestparser_test
+
+import (
+	"os"
+
+	. "code.cloudfoundry.org/cli/util/manifestparser"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidateManifest", func() {
+	var (
+		parser         ManifestParser
+		pathToManifest string
+		givenManifest  string
+
+		validationErrors []ValidationError
+		executeErr       error
+	)
+
+	BeforeEach(func() {
+		tempFile, err := os.CreateTemp("", "manifest-test-")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tempFile.Close()).ToNot(HaveOccurred())
+		pathToManifest = tempFile.Name()
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(pathToManifest)).ToNot(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		Expect(os.WriteFile(pathToManifest, []byte(givenManifest), 0666)).To(Succeed())
+		validationErrors, executeErr = parser.ValidateManifest(pathToManifest)
+	})
+
+	When("the manifest is valid", func() {
+		BeforeEach(func() {
+			givenManifest = `---
+defaults: &defaults
+  memory: 256M
+  buildpacks: [go_buildpack]
+applications:
+- name: spark
+  <<: *defaults
+  instances: ((instances))
+  disk_quota: 1G
+  health-check-type: http
+  health-check-http-endpoint: /health
+  log-rate-limit-per-second: -1
+  env:
+    FOO: bar
+  routes:
+  - route: spark.example.com/api
+  - route: tcp.example.com:1024
+    protocol: tcp
+  services:
+  - db
+  - name: queue
+    parameters: {durable: true}
+  processes:
+  - type: worker
+    instances: 0
+    memory: 1G
+  sidecars:
+  - name: proxy
+    command: ./proxy
+    process_types: [web]
+`
+		})
+
+		It("reports only the unknown top level field", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(validationErrors).To(ConsistOf(ValidationError{
+				Line:         2,
+				Column:       1,
+				Field:        "defaults",
+				Message:      "unknown field",
+				UnknownField: true,
+			}))
+		})
+	})
+
+	When("a field is misspelled", func() {
+		BeforeEach(func() {
+			givenManifest = `applications:
+- name: spark
+  healthcheck-type: http
+  processes:
+  - type: web
+    memroy: 1G
+`
+		})
+
+		It("reports its position and suggests the right field", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(validationErrors).To(Equal([]ValidationError{
+				{
+					Line:         3,
+					Column:       3,
+					Field:        "applications[0].healthcheck-type",
+					Message:      "unknown field; did you mean 'health-check-type'?",
+					UnknownField: true,
+				},
+				{
+					Line:         6,
+					Column:       5,
+					Field:        "applications[0].processes[0].memroy",
+					Message:      "unknown field; did you mean 'memory'?",
+					UnknownField: true,
+				},
+			}))
+			Expect(validationErrors[0].Error()).To(Equal("3:3: applications[0].healthcheck-type: unknown field; did you mean 'health-check-type'?"))
+		})
+	})
+
+	When("a field that is no longer supported is used", func() {
+		BeforeEach(func() {
+			givenManifest = `applications:
+- name: spark
+  host: spark
+`
+		})
+
+		It("suggests its replacement", func() {
+			Expect(validationErrors).To(ConsistOf(ValidationError{
+				Line:         3,
+				Column:       3,
+				Field:        "applications[0].host",
+				Message:      "unknown field; 'host' is no longer supported, use 'routes' instead",
+				UnknownField: true,
+			}))
+		})
+	})
+
+	When("values do not match the schema", func() {
+		BeforeEach(func() {
+			givenManifest = `applications:
+- instances: two
+  memory: 256
+  no-route: maybe
+  health-check-type: tcp
+  routes:
+  - route: https://spark.example.com
+  - route: spark
+  - protocol: http1
+  processes:
+  - memory: 1G
+  sidecars:
+  - name: proxy
+    command: ./proxy
+    process_types: []
+`
+		})
+
+		It("reports every problem", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			var messages []string
+			for _, validationError := range validationErrors {
+				messages = append(messages, validationError.Error())
+				Expect(validationError.UnknownField).To(BeFalse())
+			}
+			Expect(messages).To(Equal([]string{
+				"2:3: applications[0]: missing required field 'name'",
+				"2:14: applications[0].instances: must be a whole number",
+				"3:11: applications[0].memory: must be an amount with a unit of B, K, KB, M, MB, G, GB, T or TB (e.g. 256M, 1G)",
+				"4:13: applications[0].no-route: must be true or false",
+				"5:22: applications[0].health-check-type: must be one of: port, process, http, none",
+				"7:12: applications[0].routes[0].route: must not include a scheme such as http://",
+				"8:12: applications[0].routes[1].route: must be a host and domain with an optional port or path (e.g. my-app.example.com/path)",
+				"9:5: applications[0].routes[2]: missing required field 'route'",
+				"11:5: applications[0].processes[0]: missing required field 'type'",
+				"15:20: applications[0].sidecars[0].process_types: must have at least 1 item(s)",
+			}))
+		})
+	})
+
+	When("an HTTP endpoint is set for a health check that is not HTTP", func() {
+		BeforeEach(func() {
+			givenManifest = `applications:
+- name: spark
+  health-check-type: port
+  health-check-http-endpoint: /health
+  readiness-health-check-type: http
+  readiness-health-check-http-endpoint: /ready
+`
+		})
+
+		It("reports the combination", func() {
+			Expect(validationErrors).To(ConsistOf(ValidationError{
+				Line:    4,
+				Column:  31,
+				Field:   "applications[0].health-check-http-endpoint",
+				Message: "requires 'health-check-type: http', but it is 'port'",
+			}))
+		})
+	})
+
+	When("docker is used with buildpacks", func() {
+		BeforeEach(func() {
+			givenManifest = `applications:
+- name: spark
+  docker: {image: spark}
+  buildpacks: [go_buildpack]
+`
+		})
+
+		It("reports the combination", func() {
+			Expect(validationErrors).To(ConsistOf(ValidationError{
+				Line:    4,
+				Column:  15,
+				Field:   "applications[0].buildpacks",
+				Message: "cannot be used together with 'docker'",
+			}))
+		})
+	})
+
+	When("the instance steps are not increasing percentages", func() {
+		BeforeEach(func() {
+			givenManifest = `applications:
+- name: spark
+  strategy: canary
+  instance-steps: [10, 50, 40, 120]
+`
+		})
+
+		It("reports them", func() {
+			Expect(validationErrors).To(ConsistOf(ValidationError{
+				Line:    4,
+				Column:  19,
+				Field:   "applications[0].instance-steps",
+				Message: "must be increasing percentages between 1 and 100",
+			}))
+		})
+	})
+
+	When("instance steps are used with a strategy other than canary", func() {
+		BeforeEach(func() {
+			givenManifest = `applications:
+- name: spark
+  strategy: rolling
+  instance-steps: [10, 50]
+`
+		})
+
+		It("reports the combination", func() {
+			Expect(validationErrors).To(ConsistOf(ValidationError{
+				Line:    4,
+				Column:  19,
+				Field:   "applications[0].instance-steps",
+				Message: "requires 'strategy: canary', but it is 'rolling'",
+			}))
+		})
+	})
+
+	When("the manifest has no applications", func() {
+		BeforeEach(func() {
+			givenManifest = "version: 1\n"
+		})
+
+		It("reports it", func() {
+			Expect(validationErrors).To(ConsistOf(ValidationError{
+				Line:    1,
+				Column:  1,
+				Message: "Manifest must have at least one application.",
+			}))
+		})
+	})
+
+	When("the manifest is not valid YAML", func() {
+		BeforeEach(func() {
+			givenManifest = "applications:\n- name: spark\n  memory: [1G\n"
+		})
+
+		It("reports the line of the syntax error", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(validationErrors).To(HaveLen(1))
+			Expect(validationErrors[0].Line).To(BeNumerically(">", 0))
+			Expect(validationErrors[0].Message).To(HavePrefix("invalid YAML: "))
+		})
+	})
+
+	When("the manifest does not exist", func() {
+		JustBeforeEach(func() {
+			_, executeErr = parser.ValidateManifest("/does/not/exist/manifest.yml")
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(HaveOccurred())
+		})
+	})
+})
+
+var _ = Describe("ValidateRawManifest", func() {
+	var parser ManifestParser
+
+	It("checks the given manifest rather than a file", func() {
+		validationErrors, err := parser.ValidateRawManifest([]byte("applications:\n- name: spark\n  instances: many\n"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(validationErrors).To(HaveLen(1))
+		Expect(validationErrors[0].Line).To(Equal(3))
+		Expect(validationErrors[0].Field).To(Equal("applications[0].instances"))
+	})
+})
@@ -0,0 +1,12 @@
+package manifestparser
+
+import "fmt"
+
+type VariableSourceError struct {
+	Placeholder string
+	Err         error
+}
+
+func (e VariableSourceError) Error() string {
+	return fmt.Sprintf("Unable to resolve %s: %s", e.Placeholder, e.Err)
+}
@@ -0,0 +1,301 @@
+package manifestparser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"gopkg.in/yaml.v2"
+)
+
+// Variable sources resolve ((source:reference)) placeholders from somewhere
+// other than --var and --vars-file:
+//
+//	((env:NAME))     the value of the environment variable NAME
+//	((file:PATH))    the contents of the file at PATH, relative to the manifest
+//	((helper:NAME))  the value returned by the credential helper for NAME
+//
+// The credential helper is an executable that is run as `HELPER get NAME` and
+// writes a JSON object with a "value" field to stdout. Fields of a structured
+// value are selected with a path, as in ((helper:NAME.field)).
+const (
+	EnvVariableSource    = "env"
+	FileVariableSource   = "file"
+	HelperVariableSource = "helper"
+)
+
+// variableSourceNamePrefix starts the generated variable names, and the
+// markers that stand in for their values.
+const variableSourceNamePrefix = "__cf_variable_source_"
+
+var variableSourceRegexp = regexp.MustCompile(`\(\((env|file|helper):([^()\s]+)\)\)`)
+
+// variableSourceResolver replaces variable source placeholders with generated
+// variable names that the BOSH template interpolates, since the template only
+// supports plain variable names.
+type variableSourceResolver struct {
+	manifestDir      string
+	credentialHelper string
+
+	names   map[string]string
+	vars    template.StaticVariables
+	secrets []string
+}
+
+func newVariableSourceResolver(pathToManifest string, credentialHelper string) *variableSourceResolver {
+	return &variableSourceResolver{
+		manifestDir:      filepath.Dir(pathToManifest),
+		credentialHelper: credentialHelper,
+		names:            map[string]string{},
+		vars:             template.StaticVariables{},
+	}
+}
+
+// rewrite replaces the placeholders in raw, a manifest or an ops file. Like
+// the BOSH template, it only looks at the parsed YAML, so placeholders that
+// only appear in comments are left alone and their sources are not read.
+func (r *variableSourceResolver) rewrite(raw []byte) ([]byte, error) {
+	var document interface{}
+	if yaml.Unmarshal(raw, &document) != nil {
+		// The template reports the YAML error.
+		return raw, nil
+	}
+	placeholders := map[string]bool{}
+	findPlaceholders(document, placeholders)
+
+	var resolveErr error
+	rewritten := variableSourceRegexp.ReplaceAllFunc(raw, func(match []byte) []byte {
+		if !placeholders[string(match)] {
+			return match
+		}
+
+		submatches := variableSourceRegexp.FindSubmatch(match)
+		source, reference := string(submatches[1]), string(submatches[2])
+
+		var fieldPath string
+		if source == HelperVariableSource {
+			if i := strings.Index(reference, "."); i >= 0 {
+				reference, fieldPath = reference[:i], reference[i:]
+			}
+		}
+
+		key := source + ":" + reference
+		name, resolved := r.names[key]
+		if !resolved {
+			value, err := r.resolve(source, reference)
+			if err != nil {
+				if resolveErr == nil {
+					resolveErr = VariableSourceError{Placeholder: string(match), Err: err}
+				}
+				return match
+			}
+
+			name = fmt.Sprintf("%s%d", variableSourceNamePrefix, len(r.names))
+			r.names[key] = name
+			r.vars[name] = value
+			r.secrets = append(r.secrets, secretStrings(value)...)
+		}
+		return []byte("((" + name + fieldPath + "))")
+	})
+	return rewritten, resolveErr
+}
+
+// findPlaceholders adds the placeholders in the keys and values of a parsed
+// YAML document to placeholders.
+func findPlaceholders(document interface{}, placeholders map[string]bool) {
+	switch typedDocument := document.(type) {
+	case string:
+		for _, match := range variableSourceRegexp.FindAllString(typedDocument, -1) {
+			placeholders[match] = true
+		}
+	case map[interface{}]interface{}:
+		for key, value := range typedDocument {
+			findPlaceholders(key, placeholders)
+			findPlaceholders(value, placeholders)
+		}
+	case []interface{}:
+		for _, value := range typedDocument {
+			findPlaceholders(value, placeholders)
+		}
+	}
+}
+
+// withMarkers returns a resolver for the same placeholders whose values are
+// markers, so that the fields the values end up in can be found.
+func (r *variableSourceResolver) withMarkers() *variableSourceResolver {
+	markers := *r
+	markers.vars = template.StaticVariables{}
+	for name, value := range r.vars {
+		markers.vars[name] = markerValue(name, value)
+	}
+	return &markers
+}
+
+func (r *variableSourceResolver) resolve(source string, reference string) (interface{}, error) {
+	switch source {
+	case EnvVariableSource:
+		value, set := os.LookupEnv(reference)
+		if !set {
+			return nil, fmt.Errorf("environment variable %s is not set", reference)
+		}
+		return value, nil
+
+	case FileVariableSource:
+		path := reference
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(r.manifestDir, path)
+		}
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return strings.TrimSuffix(string(contents), "\n"), nil
+
+	default:
+		return r.runCredentialHelper(reference)
+	}
+}
+
+func (r *variableSourceResolver) runCredentialHelper(name string) (interface{}, error) {
+	if r.credentialHelper == "" {
+		return nil, fmt.Errorf("no credential helper is configured; set CF_MANIFEST_VARS_HELPER to its path")
+	}
+
+	var stdout, stderr bytes.Buffer
+	helper := exec.Command(r.credentialHelper, "get", name)
+	helper.Stdout = &stdout
+	helper.Stderr = &stderr
+	err := helper.Run()
+	if err != nil {
+		return nil, fmt.Errorf("credential helper %s failed: %s %s", r.credentialHelper, err, strings.TrimSpace(stderr.String()))
+	}
+
+	var response struct {
+		Value *interface{} `json:"value"`
+	}
+	err = json.Unmarshal(stdout.Bytes(), &response)
+	if err != nil || response.Value == nil {
+		return nil, fmt.Errorf("credential helper %s did not return a JSON object with a value", r.credentialHelper)
+	}
+	return yamlValue(*response.Value), nil
+}
+
+// yamlValue converts JSON objects to the map type that the BOSH template
+// looks up paths in.
+func yamlValue(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		converted := make(map[interface{}]interface{}, len(typedValue))
+		for key, nestedValue := range typedValue {
+			converted[key] = yamlValue(nestedValue)
+		}
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, len(typedValue))
+		for i, nestedValue := range typedValue {
+			converted[i] = yamlValue(nestedValue)
+		}
+		return converted
+	}
+	return value
+}
+
+// secretStrings returns the strings in a resolved value, which are redacted
+// wherever the manifest is displayed.
+func secretStrings(value interface{}) []string {
+	switch typedValue := value.(type) {
+	case string:
+		if typedValue == "" {
+			return nil
+		}
+		return []string{typedValue}
+	case map[interface{}]interface{}:
+		var secrets []string
+		for _, nestedValue := range typedValue {
+			secrets = append(secrets, secretStrings(nestedValue)...)
+		}
+		return secrets
+	case []interface{}:
+		var secrets []string
+		for _, nestedValue := range typedValue {
+			secrets = append(secrets, secretStrings(nestedValue)...)
+		}
+		return secrets
+	}
+	return nil
+}
+
+// markerValue returns value with every leaf replaced by the name of its
+// variable, keeping the structure that placeholders select fields from.
+func markerValue(name string, value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case map[interface{}]interface{}:
+		marked := make(map[interface{}]interface{}, len(typedValue))
+		for key, nestedValue := range typedValue {
+			marked[key] = markerValue(name, nestedValue)
+		}
+		return marked
+	case []interface{}:
+		marked := make([]interface{}, len(typedValue))
+		for i, nestedValue := range typedValue {
+			marked[i] = markerValue(name, nestedValue)
+		}
+		return marked
+	}
+	return name
+}
+
+// interpolatedValues returns the strings in rawManifest at the positions where
+// markedManifest, the same manifest interpolated with markers, has a marker.
+func interpolatedValues(markedManifest []byte, rawManifest []byte) []string {
+	var marked, raw interface{}
+	if yaml.Unmarshal(markedManifest, &marked) != nil || yaml.Unmarshal(rawManifest, &raw) != nil {
+		return nil
+	}
+
+	var values []string
+	seen := map[string]bool{}
+	for _, value := range findInterpolatedValues(marked, raw) {
+		if !seen[value] {
+			seen[value] = true
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func findInterpolatedValues(marked interface{}, raw interface{}) []string {
+	switch typedMarked := marked.(type) {
+	case string:
+		if strings.Contains(typedMarked, variableSourceNamePrefix) {
+			return secretStrings(raw)
+		}
+	case map[interface{}]interface{}:
+		rawMap, ok := raw.(map[interface{}]interface{})
+		if !ok {
+			return nil
+		}
+		var values []string
+		for key, nestedMarked := range typedMarked {
+			values = append(values, findInterpolatedValues(nestedMarked, rawMap[key])...)
+		}
+		return values
+	case []interface{}:
+		rawSlice, ok := raw.([]interface{})
+		if !ok || len(rawSlice) != len(typedMarked) {
+			return nil
+		}
+		var values []string
+		for i, nestedMarked := range typedMarked {
+			values = append(values, findInterpolatedValues(nestedMarked, rawSlice[i])...)
+		}
+		return values
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	plugin_transition "code.cloudfoundry.org/cli/plugin/transition"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -28,6 +29,7 @@ type UI interface {
 }
 
 func IsPluginCommand(osArgs []string) (configv3.Plugin, bool) {
+	osArgs = withoutGlobalContextFlag(osArgs)
 	if len(osArgs) < 1 {
 		return configv3.Plugin{}, false
 	}
@@ -47,6 +49,19 @@ func IsPluginCommand(osArgs []string) (configv3.Plugin, bool) {
 	return configv3.Plugin{}, false
 }
 
+// withoutGlobalContextFlag removes a --context flag given before the plugin
+// command name. The legacy CLI that runs the plugin applies it.
+func withoutGlobalContextFlag(osArgs []string) []string {
+	switch {
+	case len(osArgs) > 1 && osArgs[0] == "--context":
+		return osArgs[2:]
+	case len(osArgs) > 0 && strings.HasPrefix(osArgs[0], "--context="):
+		return osArgs[1:]
+	default:
+		return osArgs
+	}
+}
+
 func PluginCommandNames() []string {
 	var names []string
 
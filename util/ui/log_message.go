@@ -25,14 +25,26 @@ type LogMessage interface {
 
 // DisplayLogMessage formats and outputs a given log message.
 func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
+	ui.displayLogMessage("", message, displayHeader)
+}
+
+// DisplayAppLogMessage formats and outputs a given log message, prefixing
+// every line with the name of the app that emitted it. It is used when logs
+// of several apps are interleaved.
+func (ui *UI) DisplayAppLogMessage(appName string, message LogMessage, displayHeader bool) {
+	ui.displayLogMessage(fmt.Sprintf("[%s] ", appName), message, displayHeader)
+}
+
+func (ui *UI) displayLogMessage(prefix string, message LogMessage, displayHeader bool) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
-	var header string
+	header := prefix
 	if displayHeader {
 		time := message.Timestamp().In(ui.TimezoneLocation).Format(LogTimestampFormat)
 
-		header = fmt.Sprintf("%s [%s/%s] %s ",
+		header = fmt.Sprintf("%s%s [%s/%s] %s ",
+			prefix,
 			time,
 			message.SourceType(),
 			message.SourceInstance(),
@@ -45,6 +57,6 @@ func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
 		if message.Type() == "ERR" {
 			logLine = ui.modifyColor(logLine, color.New(color.FgRed))
 		}
-		fmt.Fprintf(ui.Out, "   %s\n", logLine)
+		fmt.Fprintf(ui.textOut(), "   %s\n", logLine)
 	}
 }
@@ -115,4 +115,35 @@ var _ = Describe("Log Message", func() {
 			})
 		})
 	})
+
+	Describe("DisplayAppLogMessage", func() {
+		var message *uifakes.FakeLogMessage
+
+		BeforeEach(func() {
+			var err error
+			ui.TimezoneLocation, err = time.LoadLocation("America/Los_Angeles")
+			Expect(err).NotTo(HaveOccurred())
+
+			message = new(uifakes.FakeLogMessage)
+			message.MessageReturns("This is a log message\nThis is also a log message")
+			message.TypeReturns("OUT")
+			message.TimestampReturns(time.Unix(1468969692, 0)) // "2016-07-19T16:08:12-07:00"
+			message.SourceTypeReturns("APP/PROC/WEB")
+			message.SourceInstanceReturns("12")
+		})
+
+		It("prefixes every line with the app name", func() {
+			ui.DisplayAppLogMessage("some-app", message, true)
+			Expect(out).To(Say(`\[some-app\] 2016-07-19T16:08:12.00-0700 \[APP/PROC/WEB/12\] OUT This is a log message\n`))
+			Expect(out).To(Say(`\[some-app\] 2016-07-19T16:08:12.00-0700 \[APP/PROC/WEB/12\] OUT This is also a log message\n`))
+		})
+
+		Context("without header", func() {
+			It("prefixes every line with the app name only", func() {
+				ui.DisplayAppLogMessage("some-app", message, false)
+				Expect(out).To(Say(`   \[some-app\] This is a log message\n`))
+				Expect(out).To(Say(`   \[some-app\] This is also a log message\n`))
+			})
+		})
+	})
 })
@@ -24,7 +24,7 @@ func newRequestLoggerTerminalDisplay(ui *UI, lock *sync.Mutex) *RequestLoggerTer
 }
 
 func (display *RequestLoggerTerminalDisplay) DisplayBody([]byte) error {
-	fmt.Fprintf(display.ui.Out, "%s\n", RedactedValue)
+	fmt.Fprintf(display.ui.textOut(), "%s\n", RedactedValue)
 	return nil
 }
 
@@ -32,17 +32,17 @@ func (display *RequestLoggerTerminalDisplay) DisplayDump(dump string) error {
 	sanitized := display.dumpSanitizer.ReplaceAllString(dump, RedactedValue)
 	cookieCutter := regexp.MustCompile("Set-Cookie:.*")
 	sanitized = cookieCutter.ReplaceAllString(sanitized, "Set-Cookie: "+RedactedValue)
-	fmt.Fprintf(display.ui.Out, "%s\n", sanitized)
+	fmt.Fprintf(display.ui.textOut(), "%s\n", sanitized)
 	return nil
 }
 
 func (display *RequestLoggerTerminalDisplay) DisplayHeader(name string, value string) error {
-	fmt.Fprintf(display.ui.Out, "%s: %s\n", display.ui.TranslateText(name), value)
+	fmt.Fprintf(display.ui.textOut(), "%s: %s\n", display.ui.TranslateText(name), value)
 	return nil
 }
 
 func (display *RequestLoggerTerminalDisplay) DisplayHost(name string) error {
-	fmt.Fprintf(display.ui.Out, "%s: %s\n", display.ui.TranslateText("Host"), name)
+	fmt.Fprintf(display.ui.textOut(), "%s: %s\n", display.ui.TranslateText("Host"), name)
 	return nil
 }
 
@@ -53,33 +53,33 @@ func (display *RequestLoggerTerminalDisplay) DisplayJSONBody(body []byte) error
 
 	sanitized, err := SanitizeJSON(body)
 	if err != nil {
-		fmt.Fprintf(display.ui.Out, "%s\n", string(body))
+		fmt.Fprintf(display.ui.textOut(), "%s\n", string(body))
 		return nil
 	}
 
-	fmt.Fprintf(display.ui.Out, "%s\n", string(sanitized))
+	fmt.Fprintf(display.ui.textOut(), "%s\n", string(sanitized))
 
 	return nil
 }
 
 func (display *RequestLoggerTerminalDisplay) DisplayMessage(msg string) error {
-	fmt.Fprintf(display.ui.Out, "%s\n", msg)
+	fmt.Fprintf(display.ui.textOut(), "%s\n", msg)
 	return nil
 }
 
 func (display *RequestLoggerTerminalDisplay) DisplayRequestHeader(method string, uri string, httpProtocol string) error {
-	fmt.Fprintf(display.ui.Out, "%s %s %s\n", method, uri, httpProtocol)
+	fmt.Fprintf(display.ui.textOut(), "%s %s %s\n", method, uri, httpProtocol)
 	return nil
 }
 
 func (display *RequestLoggerTerminalDisplay) DisplayResponseHeader(httpProtocol string, status string) error {
-	fmt.Fprintf(display.ui.Out, "%s %s\n", httpProtocol, status)
+	fmt.Fprintf(display.ui.textOut(), "%s %s\n", httpProtocol, status)
 	return nil
 }
 
 func (display *RequestLoggerTerminalDisplay) DisplayType(name string, requestDate time.Time) error {
 	text := fmt.Sprintf("%s: [%s]", name, requestDate.Format(time.RFC3339))
-	fmt.Fprintf(display.ui.Out, "%s\n", display.ui.modifyColor(display.ui.TranslateText(text), color.New(color.Bold)))
+	fmt.Fprintf(display.ui.textOut(), "%s\n", display.ui.modifyColor(display.ui.TranslateText(text), color.New(color.Bold)))
 	return nil
 }
 
@@ -93,7 +93,7 @@ func (display *RequestLoggerTerminalDisplay) Start() error {
 }
 
 func (display *RequestLoggerTerminalDisplay) Stop() error {
-	fmt.Fprintf(display.ui.Out, "\n")
+	fmt.Fprintf(display.ui.textOut(), "\n")
 	display.lock.Unlock()
 	return nil
 }
@@ -0,0 +1,258 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	"gopkg.in/yaml.v2"
+)
+
+// SetOutputFormat switches the UI to display the results of a command as
+// structured data. The data passed to DisplayStructuredData is written to
+// ui.Out as a single JSON or YAML document when FlushDeferred is called, and
+// the text, tables and prompts meant for people are displayed on ui.Err so
+// that ui.Out stays parseable.
+func (ui *UI) SetOutputFormat(format configv3.OutputFormat) {
+	ui.outputFormat = format
+}
+
+// DisplayStructuredData records the data a command displays, such as the
+// summaries returned by the actor, to be written as JSON or YAML when an
+// output format is set. It displays nothing otherwise.
+//
+// Struct fields are named after their JSON tags, or their field names in
+// snake case, and embedded structs are flattened. Empty lists are written as
+// empty lists rather than null.
+func (ui *UI) DisplayStructuredData(data interface{}) {
+	if !ui.hasStructuredOutput() {
+		return
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	ui.structuredData = append(ui.structuredData, structuredValue(reflect.ValueOf(data)))
+}
+
+// DisplayStructuredLine writes data to ui.Out straight away as a single line
+// of JSON, for commands such as logs that stream their results instead of
+// displaying them as one document.
+func (ui *UI) DisplayStructuredLine(data interface{}) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	fmt.Fprintf(ui.Out, "%s\n", raw)
+	return nil
+}
+
+func (ui *UI) hasStructuredOutput() bool {
+	return ui.outputFormat == configv3.OutputFormatJSON || ui.outputFormat == configv3.OutputFormatYAML
+}
+
+// textOut is where text meant for people is displayed.
+func (ui *UI) textOut() io.Writer {
+	if ui.hasStructuredOutput() {
+		return ui.Err
+	}
+	return ui.Out
+}
+
+// flushStructuredData writes the recorded structured data to ui.Out. Data
+// recorded once is written as is, data recorded several times is written as
+// a list. It must be called with the terminal lock held.
+func (ui *UI) flushStructuredData() {
+	if !ui.hasStructuredOutput() || len(ui.structuredData) == 0 {
+		return
+	}
+
+	var document interface{} = ui.structuredData
+	if len(ui.structuredData) == 1 {
+		document = ui.structuredData[0]
+	}
+	ui.structuredData = nil
+
+	var (
+		raw []byte
+		err error
+	)
+	switch ui.outputFormat {
+	case configv3.OutputFormatJSON:
+		buff := new(bytes.Buffer)
+		encoder := json.NewEncoder(buff)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(document)
+		raw = buff.Bytes()
+	case configv3.OutputFormatYAML:
+		raw, err = yaml.Marshal(document)
+	}
+
+	if err != nil {
+		fmt.Fprintf(ui.Err, "%s\n", err)
+		return
+	}
+
+	fmt.Fprint(ui.Out, string(raw))
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// structuredValue converts a value into maps, lists and plain values that
+// encode the same way as JSON and YAML. The MarshalJSON methods of resources
+// are skipped, since they encode requests to the API rather than what the
+// CLI displays.
+func structuredValue(value reflect.Value) interface{} {
+	switch value.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return structuredValue(value.Elem())
+	case reflect.Struct:
+		return structuredStruct(value)
+	case reflect.Map:
+		record := make(map[string]interface{}, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			record[fmt.Sprint(iter.Key().Interface())] = structuredValue(iter.Value())
+		}
+		return record
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Uint8 {
+			if value.IsNil() {
+				return nil
+			}
+			return structuredBytes(value.Bytes())
+		}
+		list := make([]interface{}, value.Len())
+		for i := range list {
+			list[i] = structuredValue(value.Index(i))
+		}
+		return list
+	case reflect.String:
+		return value.String()
+	case reflect.Bool:
+		return value.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return value.Uint()
+	case reflect.Float32, reflect.Float64:
+		return value.Float()
+	default:
+		return fmt.Sprint(value.Interface())
+	}
+}
+
+func structuredStruct(value reflect.Value) interface{} {
+	if value.Type() == timeType {
+		t := value.Interface().(time.Time)
+		if t.IsZero() {
+			return nil
+		}
+		return t.Format(time.RFC3339)
+	}
+
+	// types.NullInt, types.NullString and the like are their value when set.
+	if isNullType(value.Type()) {
+		if !value.FieldByName("IsSet").Bool() {
+			return nil
+		}
+		return structuredValue(value.FieldByName("Value"))
+	}
+
+	record := map[string]interface{}{}
+	addStructFields(record, value)
+	return record
+}
+
+func isNullType(structType reflect.Type) bool {
+	if structType.NumField() != 2 {
+		return false
+	}
+	isSet, hasIsSet := structType.FieldByName("IsSet")
+	_, hasValue := structType.FieldByName("Value")
+	return hasIsSet && hasValue && isSet.Type.Kind() == reflect.Bool
+}
+
+// addStructFields adds the exported fields of the struct to the record.
+// Fields of embedded structs are added as if they were fields of the struct,
+// unless the struct has a field of the same name.
+func addStructFields(record map[string]interface{}, value reflect.Value) {
+	var embedded []reflect.Value
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		fieldValue := value.Field(i)
+		if field.Anonymous {
+			for fieldValue.Kind() == reflect.Ptr && !fieldValue.IsNil() {
+				fieldValue = fieldValue.Elem()
+			}
+			if fieldValue.Kind() == reflect.Struct {
+				embedded = append(embedded, fieldValue)
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+
+		record[structuredKey(field)] = structuredValue(fieldValue)
+	}
+
+	for _, embeddedValue := range embedded {
+		embeddedRecord := map[string]interface{}{}
+		addStructFields(embeddedRecord, embeddedValue)
+		for key, fieldValue := range embeddedRecord {
+			if _, exists := record[key]; !exists {
+				record[key] = fieldValue
+			}
+		}
+	}
+}
+
+// structuredKey names a field after its JSON tag, or converts its name to
+// snake case, as in "process_summaries" for ProcessSummaries.
+func structuredKey(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name != "" && name != "-" {
+		return name
+	}
+
+	runes := []rune(field.Name)
+	var key strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			previousIsLower := unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if previousIsLower || (unicode.IsUpper(runes[i-1]) && nextIsLower) {
+				key.WriteRune('_')
+			}
+		}
+		key.WriteRune(unicode.ToLower(r))
+	}
+	return key.String()
+}
+
+// structuredBytes decodes raw JSON, such as service parameters, and returns
+// other bytes as a string.
+func structuredBytes(raw []byte) interface{} {
+	var decoded interface{}
+	if json.Unmarshal(raw, &decoded) == nil {
+		return decoded
+	}
+	return string(raw)
+}
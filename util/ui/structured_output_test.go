@@ -0,0 +1,200 @@
+package ui_test
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/util/ui/uifakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Structured output", func() {
+	var (
+		ui         *UI
+		fakeConfig *uifakes.FakeConfig
+		out        *Buffer
+		errBuff    *Buffer
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(uifakes.FakeConfig)
+		fakeConfig.ColorEnabledReturns(configv3.ColorDisabled)
+
+		var err error
+		ui, err = NewUI(fakeConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		out = NewBuffer()
+		ui.Out = out
+		ui.OutForInteraction = out
+		errBuff = NewBuffer()
+		ui.Err = errBuff
+	})
+
+	type process struct {
+		Type      string
+		Instances types.NullInt
+	}
+
+	type app struct {
+		GUID      string `json:"guid"`
+		Name      string
+		State     constant.ApplicationState
+		CreatedAt time.Time
+		Memory    types.NullUint64
+	}
+
+	type appSummary struct {
+		app
+		ProcessSummaries []process
+		Routes           []string
+		Parameters       json.RawMessage
+	}
+
+	When("no output format is set", func() {
+		BeforeEach(func() {
+			ui.SetOutputFormat(configv3.OutputFormatDefault)
+		})
+
+		It("displays tables and text to ui.Out and no structured data", func() {
+			ui.DisplayText("Getting apps...")
+			ui.DisplayTableWithHeader("", [][]string{{"name", "requested state"}, {"dora", "started"}}, 3)
+			ui.DisplayStructuredData([]appSummary{{app: app{Name: "dora"}}})
+			ui.FlushDeferred()
+
+			Expect(out).To(Say("Getting apps..."))
+			Expect(out).To(Say(`name\s+requested state`))
+			Expect(out).To(Say(`dora\s+started`))
+			Expect(out).NotTo(Say("process_summaries"))
+		})
+	})
+
+	When("the output format is json", func() {
+		BeforeEach(func() {
+			ui.SetOutputFormat(configv3.OutputFormatJSON)
+		})
+
+		It("displays text and tables to ui.Err and leaves ui.Out as it is", func() {
+			ui.DisplayText("Getting apps...")
+			ui.DisplayTableWithHeader("", [][]string{{"name"}, {"dora"}}, 3)
+			ui.DisplayNonWrappingTable("", [][]string{{"route:", "dora.example.com"}}, 3)
+			ui.DisplayOK()
+
+			Expect(errBuff).To(Say("Getting apps..."))
+			Expect(errBuff).To(Say("dora"))
+			Expect(errBuff).To(Say("dora.example.com"))
+			Expect(errBuff).To(Say("OK"))
+			Expect(ui.GetOut()).To(Equal(out))
+			Expect(out.Contents()).To(BeEmpty())
+		})
+
+		It("writes the structured data when the command finishes", func() {
+			ui.DisplayStructuredData([]appSummary{
+				{
+					app: app{
+						GUID:      "some-guid",
+						Name:      "dora",
+						State:     constant.ApplicationStarted,
+						CreatedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+						Memory:    types.NullUint64{Value: 32, IsSet: true},
+					},
+					ProcessSummaries: []process{{Type: "web", Instances: types.NullInt{Value: 2, IsSet: true}}},
+					Parameters:       json.RawMessage(`{"some-key": "some-value"}`),
+				},
+			})
+			Expect(out.Contents()).To(BeEmpty())
+
+			ui.FlushDeferred()
+			Expect(out.Contents()).To(MatchJSON(`[
+				{
+					"guid": "some-guid",
+					"name": "dora",
+					"state": "STARTED",
+					"created_at": "2026-01-02T03:04:05Z",
+					"memory": 32,
+					"process_summaries": [{"type": "web", "instances": 2}],
+					"routes": [],
+					"parameters": {"some-key": "some-value"}
+				}
+			]`))
+		})
+
+		It("writes empty lists as empty lists", func() {
+			var summaries []appSummary
+			ui.DisplayStructuredData(summaries)
+			ui.FlushDeferred()
+
+			Expect(out.Contents()).To(MatchJSON(`[]`))
+		})
+
+		It("writes data displayed several times as a list", func() {
+			ui.DisplayStructuredData(process{Type: "web"})
+			ui.DisplayStructuredData([]string{"a"})
+			ui.FlushDeferred()
+
+			Expect(out.Contents()).To(MatchJSON(`[
+				{"type": "web", "instances": null},
+				["a"]
+			]`))
+		})
+
+		It("displays log messages to ui.Err so that ui.Out only holds the data", func() {
+			message := new(uifakes.FakeLogMessage)
+			message.MessageReturns("Staging app...")
+			message.TypeReturns("OUT")
+
+			ui.DisplayLogMessage(message, false)
+			ui.DisplayStructuredData([]string{"dora"})
+			ui.FlushDeferred()
+
+			Expect(errBuff).To(Say("Staging app..."))
+			Expect(out.Contents()).To(MatchJSON(`["dora"]`))
+		})
+
+		It("writes structured lines to ui.Out straight away", func() {
+			Expect(ui.DisplayStructuredLine(map[string]string{"message": "Staging app..."})).To(Succeed())
+			Expect(ui.DisplayStructuredLine(map[string]string{"message": "Staging complete"})).To(Succeed())
+
+			Expect(string(out.Contents())).To(Equal(`{"message":"Staging app..."}` + "\n" + `{"message":"Staging complete"}` + "\n"))
+		})
+
+		It("does not write anything when the command fails", func() {
+			ui.DisplayStructuredData([]string{"dora"})
+			ui.DisplayError(errors.New("some-error"))
+			ui.FlushDeferred()
+
+			Expect(errBuff).To(Say("some-error"))
+			Expect(errBuff).To(Say("FAILED"))
+			Expect(out.Contents()).To(BeEmpty())
+		})
+	})
+
+	When("the output format is yaml", func() {
+		BeforeEach(func() {
+			ui.SetOutputFormat(configv3.OutputFormatYAML)
+		})
+
+		It("writes the structured data as yaml", func() {
+			ui.DisplayStructuredData([]appSummary{{app: app{Name: "dora", State: constant.ApplicationStopped}}})
+			ui.FlushDeferred()
+
+			Expect(out.Contents()).To(MatchYAML(`
+- guid: ""
+  name: dora
+  state: STOPPED
+  created_at: null
+  memory: null
+  process_summaries: []
+  routes: []
+  parameters: null
+`))
+		})
+	})
+})
@@ -65,16 +65,16 @@ func (ui *UI) DisplayNonWrappingTable(prefix string, table [][]string, padding i
 	}
 
 	for row := 0; row < rows; row++ {
-		fmt.Fprint(ui.Out, prefix)
+		fmt.Fprint(ui.textOut(), prefix)
 		for col := 0; col < columns; col++ {
 			data := table[row][col]
 			var addedPadding int
 			if col+1 != columns {
 				addedPadding = columnPadding[col] - wordSize(data)
 			}
-			fmt.Fprintf(ui.Out, "%s%s", data, strings.Repeat(" ", addedPadding))
+			fmt.Fprintf(ui.textOut(), "%s%s", data, strings.Repeat(" ", addedPadding))
 		}
-		fmt.Fprintf(ui.Out, "\n")
+		fmt.Fprintf(ui.textOut(), "\n")
 	}
 }
 
@@ -84,6 +84,7 @@ func (ui *UI) DisplayTableWithHeader(prefix string, table [][]string, padding in
 	if len(table) == 0 {
 		return
 	}
+
 	for i, str := range table[0] {
 		table[0][i] = ui.modifyColor(str, color.New(color.Bold))
 	}
@@ -96,6 +96,9 @@ type UI struct {
 	TimezoneLocation *time.Location
 
 	deferred []string
+
+	outputFormat   configv3.OutputFormat
+	structuredData []interface{}
 }
 
 // NewUI will return a UI object where Out is set to STDOUT, In is set to
@@ -204,7 +207,8 @@ func (ui *UI) DisplayError(err error) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
-	fmt.Fprintf(ui.Out, "%s\n", ui.modifyColor(ui.TranslateText("FAILED"), color.New(color.FgRed, color.Bold)))
+	ui.structuredData = nil
+	fmt.Fprintf(ui.textOut(), "%s\n", ui.modifyColor(ui.TranslateText("FAILED"), color.New(color.FgRed, color.Bold)))
 }
 
 func (ui *UI) DisplayFileDeprecationWarning() {
@@ -220,7 +224,7 @@ func (ui *UI) DisplayHeader(text string) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
-	fmt.Fprintf(ui.Out, "%s\n", ui.modifyColor(ui.TranslateText(text), color.New(color.Bold)))
+	fmt.Fprintf(ui.textOut(), "%s\n", ui.modifyColor(ui.TranslateText(text), color.New(color.Bold)))
 }
 
 // DisplayNewline outputs a newline to UI.Out.
@@ -228,7 +232,7 @@ func (ui *UI) DisplayNewline() {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
-	fmt.Fprintf(ui.Out, "\n")
+	fmt.Fprintf(ui.textOut(), "\n")
 }
 
 // DisplayOK outputs a bold green translated "OK" to UI.Out.
@@ -236,7 +240,7 @@ func (ui *UI) DisplayOK() {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
-	fmt.Fprintf(ui.Out, "%s\n\n", ui.modifyColor(ui.TranslateText("OK"), color.New(color.FgGreen, color.Bold)))
+	fmt.Fprintf(ui.textOut(), "%s\n\n", ui.modifyColor(ui.TranslateText("OK"), color.New(color.FgGreen, color.Bold)))
 }
 
 // DisplayText translates the template, substitutes in templateValues, and
@@ -245,7 +249,7 @@ func (ui *UI) DisplayText(template string, templateValues ...map[string]interfac
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
-	fmt.Fprintf(ui.Out, "%s\n", ui.TranslateText(template, templateValues...))
+	fmt.Fprintf(ui.textOut(), "%s\n", ui.TranslateText(template, templateValues...))
 }
 
 // DisplayTextLiteral outputs the text to ui.Out without modification.
@@ -254,7 +258,7 @@ func (ui *UI) DisplayTextLiteral(text string) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
-	fmt.Fprintf(ui.Out, "%s\n", text)
+	fmt.Fprintf(ui.textOut(), "%s\n", text)
 }
 
 // DisplayTextWithBold translates the template, bolds the templateValues,
@@ -268,7 +272,7 @@ func (ui *UI) DisplayTextWithBold(template string, templateValues ...map[string]
 	for key, value := range firstTemplateValues {
 		firstTemplateValues[key] = ui.modifyColor(fmt.Sprint(value), color.New(color.Bold))
 	}
-	fmt.Fprintf(ui.Out, "%s\n", ui.TranslateText(template, firstTemplateValues))
+	fmt.Fprintf(ui.textOut(), "%s\n", ui.TranslateText(template, firstTemplateValues))
 }
 
 // DisplayTextWithFlavor translates the template, bolds and adds cyan color to
@@ -282,7 +286,7 @@ func (ui *UI) DisplayTextWithFlavor(template string, templateValues ...map[strin
 	for key, value := range firstTemplateValues {
 		firstTemplateValues[key] = ui.modifyColor(fmt.Sprint(value), color.New(color.FgCyan, color.Bold))
 	}
-	fmt.Fprintf(ui.Out, "%s\n", ui.TranslateText(template, firstTemplateValues))
+	fmt.Fprintf(ui.textOut(), "%s\n", ui.TranslateText(template, firstTemplateValues))
 }
 
 // DisplayDiffAddition displays added lines in a diff, colored green and prefixed with '+'
@@ -302,7 +306,7 @@ func (ui *UI) DisplayDiffAddition(lines string, depth int, addHyphen bool) {
 		template := "+ " + indent + line
 		formatted := ui.modifyColor(template, color.New(color.FgGreen))
 
-		fmt.Fprintf(ui.Out, "%s\n", formatted)
+		fmt.Fprintf(ui.textOut(), "%s\n", formatted)
 	}
 }
 
@@ -323,7 +327,7 @@ func (ui *UI) DisplayDiffRemoval(lines string, depth int, addHyphen bool) {
 		template := "- " + indent + line
 		formatted := ui.modifyColor(template, color.New(color.FgRed))
 
-		fmt.Fprintf(ui.Out, "%s\n", formatted)
+		fmt.Fprintf(ui.textOut(), "%s\n", formatted)
 	}
 }
 
@@ -343,7 +347,7 @@ func (ui *UI) DisplayDiffUnchanged(lines string, depth int, addHyphen bool) {
 		}
 		template := "  " + indent + line
 
-		fmt.Fprintf(ui.Out, "%s\n", template)
+		fmt.Fprintf(ui.textOut(), "%s\n", template)
 	}
 }
 
@@ -364,24 +368,27 @@ func (ui *UI) DisplayJSON(name string, jsonData interface{}) error {
 	}
 
 	if name != "" {
-		fmt.Fprintf(ui.Out, "%s\n", fmt.Sprintf("%s: %s", name, buff))
+		fmt.Fprintf(ui.textOut(), "%s\n", fmt.Sprintf("%s: %s", name, buff))
 	} else {
-		fmt.Fprintf(ui.Out, "%s\n", buff)
+		fmt.Fprintf(ui.textOut(), "%s\n", buff)
 	}
 
 	return nil
 }
 
 // FlushDeferred displays text previously deferred (using DeferText) to the UI's
-// `Out`.
+// `Out`. When an output format is set, the collected structured data is
+// written afterwards.
 func (ui *UI) FlushDeferred() {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
 	for _, s := range ui.deferred {
-		fmt.Fprint(ui.Out, s)
+		fmt.Fprint(ui.textOut(), s)
 	}
 	ui.deferred = []string{}
+
+	ui.flushStructuredData()
 }
 
 // GetErr returns the error writer.
@@ -439,7 +446,7 @@ func (ui *UI) displayWrappingTableWithWidth(prefix string, table [][]string, pad
 	lastColumnWidth := ui.TerminalWidth - spilloverPadding
 
 	for row := 0; row < rows; row++ {
-		fmt.Fprint(ui.Out, prefix)
+		fmt.Fprint(ui.textOut(), prefix)
 
 		// for all columns except last, add cell value and padding
 		for col := 0; col < columns-1; col++ {
@@ -447,7 +454,7 @@ func (ui *UI) displayWrappingTableWithWidth(prefix string, table [][]string, pad
 			if col+1 != columns {
 				addedPadding = columnPadding[col] - runewidth.StringWidth(table[row][col])
 			}
-			fmt.Fprintf(ui.Out, "%s%s", table[row][col], strings.Repeat(" ", addedPadding))
+			fmt.Fprintf(ui.textOut(), "%s%s", table[row][col], strings.Repeat(" ", addedPadding))
 		}
 
 		// for last column, add each word individually. If the added word would make the column exceed terminal width, create a new line and add padding
@@ -459,17 +466,17 @@ func (ui *UI) displayWrappingTableWithWidth(prefix string, table [][]string, pad
 			switch {
 			case currentWidth == 0:
 				currentWidth = wordWidth
-				fmt.Fprintf(ui.Out, "%s", word)
+				fmt.Fprintf(ui.textOut(), "%s", word)
 			case (wordWidth + 1 + currentWidth) > lastColumnWidth:
-				fmt.Fprintf(ui.Out, "\n%s%s", strings.Repeat(" ", spilloverPadding), word)
+				fmt.Fprintf(ui.textOut(), "\n%s%s", strings.Repeat(" ", spilloverPadding), word)
 				currentWidth = wordWidth
 			default:
-				fmt.Fprintf(ui.Out, " %s", word)
+				fmt.Fprintf(ui.textOut(), " %s", word)
 				currentWidth += wordWidth + 1
 			}
 		}
 
-		fmt.Fprintf(ui.Out, "\n")
+		fmt.Fprintf(ui.textOut(), "\n")
 	}
 }
 
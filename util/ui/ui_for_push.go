@@ -99,11 +99,11 @@ func (ui UI) displayDiffForInt(offset string, header string, oldValue int, newVa
 		formattedNew := fmt.Sprintf("+ %s%s%d", ui.TranslateText(header), offset, newValue)
 
 		if oldValue != 0 {
-			fmt.Fprintln(ui.Out, ui.modifyColor(formattedOld, color.New(color.FgRed)))
+			fmt.Fprintln(ui.textOut(), ui.modifyColor(formattedOld, color.New(color.FgRed)))
 		}
-		fmt.Fprintln(ui.Out, ui.modifyColor(formattedNew, color.New(color.FgGreen)))
+		fmt.Fprintln(ui.textOut(), ui.modifyColor(formattedNew, color.New(color.FgGreen)))
 	} else {
-		fmt.Fprintf(ui.Out, "  %s%s%d\n", ui.TranslateText(header), offset, oldValue)
+		fmt.Fprintf(ui.textOut(), "  %s%s%d\n", ui.TranslateText(header), offset, oldValue)
 	}
 }
 
@@ -120,28 +120,28 @@ func (ui UI) displayDiffForMapStringString(offset string, header string, oldMap
 
 	sortedKeys := sortedUniqueArray(oldKeys, newKeys)
 
-	fmt.Fprintf(ui.Out, "  %s\n", ui.TranslateText(header))
+	fmt.Fprintf(ui.textOut(), "  %s\n", ui.TranslateText(header))
 	for _, key := range sortedKeys {
 		newVal, ok := newMap[key]
 		if !ok {
 			formattedOld := fmt.Sprintf("-   %s", key)
-			fmt.Fprintln(ui.Out, ui.modifyColor(formattedOld, color.New(color.FgRed)))
+			fmt.Fprintln(ui.textOut(), ui.modifyColor(formattedOld, color.New(color.FgRed)))
 			continue
 		}
 		oldVal, ok := oldMap[key]
 		if !ok {
 			formattedNew := fmt.Sprintf("+   %s", key)
-			fmt.Fprintln(ui.Out, ui.modifyColor(formattedNew, color.New(color.FgGreen)))
+			fmt.Fprintln(ui.textOut(), ui.modifyColor(formattedNew, color.New(color.FgGreen)))
 			continue
 		}
 
 		if oldVal == newVal {
-			fmt.Fprintf(ui.Out, "    %s\n", key)
+			fmt.Fprintf(ui.textOut(), "    %s\n", key)
 		} else {
 			formattedOld := fmt.Sprintf("-   %s", key)
-			fmt.Fprintln(ui.Out, ui.modifyColor(formattedOld, color.New(color.FgRed)))
+			fmt.Fprintln(ui.textOut(), ui.modifyColor(formattedOld, color.New(color.FgRed)))
 			formattedNew := fmt.Sprintf("+   %s", key)
-			fmt.Fprintln(ui.Out, ui.modifyColor(formattedNew, color.New(color.FgGreen)))
+			fmt.Fprintln(ui.textOut(), ui.modifyColor(formattedNew, color.New(color.FgGreen)))
 		}
 	}
 }
@@ -152,11 +152,11 @@ func (ui UI) displayDiffForNullInt(offset string, header string, oldValue types.
 		formattedNew := fmt.Sprintf("+ %s%s%d", ui.TranslateText(header), offset, newValue.Value)
 
 		if oldValue.IsSet {
-			fmt.Fprintln(ui.Out, ui.modifyColor(formattedOld, color.New(color.FgRed)))
+			fmt.Fprintln(ui.textOut(), ui.modifyColor(formattedOld, color.New(color.FgRed)))
 		}
-		fmt.Fprintln(ui.Out, ui.modifyColor(formattedNew, color.New(color.FgGreen)))
+		fmt.Fprintln(ui.textOut(), ui.modifyColor(formattedNew, color.New(color.FgGreen)))
 	} else {
-		fmt.Fprintf(ui.Out, "  %s%s%d\n", ui.TranslateText(header), offset, oldValue.Value)
+		fmt.Fprintf(ui.textOut(), "  %s%s%d\n", ui.TranslateText(header), offset, oldValue.Value)
 	}
 }
 
@@ -172,22 +172,22 @@ func (ui UI) displayDiffForString(offset string, header string, hiddenValue bool
 		}
 
 		if oVal != "" {
-			fmt.Fprintln(ui.Out, ui.modifyColor(formattedOld, color.New(color.FgRed)))
+			fmt.Fprintln(ui.textOut(), ui.modifyColor(formattedOld, color.New(color.FgRed)))
 		}
 		if nVal != "" {
-			fmt.Fprintln(ui.Out, ui.modifyColor(formattedNew, color.New(color.FgGreen)))
+			fmt.Fprintln(ui.textOut(), ui.modifyColor(formattedNew, color.New(color.FgGreen)))
 		}
 	} else {
 		if hiddenValue {
-			fmt.Fprintf(ui.Out, "  %s%s%s\n", ui.TranslateText(header), offset, RedactedValue)
+			fmt.Fprintf(ui.textOut(), "  %s%s%s\n", ui.TranslateText(header), offset, RedactedValue)
 		} else {
-			fmt.Fprintf(ui.Out, "  %s%s%s\n", ui.TranslateText(header), offset, oVal)
+			fmt.Fprintf(ui.textOut(), "  %s%s%s\n", ui.TranslateText(header), offset, oVal)
 		}
 	}
 }
 
 func (ui UI) displayDiffForStrings(offset string, header string, oldList []string, newList []string) {
-	fmt.Fprintf(ui.Out, "  %s\n", ui.TranslateText(header))
+	fmt.Fprintf(ui.textOut(), "  %s\n", ui.TranslateText(header))
 
 	fullList := sortedUniqueArray(oldList, newList)
 	for _, item := range fullList {
@@ -196,13 +196,13 @@ func (ui UI) displayDiffForStrings(offset string, header string, oldList []strin
 
 		switch {
 		case inOld && inNew:
-			fmt.Fprintf(ui.Out, "    %s\n", item)
+			fmt.Fprintf(ui.textOut(), "    %s\n", item)
 		case inOld:
 			formattedOld := fmt.Sprintf("-   %s", item)
-			fmt.Fprintln(ui.Out, ui.modifyColor(formattedOld, color.New(color.FgRed)))
+			fmt.Fprintln(ui.textOut(), ui.modifyColor(formattedOld, color.New(color.FgRed)))
 		default:
 			formattedNew := fmt.Sprintf("+   %s", item)
-			fmt.Fprintln(ui.Out, ui.modifyColor(formattedNew, color.New(color.FgGreen)))
+			fmt.Fprintln(ui.textOut(), ui.modifyColor(formattedNew, color.New(color.FgGreen)))
 		}
 	}
 }
@@ -213,11 +213,11 @@ func (ui UI) displayDiffForUint64(offset string, header string, oldValue uint64,
 		formattedNew := fmt.Sprintf("+ %s%s%d", ui.TranslateText(header), offset, newValue)
 
 		if oldValue != 0 {
-			fmt.Fprintln(ui.Out, ui.modifyColor(formattedOld, color.New(color.FgRed)))
+			fmt.Fprintln(ui.textOut(), ui.modifyColor(formattedOld, color.New(color.FgRed)))
 		}
-		fmt.Fprintln(ui.Out, ui.modifyColor(formattedNew, color.New(color.FgGreen)))
+		fmt.Fprintln(ui.textOut(), ui.modifyColor(formattedNew, color.New(color.FgGreen)))
 	} else {
-		fmt.Fprintf(ui.Out, "  %s%s%d\n", ui.TranslateText(header), offset, oldValue)
+		fmt.Fprintf(ui.textOut(), "  %s%s%d\n", ui.TranslateText(header), offset, oldValue)
 	}
 }
 